@@ -0,0 +1,141 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package http2
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"time"
+)
+
+const (
+	captureRead    = 'r'
+	captureWritten = 'w'
+
+	captureHeaderLen = 1 + 8
+)
+
+var errBadCaptureRecord = errors.New("http2: malformed capture record")
+
+// SetCapture makes the Framer record every frame it reads or writes
+// to w, in the format described by CaptureRecord. Capturing is
+// best effort: errors writing to w are ignored. A nil w stops capturing.
+//
+// Each record is written with a single call to w.Write. If frames are
+// read and written concurrently, w must be safe for concurrent use.
+func (fr *Framer) SetCapture(w io.Writer) {
+	fr.capture = w
+}
+
+func (fr *Framer) captureFrame(dir byte, hdr, payload []byte) {
+	buf := make([]byte, captureHeaderLen, captureHeaderLen+len(hdr)+len(payload))
+	buf[0] = dir
+	binary.BigEndian.PutUint64(buf[1:], uint64(time.Now().UnixNano()))
+	buf = append(buf, hdr...)
+	buf = append(buf, payload...)
+	fr.capture.Write(buf)
+}
+
+// A CaptureRecord is one frame of a capture made with Framer.SetCapture.
+//
+// A capture is a sequence of records, one per frame read or written
+// by a Framer. Each record is encoded as:
+//
+//	+---------------+
+//	| Direction (8) |  'r' if the frame was read, 'w' if written
+//	+---------------+-----------------------------------------------+
+//	|                        Timestamp (64)                         |
+//	+---------------------------------------------------------------+
+//	|                      Frame (72 + Length)                      |
+//	+---------------------------------------------------------------+
+//
+// The timestamp is a big-endian count of nanoseconds since the Unix
+// epoch. The frame is exactly as it appeared on the wire: the 9-byte
+// frame header followed by its payload.
+type CaptureRecord struct {
+	Time    time.Time
+	Written bool   // the frame was written by the Framer, not read
+	Frame   []byte // frame header and payload, as sent on the wire
+}
+
+// Header returns the header of the captured frame.
+func (r CaptureRecord) Header() (FrameHeader, error) {
+	if len(r.Frame) < frameHeaderLen {
+		return FrameHeader{}, errBadCaptureRecord
+	}
+	return readFrameHeader(make([]byte, frameHeaderLen), bytes.NewReader(r.Frame))
+}
+
+// ReadCaptureRecord reads the next record of a capture made with
+// Framer.SetCapture. It returns io.EOF if r is at the end of the
+// capture.
+func ReadCaptureRecord(r io.Reader) (CaptureRecord, error) {
+	var hdr [captureHeaderLen + frameHeaderLen]byte
+	if _, err := io.ReadFull(r, hdr[:1]); err != nil {
+		return CaptureRecord{}, err
+	}
+	if _, err := io.ReadFull(r, hdr[1:]); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return CaptureRecord{}, err
+	}
+	var rec CaptureRecord
+	switch hdr[0] {
+	case captureRead:
+	case captureWritten:
+		rec.Written = true
+	default:
+		return CaptureRecord{}, errBadCaptureRecord
+	}
+	rec.Time = time.Unix(0, int64(binary.BigEndian.Uint64(hdr[1:])))
+	fh := hdr[captureHeaderLen:]
+	length := uint32(fh[0])<<16 | uint32(fh[1])<<8 | uint32(fh[2])
+	rec.Frame = make([]byte, frameHeaderLen+int(length))
+	copy(rec.Frame, fh)
+	if _, err := io.ReadFull(r, rec.Frame[frameHeaderLen:]); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return CaptureRecord{}, err
+	}
+	return rec, nil
+}
+
+// NewCaptureReplayer returns a reader of the raw frames in capture
+// that were written by the capturing Framer (if written is true) or
+// read by it (if written is false). The result can be passed to
+// NewFramer to replay one side of a captured connection.
+func NewCaptureReplayer(capture io.Reader, written bool) io.Reader {
+	return &captureReplayer{r: capture, written: written}
+}
+
+type captureReplayer struct {
+	r       io.Reader
+	written bool
+	buf     []byte // unread part of the current frame
+	err     error
+}
+
+func (cr *captureReplayer) Read(p []byte) (int, error) {
+	for len(cr.buf) == 0 {
+		if cr.err != nil {
+			return 0, cr.err
+		}
+		rec, err := ReadCaptureRecord(cr.r)
+		if err != nil {
+			cr.err = err
+			continue
+		}
+		if rec.Written == cr.written {
+			cr.buf = rec.Frame
+		}
+	}
+	n := copy(p, cr.buf)
+	cr.buf = cr.buf[n:]
+	return n, nil
+}
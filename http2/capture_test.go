@@ -0,0 +1,99 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package http2
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestCaptureRoundTrip(t *testing.T) {
+	var capture bytes.Buffer
+	fr, _ := testFramer()
+	fr.SetCapture(&capture)
+
+	fr.WriteSettings(Setting{SettingInitialWindowSize, 1000})
+	fr.WriteData(1, true, []byte("foo"))
+	if _, err := fr.ReadFrame(); err != nil {
+		t.Fatal(err)
+	}
+	fr.WritePing(false, [8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+
+	want := []struct {
+		written bool
+		typ     FrameType
+		length  uint32
+	}{
+		{true, FrameSettings, 6},
+		{true, FrameData, 3},
+		{false, FrameSettings, 6},
+		{true, FramePing, 8},
+	}
+	r := bytes.NewReader(capture.Bytes())
+	for i, w := range want {
+		rec, err := ReadCaptureRecord(r)
+		if err != nil {
+			t.Fatalf("record %d: %v", i, err)
+		}
+		if rec.Time.IsZero() {
+			t.Errorf("record %d: zero timestamp", i)
+		}
+		fh, err := rec.Header()
+		if err != nil {
+			t.Fatalf("record %d: Header: %v", i, err)
+		}
+		if rec.Written != w.written || fh.Type != w.typ || fh.Length != w.length {
+			t.Errorf("record %d: written=%v %v; want written=%v type=%v len=%v", i, rec.Written, fh, w.written, w.typ, w.length)
+		}
+	}
+	if _, err := ReadCaptureRecord(r); err != io.EOF {
+		t.Errorf("ReadCaptureRecord at end = %v; want io.EOF", err)
+	}
+
+	// Replay the written side into a new Framer.
+	replay := NewFramer(nil, NewCaptureReplayer(bytes.NewReader(capture.Bytes()), true))
+	var got []FrameType
+	for {
+		f, err := replay.ReadFrame()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("replay ReadFrame: %v", err)
+		}
+		got = append(got, f.Header().Type)
+		if df, ok := f.(*DataFrame); ok && string(df.Data()) != "foo" {
+			t.Errorf("replayed DATA = %q; want %q", df.Data(), "foo")
+		}
+	}
+	wantTypes := []FrameType{FrameSettings, FrameData, FramePing}
+	if len(got) != len(wantTypes) {
+		t.Fatalf("replayed frames %v; want %v", got, wantTypes)
+	}
+	for i := range got {
+		if got[i] != wantTypes[i] {
+			t.Errorf("replayed frames %v; want %v", got, wantTypes)
+			break
+		}
+	}
+}
+
+func TestReadCaptureRecordMalformed(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want error
+	}{
+		{"bad direction", "x\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x04\x00\x00\x00\x00\x00", errBadCaptureRecord},
+		{"short header", "r\x00\x00", io.ErrUnexpectedEOF},
+		{"short payload", "r\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x00\x01a", io.ErrUnexpectedEOF},
+	}
+	for _, tt := range tests {
+		if _, err := ReadCaptureRecord(bytes.NewReader([]byte(tt.in))); err != tt.want {
+			t.Errorf("%s: ReadCaptureRecord = %v; want %v", tt.name, err, tt.want)
+		}
+	}
+}
@@ -10,11 +10,19 @@ import (
 	"context"
 	"crypto/tls"
 	"errors"
+	"net"
 	"net/http"
 	"sync"
 )
 
 // ClientConnPool manages a pool of HTTP/2 client connections.
+//
+// Set Transport.ConnPool to use an alternate implementation, for
+// example to select among connections per backend or to pin requests
+// to a connection. Implementations typically create connections with
+// Transport.NewClientConn and hand them out after a successful
+// ClientConn.ReserveNewRequest. If the pool also has a
+// CloseIdleConnections method, Transport.CloseIdleConnections calls it.
 type ClientConnPool interface {
 	// GetClientConn returns a specific HTTP/2 connection (usually
 	// a TLS-TCP connection) to an HTTP/2 server. On success, the
@@ -33,6 +41,12 @@ type clientConnPoolIdleCloser interface {
 	closeIdleConnections()
 }
 
+// idleCloser is the interface implemented by user-supplied
+// ClientConnPool implementations which can close their idle connections.
+type idleCloser interface {
+	CloseIdleConnections()
+}
+
 var (
 	_ clientConnPoolIdleCloser = (*clientConnPool)(nil)
 	_ clientConnPoolIdleCloser = noDialClientConnPool{}
@@ -42,9 +56,7 @@ var (
 type clientConnPool struct {
 	t *Transport
 
-	mu sync.Mutex // TODO: maybe switch to RWMutex
-	// TODO: add support for sharing conns based on cert names
-	// (e.g. share conn for googleapis.com and appspot.com)
+	mu           sync.Mutex               // TODO: maybe switch to RWMutex
 	conns        map[string][]*ClientConn // key is host:port
 	dialing      map[string]*dialCall     // currently in-flight dials
 	keys         map[*ClientConn][]string
@@ -72,6 +84,7 @@ func (p *clientConnPool) getClientConn(req *http.Request, addr string, dialOnMis
 		}
 		return cc, nil
 	}
+	triedCoalesce := false
 	for {
 		p.mu.Lock()
 		for _, cc := range p.conns[addr] {
@@ -91,6 +104,17 @@ func (p *clientConnPool) getClientConn(req *http.Request, addr string, dialOnMis
 			p.mu.Unlock()
 			return nil, ErrNoCachedConn
 		}
+		if !triedCoalesce && p.t.canCoalesceConns() {
+			triedCoalesce = true
+			if candidates := p.coalesceCandidatesLocked(addr); len(candidates) > 0 {
+				p.mu.Unlock()
+				if cc := p.coalesceConn(req.Context(), addr, candidates); cc != nil {
+					traceGetConn(req, addr)
+					return cc, nil
+				}
+				continue
+			}
+		}
 		traceGetConn(req, addr)
 		call := p.getStartDialLocked(req.Context(), addr)
 		p.mu.Unlock()
@@ -108,6 +132,79 @@ func (p *clientConnPool) getClientConn(req *http.Request, addr string, dialOnMis
 	}
 }
 
+// lookupIPAddr resolves host names for connection coalescing.
+// It is a variable so tests can replace it.
+var lookupIPAddr = net.DefaultResolver.LookupIPAddr
+
+// coalesceCandidatesLocked returns the pooled connections to other
+// hosts on the same port whose certificate is valid for addr's host.
+// See RFC 7540, Section 9.1.1.
+// p.mu must be held.
+func (p *clientConnPool) coalesceCandidatesLocked(addr string) []*ClientConn {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil
+	}
+	var candidates []*ClientConn
+	for cc, keys := range p.keys {
+		if cc.tlsState == nil || len(cc.tlsState.PeerCertificates) == 0 {
+			continue
+		}
+		samePort := false
+		for _, key := range keys {
+			if _, kport, err := net.SplitHostPort(key); err == nil && kport == port {
+				samePort = true
+				break
+			}
+		}
+		if !samePort {
+			continue
+		}
+		if cc.tlsState.PeerCertificates[0].VerifyHostname(host) != nil {
+			continue
+		}
+		if cc.CanTakeNewRequest() {
+			candidates = append(candidates, cc)
+		}
+	}
+	return candidates
+}
+
+// coalesceConn returns one of candidates to be used for requests to
+// addr, if addr's host resolves to the candidate's remote address.
+// It returns nil if none of the candidates can be used.
+func (p *clientConnPool) coalesceConn(ctx context.Context, addr string, candidates []*ClientConn) *ClientConn {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil
+	}
+	var ips []net.IPAddr
+	if ip := net.ParseIP(host); ip != nil {
+		ips = []net.IPAddr{{IP: ip}}
+	} else if ips, err = lookupIPAddr(ctx, host); err != nil {
+		return nil
+	}
+	for _, cc := range candidates {
+		raddr, ok := cc.tconn.RemoteAddr().(*net.TCPAddr)
+		if !ok {
+			continue
+		}
+		for _, ip := range ips {
+			if !ip.IP.Equal(raddr.IP) {
+				continue
+			}
+			if !cc.ReserveNewRequest() {
+				break
+			}
+			p.mu.Lock()
+			p.addConnLocked(addr, cc)
+			p.mu.Unlock()
+			return cc
+		}
+	}
+	return nil
+}
+
 // dialCall is an in-flight Transport dial call to a host.
 type dialCall struct {
 	_ incomparable
@@ -246,17 +343,21 @@ func (p *clientConnPool) MarkDead(cc *ClientConn) {
 
 func (p *clientConnPool) closeIdleConnections() {
 	p.mu.Lock()
-	defer p.mu.Unlock()
 	// TODO: don't close a cc if it was just added to the pool
 	// milliseconds ago and has never been used. There's currently
 	// a small race window with the HTTP/1 Transport's integration
 	// where it can add an idle conn just before using it, and
 	// somebody else can concurrently call CloseIdleConns and
 	// break some caller's RoundTrip.
+	var ccs []*ClientConn
 	for _, vv := range p.conns {
-		for _, cc := range vv {
-			cc.closeIfIdle()
-		}
+		ccs = append(ccs, vv...)
+	}
+	p.mu.Unlock()
+	// closeIfIdle writes a GOAWAY frame, which can block on a
+	// stalled peer, so it must not be called with p.mu held.
+	for _, cc := range ccs {
+		cc.closeIfIdle()
 	}
 }
 
@@ -0,0 +1,168 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package http2
+
+import (
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+// A fakeClock is a clock whose time only moves when advanced by a test.
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) NewTimer(d time.Duration) timer {
+	return c.addTimer(d, nil, make(chan time.Time, 1))
+}
+
+func (c *fakeClock) AfterFunc(d time.Duration, f func()) timer {
+	return c.addTimer(d, f, nil)
+}
+
+func (c *fakeClock) addTimer(d time.Duration, f func(), ch chan time.Time) *fakeTimer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := &fakeTimer{c: c, when: c.now.Add(d), f: f, ch: ch, active: true}
+	c.timers = append(c.timers, t)
+	return t
+}
+
+// advance moves the clock forward by d, firing timers that come due in
+// order. AfterFunc functions are called on the calling goroutine.
+func (c *fakeClock) advance(d time.Duration) {
+	c.mu.Lock()
+	c.now = c.now.Add(d)
+	now := c.now
+	var due []*fakeTimer
+	live := c.timers[:0]
+	for _, t := range c.timers {
+		switch {
+		case !t.active:
+		case !t.when.After(now):
+			t.active = false
+			due = append(due, t)
+		default:
+			live = append(live, t)
+		}
+	}
+	c.timers = live
+	c.mu.Unlock()
+
+	sort.SliceStable(due, func(i, j int) bool { return due[i].when.Before(due[j].when) })
+	for _, t := range due {
+		if t.f != nil {
+			t.f()
+		} else {
+			select {
+			case t.ch <- t.when:
+			default:
+			}
+		}
+	}
+}
+
+// awaitTimer waits until a timer is set to fire d from now.
+// It's used to synchronize with goroutines that start timers.
+func (c *fakeClock) awaitTimer(t testing.TB, d time.Duration) {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		c.mu.Lock()
+		when := c.now.Add(d)
+		for _, ft := range c.timers {
+			if ft.active && ft.when.Equal(when) {
+				c.mu.Unlock()
+				return
+			}
+		}
+		c.mu.Unlock()
+		if time.Now().After(deadline) {
+			t.Fatalf("no timer set for %v from now", d)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+type fakeTimer struct {
+	c      *fakeClock
+	when   time.Time
+	f      func()
+	ch     chan time.Time
+	active bool // guarded by c.mu
+}
+
+func (t *fakeTimer) C() <-chan time.Time { return t.ch }
+
+func (t *fakeTimer) Stop() bool {
+	t.c.mu.Lock()
+	defer t.c.mu.Unlock()
+	wasActive := t.active
+	t.active = false
+	return wasActive
+}
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	t.c.mu.Lock()
+	defer t.c.mu.Unlock()
+	wasActive := t.active
+	t.when = t.c.now.Add(d)
+	if !wasActive {
+		t.active = true
+		t.c.timers = append(t.c.timers, t)
+	}
+	return wasActive
+}
+
+func TestFakeClock(t *testing.T) {
+	c := newFakeClock()
+	start := c.Now()
+	var fired []string
+	c.AfterFunc(2*time.Second, func() { fired = append(fired, "b") })
+	c.AfterFunc(1*time.Second, func() { fired = append(fired, "a") })
+	stopped := c.AfterFunc(1*time.Second, func() { fired = append(fired, "stopped") })
+	tm := c.NewTimer(3 * time.Second)
+	if !stopped.Stop() {
+		t.Errorf("Stop of pending timer = false; want true")
+	}
+
+	c.advance(2 * time.Second)
+	if got, want := len(fired), 2; got != want || fired[0] != "a" || fired[1] != "b" {
+		t.Errorf("after 2s, fired %q; want [a b]", fired)
+	}
+	select {
+	case <-tm.C():
+		t.Errorf("3s timer fired after 2s")
+	default:
+	}
+	if tm.Reset(time.Second) != true {
+		t.Errorf("Reset of pending timer = false; want true")
+	}
+	c.advance(time.Second)
+	select {
+	case now := <-tm.C():
+		if got, want := now.Sub(start), 3*time.Second; got != want {
+			t.Errorf("timer fired at start+%v; want start+%v", got, want)
+		}
+	default:
+		t.Errorf("reset timer didn't fire")
+	}
+	if stopped.Stop() {
+		t.Errorf("Stop of stopped timer = true; want false")
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package http2
+
+import (
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// A DebugCapture records the connections of a Server or Transport for
+// later inspection. It writes two kinds of files to its directory:
+//
+// The TLS secrets of every connection are appended to keylog.txt, in
+// the NSS key log format. Given that file as its "(Pre)-Master-Secret
+// log filename" TLS preference, Wireshark can decrypt a packet capture
+// of the connections, such as one made with tcpdump, and dissect the
+// HTTP/2 frames within.
+//
+// The frames of each connection, as recorded by Framer.SetCapture, are
+// written to their own file named conn-N-ADDR.h2cap, where N counts
+// connections from 1 and ADDR is the peer's address. These don't depend
+// on a packet capture and can be read with ReadCaptureRecord or
+// replayed with NewCaptureReplayer.
+//
+// DebugCapture is intended for diagnosing interoperability problems.
+// Anyone holding its key log can decrypt the captured connections.
+type DebugCapture struct {
+	dir    string
+	keyLog *os.File
+
+	mu    sync.Mutex
+	nconn int
+}
+
+// NewDebugCapture returns a DebugCapture writing to dir, which is
+// created if it doesn't exist.
+func NewDebugCapture(dir string) (*DebugCapture, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	keyLog, err := os.OpenFile(filepath.Join(dir, "keylog.txt"), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &DebugCapture{dir: dir, keyLog: keyLog}, nil
+}
+
+// ConfigureServer configures s and conf to record their connections.
+// conf must be the Server passed to ConfigureServer for s, which may be
+// called before or after this method.
+func (dc *DebugCapture) ConfigureServer(s *http.Server, conf *Server) {
+	if s.TLSConfig == nil {
+		s.TLSConfig = new(tls.Config)
+	}
+	s.TLSConfig.KeyLogWriter = dc.keyLog
+	conf.CaptureFrames = dc.captureFrames
+}
+
+// ConfigureTransport configures t to record its connections.
+func (dc *DebugCapture) ConfigureTransport(t *Transport) {
+	if t.TLSClientConfig == nil {
+		t.TLSClientConfig = new(tls.Config)
+	}
+	t.TLSClientConfig.KeyLogWriter = dc.keyLog
+	t.CaptureFrames = dc.captureFrames
+}
+
+// Close closes the key log. Files of connections that are still open
+// are closed along with the connections.
+func (dc *DebugCapture) Close() error {
+	return dc.keyLog.Close()
+}
+
+func (dc *DebugCapture) captureFrames(c net.Conn) io.Writer {
+	dc.mu.Lock()
+	dc.nconn++
+	n := dc.nconn
+	dc.mu.Unlock()
+
+	name := fmt.Sprintf("conn-%d-%s.h2cap", n, fileNameSafe(c.RemoteAddr().String()))
+	f, err := os.OpenFile(filepath.Join(dc.dir, name), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		// Capturing is best effort.
+		return nil
+	}
+	// Writes to an *os.File are safe for concurrent use, as
+	// Framer.SetCapture requires.
+	return f
+}
+
+// fileNameSafe replaces the bytes of s that aren't letters, digits,
+// dots, or hyphens with underscores.
+func fileNameSafe(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9', c == '.', c == '-':
+		default:
+			b[i] = '_'
+		}
+	}
+	return string(b)
+}
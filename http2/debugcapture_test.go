@@ -0,0 +1,119 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package http2
+
+import (
+	"bytes"
+	"crypto/tls"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDebugCapture(t *testing.T) {
+	serverDir := filepath.Join(t.TempDir(), "server")
+	clientDir := filepath.Join(t.TempDir(), "client")
+
+	sdc, err := NewDebugCapture(serverDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sdc.Close()
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "hello")
+	}))
+	h2s := new(Server)
+	ConfigureServer(ts.Config, h2s)
+	sdc.ConfigureServer(ts.Config, h2s)
+	ts.TLS = ts.Config.TLSConfig
+	ts.StartTLS()
+
+	cdc, err := NewDebugCapture(clientDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cdc.Close()
+	tr := &Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+	cdc.ConfigureTransport(tr)
+
+	req, err := http.NewRequest("GET", ts.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := tr.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slurp, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil || string(slurp) != "hello" {
+		t.Fatalf("body = %q, %v; want hello", slurp, err)
+	}
+	tr.CloseIdleConnections()
+	ts.Close()
+
+	for _, dir := range []string{serverDir, clientDir} {
+		keyLog, err := ioutil.ReadFile(filepath.Join(dir, "keylog.txt"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Contains(keyLog, []byte("CLIENT_")) {
+			t.Errorf("%v: key log has no client secrets:\n%s", dir, keyLog)
+		}
+
+		conns, err := filepath.Glob(filepath.Join(dir, "conn-1-*.h2cap"))
+		if err != nil || len(conns) != 1 {
+			t.Fatalf("%v: frame captures = %q, %v; want one", dir, conns, err)
+		}
+		f, err := os.Open(conns[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		var sawHeaders, sawData bool
+		for {
+			rec, err := ReadCaptureRecord(f)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("%v: %v", conns[0], err)
+			}
+			fh, err := rec.Header()
+			if err != nil {
+				t.Fatalf("%v: %v", conns[0], err)
+			}
+			// The server writes the response; the client reads it.
+			if rec.Written != (dir == serverDir) {
+				continue
+			}
+			switch fh.Type {
+			case FrameHeaders:
+				sawHeaders = true
+			case FrameData:
+				sawData = true
+			}
+		}
+		f.Close()
+		if !sawHeaders || !sawData {
+			t.Errorf("%v: capture of response has HEADERS %v, DATA %v; want both", dir, sawHeaders, sawData)
+		}
+	}
+}
+
+func TestFileNameSafe(t *testing.T) {
+	for _, tt := range []struct{ in, want string }{
+		{"127.0.0.1:443", "127.0.0.1_443"},
+		{"[::1]:8080", "___1__8080"},
+		{"pipe", "pipe"},
+	} {
+		if got := fileNameSafe(tt.in); got != tt.want {
+			t.Errorf("fileNameSafe(%q) = %q; want %q", tt.in, got, tt.want)
+		}
+	}
+}
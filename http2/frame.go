@@ -13,6 +13,7 @@ import (
 	"log"
 	"strings"
 	"sync"
+	"time"
 
 	"golang.org/x/net/http/httpguts"
 	"golang.org/x/net/http2/hpack"
@@ -37,6 +38,10 @@ const (
 	FrameGoAway       FrameType = 0x7
 	FrameWindowUpdate FrameType = 0x8
 	FrameContinuation FrameType = 0x9
+
+	// FramePriorityUpdate is the PRIORITY_UPDATE frame defined by
+	// RFC 9218, Section 7.1.
+	FramePriorityUpdate FrameType = 0x10
 )
 
 var frameName = map[FrameType]string{
@@ -50,6 +55,8 @@ var frameName = map[FrameType]string{
 	FrameGoAway:       "GOAWAY",
 	FrameWindowUpdate: "WINDOW_UPDATE",
 	FrameContinuation: "CONTINUATION",
+
+	FramePriorityUpdate: "PRIORITY_UPDATE",
 }
 
 func (t FrameType) String() string {
@@ -135,6 +142,8 @@ var frameParsers = map[FrameType]frameParser{
 	FrameGoAway:       parseGoAwayFrame,
 	FrameWindowUpdate: parseWindowUpdateFrame,
 	FrameContinuation: parseContinuationFrame,
+
+	FramePriorityUpdate: parsePriorityUpdateFrame,
 }
 
 func typeFrameParser(t FrameType) frameParser {
@@ -144,6 +153,46 @@ func typeFrameParser(t FrameType) frameParser {
 	return parseUnknownFrame
 }
 
+// RegisterFrameParser makes ReadFrame decode frames of the extension
+// type t with parse, rather than returning them as UnknownFrame.
+// Frames returned by parse should embed a FrameHeader, which
+// satisfies the Frame interface, initialized from the provided one.
+// The payload is only valid until the next call to ReadFrame.
+//
+// Frames that implement ExtensionFrame can be written back with
+// WriteExtensionFrame.
+//
+// It is an error to register a parser for a frame type that the
+// Framer already decodes, such as those defined by RFC 7540.
+func (fr *Framer) RegisterFrameParser(t FrameType, parse func(fh FrameHeader, payload []byte) (Frame, error)) error {
+	if _, ok := frameParsers[t]; ok {
+		return fmt.Errorf("http2: cannot replace parser for %v frames", t)
+	}
+	if fr.extParsers == nil {
+		fr.extParsers = make(map[FrameType]frameParser)
+	}
+	fr.extParsers[t] = func(_ *frameCache, fh FrameHeader, _ func(string), payload []byte) (Frame, error) {
+		return parse(fh, payload)
+	}
+	return nil
+}
+
+func (fr *Framer) frameParser(t FrameType) frameParser {
+	if f := fr.extParsers[t]; f != nil {
+		return f
+	}
+	return typeFrameParser(t)
+}
+
+// An ExtensionFrame is a frame of an extension type that can
+// serialize its own payload, for use with Framer.WriteExtensionFrame.
+type ExtensionFrame interface {
+	Frame
+
+	// AppendPayload appends the frame's payload to b.
+	AppendPayload(b []byte) []byte
+}
+
 // A FrameHeader is the 9 byte header of all HTTP/2 frames.
 //
 // See http://http2.github.io/http2-spec/#FrameHeader
@@ -267,11 +316,6 @@ type Framer struct {
 	lastFrame Frame
 	errDetail error
 
-	// countError is a non-nil func that's called on a frame parse
-	// error with some unique error path token. It's initialized
-	// from Transport.CountError or Server.CountError.
-	countError func(errToken string)
-
 	// lastHeaderStream is non-zero if the last frame was an
 	// unfinished HEADERS/CONTINUATION.
 	lastHeaderStream uint32
@@ -302,9 +346,32 @@ type Framer struct {
 	// to return non-compliant frames or frame orders.
 	// This is for testing and permits using the Framer to test
 	// other HTTP/2 implementations' conformance to the spec.
-	// It is not compatible with ReadMetaHeaders.
+	// Violations that would otherwise be connection errors are
+	// instead reported by ErrorDetail after ReadFrame returns;
+	// a frame that could not be parsed is returned as an
+	// *UnknownFrame holding its raw payload.
+	// It is not compatible with ReadMetaHeaders: if both are set,
+	// ReadFrame returns an error for a valid HEADERS frame rather
+	// than decode it, while an invalid one is still returned as an
+	// *UnknownFrame.
 	AllowIllegalReads bool
 
+	// StrictReads makes ReadFrame reject frames with a reserved
+	// bit set: in the frame header's stream identifier, or in
+	// the WINDOW_UPDATE increment, GOAWAY last stream ID or
+	// PUSH_PROMISE promised stream ID. The spec says receivers
+	// ignore these bits, so this is only useful for checking
+	// other implementations' conformance. Violations are
+	// ConnectionErrors, described by ErrorDetail.
+	StrictReads bool
+
+	// CountError, if non-nil, is called by ReadFrame for each frame
+	// that fails to parse or validate, with a token naming the kind
+	// of error, such as "frame_data_pad_too_big", so that programs
+	// can export metrics about the malformed input they see. The
+	// Transport and Server set it from their CountError fields.
+	CountError func(errToken string)
+
 	// ReadMetaHeaders if non-nil causes ReadFrame to merge
 	// HEADERS and CONTINUATION frames together and return
 	// MetaHeadersFrame instead.
@@ -314,6 +381,9 @@ type Framer struct {
 	// It's used only if ReadMetaHeaders is set; 0 means a sane default
 	// (currently 16MB)
 	// If the limit is hit, MetaHeadersFrame.Truncated is set true.
+	// If the peer keeps sending header data well past the limit,
+	// ReadFrame returns a ConnectionError and ErrorDetail reports
+	// ErrHeaderListTooLarge.
 	MaxHeaderListSize uint32
 
 	// TODO: track which type of frame & with which flags was sent
@@ -329,9 +399,25 @@ type Framer struct {
 	debugReadLoggerf  func(string, ...interface{})
 	debugWriteLoggerf func(string, ...interface{})
 
-	frameCache *frameCache // nil if frames aren't reused (default)
+	frameCache   *frameCache // nil if frames aren't reused (default)
+	retainFrames bool        // frames stay valid after the next ReadFrame
+
+	capture io.Writer // if non-nil, where frames are recorded; see SetCapture
+
+	extParsers map[FrameType]frameParser // see RegisterFrameParser
+}
+
+// countError calls fr.CountError, if set, with errToken.
+func (fr *Framer) countError(errToken string) {
+	if fr.CountError != nil {
+		fr.CountError(errToken)
+	}
 }
 
+// ignoreErrorToken is passed to frame parsers in place of a nil
+// Framer.CountError.
+func ignoreErrorToken(string) {}
+
 func (fr *Framer) maxHeaderListSize() uint32 {
 	if fr.MaxHeaderListSize == 0 {
 		return 16 << 20 // sane default, per docs
@@ -367,6 +453,9 @@ func (f *Framer) endWrite() error {
 	if f.logWrites {
 		f.logWrite()
 	}
+	if f.capture != nil {
+		f.captureFrame(captureWritten, f.wbuf[:frameHeaderLen], f.wbuf[frameHeaderLen:])
+	}
 
 	n, err := f.w.Write(f.wbuf)
 	if err == nil && n != len(f.wbuf) {
@@ -390,7 +479,7 @@ func (f *Framer) logWrite() {
 		f.debugWriteLoggerf("http2: Framer %p: failed to decode just-written frame", f)
 		return
 	}
-	f.debugWriteLoggerf("http2: Framer %p: wrote %v", f, summarizeFrame(fr))
+	f.debugWriteLoggerf("http2: Framer %p: wrote %v", f, SummarizeFrame(fr))
 }
 
 func (f *Framer) writeByte(v byte)     { f.wbuf = append(f.wbuf, v) }
@@ -409,12 +498,24 @@ const (
 // If called on a Framer, Frames returned by calls to ReadFrame are only
 // valid until the next call to ReadFrame.
 func (fr *Framer) SetReuseFrames() {
-	if fr.frameCache != nil {
+	if fr.frameCache != nil || fr.retainFrames {
 		return
 	}
 	fr.frameCache = &frameCache{}
 }
 
+// SetRetainFrames makes Frames returned by ReadFrame remain valid
+// after subsequent calls to ReadFrame, so callers may keep them or
+// hand them to other goroutines. Each frame is read into a newly
+// allocated buffer. It takes precedence over SetReuseFrames.
+func (fr *Framer) SetRetainFrames() {
+	fr.retainFrames = true
+	fr.frameCache = nil
+	fr.getReadBuf = func(size uint32) []byte {
+		return make([]byte, size)
+	}
+}
+
 type frameCache struct {
 	dataFrame DataFrame
 }
@@ -431,7 +532,6 @@ func NewFramer(w io.Writer, r io.Reader) *Framer {
 	fr := &Framer{
 		w:                 w,
 		r:                 r,
-		countError:        func(string) {},
 		logReads:          logFrameReads,
 		logWrites:         logFrameWrites,
 		debugReadLoggerf:  log.Printf,
@@ -459,6 +559,19 @@ func (fr *Framer) SetMaxReadFrameSize(v uint32) {
 	fr.maxReadSize = v
 }
 
+// SetReadDeadline sets the deadline for future ReadFrame calls,
+// bounding how long reading a single frame may block. A zero value
+// for t means ReadFrame will not time out. It requires that the
+// Framer's reader has a SetReadDeadline method, as net.Conn does,
+// and otherwise returns an error.
+func (fr *Framer) SetReadDeadline(t time.Time) error {
+	d, ok := fr.r.(interface{ SetReadDeadline(time.Time) error })
+	if !ok {
+		return errNoReadDeadline
+	}
+	return d.SetReadDeadline(t)
+}
+
 // ErrorDetail returns a more detailed error of the last error
 // returned by Framer.ReadFrame. For instance, if ReadFrame
 // returns a StreamError with code PROTOCOL_ERROR, ErrorDetail
@@ -474,6 +587,12 @@ func (fr *Framer) ErrorDetail() error {
 // sends a frame that is larger than declared with SetMaxReadFrameSize.
 var ErrFrameTooLarge = errors.New("http2: frame too large")
 
+// ErrHeaderListTooLarge is returned by Framer.ErrorDetail when
+// ReadFrame stopped assembling a header block because the peer kept
+// sending header data well past Framer.MaxHeaderListSize.
+// ReadFrame itself returns a ConnectionError in that case.
+var ErrHeaderListTooLarge = errors.New("http2: header list too large")
+
 // terminalReadFrameError reports whether err is an unrecoverable
 // error from ReadFrame and no other frames should be read.
 func terminalReadFrameError(err error) bool {
@@ -492,7 +611,7 @@ func terminalReadFrameError(err error) bool {
 // reader.
 func (fr *Framer) ReadFrame() (Frame, error) {
 	fr.errDetail = nil
-	if fr.lastFrame != nil {
+	if fr.lastFrame != nil && !fr.retainFrames {
 		fr.lastFrame.invalidate()
 	}
 	fh, err := readFrameHeader(fr.headerBuf[:], fr.r)
@@ -500,31 +619,82 @@ func (fr *Framer) ReadFrame() (Frame, error) {
 		return nil, err
 	}
 	if fh.Length > fr.maxReadSize {
+		fr.countError("frame_too_large")
 		return nil, ErrFrameTooLarge
 	}
 	payload := fr.getReadBuf(fh.Length)
 	if _, err := io.ReadFull(fr.r, payload); err != nil {
 		return nil, err
 	}
-	f, err := typeFrameParser(fh.Type)(fr.frameCache, fh, fr.countError, payload)
+	if fr.capture != nil {
+		fr.captureFrame(captureRead, fr.headerBuf[:], payload)
+	}
+	countError := fr.CountError
+	if countError == nil {
+		countError = ignoreErrorToken
+	}
+	var f Frame
+	if fr.StrictReads {
+		err = checkReservedBits(fh, fr.headerBuf[:], payload, countError)
+	}
+	if err == nil {
+		f, err = fr.frameParser(fh.Type)(fr.frameCache, fh, countError, payload)
+	}
 	if err != nil {
 		if ce, ok := err.(connError); ok {
-			return nil, fr.connError(ce.Code, ce.Reason)
+			err = fr.connError(ce.Code, ce.Reason)
 		}
-		return nil, err
+		if _, ok := err.(ConnectionError); !ok || !fr.AllowIllegalReads {
+			return nil, err
+		}
+		if fr.errDetail == nil {
+			fr.errDetail = fmt.Errorf("invalid %v frame", fh.Type)
+		}
+		f = &UnknownFrame{fh, payload}
 	}
 	if err := fr.checkFrameOrder(f); err != nil {
 		return nil, err
 	}
 	if fr.logReads {
-		fr.debugReadLoggerf("http2: Framer %p: read %v", fr, summarizeFrame(f))
+		fr.debugReadLoggerf("http2: Framer %p: read %v", fr, SummarizeFrame(f))
 	}
-	if fh.Type == FrameHeaders && fr.ReadMetaHeaders != nil {
-		return fr.readMetaFrame(f.(*HeadersFrame))
+	// f is an UnknownFrame if the HEADERS frame was invalid and
+	// AllowIllegalReads is set.
+	if hf, ok := f.(*HeadersFrame); ok && fr.ReadMetaHeaders != nil {
+		mh, err := fr.readMetaFrame(hf)
+		if err != nil {
+			// Don't return a non-nil Frame holding a nil *MetaHeadersFrame.
+			return nil, err
+		}
+		return mh, nil
 	}
 	return f, nil
 }
 
+// checkReservedBits implements Framer.StrictReads. hdr is the
+// raw frame header.
+func checkReservedBits(fh FrameHeader, hdr, payload []byte, countError func(string)) error {
+	if hdr[5]&0x80 != 0 {
+		countError("frame_reserved_stream_bit")
+		return connError{ErrCodeProtocol, fmt.Sprintf("%v frame with reserved stream identifier bit set", fh.Type)}
+	}
+	off := -1 // offset of a 31-bit field with a reserved bit
+	switch fh.Type {
+	case FrameWindowUpdate, FrameGoAway:
+		off = 0
+	case FramePushPromise:
+		off = 0
+		if fh.Flags.Has(FlagPushPromisePadded) {
+			off = 1
+		}
+	}
+	if off >= 0 && len(payload) > off && payload[off]&0x80 != 0 {
+		countError("frame_reserved_payload_bit")
+		return connError{ErrCodeProtocol, fmt.Sprintf("%v frame with reserved payload bit set", fh.Type)}
+	}
+	return nil
+}
+
 // connError returns ConnectionError(code) but first
 // stashes away a public reason to the caller can optionally relay it
 // to the peer before hanging up on them. This might help others debug
@@ -540,26 +710,31 @@ func (fr *Framer) connError(code ErrCode, reason string) error {
 func (fr *Framer) checkFrameOrder(f Frame) error {
 	last := fr.lastFrame
 	fr.lastFrame = f
-	if fr.AllowIllegalReads {
-		return nil
-	}
 
+	var err error
 	fh := f.Header()
 	if fr.lastHeaderStream != 0 {
 		if fh.Type != FrameContinuation {
-			return fr.connError(ErrCodeProtocol,
+			fr.countError("frame_order_continuation_expected")
+			err = fr.connError(ErrCodeProtocol,
 				fmt.Sprintf("got %s for stream %d; expected CONTINUATION following %s for stream %d",
 					fh.Type, fh.StreamID,
 					last.Header().Type, fr.lastHeaderStream))
-		}
-		if fh.StreamID != fr.lastHeaderStream {
-			return fr.connError(ErrCodeProtocol,
+		} else if fh.StreamID != fr.lastHeaderStream {
+			fr.countError("frame_order_continuation_stream")
+			err = fr.connError(ErrCodeProtocol,
 				fmt.Sprintf("got CONTINUATION for stream %d; expected stream %d",
 					fh.StreamID, fr.lastHeaderStream))
 		}
 	} else if fh.Type == FrameContinuation {
-		return fr.connError(ErrCodeProtocol, fmt.Sprintf("unexpected CONTINUATION for stream %d", fh.StreamID))
+		fr.countError("frame_order_continuation_unexpected")
+		err = fr.connError(ErrCodeProtocol, fmt.Sprintf("unexpected CONTINUATION for stream %d", fh.StreamID))
 	}
+	if err != nil && !fr.AllowIllegalReads {
+		return err
+	}
+	// With AllowIllegalReads, the violation is left in errDetail
+	// and the frame is returned anyway.
 
 	switch fh.Type {
 	case FrameHeaders, FrameContinuation:
@@ -629,10 +804,14 @@ func parseDataFrame(fc *frameCache, fh FrameHeader, countError func(string), pay
 }
 
 var (
-	errStreamID    = errors.New("invalid stream ID")
-	errDepStreamID = errors.New("invalid dependent stream ID")
-	errPadLength   = errors.New("pad length too large")
-	errPadBytes    = errors.New("padding bytes must all be zeros unless AllowIllegalWrites is enabled")
+	errStreamID       = errors.New("invalid stream ID")
+	errDepStreamID    = errors.New("invalid dependent stream ID")
+	errSelfDep        = errors.New("stream cannot depend on itself")
+	errPadLength      = errors.New("pad length too large")
+	errPadBytes       = errors.New("padding bytes must all be zeros unless AllowIllegalWrites is enabled")
+	errSettingValue   = errors.New("invalid SETTINGS value")
+	errHeaderSpace    = errors.New("buffer too short for frame header")
+	errNoReadDeadline = errors.New("http2: Framer reader does not support read deadlines")
 )
 
 func validStreamIDOrZero(streamID uint32) bool {
@@ -694,6 +873,85 @@ func (f *Framer) WriteDataPadded(streamID uint32, endStream bool, data, pad []by
 	return f.endWrite()
 }
 
+// maxRetainedWriteBuf is the largest write buffer a Framer keeps
+// after WriteDataN. A bigger buffer, grown for a single large frame,
+// is dropped so it isn't held for the lifetime of the Framer.
+const maxRetainedWriteBuf = frameHeaderLen + initialMaxFrameSize
+
+// WriteDataN writes a DATA frame whose payload is the next n bytes
+// read from r. The payload is read directly into the Framer's write
+// buffer, so callers such as file servers and proxies need not hold
+// it in a separate []byte first.
+//
+// If r fails before n bytes are read, nothing is written and the
+// read error is returned, or io.ErrUnexpectedEOF if r ended early.
+// The bytes already consumed from r are discarded, so the stream
+// can't be resumed from r; callers typically reset the stream.
+//
+// It will perform exactly one Write to the underlying Writer.
+// It is the caller's responsibility not to violate the maximum frame size
+// and to not call other Write methods concurrently.
+func (f *Framer) WriteDataN(streamID uint32, endStream bool, r io.Reader, n int) error {
+	if !validStreamID(streamID) && !f.AllowIllegalWrites {
+		return errStreamID
+	}
+	if n < 0 || n > maxFrameSize {
+		return ErrFrameTooLarge
+	}
+	var flags Flags
+	if endStream {
+		flags |= FlagDataEndStream
+	}
+	f.startWrite(FrameData, flags, streamID)
+	if need := frameHeaderLen + n; cap(f.wbuf) < need {
+		wbuf := make([]byte, need)
+		copy(wbuf, f.wbuf)
+		f.wbuf = wbuf
+	} else {
+		f.wbuf = f.wbuf[:need]
+	}
+	_, err := io.ReadFull(r, f.wbuf[frameHeaderLen:])
+	if err == nil {
+		err = f.endWrite()
+	} else if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	if cap(f.wbuf) > maxRetainedWriteBuf {
+		f.wbuf = nil
+	}
+	return err
+}
+
+// WriteDataInPlace writes a DATA frame whose payload is buf[9:],
+// filling in the 9-byte frame header at the start of buf.
+// Unlike WriteData, it does not copy the payload into the Framer's
+// own buffer: buf is passed to the underlying Writer as is, which
+// saves a copy per frame for callers that can reserve the header
+// space up front.
+//
+// It will perform exactly one Write to the underlying Writer.
+// It is the caller's responsibility not to violate the maximum frame size
+// and to not call other Write methods concurrently.
+func (f *Framer) WriteDataInPlace(streamID uint32, endStream bool, buf []byte) error {
+	if !validStreamID(streamID) && !f.AllowIllegalWrites {
+		return errStreamID
+	}
+	if len(buf) < frameHeaderLen {
+		return errHeaderSpace
+	}
+	var flags Flags
+	if endStream {
+		flags |= FlagDataEndStream
+	}
+	wbuf := f.wbuf
+	f.wbuf = buf[:0]
+	f.startWrite(FrameData, flags, streamID) // fills in buf[:frameHeaderLen]
+	f.wbuf = buf
+	err := f.endWrite()
+	f.wbuf = wbuf
+	return err
+}
+
 // A SettingsFrame conveys configuration parameters that affect how
 // endpoints communicate, such as preferences and constraints on peer
 // behavior.
@@ -813,10 +1071,19 @@ func (f *SettingsFrame) ForeachSetting(fn func(Setting) error) error {
 
 // WriteSettings writes a SETTINGS frame with zero or more settings
 // specified and the ACK bit not set.
+// It returns an error if any setting has an invalid value,
+// unless f.AllowIllegalWrites is set.
 //
 // It will perform exactly one Write to the underlying Writer.
 // It is the caller's responsibility to not call other Write methods concurrently.
 func (f *Framer) WriteSettings(settings ...Setting) error {
+	if !f.AllowIllegalWrites {
+		for _, s := range settings {
+			if s.Valid() != nil {
+				return errSettingValue
+			}
+		}
+	}
 	f.startWrite(FrameSettings, 0, 0)
 	for _, s := range settings {
 		f.writeUint16(uint16(s.ID))
@@ -1173,15 +1440,21 @@ func parsePriorityFrame(_ *frameCache, fh FrameHeader, countError func(string),
 
 // WritePriority writes a PRIORITY frame.
 //
+// It returns an error if streamID is invalid or depends on itself,
+// unless f.AllowIllegalWrites is set.
+//
 // It will perform exactly one Write to the underlying Writer.
 // It is the caller's responsibility to not call other Write methods concurrently.
 func (f *Framer) WritePriority(streamID uint32, p PriorityParam) error {
 	if !validStreamID(streamID) && !f.AllowIllegalWrites {
 		return errStreamID
 	}
-	if !validStreamIDOrZero(p.StreamDep) {
+	if !validStreamIDOrZero(p.StreamDep) && !f.AllowIllegalWrites {
 		return errDepStreamID
 	}
+	if p.StreamDep == streamID && !f.AllowIllegalWrites {
+		return errSelfDep
+	}
 	f.startWrite(FramePriority, 0, streamID)
 	v := p.StreamDep
 	if p.Exclusive {
@@ -1192,6 +1465,60 @@ func (f *Framer) WritePriority(streamID uint32, p PriorityParam) error {
 	return f.endWrite()
 }
 
+// A PriorityUpdateFrame signals the priority of a request, as defined
+// by RFC 9218, Section 7.1. It is sent by clients on stream 0.
+type PriorityUpdateFrame struct {
+	FrameHeader
+
+	// PrioritizedStreamID is the request stream whose priority
+	// is being updated.
+	PrioritizedStreamID uint32
+
+	// Priority is the Priority Field Value, in the same format as
+	// the Priority header field. See ParseExtensiblePriority.
+	Priority string
+}
+
+func parsePriorityUpdateFrame(_ *frameCache, fh FrameHeader, countError func(string), payload []byte) (Frame, error) {
+	if fh.StreamID != 0 {
+		countError("frame_priority_update_non_zero_stream")
+		return nil, connError{ErrCodeProtocol, "PRIORITY_UPDATE frame with non-zero stream ID"}
+	}
+	if len(payload) < 4 {
+		countError("frame_priority_update_short")
+		return nil, connError{ErrCodeFrameSize, fmt.Sprintf("PRIORITY_UPDATE frame payload size was %d; want at least 4", len(payload))}
+	}
+	id := binary.BigEndian.Uint32(payload[:4]) & (1<<31 - 1)
+	if id == 0 {
+		countError("frame_priority_update_zero_stream")
+		return nil, connError{ErrCodeProtocol, "PRIORITY_UPDATE frame for stream ID 0"}
+	}
+	return &PriorityUpdateFrame{
+		FrameHeader:         fh,
+		PrioritizedStreamID: id,
+		Priority:            string(payload[4:]),
+	}, nil
+}
+
+// WritePriorityUpdate writes a PRIORITY_UPDATE frame setting the
+// priority of the stream streamID to the Priority Field Value priority,
+// such as "u=1, i".
+//
+// It returns an error if streamID is invalid, unless
+// f.AllowIllegalWrites is set.
+//
+// It will perform exactly one Write to the underlying Writer.
+// It is the caller's responsibility to not call other Write methods concurrently.
+func (f *Framer) WritePriorityUpdate(streamID uint32, priority string) error {
+	if !validStreamID(streamID) && !f.AllowIllegalWrites {
+		return errStreamID
+	}
+	f.startWrite(FramePriorityUpdate, 0, 0)
+	f.writeUint32(streamID)
+	f.wbuf = append(f.wbuf, priority...)
+	return f.endWrite()
+}
+
 // A RSTStreamFrame allows for abnormal termination of a stream.
 // See http://http2.github.io/http2-spec/#rfc.section.6.4
 type RSTStreamFrame struct {
@@ -1312,6 +1639,12 @@ func parsePushPromise(_ *frameCache, fh FrameHeader, countError func(string), p
 		return
 	}
 	pp.PromiseID = pp.PromiseID & (1<<31 - 1)
+	if pp.PromiseID == 0 {
+		// The promised stream identifier MUST be a valid choice
+		// for the next stream sent by the sender (Section 6.6).
+		countError("frame_pushpromise_zero_promiseid")
+		return nil, ConnectionError(ErrCodeProtocol)
+	}
 
 	if int(padLength) > len(p) {
 		// like the DATA frame, error out if padding is longer than the body.
@@ -1355,6 +1688,9 @@ func (f *Framer) WritePushPromise(p PushPromiseParam) error {
 	if !validStreamID(p.StreamID) && !f.AllowIllegalWrites {
 		return errStreamID
 	}
+	if !validStreamID(p.PromiseID) && !f.AllowIllegalWrites {
+		return errStreamID
+	}
 	var flags Flags
 	if p.PadLength != 0 {
 		flags |= FlagPushPromisePadded
@@ -1366,9 +1702,6 @@ func (f *Framer) WritePushPromise(p PushPromiseParam) error {
 	if p.PadLength != 0 {
 		f.writeByte(p.PadLength)
 	}
-	if !validStreamID(p.PromiseID) && !f.AllowIllegalWrites {
-		return errStreamID
-	}
 	f.writeUint32(p.PromiseID)
 	f.wbuf = append(f.wbuf, p.BlockFragment...)
 	f.wbuf = append(f.wbuf, padZeros[:p.PadLength]...)
@@ -1376,13 +1709,43 @@ func (f *Framer) WritePushPromise(p PushPromiseParam) error {
 }
 
 // WriteRawFrame writes a raw frame. This can be used to write
-// extension frames unknown to this package.
+// extension frames unknown to this package, or, with
+// AllowIllegalWrites, malformed frames of any type.
+//
+// It returns ErrFrameTooLarge if the payload cannot be described
+// by the 24-bit frame length, and an error if streamID has the
+// reserved high bit set, unless f.AllowIllegalWrites is set.
+//
+// It will perform exactly one Write to the underlying Writer.
+// It is the caller's responsibility to not call other Write methods concurrently.
 func (f *Framer) WriteRawFrame(t FrameType, flags Flags, streamID uint32, payload []byte) error {
+	if len(payload) > maxFrameSize {
+		return ErrFrameTooLarge
+	}
+	if !validStreamIDOrZero(streamID) && !f.AllowIllegalWrites {
+		return errStreamID
+	}
 	f.startWrite(t, flags, streamID)
 	f.writeBytes(payload)
 	return f.endWrite()
 }
 
+// WriteExtensionFrame writes f using the type, flags and stream ID
+// from its header, and the payload from its AppendPayload method.
+// The header's Length is ignored.
+//
+// It will perform exactly one Write to the underlying Writer.
+// It is the caller's responsibility to not call other Write methods concurrently.
+func (f *Framer) WriteExtensionFrame(ef ExtensionFrame) error {
+	fh := ef.Header()
+	if !validStreamIDOrZero(fh.StreamID) && !f.AllowIllegalWrites {
+		return errStreamID
+	}
+	f.startWrite(fh.Type, fh.Flags, fh.StreamID)
+	f.wbuf = ef.AppendPayload(f.wbuf)
+	return f.endWrite()
+}
+
 func readByte(p []byte) (remain []byte, b byte, err error) {
 	if len(p) == 0 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -1555,6 +1918,7 @@ func (fr *Framer) readMetaFrame(hf *HeadersFrame) (*MetaHeadersFrame, error) {
 		if size > remainSize {
 			hdec.SetEmitEnabled(false)
 			mh.Truncated = true
+			remainSize = 0
 			return
 		}
 		remainSize -= size
@@ -1567,7 +1931,34 @@ func (fr *Framer) readMetaFrame(hf *HeadersFrame) (*MetaHeadersFrame, error) {
 	var hc headersOrContinuation = hf
 	for {
 		frag := hc.HeaderBlockFragment()
+
+		// Avoid decoding large amounts of headers that we would
+		// only discard. "Too much" is any CONTINUATION frame once
+		// the header list size has been exceeded (remainSize is 0),
+		// or a fragment more than twice as large as what remains.
+		if int64(len(frag)) > int64(2*remainSize) {
+			fr.countError("frame_headers_list_too_large")
+			fr.errDetail = ErrHeaderListTooLarge
+			if VerboseLogs {
+				log.Printf("http2: header list too large")
+			}
+			return nil, ConnectionError(ErrCodeProtocol)
+		}
+
+		// Likewise after an invalid header field, since we stop
+		// tracking the header list size at that point.
+		if invalid != nil && len(frag) > 0 {
+			fr.countError("frame_headers_continuation_after_invalid")
+			fr.errDetail = invalid
+			if VerboseLogs {
+				log.Printf("http2: invalid header: %v", invalid)
+			}
+			return nil, ConnectionError(ErrCodeProtocol)
+		}
+
 		if _, err := hdec.Write(frag); err != nil {
+			fr.countError("frame_headers_hpack_decode")
+			fr.errDetail = hdec.ErrorDetail()
 			return nil, ConnectionError(ErrCodeCompression)
 		}
 
@@ -1585,9 +1976,12 @@ func (fr *Framer) readMetaFrame(hf *HeadersFrame) (*MetaHeadersFrame, error) {
 	mh.HeadersFrame.invalidate()
 
 	if err := hdec.Close(); err != nil {
+		fr.countError("frame_headers_hpack_truncated")
+		fr.errDetail = hdec.ErrorDetail()
 		return nil, ConnectionError(ErrCodeCompression)
 	}
 	if invalid != nil {
+		fr.countError("frame_headers_invalid_field")
 		fr.errDetail = invalid
 		if VerboseLogs {
 			log.Printf("http2: invalid header: %v", invalid)
@@ -1595,6 +1989,7 @@ func (fr *Framer) readMetaFrame(hf *HeadersFrame) (*MetaHeadersFrame, error) {
 		return nil, StreamError{mh.StreamID, ErrCodeProtocol, invalid}
 	}
 	if err := mh.checkPseudos(); err != nil {
+		fr.countError("frame_headers_invalid_pseudo")
 		fr.errDetail = err
 		if VerboseLogs {
 			log.Printf("http2: invalid pseudo headers: %v", err)
@@ -1604,7 +1999,10 @@ func (fr *Framer) readMetaFrame(hf *HeadersFrame) (*MetaHeadersFrame, error) {
 	return mh, nil
 }
 
-func summarizeFrame(f Frame) string {
+// SummarizeFrame returns a compact one-line description of f, such as
+// `DATA flags=END_STREAM stream=5 len=5 data="hello"`,
+// for logging and debugging. The format is not stable and may change.
+func SummarizeFrame(f Frame) string {
 	var buf bytes.Buffer
 	f.Header().writeDebug(&buf)
 	switch f := f.(type) {
@@ -1643,6 +2041,28 @@ func summarizeFrame(f Frame) string {
 			f.LastStreamID, f.ErrCode, f.debugData)
 	case *RSTStreamFrame:
 		fmt.Fprintf(&buf, " ErrCode=%v", f.ErrCode)
+	case *HeadersFrame:
+		if f.HasPriority() {
+			writePriorityDebug(&buf, f.Priority)
+		}
+	case *MetaHeadersFrame:
+		fmt.Fprintf(&buf, " fields=%d", len(f.Fields))
+		if f.Truncated {
+			buf.WriteString(" (truncated)")
+		}
+	case *PriorityFrame:
+		writePriorityDebug(&buf, f.PriorityParam)
+	case *PushPromiseFrame:
+		fmt.Fprintf(&buf, " promised=%d", f.PromiseID)
+	case *PriorityUpdateFrame:
+		fmt.Fprintf(&buf, " prioritized=%d priority=%q", f.PrioritizedStreamID, f.Priority)
 	}
 	return buf.String()
 }
+
+func writePriorityDebug(buf *bytes.Buffer, p PriorityParam) {
+	fmt.Fprintf(buf, " dep=%d weight=%d", p.StreamDep, p.Weight)
+	if p.Exclusive {
+		buf.WriteString(" exclusive")
+	}
+}
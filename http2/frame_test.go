@@ -8,9 +8,11 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"net"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 	"unsafe"
 
 	"golang.org/x/net/http2/hpack"
@@ -100,6 +102,31 @@ func TestWriteData(t *testing.T) {
 	}
 }
 
+func TestReadFrameRetainFrames(t *testing.T) {
+	fr, _ := testFramer()
+	fr.SetReuseFrames()
+	fr.SetRetainFrames()
+	fr.WriteData(1, false, []byte("foo"))
+	fr.WriteData(1, true, []byte("bar"))
+
+	var frames []*DataFrame
+	for i := 0; i < 2; i++ {
+		f, err := fr.ReadFrame()
+		if err != nil {
+			t.Fatal(err)
+		}
+		frames = append(frames, f.(*DataFrame))
+	}
+	if frames[0] == frames[1] {
+		t.Fatalf("ReadFrame returned the same DataFrame twice")
+	}
+	for i, want := range []string{"foo", "bar"} {
+		if got := string(frames[i].Data()); got != want {
+			t.Errorf("frame %d data = %q; want %q", i, got, want)
+		}
+	}
+}
+
 func TestWriteDataPadded(t *testing.T) {
 	tests := [...]struct {
 		streamID   uint32
@@ -171,6 +198,136 @@ func TestWriteDataPadded(t *testing.T) {
 	}
 }
 
+func TestWriteDataInPlace(t *testing.T) {
+	fr, buf := testFramer()
+	b := append(make([]byte, frameHeaderLen), "foo"...)
+	if err := fr.WriteDataInPlace(1, true, b); err != nil {
+		t.Fatal(err)
+	}
+	const wantEnc = "\x00\x00\x03\x00\x01\x00\x00\x00\x01foo"
+	if buf.String() != wantEnc {
+		t.Errorf("encoded as %q; want %q", buf.Bytes(), wantEnc)
+	}
+	if err := fr.WriteDataInPlace(1, false, b[:frameHeaderLen-1]); err != errHeaderSpace {
+		t.Errorf("WriteDataInPlace with short buffer = %v; want %v", err, errHeaderSpace)
+	}
+
+	// The Framer's own buffer is unaffected.
+	buf.Reset()
+	fr.WriteData(3, false, []byte("bar"))
+	f, err := fr.ReadFrame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if df := f.(*DataFrame); df.StreamID != 3 || string(df.Data()) != "bar" {
+		t.Errorf("after WriteDataInPlace, WriteData wrote %v", SummarizeFrame(f))
+	}
+}
+
+func TestWriteDataN(t *testing.T) {
+	fr, buf := testFramer()
+	r := strings.NewReader("foobar")
+	if err := fr.WriteDataN(1, false, r, 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := fr.WriteDataN(1, true, r, 3); err != nil {
+		t.Fatal(err)
+	}
+	const wantEnc = "\x00\x00\x03\x00\x00\x00\x00\x00\x01foo" +
+		"\x00\x00\x03\x00\x01\x00\x00\x00\x01bar"
+	if buf.String() != wantEnc {
+		t.Errorf("encoded as %q; want %q", buf.Bytes(), wantEnc)
+	}
+
+	buf.Reset()
+	r = strings.NewReader("ab")
+	if err := fr.WriteDataN(1, true, r, 3); err != io.ErrUnexpectedEOF {
+		t.Errorf("WriteDataN with short reader = %v; want %v", err, io.ErrUnexpectedEOF)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("wrote %d bytes after short read; want none", buf.Len())
+	}
+	if r.Len() != 0 {
+		t.Errorf("%d bytes left unread after short read; want the partial payload consumed", r.Len())
+	}
+}
+
+func TestWriteDataNReleasesLargeBuffer(t *testing.T) {
+	fr, buf := testFramer()
+	const n = 1 << 20
+	if err := fr.WriteDataN(1, true, strings.NewReader(strings.Repeat("a", n)), n); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != frameHeaderLen+n {
+		t.Errorf("wrote %d bytes; want %d", buf.Len(), frameHeaderLen+n)
+	}
+	if cap(fr.wbuf) > maxRetainedWriteBuf {
+		t.Errorf("Framer kept a %d byte write buffer; want at most %d", cap(fr.wbuf), maxRetainedWriteBuf)
+	}
+
+	// The buffer is released after a failed read, too.
+	if err := fr.WriteDataN(1, true, strings.NewReader("short"), n); err != io.ErrUnexpectedEOF {
+		t.Errorf("WriteDataN with short reader = %v; want %v", err, io.ErrUnexpectedEOF)
+	}
+	if cap(fr.wbuf) > maxRetainedWriteBuf {
+		t.Errorf("after short read, Framer kept a %d byte write buffer; want at most %d", cap(fr.wbuf), maxRetainedWriteBuf)
+	}
+}
+
+func BenchmarkWriteData(b *testing.B) {
+	fr := NewFramer(io.Discard, nil)
+	data := make([]byte, 16<<10)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		fr.WriteData(1, false, data)
+	}
+}
+
+func BenchmarkWriteDataInPlace(b *testing.B) {
+	fr := NewFramer(io.Discard, nil)
+	buf := make([]byte, frameHeaderLen+16<<10)
+	b.SetBytes(int64(len(buf) - frameHeaderLen))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		fr.WriteDataInPlace(1, false, buf)
+	}
+}
+
+func TestWriteDataPaddedErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		streamID uint32
+		pad      []byte
+		allow    bool
+		wantErr  error
+	}{
+		{name: "stream 0", streamID: 0, pad: nil, wantErr: errStreamID},
+		{name: "pad too long", streamID: 1, pad: make([]byte, 256), wantErr: errPadLength},
+		{name: "pad too long, illegal allowed", streamID: 1, pad: make([]byte, 256), allow: true, wantErr: errPadLength},
+		{name: "nonzero pad", streamID: 1, pad: []byte{0, 1}, wantErr: errPadBytes},
+		{name: "nonzero pad, illegal allowed", streamID: 1, pad: []byte{0, 1}, allow: true, wantErr: nil},
+		{name: "max pad", streamID: 1, pad: make([]byte, 255), wantErr: nil},
+	}
+	for _, tt := range tests {
+		fr, buf := testFramer()
+		fr.AllowIllegalWrites = tt.allow
+		err := fr.WriteDataPadded(tt.streamID, false, []byte("foo"), tt.pad)
+		if err != tt.wantErr {
+			t.Errorf("%s: WriteDataPadded = %v; want %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if err != nil && buf.Len() != 0 {
+			t.Errorf("%s: wrote %d bytes on error; want none", tt.name, buf.Len())
+		}
+		if err == nil {
+			if want := frameHeaderLen + 1 + 3 + len(tt.pad); buf.Len() != want {
+				t.Errorf("%s: wrote %d bytes; want %d", tt.name, buf.Len(), want)
+			}
+		}
+	}
+}
+
 func (fh FrameHeader) Equal(b FrameHeader) bool {
 	return fh.valid == b.valid &&
 		fh.Type == b.Type &&
@@ -425,6 +582,137 @@ func TestWriteContinuation(t *testing.T) {
 	}
 }
 
+func TestReadFrameAllowIllegalReads(t *testing.T) {
+	fr, _ := testFramer()
+	fr.AllowIllegalWrites = true
+	fr.AllowIllegalReads = true
+
+	// An unparseable frame is returned as an UnknownFrame.
+	fr.WriteData(0, false, []byte("foo"))
+	f, err := fr.ReadFrame()
+	if err != nil {
+		t.Fatalf("ReadFrame of DATA on stream 0: %v", err)
+	}
+	if uf, ok := f.(*UnknownFrame); !ok || uf.Type != FrameData || string(uf.Payload()) != "foo" {
+		t.Errorf("ReadFrame of DATA on stream 0 = %#v; want UnknownFrame with payload %q", f, "foo")
+	}
+	if got, want := fmt.Sprint(fr.ErrorDetail()), "DATA frame with stream ID 0"; got != want {
+		t.Errorf("ErrorDetail = %q; want %q", got, want)
+	}
+
+	// Frame order violations are returned along with the frame.
+	fr.WriteHeaders(HeadersFrameParam{StreamID: 1, BlockFragment: []byte("abc")})
+	fr.WriteData(1, false, []byte("bar"))
+	if _, err := fr.ReadFrame(); err != nil || fr.ErrorDetail() != nil {
+		t.Fatalf("ReadFrame of HEADERS = %v, ErrorDetail %v; want nil, nil", err, fr.ErrorDetail())
+	}
+	f, err = fr.ReadFrame()
+	if err != nil {
+		t.Fatalf("ReadFrame of DATA following HEADERS: %v", err)
+	}
+	if df, ok := f.(*DataFrame); !ok || string(df.Data()) != "bar" {
+		t.Errorf("ReadFrame of DATA following HEADERS = %#v; want DataFrame", f)
+	}
+	if fr.ErrorDetail() == nil {
+		t.Errorf("ErrorDetail = nil; want frame order violation")
+	}
+}
+
+// Tests that an invalid HEADERS frame read with AllowIllegalReads and
+// ReadMetaHeaders is returned as an UnknownFrame.
+func TestReadFrameAllowIllegalReadsMetaHeaders(t *testing.T) {
+	fr, _ := testFramer()
+	fr.AllowIllegalWrites = true
+	fr.AllowIllegalReads = true
+	fr.ReadMetaHeaders = hpack.NewDecoder(initialHeaderTableSize, nil)
+	fr.WriteHeaders(HeadersFrameParam{StreamID: 0, BlockFragment: []byte("\x82"), EndHeaders: true})
+	f, err := fr.ReadFrame()
+	if err != nil {
+		t.Fatalf("ReadFrame of HEADERS on stream 0: %v", err)
+	}
+	if uf, ok := f.(*UnknownFrame); !ok || uf.Type != FrameHeaders {
+		t.Errorf("ReadFrame of HEADERS on stream 0 = %#v; want UnknownFrame", f)
+	}
+	if fr.ErrorDetail() == nil {
+		t.Errorf("ErrorDetail = nil; want invalid HEADERS frame")
+	}
+}
+
+func TestReadMetaFrameHpackErrorDetail(t *testing.T) {
+	fr, _ := testFramer()
+	fr.ReadMetaHeaders = hpack.NewDecoder(initialHeaderTableSize, nil)
+	// :method GET, then a reference to a dynamic table entry that doesn't exist.
+	fr.WriteHeaders(HeadersFrameParam{StreamID: 1, BlockFragment: []byte("\x82\xff\x00"), EndHeaders: true})
+	_, err := fr.ReadFrame()
+	if err != ConnectionError(ErrCodeCompression) {
+		t.Fatalf("ReadFrame = %v; want %v", err, ConnectionError(ErrCodeCompression))
+	}
+	de, ok := fr.ErrorDetail().(*hpack.DecodeError)
+	if !ok {
+		t.Fatalf("ErrorDetail = %#v; want *hpack.DecodeError", fr.ErrorDetail())
+	}
+	if de.Offset != 1 || de.Op != "indexed" {
+		t.Errorf("DecodeError at offset %d (%s); want offset 1 (indexed)", de.Offset, de.Op)
+	}
+}
+
+func TestReadFrameStrictReads(t *testing.T) {
+	tests := []struct {
+		name    string
+		write   func(*Framer)
+		wantErr string // ErrorDetail if StrictReads is set
+	}{{
+		name: "stream_id",
+		write: func(fr *Framer) {
+			fr.WriteRawFrame(FrameData, 0, 1, []byte("x"))
+			fr.AllowIllegalWrites = true
+			fr.WriteRawFrame(FrameData, 0, 1<<31|1, []byte("x"))
+		},
+		wantErr: "DATA frame with reserved stream identifier bit set",
+	}, {
+		name: "window_update",
+		write: func(fr *Framer) {
+			fr.WriteRawFrame(FrameWindowUpdate, 0, 1, []byte{0x80, 0, 0, 1})
+		},
+		wantErr: "WINDOW_UPDATE frame with reserved payload bit set",
+	}, {
+		name: "goaway",
+		write: func(fr *Framer) {
+			fr.WriteRawFrame(FrameGoAway, 0, 0, []byte{0x80, 0, 0, 1, 0, 0, 0, 0})
+		},
+		wantErr: "GOAWAY frame with reserved payload bit set",
+	}, {
+		name: "push_promise_padded",
+		write: func(fr *Framer) {
+			fr.WriteRawFrame(FramePushPromise, FlagPushPromisePadded|FlagPushPromiseEndHeaders, 1, []byte{0, 0x80, 0, 0, 2})
+		},
+		wantErr: "PUSH_PROMISE frame with reserved payload bit set",
+	}}
+	for _, tt := range tests {
+		for _, strict := range []bool{false, true} {
+			fr, _ := testFramer()
+			fr.StrictReads = strict
+			tt.write(fr)
+			var err error
+			for err == nil {
+				_, err = fr.ReadFrame()
+			}
+			if !strict {
+				if err != io.EOF {
+					t.Errorf("%s: without StrictReads, ReadFrame = %v; want io.EOF", tt.name, err)
+				}
+				continue
+			}
+			if err != ConnectionError(ErrCodeProtocol) {
+				t.Errorf("%s: ReadFrame = %v; want %v", tt.name, err, ConnectionError(ErrCodeProtocol))
+			}
+			if got := fmt.Sprint(fr.ErrorDetail()); got != tt.wantErr {
+				t.Errorf("%s: ErrorDetail = %q; want %q", tt.name, got, tt.wantErr)
+			}
+		}
+	}
+}
+
 func TestWritePriority(t *testing.T) {
 	const streamID = 42
 	tests := []struct {
@@ -493,6 +781,164 @@ func TestWritePriority(t *testing.T) {
 	}
 }
 
+func TestWritePriorityUpdate(t *testing.T) {
+	fr, _ := testFramer()
+	if err := fr.WritePriorityUpdate(5, "u=1, i"); err != nil {
+		t.Fatal(err)
+	}
+	f, err := fr.ReadFrame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &PriorityUpdateFrame{
+		FrameHeader: FrameHeader{
+			valid:  true,
+			Type:   FramePriorityUpdate,
+			Length: 4 + 6,
+		},
+		PrioritizedStreamID: 5,
+		Priority:            "u=1, i",
+	}
+	if !reflect.DeepEqual(f, want) {
+		t.Errorf("mismatch.\n got: %#v\nwant: %#v", f, want)
+	}
+	if got, want := SummarizeFrame(f), `PRIORITY_UPDATE len=10 prioritized=5 priority="u=1, i"`; got != want {
+		t.Errorf("SummarizeFrame = %q; want %q", got, want)
+	}
+	if err := fr.WritePriorityUpdate(0, "u=1"); err != errStreamID {
+		t.Errorf("WritePriorityUpdate(0) = %v; want errStreamID", err)
+	}
+}
+
+func TestReadPriorityUpdateInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		streamID uint32
+		payload  string
+		want     ErrCode
+	}{
+		{"non-zero stream", 1, "\x00\x00\x00\x05u=1", ErrCodeProtocol},
+		{"short", 0, "\x00\x00\x05", ErrCodeFrameSize},
+		{"zero prioritized stream", 0, "\x80\x00\x00\x00u=1", ErrCodeProtocol},
+	}
+	for _, tt := range tests {
+		fr, _ := testFramer()
+		fr.WriteRawFrame(FramePriorityUpdate, 0, tt.streamID, []byte(tt.payload))
+		if _, err := fr.ReadFrame(); err != ConnectionError(tt.want) {
+			t.Errorf("%s: ReadFrame = %v; want %v", tt.name, err, ConnectionError(tt.want))
+		}
+	}
+}
+
+func TestWritePriorityInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		streamID uint32
+		priority PriorityParam
+		wantErr  error
+	}{
+		{"stream 0", 0, PriorityParam{StreamDep: 1}, errStreamID},
+		{"self dependency", 3, PriorityParam{StreamDep: 3}, errSelfDep},
+	}
+	for _, tt := range tests {
+		fr, buf := testFramer()
+		if err := fr.WritePriority(tt.streamID, tt.priority); err != tt.wantErr {
+			t.Errorf("test %q: WritePriority = %v; want %v", tt.name, err, tt.wantErr)
+		}
+		if buf.Len() != 0 {
+			t.Errorf("test %q: wrote %d bytes; want none", tt.name, buf.Len())
+		}
+
+		fr.AllowIllegalWrites = true
+		if err := fr.WritePriority(tt.streamID, tt.priority); err != nil {
+			t.Errorf("test %q: with AllowIllegalWrites, WritePriority = %v", tt.name, err)
+		}
+	}
+}
+
+func TestWriteRawFrame(t *testing.T) {
+	fr, buf := testFramer()
+	if err := fr.WriteRawFrame(0xfa, 0x3, 7, []byte("ext")); err != nil {
+		t.Fatal(err)
+	}
+	const wantEnc = "\x00\x00\x03\xfa\x03\x00\x00\x00\x07ext"
+	if buf.String() != wantEnc {
+		t.Errorf("encoded as %q; want %q", buf.Bytes(), wantEnc)
+	}
+	f, err := fr.ReadFrame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uf, ok := f.(*UnknownFrame); !ok || uf.Type != 0xfa || string(uf.Payload()) != "ext" {
+		t.Errorf("read back %v; want UnknownFrame of type 0xfa", SummarizeFrame(f))
+	}
+
+	buf.Reset()
+	if err := fr.WriteRawFrame(FrameData, 0, 1<<31|1, nil); err != errStreamID {
+		t.Errorf("WriteRawFrame with reserved stream ID bit = %v; want %v", err, errStreamID)
+	}
+	if err := fr.WriteRawFrame(0xfa, 0, 1, make([]byte, maxFrameSize+1)); err != ErrFrameTooLarge {
+		t.Errorf("WriteRawFrame with oversized payload = %v; want %v", err, ErrFrameTooLarge)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("wrote %d bytes after errors; want none", buf.Len())
+	}
+	fr.AllowIllegalWrites = true
+	if err := fr.WriteRawFrame(FrameData, 0, 1<<31|1, nil); err != nil {
+		t.Errorf("WriteRawFrame with AllowIllegalWrites = %v; want nil", err)
+	}
+}
+
+type testExtFrame struct {
+	FrameHeader
+	value string
+}
+
+func (f *testExtFrame) AppendPayload(b []byte) []byte { return append(b, f.value...) }
+
+func TestRegisterFrameParser(t *testing.T) {
+	const extType FrameType = 0xfa
+	fr, buf := testFramer()
+	if err := fr.RegisterFrameParser(FrameData, nil); err == nil {
+		t.Errorf("RegisterFrameParser(FrameData) succeeded; want error")
+	}
+	err := fr.RegisterFrameParser(extType, func(fh FrameHeader, payload []byte) (Frame, error) {
+		return &testExtFrame{FrameHeader: fh, value: string(payload)}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ef := &testExtFrame{FrameHeader: FrameHeader{Type: extType, Flags: 0x1, StreamID: 3}, value: "hello"}
+	if err := fr.WriteExtensionFrame(ef); err != nil {
+		t.Fatal(err)
+	}
+	const wantEnc = "\x00\x00\x05\xfa\x01\x00\x00\x00\x03hello"
+	if buf.String() != wantEnc {
+		t.Errorf("encoded as %q; want %q", buf.Bytes(), wantEnc)
+	}
+	f, err := fr.ReadFrame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, ok := f.(*testExtFrame)
+	if !ok {
+		t.Fatalf("ReadFrame returned %T; want *testExtFrame", f)
+	}
+	if got.Type != extType || got.Flags != 0x1 || got.StreamID != 3 || got.Length != 5 || got.value != "hello" {
+		t.Errorf("read back %+v", got)
+	}
+
+	// Other Framers are unaffected.
+	fr2, _ := testFramer()
+	fr2.WriteExtensionFrame(ef)
+	if f, err := fr2.ReadFrame(); err != nil {
+		t.Fatal(err)
+	} else if _, ok := f.(*UnknownFrame); !ok {
+		t.Errorf("unregistered Framer returned %T; want *UnknownFrame", f)
+	}
+}
+
 func TestWriteSettings(t *testing.T) {
 	fr, buf := testFramer()
 	settings := []Setting{{1, 2}, {3, 4}}
@@ -523,6 +969,29 @@ func TestWriteSettings(t *testing.T) {
 	}
 }
 
+func TestWriteSettingsInvalid(t *testing.T) {
+	tests := []Setting{
+		{SettingEnablePush, 2},
+		{SettingInitialWindowSize, 1 << 31},
+		{SettingMaxFrameSize, 16383},
+		{SettingMaxFrameSize, 1 << 24},
+	}
+	for _, s := range tests {
+		fr, buf := testFramer()
+		if err := fr.WriteSettings(Setting{SettingHeaderTableSize, 0}, s); err != errSettingValue {
+			t.Errorf("WriteSettings(%v) = %v; want %v", s, err, errSettingValue)
+		}
+		if buf.Len() != 0 {
+			t.Errorf("WriteSettings(%v) wrote %d bytes; want none", s, buf.Len())
+		}
+
+		fr.AllowIllegalWrites = true
+		if err := fr.WriteSettings(s); err != nil {
+			t.Errorf("WriteSettings(%v) with AllowIllegalWrites = %v; want nil", s, err)
+		}
+	}
+}
+
 func TestWriteSettingsAck(t *testing.T) {
 	fr, buf := testFramer()
 	fr.WriteSettingsAck()
@@ -664,6 +1133,64 @@ func TestReadWriteFrameHeader(t *testing.T) {
 
 }
 
+func TestReadLargeFrames(t *testing.T) {
+	fr, _ := testFramer()
+	fr.SetMaxReadFrameSize(1 << 30)
+	if fr.maxReadSize != maxFrameSize {
+		t.Fatalf("SetMaxReadFrameSize(1<<30) set max to %v; want %v", fr.maxReadSize, maxFrameSize)
+	}
+	// Grow and then shrink the read buffer.
+	for _, size := range []int{minMaxFrameSize, minMaxFrameSize + 1, 1 << 20, maxFrameSize, 3} {
+		data := make([]byte, size)
+		for i := range data {
+			data[i] = byte(i)
+		}
+		if err := fr.WriteData(1, false, data); err != nil {
+			t.Fatalf("WriteData of %v bytes: %v", size, err)
+		}
+		f, err := fr.ReadFrame()
+		if err != nil {
+			t.Fatalf("ReadFrame of %v bytes: %v", size, err)
+		}
+		df := f.(*DataFrame)
+		if df.Length != uint32(size) || !bytes.Equal(df.Data(), data) {
+			t.Errorf("read back %v bytes of DATA, length %v; want %v bytes", len(df.Data()), df.Length, size)
+		}
+	}
+}
+
+func TestReadFrameOverMaxReadFrameSize(t *testing.T) {
+	fr, _ := testFramer()
+	fr.SetMaxReadFrameSize(minMaxFrameSize)
+	fr.WriteData(1, false, make([]byte, minMaxFrameSize))
+	fr.WriteData(1, false, make([]byte, minMaxFrameSize+1))
+	if _, err := fr.ReadFrame(); err != nil {
+		t.Fatalf("ReadFrame at the limit: %v", err)
+	}
+	if _, err := fr.ReadFrame(); err != ErrFrameTooLarge {
+		t.Errorf("ReadFrame one byte over the limit = %v; want %v", err, ErrFrameTooLarge)
+	}
+}
+
+func TestFramerSetReadDeadline(t *testing.T) {
+	c1, c2 := net.Pipe()
+	defer c1.Close()
+	defer c2.Close()
+	fr := NewFramer(c1, c1)
+	if err := fr.SetReadDeadline(time.Now().Add(10 * time.Millisecond)); err != nil {
+		t.Fatal(err)
+	}
+	_, err := fr.ReadFrame()
+	if ne, ok := err.(net.Error); !ok || !ne.Timeout() {
+		t.Errorf("ReadFrame past deadline = %v; want timeout", err)
+	}
+
+	fr, _ = testFramer()
+	if err := fr.SetReadDeadline(time.Time{}); err != errNoReadDeadline {
+		t.Errorf("SetReadDeadline on a bytes.Buffer = %v; want %v", err, errNoReadDeadline)
+	}
+}
+
 func TestWriteTooLargeFrame(t *testing.T) {
 	fr, _ := testFramer()
 	fr.startWrite(0, 1, 1)
@@ -746,6 +1273,57 @@ func TestWritePushPromise(t *testing.T) {
 	}
 }
 
+func TestWritePushPromisePadded(t *testing.T) {
+	pp := PushPromiseParam{
+		StreamID:      1,
+		PromiseID:     2,
+		BlockFragment: []byte("abc"),
+		EndHeaders:    true,
+		PadLength:     4,
+	}
+	fr, buf := testFramer()
+	if err := fr.WritePushPromise(pp); err != nil {
+		t.Fatal(err)
+	}
+	const wantEnc = "\x00\x00\x0c\x05\x0c\x00\x00\x00\x01\x04\x00\x00\x00\x02abc\x00\x00\x00\x00"
+	if buf.String() != wantEnc {
+		t.Errorf("encoded as %q; want %q", buf.Bytes(), wantEnc)
+	}
+	f, err := fr.ReadFrame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ppf, ok := f.(*PushPromiseFrame)
+	if !ok {
+		t.Fatalf("got %T; want *PushPromiseFrame", f)
+	}
+	if ppf.PromiseID != 2 || !ppf.HeadersEnded() || string(ppf.HeaderBlockFragment()) != "abc" {
+		t.Errorf("parsed back %#v; want PromiseID 2, END_HEADERS, fragment %q", ppf, "abc")
+	}
+}
+
+func TestWritePushPromiseInvalid(t *testing.T) {
+	fr, buf := testFramer()
+	err := fr.WritePushPromise(PushPromiseParam{StreamID: 1, PromiseID: 0, EndHeaders: true})
+	if err != errStreamID {
+		t.Errorf("WritePushPromise with PromiseID 0 = %v; want %v", err, errStreamID)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("wrote %d bytes; want none", buf.Len())
+	}
+}
+
+func TestReadPushPromiseZeroPromiseID(t *testing.T) {
+	fr, _ := testFramer()
+	fr.AllowIllegalWrites = true
+	if err := fr.WritePushPromise(PushPromiseParam{StreamID: 1, PromiseID: 0, EndHeaders: true}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fr.ReadFrame(); err != ConnectionError(ErrCodeProtocol) {
+		t.Errorf("ReadFrame = %v; want %v", err, ConnectionError(ErrCodeProtocol))
+	}
+}
+
 // test checkFrameOrder and that HEADERS and CONTINUATION frames can't be intermingled.
 func TestReadFrameOrder(t *testing.T) {
 	head := func(f *Framer, id uint32, end bool) {
@@ -1070,6 +1648,26 @@ func TestMetaFrameHeader(t *testing.T) {
 			want:          streamError(1, ErrCodeProtocol),
 			wantErrReason: "invalid header field value \"bad_null\\x00\"",
 		},
+		13: {
+			name: "max_header_list_continuation_flood",
+			w: func(f *Framer) {
+				var he hpackEncoder
+				var pairs = []string{":method", "GET", ":path", "/"}
+				for i := 0; i < 100; i++ {
+					pairs = append(pairs, "foo", "bar")
+				}
+				all := he.encodeHeaderRaw(t, pairs...)
+				frags := [][]byte{all}
+				for i := 0; i < 100; i++ {
+					frags = append(frags, []byte{})
+				}
+				frags = append(frags, all[:1])
+				write(f, frags...)
+			},
+			maxHeaderListSize: (1 << 10) / 2,
+			want:              ConnectionError(ErrCodeProtocol),
+			wantErrReason:     ErrHeaderListTooLarge.Error(),
+		},
 	}
 	for i, tt := range tests {
 		buf := new(bytes.Buffer)
@@ -1245,6 +1843,7 @@ func TestSettingsDuplicates(t *testing.T) {
 	}
 	for i, tt := range tests {
 		fr, _ := testFramer()
+		fr.AllowIllegalWrites = true // some settings above have out-of-range values
 		fr.WriteSettings(tt.settings...)
 		f, err := fr.ReadFrame()
 		if err != nil {
@@ -1258,3 +1857,147 @@ func TestSettingsDuplicates(t *testing.T) {
 	}
 
 }
+
+func TestSummarizeFrame(t *testing.T) {
+	tests := []struct {
+		write func(*Framer)
+		want  string
+	}{
+		{
+			func(fr *Framer) { fr.WriteData(5, true, []byte("hi")) },
+			`DATA flags=END_STREAM stream=5 len=2 data="hi"`,
+		},
+		{
+			func(fr *Framer) { fr.WriteSettings(Setting{SettingMaxFrameSize, 1 << 20}) },
+			`SETTINGS len=6, settings: MAX_FRAME_SIZE=1048576`,
+		},
+		{
+			func(fr *Framer) { fr.WriteGoAway(7, ErrCodeProtocol, []byte("bye")) },
+			`GOAWAY len=11 LastStreamID=7 ErrCode=PROTOCOL_ERROR Debug="bye"`,
+		},
+		{
+			func(fr *Framer) { fr.WritePriority(3, PriorityParam{StreamDep: 1, Weight: 15, Exclusive: true}) },
+			`PRIORITY stream=3 len=5 dep=1 weight=15 exclusive`,
+		},
+		{
+			func(fr *Framer) {
+				fr.WriteHeaders(HeadersFrameParam{
+					StreamID:      3,
+					BlockFragment: []byte("abc"),
+					EndHeaders:    true,
+					Priority:      PriorityParam{StreamDep: 1, Weight: 200},
+				})
+			},
+			`HEADERS flags=END_HEADERS|PRIORITY stream=3 len=8 dep=1 weight=200`,
+		},
+		{
+			func(fr *Framer) {
+				fr.WritePushPromise(PushPromiseParam{StreamID: 1, PromiseID: 2, BlockFragment: []byte("abc"), EndHeaders: true})
+			},
+			`PUSH_PROMISE flags=END_HEADERS stream=1 len=7 promised=2`,
+		},
+	}
+	for _, tt := range tests {
+		fr, _ := testFramer()
+		tt.write(fr)
+		f, err := fr.ReadFrame()
+		if err != nil {
+			t.Errorf("ReadFrame for %q: %v", tt.want, err)
+			continue
+		}
+		if got := SummarizeFrame(f); got != tt.want {
+			t.Errorf("SummarizeFrame:\n got: %s\nwant: %s", got, tt.want)
+		}
+	}
+}
+
+func TestReadFrameCountError(t *testing.T) {
+	block := func(fields ...hpack.HeaderField) []byte {
+		var buf bytes.Buffer
+		enc := hpack.NewEncoder(&buf)
+		for _, f := range fields {
+			enc.WriteField(f)
+		}
+		return buf.Bytes()
+	}
+	get := block(hpack.HeaderField{Name: ":method", Value: "GET"})
+	tests := []struct {
+		want  string
+		write func(fr *Framer)
+	}{{
+		want: "frame_too_large",
+		write: func(fr *Framer) {
+			fr.SetMaxReadFrameSize(minMaxFrameSize)
+			fr.WriteData(1, false, make([]byte, minMaxFrameSize+1))
+		},
+	}, {
+		want: "frame_order_continuation_expected",
+		write: func(fr *Framer) {
+			fr.WriteHeaders(HeadersFrameParam{StreamID: 1, BlockFragment: get})
+			fr.WriteData(1, false, []byte("foo"))
+		},
+	}, {
+		want: "frame_order_continuation_stream",
+		write: func(fr *Framer) {
+			fr.WriteHeaders(HeadersFrameParam{StreamID: 1, BlockFragment: get})
+			fr.WriteContinuation(3, true, nil)
+		},
+	}, {
+		want: "frame_order_continuation_unexpected",
+		write: func(fr *Framer) {
+			fr.WriteContinuation(1, true, get)
+		},
+	}, {
+		want: "frame_headers_list_too_large",
+		write: func(fr *Framer) {
+			fr.MaxHeaderListSize = 10
+			fr.WriteHeaders(HeadersFrameParam{StreamID: 1, BlockFragment: block(hpack.HeaderField{Name: "x-long", Value: strings.Repeat("a", 100)}), EndHeaders: true})
+		},
+	}, {
+		want: "frame_headers_continuation_after_invalid",
+		write: func(fr *Framer) {
+			fr.WriteHeaders(HeadersFrameParam{StreamID: 1, BlockFragment: block(hpack.HeaderField{Name: "Invalid", Value: "x"})})
+			fr.WriteContinuation(1, true, get)
+		},
+	}, {
+		want: "frame_headers_hpack_decode",
+		write: func(fr *Framer) {
+			// A reference to a dynamic table entry that doesn't exist.
+			fr.WriteHeaders(HeadersFrameParam{StreamID: 1, BlockFragment: []byte("\xff\x00"), EndHeaders: true})
+		},
+	}, {
+		want: "frame_headers_hpack_truncated",
+		write: func(fr *Framer) {
+			// A literal whose value is cut short.
+			fr.WriteHeaders(HeadersFrameParam{StreamID: 1, BlockFragment: []byte("\x00\x01a\x05ab"), EndHeaders: true})
+		},
+	}, {
+		want: "frame_headers_invalid_field",
+		write: func(fr *Framer) {
+			fr.WriteHeaders(HeadersFrameParam{StreamID: 1, BlockFragment: block(hpack.HeaderField{Name: "Invalid", Value: "x"}), EndHeaders: true})
+		},
+	}, {
+		want: "frame_headers_invalid_pseudo",
+		write: func(fr *Framer) {
+			fr.WriteHeaders(HeadersFrameParam{StreamID: 1, BlockFragment: block(
+				hpack.HeaderField{Name: ":method", Value: "GET"},
+				hpack.HeaderField{Name: ":status", Value: "200"},
+			), EndHeaders: true})
+		},
+	}}
+	for _, tt := range tests {
+		fr, _ := testFramer()
+		fr.ReadMetaHeaders = hpack.NewDecoder(initialHeaderTableSize, nil)
+		var got []string
+		fr.CountError = func(token string) { got = append(got, token) }
+		tt.write(fr)
+		for i := 0; i < 2; i++ {
+			if _, err := fr.ReadFrame(); err != nil {
+				break
+			}
+		}
+		if len(got) != 1 || got[0] != tt.want {
+			t.Errorf("%s: counted errors %q; want %q", tt.want, got, tt.want)
+		}
+	}
+}
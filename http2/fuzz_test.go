@@ -0,0 +1,132 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package http2
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"golang.org/x/net/http2/hpack"
+)
+
+// fuzzSeedFrames returns a sequence of valid frames, as a client might
+// send them, for seeding fuzz targets.
+func fuzzSeedFrames() [][]byte {
+	var seeds [][]byte
+	add := func(write func(fr *Framer)) {
+		var buf bytes.Buffer
+		write(NewFramer(&buf, nil))
+		seeds = append(seeds, buf.Bytes())
+	}
+	var hbuf bytes.Buffer
+	enc := hpack.NewEncoder(&hbuf)
+	for _, f := range []hpack.HeaderField{
+		{Name: ":method", Value: "POST"},
+		{Name: ":scheme", Value: "https"},
+		{Name: ":path", Value: "/"},
+		{Name: ":authority", Value: "example.com"},
+		{Name: "content-length", Value: "5"},
+	} {
+		enc.WriteField(f)
+	}
+	block := hbuf.Bytes()
+
+	add(func(fr *Framer) {
+		fr.WriteSettings(Setting{SettingInitialWindowSize, 1 << 20}, Setting{SettingMaxFrameSize, 1 << 15})
+		fr.WriteSettingsAck()
+	})
+	add(func(fr *Framer) {
+		fr.WriteHeaders(HeadersFrameParam{StreamID: 1, BlockFragment: block, EndHeaders: true})
+		fr.WriteData(1, false, []byte("hello"))
+		fr.WriteDataPadded(1, true, nil, []byte{0, 0})
+	})
+	add(func(fr *Framer) {
+		fr.WriteHeaders(HeadersFrameParam{StreamID: 1, BlockFragment: block[:3], EndStream: true})
+		fr.WriteContinuation(1, true, block[3:])
+		fr.WriteRSTStream(1, ErrCodeCancel)
+	})
+	add(func(fr *Framer) {
+		fr.WritePing(false, [8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+		fr.WriteWindowUpdate(0, 1<<16)
+		fr.WritePriority(3, PriorityParam{StreamDep: 1, Weight: 15})
+		fr.WritePriorityUpdate(3, "u=1, i")
+		fr.WriteGoAway(0, ErrCodeNo, []byte("bye"))
+	})
+	return seeds
+}
+
+// FuzzReadFrame reads arbitrary bytes as a sequence of frames, with
+// header blocks decoded, optionally with AllowIllegalReads set.
+func FuzzReadFrame(f *testing.F) {
+	seeds := fuzzSeedFrames()
+	var buf bytes.Buffer
+	fr := NewFramer(&buf, nil)
+	fr.AllowIllegalWrites = true
+	fr.WriteHeaders(HeadersFrameParam{StreamID: 0, BlockFragment: []byte{0x82}, EndHeaders: true})
+	seeds = append(seeds, buf.Bytes())
+	for _, seed := range seeds {
+		f.Add(seed, false)
+		f.Add(seed, true)
+	}
+	f.Fuzz(func(t *testing.T, data []byte, allowIllegalReads bool) {
+		fr := NewFramer(io.Discard, bytes.NewReader(data))
+		fr.AllowIllegalReads = allowIllegalReads
+		fr.ReadMetaHeaders = hpack.NewDecoder(initialHeaderTableSize, nil)
+		fr.MaxHeaderListSize = 1 << 16
+		fr.SetMaxReadFrameSize(1 << 16)
+		for i := 0; i < 100; i++ {
+			f, err := fr.ReadFrame()
+			if err != nil {
+				if f != nil {
+					t.Fatalf("ReadFrame returned both frame %v and error %v", f, err)
+				}
+				return
+			}
+		}
+	})
+}
+
+// FuzzServerHandshake serves a connection whose client sends the
+// connection preface followed by arbitrary bytes.
+func FuzzServerHandshake(f *testing.F) {
+	for _, seed := range fuzzSeedFrames() {
+		f.Add(seed)
+	}
+	srv := &Server{}
+	opts := &ServeConnOpts{
+		BaseConfig: &http.Server{ErrorLog: log.New(io.Discard, "", 0)},
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			io.Copy(io.Discard, r.Body)
+			w.Write([]byte("ok"))
+		}),
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		c, s := net.Pipe()
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			srv.ServeConn(s, opts)
+		}()
+		go io.Copy(io.Discard, c)
+		c.SetWriteDeadline(time.Now().Add(100 * time.Millisecond)) // in case the server stops reading
+		if _, err := io.WriteString(c, ClientPreface); err == nil {
+			c.Write(data)
+		}
+		c.Close()
+		select {
+		case <-done:
+		case <-time.After(10 * time.Second):
+			t.Fatal("ServeConn didn't return after the client closed the connection")
+		}
+	})
+}
@@ -24,6 +24,7 @@ func TestSettingsAckSwallowWriter(t *testing.T) {
 	var buf bytes.Buffer
 	swallower := newSettingsAckSwallowWriter(bufio.NewWriter(&buf))
 	fw := http2.NewFramer(swallower, nil)
+	fw.AllowIllegalWrites = true
 	fw.WriteSettings(http2.Setting{http2.SettingMaxFrameSize, 2})
 	fw.WriteSettingsAck()
 	fw.WriteData(1, true, []byte{})
@@ -0,0 +1,342 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package h2conform
+
+import (
+	"io"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/hpack"
+)
+
+// Cases returns the conformance checks, in the order of the RFC 7540
+// sections they cover. Callers may modify the returned slice.
+func Cases() []*Case {
+	return []*Case{{
+		ID:            "3.5/1",
+		Description:   "Sends an invalid connection preface; requires a connection error",
+		SkipHandshake: true,
+		Run: func(c *Conn) error {
+			if _, err := io.WriteString(c, "INVALID CONNECTION PREFACE\r\n\r\n"); err != nil {
+				return err
+			}
+			return c.WaitConnectionError(http2.ErrCodeProtocol)
+		},
+	}, {
+		ID:          "4.1/1",
+		Description: "Sends a frame of unknown type; requires the frame be ignored",
+		Run: func(c *Conn) error {
+			if err := c.Framer.WriteRawFrame(0xff, 0, 0, []byte("unknown")); err != nil {
+				return err
+			}
+			return ping(c)
+		},
+	}, {
+		ID:          "4.2/1",
+		Description: "Sends a DATA frame larger than SETTINGS_MAX_FRAME_SIZE; requires a FRAME_SIZE_ERROR",
+		Run: func(c *Conn) error {
+			fields := c.RequestHeaders()
+			fields[0].Value = "POST"
+			if err := c.WriteRequest(1, false, fields); err != nil {
+				return err
+			}
+			if err := c.Framer.WriteData(1, true, make([]byte, c.MaxFrameSize()+1)); err != nil {
+				return err
+			}
+			return c.WaitStreamError(1, http2.ErrCodeFrameSize)
+		},
+	}, {
+		ID:          "4.3/1",
+		Description: "Sends an undecodable header block; requires a COMPRESSION_ERROR",
+		Run: func(c *Conn) error {
+			if err := c.Framer.WriteHeaders(http2.HeadersFrameParam{
+				StreamID:      1,
+				BlockFragment: []byte{0x80}, // index 0 is invalid
+				EndStream:     true,
+				EndHeaders:    true,
+			}); err != nil {
+				return err
+			}
+			return c.WaitConnectionError(http2.ErrCodeCompression)
+		},
+	}, {
+		ID:          "5.1/1",
+		Description: "Sends a DATA frame on an idle stream; requires a PROTOCOL_ERROR",
+		Run: func(c *Conn) error {
+			if err := c.Framer.WriteData(1, true, []byte("test")); err != nil {
+				return err
+			}
+			return c.WaitConnectionError(http2.ErrCodeProtocol)
+		},
+	}, {
+		ID:          "5.1/2",
+		Description: "Sends a HEADERS frame on a half-closed (remote) stream; requires a STREAM_CLOSED error",
+		Run: func(c *Conn) error {
+			if err := c.WriteRequest(1, true, c.RequestHeaders()); err != nil {
+				return err
+			}
+			if err := c.WriteRequest(1, true, c.RequestHeaders()); err != nil {
+				return err
+			}
+			return c.WaitStreamError(1, http2.ErrCodeStreamClosed, http2.ErrCodeProtocol)
+		},
+	}, {
+		ID:          "5.1.1/1",
+		Description: "Sends a HEADERS frame with an even stream ID; requires a PROTOCOL_ERROR",
+		Run: func(c *Conn) error {
+			if err := c.WriteRequest(2, true, c.RequestHeaders()); err != nil {
+				return err
+			}
+			return c.WaitConnectionError(http2.ErrCodeProtocol)
+		},
+	}, {
+		ID:          "5.1.1/2",
+		Description: "Sends a HEADERS frame with a stream ID lower than a previous one; requires a PROTOCOL_ERROR",
+		Run: func(c *Conn) error {
+			if err := c.WriteRequest(5, true, c.RequestHeaders()); err != nil {
+				return err
+			}
+			if err := c.WriteRequest(3, true, c.RequestHeaders()); err != nil {
+				return err
+			}
+			return c.WaitConnectionError(http2.ErrCodeProtocol)
+		},
+	}, {
+		ID:          "6.1/1",
+		Description: "Sends a DATA frame with stream ID 0; requires a PROTOCOL_ERROR",
+		Run: func(c *Conn) error {
+			if err := c.Framer.WriteData(0, true, []byte("test")); err != nil {
+				return err
+			}
+			return c.WaitConnectionError(http2.ErrCodeProtocol)
+		},
+	}, {
+		ID:          "6.2/1",
+		Description: "Sends a HEADERS frame with stream ID 0; requires a PROTOCOL_ERROR",
+		Run: func(c *Conn) error {
+			if err := c.WriteRequest(0, true, c.RequestHeaders()); err != nil {
+				return err
+			}
+			return c.WaitConnectionError(http2.ErrCodeProtocol)
+		},
+	}, {
+		ID:          "6.2/2",
+		Description: "Sends a HEADERS frame without END_HEADERS followed by a PING; requires a PROTOCOL_ERROR",
+		Run: func(c *Conn) error {
+			if err := c.Framer.WriteHeaders(http2.HeadersFrameParam{
+				StreamID:      1,
+				BlockFragment: c.EncodeHeaders(c.RequestHeaders()),
+				EndStream:     true,
+			}); err != nil {
+				return err
+			}
+			if err := c.Framer.WritePing(false, [8]byte{}); err != nil {
+				return err
+			}
+			return c.WaitConnectionError(http2.ErrCodeProtocol)
+		},
+	}, {
+		ID:          "6.4/1",
+		Description: "Sends a RST_STREAM frame with stream ID 0; requires a PROTOCOL_ERROR",
+		Run: func(c *Conn) error {
+			if err := c.Framer.WriteRSTStream(0, http2.ErrCodeCancel); err != nil {
+				return err
+			}
+			return c.WaitConnectionError(http2.ErrCodeProtocol)
+		},
+	}, {
+		ID:          "6.4/2",
+		Description: "Sends a RST_STREAM frame on an idle stream; requires a PROTOCOL_ERROR",
+		Run: func(c *Conn) error {
+			if err := c.Framer.WriteRSTStream(1, http2.ErrCodeCancel); err != nil {
+				return err
+			}
+			return c.WaitConnectionError(http2.ErrCodeProtocol)
+		},
+	}, {
+		ID:          "6.5/1",
+		Description: "Sends a SETTINGS frame with ACK and a payload; requires a FRAME_SIZE_ERROR",
+		Run: func(c *Conn) error {
+			if err := c.Framer.WriteRawFrame(http2.FrameSettings, http2.FlagSettingsAck, 0, make([]byte, 6)); err != nil {
+				return err
+			}
+			return c.WaitConnectionError(http2.ErrCodeFrameSize)
+		},
+	}, {
+		ID:          "6.5/2",
+		Description: "Sends a SETTINGS frame with a stream ID other than 0; requires a PROTOCOL_ERROR",
+		Run: func(c *Conn) error {
+			if err := c.Framer.WriteRawFrame(http2.FrameSettings, 0, 1, nil); err != nil {
+				return err
+			}
+			return c.WaitConnectionError(http2.ErrCodeProtocol)
+		},
+	}, {
+		ID:          "6.5/3",
+		Description: "Sends a SETTINGS frame with a length that isn't a multiple of 6; requires a FRAME_SIZE_ERROR",
+		Run: func(c *Conn) error {
+			if err := c.Framer.WriteRawFrame(http2.FrameSettings, 0, 0, make([]byte, 3)); err != nil {
+				return err
+			}
+			return c.WaitConnectionError(http2.ErrCodeFrameSize)
+		},
+	}, {
+		ID:          "6.5.2/1",
+		Description: "Sends SETTINGS_ENABLE_PUSH with a value other than 0 or 1; requires a PROTOCOL_ERROR",
+		Run: func(c *Conn) error {
+			if err := c.Framer.WriteSettings(http2.Setting{ID: http2.SettingEnablePush, Val: 2}); err != nil {
+				return err
+			}
+			return c.WaitConnectionError(http2.ErrCodeProtocol)
+		},
+	}, {
+		ID:          "6.5.2/2",
+		Description: "Sends SETTINGS_INITIAL_WINDOW_SIZE above the maximum window size; requires a FLOW_CONTROL_ERROR",
+		Run: func(c *Conn) error {
+			if err := c.Framer.WriteSettings(http2.Setting{ID: http2.SettingInitialWindowSize, Val: 1 << 31}); err != nil {
+				return err
+			}
+			return c.WaitConnectionError(http2.ErrCodeFlowControl)
+		},
+	}, {
+		ID:          "6.5.2/3",
+		Description: "Sends SETTINGS_MAX_FRAME_SIZE below the initial value; requires a PROTOCOL_ERROR",
+		Run: func(c *Conn) error {
+			if err := c.Framer.WriteSettings(http2.Setting{ID: http2.SettingMaxFrameSize, Val: 16<<10 - 1}); err != nil {
+				return err
+			}
+			return c.WaitConnectionError(http2.ErrCodeProtocol)
+		},
+	}, {
+		ID:          "6.7/1",
+		Description: "Sends a PING frame; requires a PING frame with ACK and the same data",
+		Run:         ping,
+	}, {
+		ID:          "6.7/2",
+		Description: "Sends a PING frame with a stream ID other than 0; requires a PROTOCOL_ERROR",
+		Run: func(c *Conn) error {
+			if err := c.Framer.WriteRawFrame(http2.FramePing, 0, 1, make([]byte, 8)); err != nil {
+				return err
+			}
+			return c.WaitConnectionError(http2.ErrCodeProtocol)
+		},
+	}, {
+		ID:          "6.7/3",
+		Description: "Sends a PING frame with a length other than 8; requires a FRAME_SIZE_ERROR",
+		Run: func(c *Conn) error {
+			if err := c.Framer.WriteRawFrame(http2.FramePing, 0, 0, make([]byte, 6)); err != nil {
+				return err
+			}
+			return c.WaitConnectionError(http2.ErrCodeFrameSize)
+		},
+	}, {
+		ID:          "6.9/1",
+		Description: "Sends a WINDOW_UPDATE frame with an increment of 0; requires a PROTOCOL_ERROR",
+		Run: func(c *Conn) error {
+			if err := c.Framer.WriteWindowUpdate(0, 0); err != nil {
+				return err
+			}
+			return c.WaitConnectionError(http2.ErrCodeProtocol)
+		},
+	}, {
+		ID:          "6.9.1/1",
+		Description: "Sends WINDOW_UPDATE frames that overflow the connection window; requires a FLOW_CONTROL_ERROR",
+		Run: func(c *Conn) error {
+			if err := c.Framer.WriteWindowUpdate(0, 1<<31-1); err != nil {
+				return err
+			}
+			if err := c.Framer.WriteWindowUpdate(0, 1<<31-1); err != nil {
+				return err
+			}
+			return c.WaitConnectionError(http2.ErrCodeFlowControl)
+		},
+	}, {
+		ID:          "6.10/1",
+		Description: "Sends a CONTINUATION frame without a preceding HEADERS frame; requires a PROTOCOL_ERROR",
+		Run: func(c *Conn) error {
+			if err := c.Framer.WriteContinuation(1, true, c.EncodeHeaders(c.RequestHeaders())); err != nil {
+				return err
+			}
+			return c.WaitConnectionError(http2.ErrCodeProtocol)
+		},
+	}, {
+		ID:          "8.1/1",
+		Description: "Sends a GET request; requires a response",
+		Run: func(c *Conn) error {
+			if err := c.WriteRequest(1, true, c.RequestHeaders()); err != nil {
+				return err
+			}
+			_, err := c.WaitHeaders(1)
+			return err
+		},
+	}, {
+		ID:          "8.1.2/1",
+		Description: "Sends a header field name with uppercase letters; requires a PROTOCOL_ERROR",
+		Run: func(c *Conn) error {
+			fields := append(c.RequestHeaders(), hpack.HeaderField{Name: "X-Test", Value: "ok"})
+			return requestError(c, fields)
+		},
+	}, {
+		ID:          "8.1.2.1/1",
+		Description: "Sends an unknown pseudo-header field; requires a PROTOCOL_ERROR",
+		Run: func(c *Conn) error {
+			fields := append(c.RequestHeaders(), hpack.HeaderField{Name: ":test", Value: "ok"})
+			return requestError(c, fields)
+		},
+	}, {
+		ID:          "8.1.2.1/2",
+		Description: "Sends a pseudo-header field after a regular field; requires a PROTOCOL_ERROR",
+		Run: func(c *Conn) error {
+			fields := c.RequestHeaders()
+			fields = append([]hpack.HeaderField{{Name: "x-test", Value: "ok"}}, fields...)
+			return requestError(c, fields)
+		},
+	}, {
+		ID:          "8.1.2.2/1",
+		Description: "Sends a connection-specific header field; requires a PROTOCOL_ERROR",
+		Run: func(c *Conn) error {
+			fields := append(c.RequestHeaders(), hpack.HeaderField{Name: "connection", Value: "keep-alive"})
+			return requestError(c, fields)
+		},
+	}, {
+		ID:          "8.1.2.2/2",
+		Description: "Sends a TE header field with a value other than \"trailers\"; requires a PROTOCOL_ERROR",
+		Run: func(c *Conn) error {
+			fields := append(c.RequestHeaders(), hpack.HeaderField{Name: "te", Value: "gzip"})
+			return requestError(c, fields)
+		},
+	}, {
+		ID:          "8.1.2.3/1",
+		Description: "Sends a request without the :method pseudo-header field; requires a PROTOCOL_ERROR",
+		Run: func(c *Conn) error {
+			return requestError(c, c.RequestHeaders()[1:])
+		},
+	}, {
+		ID:          "8.1.2.3/2",
+		Description: "Sends a request with duplicate :path pseudo-header fields; requires a PROTOCOL_ERROR",
+		Run: func(c *Conn) error {
+			fields := append(c.RequestHeaders(), hpack.HeaderField{Name: ":path", Value: "/"})
+			return requestError(c, fields)
+		},
+	}}
+}
+
+// ping sends a PING frame and waits for its acknowledgment.
+func ping(c *Conn) error {
+	data := [8]byte{'h', '2', 'c', 'o', 'n', 'f'}
+	if err := c.Framer.WritePing(false, data); err != nil {
+		return err
+	}
+	return c.WaitPingAck(data)
+}
+
+// requestError sends a request on stream 1 with fields, and waits for
+// the server to reject it with a PROTOCOL_ERROR.
+func requestError(c *Conn, fields []hpack.HeaderField) error {
+	if err := c.WriteRequest(1, true, fields); err != nil {
+		return err
+	}
+	return c.WaitStreamError(1, http2.ErrCodeProtocol)
+}
@@ -0,0 +1,234 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package h2conform
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/hpack"
+)
+
+// A Conn is a connection to the server under test.
+type Conn struct {
+	net.Conn
+
+	// Framer reads and writes frames on the connection.
+	// It allows illegal writes.
+	Framer *http2.Framer
+
+	// ServerSettings holds the settings from the server's first
+	// SETTINGS frame, after Handshake.
+	ServerSettings map[http2.SettingID]uint32
+
+	cfg  *Config
+	hbuf bytes.Buffer
+	henc *hpack.Encoder
+}
+
+func newConn(nc net.Conn, cfg *Config) *Conn {
+	c := &Conn{
+		Conn:           nc,
+		Framer:         http2.NewFramer(nc, nc),
+		ServerSettings: make(map[http2.SettingID]uint32),
+		cfg:            cfg,
+	}
+	c.Framer.AllowIllegalWrites = true
+	c.Framer.AllowIllegalReads = true
+	c.henc = hpack.NewEncoder(&c.hbuf)
+	return c
+}
+
+// Handshake sends the client connection preface and an empty SETTINGS
+// frame, then waits for the server's SETTINGS frame and for the server
+// to acknowledge the client's.
+func (c *Conn) Handshake() error {
+	if _, err := io.WriteString(c, http2.ClientPreface); err != nil {
+		return err
+	}
+	if err := c.Framer.WriteSettings(); err != nil {
+		return err
+	}
+	f, err := c.ReadFrame()
+	if err != nil {
+		return err
+	}
+	sf, ok := f.(*http2.SettingsFrame)
+	if !ok || sf.IsAck() {
+		return fmt.Errorf("server's first frame is %v; want SETTINGS", f.Header())
+	}
+	sf.ForeachSetting(func(s http2.Setting) error {
+		c.ServerSettings[s.ID] = s.Val
+		return nil
+	})
+	if err := c.Framer.WriteSettingsAck(); err != nil {
+		return err
+	}
+	for {
+		f, err := c.ReadFrame()
+		if err != nil {
+			return fmt.Errorf("waiting for SETTINGS ACK: %v", err)
+		}
+		if sf, ok := f.(*http2.SettingsFrame); ok && sf.IsAck() {
+			return nil
+		}
+	}
+}
+
+// MaxFrameSize returns the largest frame payload the server accepts.
+func (c *Conn) MaxFrameSize() uint32 {
+	if v, ok := c.ServerSettings[http2.SettingMaxFrameSize]; ok {
+		return v
+	}
+	return 16 << 10
+}
+
+// RequestHeaders returns the pseudo-header fields of a GET request for
+// the configured authority, scheme, and path.
+func (c *Conn) RequestHeaders() []hpack.HeaderField {
+	return []hpack.HeaderField{
+		{Name: ":method", Value: "GET"},
+		{Name: ":scheme", Value: c.cfg.scheme()},
+		{Name: ":path", Value: c.cfg.path()},
+		{Name: ":authority", Value: c.cfg.authority()},
+	}
+}
+
+// EncodeHeaders returns a header block containing fields.
+func (c *Conn) EncodeHeaders(fields []hpack.HeaderField) []byte {
+	c.hbuf.Reset()
+	for _, f := range fields {
+		c.henc.WriteField(f)
+	}
+	return append([]byte(nil), c.hbuf.Bytes()...)
+}
+
+// WriteRequest opens streamID with a HEADERS frame containing fields,
+// ending the stream if endStream is set.
+func (c *Conn) WriteRequest(streamID uint32, endStream bool, fields []hpack.HeaderField) error {
+	return c.Framer.WriteHeaders(http2.HeadersFrameParam{
+		StreamID:      streamID,
+		BlockFragment: c.EncodeHeaders(fields),
+		EndStream:     endStream,
+		EndHeaders:    true,
+	})
+}
+
+// errTimeout is returned by ReadFrame when the server sends nothing
+// within the configured timeout.
+var errTimeout = errors.New("timed out waiting for a frame")
+
+// ReadFrame reads the next frame from the server, waiting for at most
+// the configured timeout.
+func (c *Conn) ReadFrame() (http2.Frame, error) {
+	c.SetReadDeadline(time.Now().Add(c.cfg.timeout()))
+	f, err := c.Framer.ReadFrame()
+	if ne, ok := err.(net.Error); ok && ne.Timeout() {
+		return nil, errTimeout
+	}
+	return f, err
+}
+
+// WaitConnectionError reads frames until the server signals a
+// connection error, by sending a GOAWAY frame or by closing the
+// connection. It returns an error if the GOAWAY frame's error code isn't
+// one of codes, or if the server doesn't signal an error.
+func (c *Conn) WaitConnectionError(codes ...http2.ErrCode) error {
+	return c.waitError(0, codes)
+}
+
+// WaitStreamError reads frames until the server signals a stream error
+// on streamID, by sending a RST_STREAM frame, or a connection error. It
+// returns an error if the error code isn't one of codes, or if the
+// server doesn't signal an error.
+func (c *Conn) WaitStreamError(streamID uint32, codes ...http2.ErrCode) error {
+	return c.waitError(streamID, codes)
+}
+
+func (c *Conn) waitError(streamID uint32, codes []http2.ErrCode) error {
+	want := fmt.Sprintf("GOAWAY with %v", codes)
+	if streamID != 0 {
+		want = fmt.Sprintf("RST_STREAM on stream %d or GOAWAY with %v", streamID, codes)
+	}
+	for {
+		f, err := c.ReadFrame()
+		if err == errTimeout {
+			return fmt.Errorf("timed out waiting for %v", want)
+		}
+		if err != nil {
+			// The server closed the connection.
+			return nil
+		}
+		var code http2.ErrCode
+		switch f := f.(type) {
+		case *http2.GoAwayFrame:
+			code = f.ErrCode
+		case *http2.RSTStreamFrame:
+			if streamID == 0 || f.StreamID != streamID {
+				continue
+			}
+			code = f.ErrCode
+		default:
+			continue
+		}
+		for _, wantCode := range codes {
+			if code == wantCode {
+				return nil
+			}
+		}
+		return fmt.Errorf("got %v with %v; want %v", f.Header().Type, code, want)
+	}
+}
+
+// WaitHeaders reads frames until the server sends a HEADERS frame on
+// streamID.
+func (c *Conn) WaitHeaders(streamID uint32) (*http2.HeadersFrame, error) {
+	for {
+		f, err := c.ReadFrame()
+		if err != nil {
+			return nil, fmt.Errorf("waiting for HEADERS on stream %d: %v", streamID, err)
+		}
+		switch f := f.(type) {
+		case *http2.HeadersFrame:
+			if f.StreamID == streamID {
+				return f, nil
+			}
+		case *http2.GoAwayFrame:
+			return nil, fmt.Errorf("got GOAWAY with %v waiting for HEADERS on stream %d", f.ErrCode, streamID)
+		case *http2.RSTStreamFrame:
+			if f.StreamID == streamID {
+				return nil, fmt.Errorf("got RST_STREAM with %v waiting for HEADERS on stream %d", f.ErrCode, streamID)
+			}
+		}
+	}
+}
+
+// WaitPingAck reads frames until the server acknowledges a PING frame
+// with data.
+func (c *Conn) WaitPingAck(data [8]byte) error {
+	for {
+		f, err := c.ReadFrame()
+		if err != nil {
+			return fmt.Errorf("waiting for PING ACK: %v", err)
+		}
+		switch f := f.(type) {
+		case *http2.PingFrame:
+			if !f.IsAck() {
+				continue
+			}
+			if f.Data != data {
+				return fmt.Errorf("PING ACK data = %q; want %q", f.Data, data)
+			}
+			return nil
+		case *http2.GoAwayFrame:
+			return fmt.Errorf("got GOAWAY with %v waiting for PING ACK", f.ErrCode)
+		}
+	}
+}
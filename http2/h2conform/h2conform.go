@@ -0,0 +1,166 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package h2conform checks HTTP/2 servers for conformance with RFC 7540.
+//
+// Each check sends a crafted sequence of frames, usually invalid ones,
+// and verifies that the server responds as the RFC requires, typically
+// with a GOAWAY or RST_STREAM frame carrying a particular error code.
+// The checks are modeled on those of the h2spec tool, and are intended
+// to run in the CI of programs that embed an HTTP/2 server:
+//
+//	func TestConformance(t *testing.T) {
+//		cfg := &h2conform.Config{
+//			Dial: func() (net.Conn, error) { return net.Dial("tcp", addr) },
+//		}
+//		cfg.Test(t)
+//	}
+//
+// Servers are spoken to with prior knowledge of HTTP/2. To test a server
+// over TLS, make Dial return a *tls.Conn that has negotiated "h2".
+package h2conform // import "golang.org/x/net/http2/h2conform"
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+)
+
+// A Config describes the server under test.
+type Config struct {
+	// Dial connects to the server. Each check uses its own
+	// connection.
+	Dial func() (net.Conn, error)
+
+	// Authority, Scheme, and Path are the request pseudo-header
+	// values used for requests. The defaults are "localhost",
+	// "http", and "/".
+	Authority string
+	Scheme    string
+	Path      string
+
+	// Timeout is how long to wait for each expected response from
+	// the server. The default is 1 second.
+	Timeout time.Duration
+
+	// Skip lists the IDs of cases not to run, such as checks of
+	// behavior the server knowingly doesn't conform to.
+	Skip []string
+}
+
+func (cfg *Config) skip(tc *Case) bool {
+	for _, id := range cfg.Skip {
+		if id == tc.ID {
+			return true
+		}
+	}
+	return false
+}
+
+func (cfg *Config) authority() string {
+	if cfg.Authority == "" {
+		return "localhost"
+	}
+	return cfg.Authority
+}
+
+func (cfg *Config) scheme() string {
+	if cfg.Scheme == "" {
+		return "http"
+	}
+	return cfg.Scheme
+}
+
+func (cfg *Config) path() string {
+	if cfg.Path == "" {
+		return "/"
+	}
+	return cfg.Path
+}
+
+func (cfg *Config) timeout() time.Duration {
+	if cfg.Timeout <= 0 {
+		return time.Second
+	}
+	return cfg.Timeout
+}
+
+// A Case is a single conformance check.
+type Case struct {
+	// ID identifies the check by the RFC 7540 section it covers,
+	// such as "6.5.2/1".
+	ID string
+
+	// Description describes the frames sent and the required
+	// response.
+	Description string
+
+	// SkipHandshake reports whether Run is called before the
+	// connection preface and SETTINGS frames have been exchanged.
+	SkipHandshake bool
+
+	// Run performs the check on a new connection. It returns an
+	// error if the server doesn't conform.
+	Run func(c *Conn) error
+}
+
+func (tc *Case) String() string {
+	return tc.ID + ": " + tc.Description
+}
+
+// A Result is the outcome of a Case.
+type Result struct {
+	Case    *Case
+	Skipped bool  // the case is listed in Config.Skip
+	Err     error // nil if the server passed or the case was skipped
+}
+
+// Run runs each of cases, or all of Cases if cases is nil, against the
+// server described by cfg.
+func (cfg *Config) Run(cases []*Case) []Result {
+	if cases == nil {
+		cases = Cases()
+	}
+	results := make([]Result, len(cases))
+	for i, tc := range cases {
+		if cfg.skip(tc) {
+			results[i] = Result{Case: tc, Skipped: true}
+			continue
+		}
+		results[i] = Result{Case: tc, Err: cfg.runCase(tc)}
+	}
+	return results
+}
+
+// Test runs all of Cases against the server described by cfg, each as a
+// subtest of t.
+func (cfg *Config) Test(t *testing.T) {
+	for _, tc := range Cases() {
+		tc := tc
+		t.Run(tc.ID, func(t *testing.T) {
+			if cfg.skip(tc) {
+				t.Skip("listed in Config.Skip")
+			}
+			if err := cfg.runCase(tc); err != nil {
+				t.Errorf("%v\n%v", tc.Description, err)
+			}
+		})
+	}
+}
+
+func (cfg *Config) runCase(tc *Case) error {
+	nc, err := cfg.Dial()
+	if err != nil {
+		return fmt.Errorf("dial: %v", err)
+	}
+	c := newConn(nc, cfg)
+	defer c.Close()
+	if !tc.SkipHandshake {
+		if err := c.Handshake(); err != nil {
+			return fmt.Errorf("handshake: %v", err)
+		}
+	}
+	return tc.Run(c)
+}
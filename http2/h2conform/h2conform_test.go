@@ -0,0 +1,82 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package h2conform
+
+import (
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"testing"
+
+	"golang.org/x/net/http2"
+)
+
+// serve serves HTTP/2 with prior knowledge on a local listener, using
+// this module's server, and returns a Config for it.
+func serve(t *testing.T) *Config {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	s := &http2.Server{}
+	// The server logs each connection error the checks provoke.
+	hs := &http.Server{ErrorLog: log.New(io.Discard, "", 0)}
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})
+	go func() {
+		for {
+			c, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.ServeConn(c, &http2.ServeConnOpts{BaseConfig: hs, Handler: h})
+		}
+	}()
+	return &Config{
+		Dial: func() (net.Conn, error) { return net.Dial("tcp", ln.Addr().String()) },
+	}
+}
+
+func TestServerConformance(t *testing.T) {
+	cfg := serve(t)
+	// The server answers requests with connection-specific header
+	// fields with a 400 response rather than a stream error.
+	// See checkValidHTTP2RequestHeaders.
+	cfg.Skip = []string{"8.1.2.2/1", "8.1.2.2/2"}
+	cfg.Test(t)
+}
+
+func TestRunReportsFailures(t *testing.T) {
+	cfg := serve(t)
+	// A server that answers PING is not expected to reject it.
+	wrong := &Case{
+		ID:          "test",
+		Description: "expects a PING to be rejected",
+		Run: func(c *Conn) error {
+			if err := c.Framer.WritePing(false, [8]byte{}); err != nil {
+				return err
+			}
+			return c.WaitConnectionError(http2.ErrCodeProtocol)
+		},
+	}
+	cases := []*Case{Cases()[0], wrong, Cases()[1]}
+	cfg.Skip = []string{cases[2].ID}
+	results := cfg.Run(cases)
+	if len(results) != 3 {
+		t.Fatalf("got %d results; want 3", len(results))
+	}
+	if results[0].Case != cases[0] || results[0].Err != nil {
+		t.Errorf("results[0] = %v, %v; want %v, nil", results[0].Case, results[0].Err, cases[0])
+	}
+	if results[1].Case != wrong || results[1].Err == nil {
+		t.Errorf("results[1] = %v, %v; want %v, an error", results[1].Case, results[1].Err, wrong)
+	}
+	if results[2].Case != cases[2] || !results[2].Skipped || results[2].Err != nil {
+		t.Errorf("results[2] = %+v; want skipped", results[2])
+	}
+}
@@ -0,0 +1,343 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+The h2demo command is an HTTP/2 server demonstrating the http2 package.
+
+Usage:
+
+	$ h2demo [flags]
+
+h2demo serves HTTP/2 over TLS on -addr. Without -cert and -key it
+generates a self-signed certificate for localhost at startup. Its pages
+exercise server push, streaming responses, request and response
+trailers, and request body echoing; /debug/http2 shows the server's
+configuration and the HTTP/2 errors it has counted.
+
+With -capture, h2demo records the TLS secrets and frames of its
+connections for inspection with Wireshark, as described by
+http2.DebugCapture.
+
+On SIGINT or SIGTERM, h2demo sends GOAWAY frames on its open connections
+and waits up to -shutdown-timeout for their requests to finish.
+
+It's also a convenient target for the Docker curl image built by the
+http2 package's Makefile:
+
+	$ h2demo &
+	$ docker run --net=host gohttp2/curl --http2 --insecure -v https://localhost:4430/reqinfo
+*/
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"html"
+	"io"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"golang.org/x/net/http2"
+)
+
+// Flags
+var (
+	flagAddr            = flag.String("addr", "localhost:4430", "address to listen on")
+	flagCert            = flag.String("cert", "", "TLS certificate file; if empty, a self-signed certificate is generated")
+	flagKey             = flag.String("key", "", "TLS key file")
+	flagShutdownTimeout = flag.Duration("shutdown-timeout", 10*time.Second, "how long to wait for requests to finish on shutdown")
+	flagCapture         = flag.String("capture", "", "if non-empty, directory to record TLS keys and frames to; see http2.DebugCapture")
+)
+
+func main() {
+	flag.Parse()
+
+	h2 := &http2.Server{
+		CountError: errorCounts.add,
+	}
+	srv := &http.Server{
+		Addr:    *flagAddr,
+		Handler: newMux(h2),
+	}
+	if *flagCert == "" {
+		cert, err := selfSignedCert()
+		if err != nil {
+			log.Fatal(err)
+		}
+		srv.TLSConfig = &tls.Config{Certificates: []tls.Certificate{cert}}
+	}
+	if err := http2.ConfigureServer(srv, h2); err != nil {
+		log.Fatal(err)
+	}
+	if *flagCapture != "" {
+		dc, err := http2.NewDebugCapture(*flagCapture)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer dc.Close()
+		dc.ConfigureServer(srv, h2)
+	}
+
+	idleConnsClosed := make(chan struct{})
+	go func() {
+		sigc := make(chan os.Signal, 1)
+		signal.Notify(sigc, os.Interrupt, syscall.SIGTERM)
+		<-sigc
+		log.Printf("Shutting down")
+		ctx, cancel := context.WithTimeout(context.Background(), *flagShutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(ctx); err != nil {
+			log.Printf("Shutdown: %v", err)
+		}
+		close(idleConnsClosed)
+	}()
+
+	ln, err := net.Listen("tcp", srv.Addr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("Listening on https://%v/", ln.Addr())
+	if err := srv.ServeTLS(ln, *flagCert, *flagKey); err != http.ErrServerClosed {
+		log.Fatal(err)
+	}
+	<-idleConnsClosed
+}
+
+func newMux(h2 *http2.Server) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", home)
+	mux.HandleFunc("/reqinfo", reqInfo)
+	mux.HandleFunc("/push", push)
+	mux.HandleFunc("/push/style.css", pushStyle)
+	mux.HandleFunc("/clockstream", clockStream)
+	mux.HandleFunc("/trailers", trailers)
+	mux.HandleFunc("/echo", echo)
+	mux.Handle("/debug/http2", debugHandler{h2})
+	return mux
+}
+
+func home(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	io.WriteString(w, `<html><body>
+<h1>Go HTTP/2 demo</h1>
+<p>Connected with <b>`+html.EscapeString(r.Proto)+`</b>.</p>
+<ul>
+<li><a href="/reqinfo">/reqinfo</a>: the request as the server saw it</li>
+<li><a href="/push">/push</a>: a page whose stylesheet is pushed</li>
+<li><a href="/clockstream">/clockstream</a>: a streaming response</li>
+<li><a href="/trailers">/trailers</a>: a response with trailers</li>
+<li>/echo: PUT or POST a body to have it streamed back</li>
+<li><a href="/debug/http2">/debug/http2</a>: server configuration and error counts</li>
+</ul>
+</body></html>
+`)
+}
+
+func reqInfo(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprintf(w, "Method: %s\n", r.Method)
+	fmt.Fprintf(w, "Protocol: %s\n", r.Proto)
+	fmt.Fprintf(w, "Host: %s\n", r.Host)
+	fmt.Fprintf(w, "RemoteAddr: %s\n", r.RemoteAddr)
+	fmt.Fprintf(w, "RequestURI: %q\n", r.RequestURI)
+	if r.TLS != nil {
+		fmt.Fprintf(w, "TLS: %s, %s\n", tlsVersionName(r.TLS.Version), tls.CipherSuiteName(r.TLS.CipherSuite))
+	}
+	fmt.Fprintf(w, "\nHeaders:\n")
+	r.Header.Write(w)
+}
+
+func tlsVersionName(v uint16) string {
+	switch v {
+	case tls.VersionTLS12:
+		return "TLS 1.2"
+	case tls.VersionTLS13:
+		return "TLS 1.3"
+	}
+	return fmt.Sprintf("TLS version %#x", v)
+}
+
+func push(w http.ResponseWriter, r *http.Request) {
+	if p, ok := w.(http.Pusher); ok {
+		if err := p.Push("/push/style.css", nil); err != nil && err != http.ErrNotSupported {
+			log.Printf("Push: %v", err)
+		}
+	}
+	io.WriteString(w, `<html><head><link rel="stylesheet" href="/push/style.css"></head><body>
+<h1>Server push</h1>
+<p>If your client accepted the push, this page's stylesheet arrived
+before the page asked for it.</p>
+</body></html>
+`)
+}
+
+func pushStyle(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/css")
+	w.Header().Set("Cache-Control", "max-age=60")
+	io.WriteString(w, "body { font-family: sans-serif; background: #e0ebf5; }\n")
+}
+
+// clockStream writes the time once a second for 10 seconds, flushing
+// each line to the client as it's written.
+func clockStream(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	f, _ := w.(http.Flusher)
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for i := 0; i < 10; i++ {
+		fmt.Fprintf(w, "%v\n", time.Now().Format(time.RFC3339))
+		if f != nil {
+			f.Flush()
+		}
+		select {
+		case <-ticker.C:
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// trailers writes a body followed by a trailer holding its SHA-256
+// digest. If the request has trailers, they're echoed in the body.
+func trailers(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Trailer", "Body-Sha256")
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	h := sha256.New()
+	mw := io.MultiWriter(w, h)
+	io.Copy(io.Discard, r.Body) // read the body so r.Trailer is populated
+	fmt.Fprintf(mw, "This response's digest is in its Body-Sha256 trailer.\n")
+	for k, vv := range r.Trailer {
+		for _, v := range vv {
+			fmt.Fprintf(mw, "Request trailer %s: %s\n", k, v)
+		}
+	}
+	w.Header().Set("Body-Sha256", hex.EncodeToString(h.Sum(nil)))
+}
+
+// echo streams the request body back to the client as it arrives.
+func echo(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "PUT" && r.Method != "POST" {
+		w.Header().Set("Allow", "PUT, POST")
+		http.Error(w, "PUT or POST a body to echo it", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	f, _ := w.(http.Flusher)
+	buf := make([]byte, 16<<10)
+	for {
+		n, err := r.Body.Read(buf)
+		if n > 0 {
+			if _, werr := w.Write(buf[:n]); werr != nil {
+				return
+			}
+			if f != nil {
+				f.Flush()
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// errorCounts counts the error types reported by http2.Server.CountError.
+var errorCounts = &counter{m: make(map[string]int)}
+
+type counter struct {
+	mu sync.Mutex
+	m  map[string]int
+}
+
+func (c *counter) add(errType string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.m[errType]++
+}
+
+func (c *counter) snapshot() (keys []string, counts map[string]int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	counts = make(map[string]int, len(c.m))
+	for k, n := range c.m {
+		keys = append(keys, k)
+		counts[k] = n
+	}
+	sort.Strings(keys)
+	return keys, counts
+}
+
+// debugHandler serves /debug/http2.
+type debugHandler struct {
+	h2 *http2.Server
+}
+
+func (d debugHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprintf(w, "Server configuration:\n")
+	fmt.Fprintf(w, "  MaxHandlers: %d\n", d.h2.MaxHandlers)
+	fmt.Fprintf(w, "  MaxConcurrentStreams: %d\n", d.h2.MaxConcurrentStreams)
+	fmt.Fprintf(w, "  MaxReadFrameSize: %d\n", d.h2.MaxReadFrameSize)
+	fmt.Fprintf(w, "  IdleTimeout: %v\n", d.h2.IdleTimeout)
+	fmt.Fprintf(w, "  MaxUploadBufferPerConnection: %d\n", d.h2.MaxUploadBufferPerConnection)
+	fmt.Fprintf(w, "  MaxUploadBufferPerStream: %d\n", d.h2.MaxUploadBufferPerStream)
+	fmt.Fprintf(w, "  WriteQuota: %+v\n", d.h2.WriteQuota)
+
+	keys, counts := errorCounts.snapshot()
+	fmt.Fprintf(w, "\nErrors counted:\n")
+	if len(keys) == 0 {
+		fmt.Fprintf(w, "  (none)\n")
+	}
+	for _, k := range keys {
+		fmt.Fprintf(w, "  %s: %d\n", k, counts[k])
+	}
+}
+
+// selfSignedCert returns a certificate for localhost, valid for a day.
+func selfSignedCert() (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{Organization: []string{"h2demo"}},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
@@ -17,6 +17,10 @@ Interactive commands in the console: (all parts case-insensitive)
   settings ack
   settings FOO=n BAR=z
   headers      (open a new stream by typing HTTP/1.1)
+  goaway [ERROR_CODE [debug data]]
+  quit
+
+Frames sent to the server are shown prefixed with ">".
 */
 package main
 
@@ -75,6 +79,16 @@ var commands = map[string]command{
 	},
 	"quit":    {run: (*h2i).cmdQuit},
 	"headers": {run: (*h2i).cmdHeaders},
+	"goaway": {
+		run: (*h2i).cmdGoAway,
+		complete: func() []string {
+			var names []string
+			for code := http2.ErrCodeNo; code <= http2.ErrCodeHTTP11Required; code++ {
+				names = append(names, code.String())
+			}
+			return names
+		},
+	},
 }
 
 func usage() {
@@ -112,7 +126,7 @@ type h2i struct {
 
 	// owned by the readFrames loop:
 	peerSetting map[http2.SettingID]uint32
-	hdec        *hpack.Decoder
+	recvLog     *frameLog
 }
 
 func main() {
@@ -129,6 +143,7 @@ func main() {
 		host:        host,
 		peerSetting: make(map[http2.SettingID]uint32),
 	}
+	app.recvLog = &frameLog{app: app}
 	app.henc = hpack.NewEncoder(&app.hbuf)
 
 	if err := app.Main(); err != nil {
@@ -179,7 +194,7 @@ func (app *h2i) Main() error {
 		return err
 	}
 
-	app.framer = http2.NewFramer(tc, tc)
+	app.framer = http2.NewFramer(newSentFrameLogger(app, tc), tc)
 
 	oldState, err := term.MakeRaw(int(os.Stdin.Fd()))
 	if err != nil {
@@ -352,7 +367,6 @@ func (a *h2i) cmdSettings(args []string) error {
 			Val: uint32(val),
 		})
 	}
-	a.logf("Sending: %v", settings)
 	return a.framer.WriteSettings(settings...)
 }
 
@@ -386,6 +400,36 @@ func (app *h2i) cmdPing(args []string) error {
 	return app.framer.WritePing(false, data)
 }
 
+func (app *h2i) cmdGoAway(args []string) error {
+	code := http2.ErrCodeNo
+	var debug string
+	if len(args) > 0 {
+		var ok bool
+		code, ok = errCodeByName(args[0])
+		if !ok {
+			app.logf("Error: unknown error code %q", args[0])
+			return nil
+		}
+		debug = strings.Join(args[1:], " ")
+	}
+	// h2i never accepts pushed streams, so it has processed none.
+	return app.framer.WriteGoAway(0, code, []byte(debug))
+}
+
+// errCodeByName returns the ErrCode named by name, such as
+// PROTOCOL_ERROR, or given as a number.
+func errCodeByName(name string) (http2.ErrCode, bool) {
+	if v, err := strconv.ParseUint(name, 0, 32); err == nil {
+		return http2.ErrCode(v), true
+	}
+	for code := http2.ErrCodeNo; code <= http2.ErrCodeHTTP11Required; code++ {
+		if strings.EqualFold(code.String(), name) {
+			return code, true
+		}
+	}
+	return 0, false
+}
+
 func (app *h2i) cmdHeaders(args []string) error {
 	if len(args) > 0 {
 		app.logf("Error: HEADERS doesn't yet take arguments.")
@@ -437,53 +481,131 @@ func (app *h2i) readFrames() error {
 		if err != nil {
 			return fmt.Errorf("ReadFrame: %v", err)
 		}
-		app.logf("%v", f)
-		switch f := f.(type) {
-		case *http2.PingFrame:
-			app.logf("  Data = %q", f.Data)
-		case *http2.SettingsFrame:
+		app.recvLog.log(f)
+		if f, ok := f.(*http2.SettingsFrame); ok {
 			f.ForeachSetting(func(s http2.Setting) error {
-				app.logf("  %v", s)
 				app.peerSetting[s.ID] = s.Val
 				return nil
 			})
-		case *http2.WindowUpdateFrame:
-			app.logf("  Window-Increment = %v", f.Increment)
-		case *http2.GoAwayFrame:
-			app.logf("  Last-Stream-ID = %d; Error-Code = %v (%d)", f.LastStreamID, f.ErrCode, f.ErrCode)
-		case *http2.DataFrame:
-			app.logf("  %q", f.Data())
-		case *http2.HeadersFrame:
-			if f.HasPriority() {
-				app.logf("  PRIORITY = %v", f.Priority)
-			}
-			if app.hdec == nil {
-				// TODO: if the user uses h2i to send a SETTINGS frame advertising
-				// something larger, we'll need to respect SETTINGS_HEADER_TABLE_SIZE
-				// and stuff here instead of using the 4k default. But for now:
-				tableSize := uint32(4 << 10)
-				app.hdec = hpack.NewDecoder(tableSize, app.onNewHeaderField)
-			}
-			app.hdec.Write(f.HeaderBlockFragment())
-		case *http2.PushPromiseFrame:
-			if app.hdec == nil {
-				// TODO: if the user uses h2i to send a SETTINGS frame advertising
-				// something larger, we'll need to respect SETTINGS_HEADER_TABLE_SIZE
-				// and stuff here instead of using the 4k default. But for now:
-				tableSize := uint32(4 << 10)
-				app.hdec = hpack.NewDecoder(tableSize, app.onNewHeaderField)
-			}
-			app.hdec.Write(f.HeaderBlockFragment())
 		}
 	}
 }
 
-// called from readLoop
-func (app *h2i) onNewHeaderField(f hpack.HeaderField) {
+// A frameLog logs the frames sent or received on the connection,
+// decoding their header blocks, which may span CONTINUATION frames,
+// with the HPACK state of that direction.
+type frameLog struct {
+	app    *h2i
+	prefix string
+	hdec   *hpack.Decoder
+}
+
+func (fl *frameLog) log(f http2.Frame) {
+	fl.app.logFrame(fl.prefix, f)
+	var frag []byte
+	var end bool
+	switch f := f.(type) {
+	case *http2.HeadersFrame:
+		frag, end = f.HeaderBlockFragment(), f.HeadersEnded()
+	case *http2.PushPromiseFrame:
+		frag, end = f.HeaderBlockFragment(), f.HeadersEnded()
+	case *http2.ContinuationFrame:
+		frag, end = f.HeaderBlockFragment(), f.HeadersEnded()
+	default:
+		return
+	}
+	if fl.hdec == nil {
+		// TODO: if the user uses h2i to send a SETTINGS frame advertising
+		// something larger, we'll need to respect SETTINGS_HEADER_TABLE_SIZE
+		// and stuff here instead of using the 4k default. But for now:
+		tableSize := uint32(4 << 10)
+		fl.hdec = hpack.NewDecoder(tableSize, func(f hpack.HeaderField) {
+			fl.app.logHeaderField(fl.prefix, f)
+		})
+	}
+	if _, err := fl.hdec.Write(frag); err != nil {
+		fl.app.logf("%s  Error decoding header block: %v", fl.prefix, err)
+		return
+	}
+	if end {
+		if err := fl.hdec.Close(); err != nil {
+			fl.app.logf("%s  Error decoding header block: %v", fl.prefix, err)
+		}
+	}
+}
+
+// logFrame logs f and the contents of its payload, other than header
+// blocks, with each line prefixed by prefix.
+func (app *h2i) logFrame(prefix string, f http2.Frame) {
+	app.logf("%s%v", prefix, f)
+	switch f := f.(type) {
+	case *http2.PingFrame:
+		app.logf("%s  Data = %q", prefix, f.Data)
+	case *http2.SettingsFrame:
+		f.ForeachSetting(func(s http2.Setting) error {
+			app.logf("%s  %v", prefix, s)
+			return nil
+		})
+	case *http2.WindowUpdateFrame:
+		app.logf("%s  Window-Increment = %v", prefix, f.Increment)
+	case *http2.GoAwayFrame:
+		app.logf("%s  Last-Stream-ID = %d; Error-Code = %v (%d)", prefix, f.LastStreamID, f.ErrCode, f.ErrCode)
+		if len(f.DebugData()) > 0 {
+			app.logf("%s  Debug-Data = %q", prefix, f.DebugData())
+		}
+	case *http2.RSTStreamFrame:
+		app.logf("%s  Error-Code = %v (%d)", prefix, f.ErrCode, f.ErrCode)
+	case *http2.DataFrame:
+		app.logf("%s  %q", prefix, f.Data())
+	case *http2.HeadersFrame:
+		if f.HasPriority() {
+			app.logf("%s  PRIORITY = %v", prefix, f.Priority)
+		}
+	}
+}
+
+// sentFrameLogger is the writer of the app's Framer. It logs each frame
+// on its way to the server, reading the frames back with a Framer of its
+// own that lasts for the session.
+type sentFrameLogger struct {
+	w   io.Writer
+	buf bytes.Buffer
+	fr  *http2.Framer
+	log frameLog
+}
+
+func newSentFrameLogger(app *h2i, w io.Writer) *sentFrameLogger {
+	l := &sentFrameLogger{w: w, log: frameLog{app: app, prefix: "> "}}
+	l.fr = http2.NewFramer(nil, &l.buf)
+	// Show whatever the user sends, even frames too large or out of
+	// order for the server to accept.
+	l.fr.SetMaxReadFrameSize(1<<24 - 1)
+	l.fr.AllowIllegalReads = true
+	return l
+}
+
+func (l *sentFrameLogger) Write(p []byte) (int, error) {
+	// The Framer writes each frame with a single call to Write.
+	l.buf.Write(p)
+	for l.buf.Len() > 0 {
+		f, err := l.fr.ReadFrame()
+		if err != nil {
+			l.log.app.logf("> Error reading sent frame: %v", err)
+			l.buf.Reset()
+			break
+		}
+		l.log.log(f)
+	}
+	return l.w.Write(p)
+}
+
+// logHeaderField logs a decoded header field, prefixed by prefix.
+func (app *h2i) logHeaderField(prefix string, f hpack.HeaderField) {
 	if f.Sensitive {
-		app.logf("  %s = %q (SENSITIVE)", f.Name, f.Value)
+		app.logf("%s  %s = %q (SENSITIVE)", prefix, f.Name, f.Value)
+		return
 	}
-	app.logf("  %s = %q", f.Name, f.Value)
+	app.logf("%s  %s = %q", prefix, f.Name, f.Value)
 }
 
 func (app *h2i) encodeHeaders(req *http.Request) []byte {
@@ -517,7 +639,8 @@ func (app *h2i) encodeHeaders(req *http.Request) []byte {
 	return app.hbuf.Bytes()
 }
 
+// writeHeader encodes a header field. It's logged when the header block
+// is sent.
 func (app *h2i) writeHeader(name, value string) {
 	app.henc.WriteField(hpack.HeaderField{Name: name, Value: value})
-	app.logf(" %s = %s", name, value)
 }
@@ -0,0 +1,263 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+The h2load command generates HTTP/2 load against a server and reports
+what it saw.
+
+Usage:
+
+	$ h2load [flags] <url>
+
+h2load opens -c connections and sends -n requests over them in total,
+keeping up to -m requests in flight on each connection. Requests are
+sent with the http2 package's Transport, over TLS for https URLs and
+with prior knowledge over cleartext TCP for http URLs.
+
+When the requests are done, h2load reports the request rate, latency
+percentiles, response status codes, and the streams the server reset.
+It also decodes the frames sent on each connection to report GOAWAY
+frames and flow-control stalls: times that the window the server writes
+DATA into, for the connection or a stream, was used up before the
+response was complete.
+*/
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/http2"
+)
+
+// Flags
+var (
+	flagRequests = flag.Int("n", 1000, "total number of requests to send")
+	flagConns    = flag.Int("c", 1, "number of connections to open")
+	flagStreams  = flag.Int("m", 10, "maximum number of concurrent requests per connection")
+	flagMethod   = flag.String("method", "GET", "request method")
+	flagBodySize = flag.Int("body", 0, "size in bytes of the request body to send")
+	flagInsecure = flag.Bool("insecure", false, "whether to skip TLS cert validation")
+	flagTimeout  = flag.Duration("timeout", 30*time.Second, "timeout for each request")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: h2load [flags] <url>\n\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() != 1 || *flagRequests < 1 || *flagConns < 1 || *flagStreams < 1 {
+		usage()
+		os.Exit(2)
+	}
+	log.SetFlags(0)
+
+	u, err := url.Parse(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		log.Fatalf("unsupported URL scheme %q", u.Scheme)
+	}
+
+	var conns []*conn
+	for i := 0; i < *flagConns; i++ {
+		c, err := dial(u)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer c.cc.Close()
+		conns = append(conns, c)
+	}
+
+	var (
+		mu      sync.Mutex
+		results []result
+		wg      sync.WaitGroup
+		tickets = int64(*flagRequests)
+	)
+	start := time.Now()
+	for _, c := range conns {
+		for i := 0; i < *flagStreams; i++ {
+			wg.Add(1)
+			go func(c *conn) {
+				defer wg.Done()
+				for atomic.AddInt64(&tickets, -1) >= 0 {
+					res := c.do(u)
+					mu.Lock()
+					results = append(results, res)
+					mu.Unlock()
+				}
+			}(c)
+		}
+	}
+	wg.Wait()
+	elapsed := time.Since(start)
+
+	report(os.Stdout, results, conns, elapsed)
+}
+
+// A conn is a connection to the server.
+type conn struct {
+	cc  *http2.ClientConn
+	mon *flowMonitor
+}
+
+func dial(u *url.URL) (*conn, error) {
+	addr := u.Host
+	if u.Port() == "" {
+		addr = net.JoinHostPort(u.Hostname(), map[string]string{"http": "80", "https": "443"}[u.Scheme])
+	}
+	var nc net.Conn
+	var err error
+	tr := &http2.Transport{}
+	if u.Scheme == "https" {
+		tr.TLSClientConfig = &tls.Config{
+			ServerName:         u.Hostname(),
+			NextProtos:         []string{http2.NextProtoTLS},
+			InsecureSkipVerify: *flagInsecure,
+		}
+		nc, err = tls.Dial("tcp", addr, tr.TLSClientConfig)
+		if err == nil {
+			if p := nc.(*tls.Conn).ConnectionState().NegotiatedProtocol; p != http2.NextProtoTLS {
+				nc.Close()
+				err = fmt.Errorf("%s negotiated protocol %q, not %q", addr, p, http2.NextProtoTLS)
+			}
+		}
+	} else {
+		tr.AllowHTTP = true
+		nc, err = net.Dial("tcp", addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	mon := newFlowMonitor()
+	cc, err := tr.NewClientConn(mon.wrap(nc))
+	if err != nil {
+		nc.Close()
+		return nil, err
+	}
+	return &conn{cc: cc, mon: mon}, nil
+}
+
+// A result is the outcome of one request.
+type result struct {
+	latency time.Duration // until the response body was read
+	status  int           // 0 if the request failed
+	reset   bool          // the server reset the stream
+	err     error
+}
+
+func (c *conn) do(u *url.URL) result {
+	var body io.Reader
+	if *flagBodySize > 0 {
+		body = bytes.NewReader(make([]byte, *flagBodySize))
+	}
+	ctx := context.Background()
+	if *flagTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *flagTimeout)
+		defer cancel()
+	}
+	req, err := http.NewRequestWithContext(ctx, *flagMethod, u.String(), body)
+	if err != nil {
+		return result{err: err}
+	}
+	start := time.Now()
+	res, err := c.cc.RoundTrip(req)
+	if err == nil {
+		_, err = io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+	}
+	r := result{latency: time.Since(start), err: err}
+	var se http2.StreamError
+	switch {
+	case errors.As(err, &se):
+		r.reset = true
+	case err == nil:
+		r.status = res.StatusCode
+	}
+	return r
+}
+
+func report(w io.Writer, results []result, conns []*conn, elapsed time.Duration) {
+	var (
+		latencies []time.Duration
+		statuses  = map[int]int{}
+		resets    int
+		failed    int
+		lastErr   error
+	)
+	for _, r := range results {
+		switch {
+		case r.reset:
+			resets++
+		case r.err != nil:
+			failed++
+			lastErr = r.err
+		default:
+			statuses[r.status]++
+			latencies = append(latencies, r.latency)
+		}
+	}
+	fmt.Fprintf(w, "finished in %v, %.1f req/s\n", elapsed.Round(time.Millisecond), float64(len(results))/elapsed.Seconds())
+	fmt.Fprintf(w, "requests: %d total, %d succeeded, %d reset, %d failed\n", len(results), len(latencies), resets, failed)
+	if lastErr != nil {
+		fmt.Fprintf(w, "last error: %v\n", lastErr)
+	}
+
+	var codes []int
+	for code := range statuses {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+	fmt.Fprintf(w, "status codes:")
+	for _, code := range codes {
+		fmt.Fprintf(w, " %d: %d", code, statuses[code])
+	}
+	fmt.Fprintf(w, "\n")
+
+	if len(latencies) > 0 {
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+		var sum time.Duration
+		for _, d := range latencies {
+			sum += d
+		}
+		fmt.Fprintf(w, "latency: min %v, mean %v, p50 %v, p90 %v, p99 %v, max %v\n",
+			latencies[0], sum/time.Duration(len(latencies)),
+			percentile(latencies, 50), percentile(latencies, 90), percentile(latencies, 99),
+			latencies[len(latencies)-1])
+	}
+
+	var st flowStats
+	for _, c := range conns {
+		st.add(c.mon.stats())
+	}
+	fmt.Fprintf(w, "frames: %d RST_STREAM received, %d GOAWAY received, %d WINDOW_UPDATE sent\n",
+		st.resets, st.goAways, st.windowUpdates)
+	fmt.Fprintf(w, "flow control: %d connection stalls, %d stream stalls\n", st.connStalls, st.streamStalls)
+}
+
+// percentile returns the p-th percentile of the sorted, non-empty
+// latencies, using the nearest lower rank.
+func percentile(latencies []time.Duration, p int) time.Duration {
+	return latencies[(len(latencies)-1)*p/100]
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/http2"
+)
+
+func TestPercentile(t *testing.T) {
+	var latencies []time.Duration
+	for i := 1; i <= 100; i++ {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+	for _, tt := range []struct {
+		p    int
+		want time.Duration
+	}{
+		{0, 1 * time.Millisecond},
+		{50, 50 * time.Millisecond},
+		{90, 90 * time.Millisecond},
+		{99, 99 * time.Millisecond},
+		{100, 100 * time.Millisecond},
+	} {
+		if got := percentile(latencies, tt.p); got != tt.want {
+			t.Errorf("percentile(1ms..100ms, %d) = %v; want %v", tt.p, got, tt.want)
+		}
+	}
+	if got := percentile([]time.Duration{time.Second}, 99); got != time.Second {
+		t.Errorf("percentile of one latency = %v; want %v", got, time.Second)
+	}
+}
+
+func TestReport(t *testing.T) {
+	var results []result
+	for i := 1; i <= 10; i++ {
+		results = append(results, result{latency: time.Duration(i) * time.Millisecond, status: 200})
+	}
+	results = append(results,
+		result{latency: 20 * time.Millisecond, status: 404},
+		result{latency: time.Second, reset: true, err: http2.StreamError{StreamID: 1, Code: http2.ErrCodeRefusedStream}},
+		result{latency: time.Second, err: errors.New("boom")},
+	)
+	mon := newFlowMonitor()
+	mon.flowStats = flowStats{resets: 1, goAways: 2, windowUpdates: 3, connStalls: 4, streamStalls: 5}
+	conns := []*conn{{mon: mon}, {mon: newFlowMonitor()}}
+
+	var buf strings.Builder
+	report(&buf, results, conns, 2*time.Second)
+	want := `finished in 2s, 6.5 req/s
+requests: 13 total, 11 succeeded, 1 reset, 1 failed
+last error: boom
+status codes: 200: 10 404: 1
+latency: min 1ms, mean 6.818181ms, p50 6ms, p90 10ms, p99 10ms, max 20ms
+frames: 1 RST_STREAM received, 2 GOAWAY received, 3 WINDOW_UPDATE sent
+flow control: 4 connection stalls, 5 stream stalls
+`
+	if got := buf.String(); got != want {
+		t.Errorf("report:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io"
+	"net"
+	"sync"
+
+	"golang.org/x/net/http2"
+)
+
+// A flowMonitor decodes the frames sent in both directions on a
+// connection, and tracks the flow-control windows the server sends DATA
+// into.
+//
+// Frames are decoded on their own goroutines, so the monitor's view of
+// the windows can lag slightly behind the Transport's.
+type flowMonitor struct {
+	mu sync.Mutex
+	flowStats
+	initialWindow int32            // client's SETTINGS_INITIAL_WINDOW_SIZE
+	connWindow    int32            // remaining connection window
+	streamWindows map[uint32]int32 // remaining window of each open stream
+	lastStreamID  uint32           // highest stream opened by the client
+}
+
+// flowStats are the events counted by a flowMonitor.
+type flowStats struct {
+	resets        int // RST_STREAM frames received
+	goAways       int // GOAWAY frames received
+	windowUpdates int // WINDOW_UPDATE frames sent
+	connStalls    int // times the connection window was used up
+	streamStalls  int // times a stream window was used up
+}
+
+func (s *flowStats) add(t flowStats) {
+	s.resets += t.resets
+	s.goAways += t.goAways
+	s.windowUpdates += t.windowUpdates
+	s.connStalls += t.connStalls
+	s.streamStalls += t.streamStalls
+}
+
+// defaultWindow is the initial flow-control window size defined by
+// RFC 7540, Section 6.9.2.
+const defaultWindow = 65535
+
+func newFlowMonitor() *flowMonitor {
+	return &flowMonitor{
+		initialWindow: defaultWindow,
+		connWindow:    defaultWindow,
+		streamWindows: make(map[uint32]int32),
+	}
+}
+
+func (m *flowMonitor) stats() flowStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.flowStats
+}
+
+// wrap returns a net.Conn that passes everything read from and written
+// to c through m.
+func (m *flowMonitor) wrap(c net.Conn) net.Conn {
+	rr, rw := io.Pipe()
+	wr, ww := io.Pipe()
+	go m.decode(rr, m.received, false)
+	go m.decode(wr, m.sent, true)
+	return &monitoredConn{Conn: c, r: rw, w: ww}
+}
+
+// decode reads frames from r and passes them to f. If preface is set,
+// r begins with the client connection preface.
+func (m *flowMonitor) decode(r *io.PipeReader, f func(http2.Frame), preface bool) {
+	defer io.Copy(io.Discard, r) // never block the connection
+	if preface {
+		if _, err := io.ReadFull(r, make([]byte, len(http2.ClientPreface))); err != nil {
+			return
+		}
+	}
+	fr := http2.NewFramer(nil, r)
+	fr.SetMaxReadFrameSize(1<<24 - 1)
+	for {
+		frame, err := fr.ReadFrame()
+		if err != nil {
+			return
+		}
+		m.mu.Lock()
+		f(frame)
+		m.mu.Unlock()
+	}
+}
+
+// sent handles a frame written by the client.
+func (m *flowMonitor) sent(f http2.Frame) {
+	switch f := f.(type) {
+	case *http2.SettingsFrame:
+		if v, ok := f.Value(http2.SettingInitialWindowSize); ok {
+			delta := int32(v) - m.initialWindow
+			m.initialWindow = int32(v)
+			for id := range m.streamWindows {
+				m.streamWindows[id] += delta
+			}
+		}
+	case *http2.HeadersFrame:
+		// Only the first HEADERS opens the stream; a later one
+		// carries trailers and doesn't reset its window.
+		if f.StreamID > m.lastStreamID {
+			m.lastStreamID = f.StreamID
+			m.streamWindows[f.StreamID] = m.initialWindow
+		}
+	case *http2.WindowUpdateFrame:
+		m.windowUpdates++
+		if f.StreamID == 0 {
+			m.connWindow += int32(f.Increment)
+		} else if _, ok := m.streamWindows[f.StreamID]; ok {
+			m.streamWindows[f.StreamID] += int32(f.Increment)
+		}
+	case *http2.RSTStreamFrame:
+		delete(m.streamWindows, f.StreamID)
+	}
+}
+
+// received handles a frame written by the server.
+func (m *flowMonitor) received(f http2.Frame) {
+	switch f := f.(type) {
+	case *http2.DataFrame:
+		n := int32(f.Length)
+		if n > 0 {
+			m.connWindow -= n
+			if m.connWindow <= 0 {
+				m.connStalls++
+			}
+		}
+		if w, ok := m.streamWindows[f.StreamID]; ok {
+			w -= n
+			m.streamWindows[f.StreamID] = w
+			if n > 0 && w <= 0 && !f.StreamEnded() {
+				m.streamStalls++
+			}
+		}
+		if f.StreamEnded() {
+			delete(m.streamWindows, f.StreamID)
+		}
+	case *http2.HeadersFrame:
+		if f.StreamEnded() {
+			delete(m.streamWindows, f.StreamID)
+		}
+	case *http2.RSTStreamFrame:
+		m.resets++
+		delete(m.streamWindows, f.StreamID)
+	case *http2.GoAwayFrame:
+		m.goAways++
+	}
+}
+
+// A monitoredConn copies the bytes read from and written to a Conn to
+// a flowMonitor.
+type monitoredConn struct {
+	net.Conn
+	r, w *io.PipeWriter
+}
+
+func (c *monitoredConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	if n > 0 {
+		c.r.Write(p[:n])
+	}
+	return n, err
+}
+
+func (c *monitoredConn) Write(p []byte) (int, error) {
+	n, err := c.Conn.Write(p)
+	if n > 0 {
+		c.w.Write(p[:n])
+	}
+	return n, err
+}
+
+func (c *monitoredConn) Close() error {
+	c.r.Close()
+	c.w.Close()
+	return c.Conn.Close()
+}
@@ -0,0 +1,146 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"golang.org/x/net/http2"
+)
+
+// feed writes frames with write, then reads them back and passes each
+// to f.
+func feed(t *testing.T, f func(http2.Frame), write func(fr *http2.Framer) error) {
+	t.Helper()
+	var buf bytes.Buffer
+	fr := http2.NewFramer(&buf, &buf)
+	if err := write(fr); err != nil {
+		t.Fatal(err)
+	}
+	for {
+		frame, err := fr.ReadFrame()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		f(frame)
+	}
+}
+
+func headers(fr *http2.Framer, streamID uint32, endStream bool) error {
+	return fr.WriteHeaders(http2.HeadersFrameParam{
+		StreamID:      streamID,
+		BlockFragment: []byte{0x82}, // :method: GET
+		EndStream:     endStream,
+		EndHeaders:    true,
+	})
+}
+
+func TestFlowMonitorStreamWindow(t *testing.T) {
+	m := newFlowMonitor()
+	feed(t, m.sent, func(fr *http2.Framer) error {
+		return headers(fr, 1, false)
+	})
+	if got, want := m.streamWindows[1], int32(defaultWindow); got != want {
+		t.Fatalf("window after HEADERS = %v; want %v", got, want)
+	}
+
+	feed(t, m.received, func(fr *http2.Framer) error {
+		return fr.WriteData(1, false, make([]byte, 1000))
+	})
+	// Trailers don't reopen the stream with a fresh window.
+	feed(t, m.sent, func(fr *http2.Framer) error {
+		return headers(fr, 1, true)
+	})
+	if got, want := m.streamWindows[1], int32(defaultWindow-1000); got != want {
+		t.Errorf("window after DATA and trailers = %v; want %v", got, want)
+	}
+	if got, want := m.connWindow, int32(defaultWindow-1000); got != want {
+		t.Errorf("connection window after DATA = %v; want %v", got, want)
+	}
+
+	feed(t, m.sent, func(fr *http2.Framer) error {
+		if err := fr.WriteWindowUpdate(0, 1000); err != nil {
+			return err
+		}
+		if err := fr.WriteWindowUpdate(1, 500); err != nil {
+			return err
+		}
+		return fr.WriteSettings(http2.Setting{ID: http2.SettingInitialWindowSize, Val: defaultWindow + 100})
+	})
+	if got, want := m.streamWindows[1], int32(defaultWindow-1000+500+100); got != want {
+		t.Errorf("window after WINDOW_UPDATE and SETTINGS = %v; want %v", got, want)
+	}
+	if got, want := m.connWindow, int32(defaultWindow); got != want {
+		t.Errorf("connection window after WINDOW_UPDATE = %v; want %v", got, want)
+	}
+	if got, want := m.windowUpdates, 2; got != want {
+		t.Errorf("windowUpdates = %v; want %v", got, want)
+	}
+
+	feed(t, m.received, func(fr *http2.Framer) error {
+		return fr.WriteData(1, true, nil)
+	})
+	if _, ok := m.streamWindows[1]; ok {
+		t.Errorf("stream 1 still tracked after END_STREAM")
+	}
+	// A stream that was already ended isn't reopened by late trailers.
+	feed(t, m.sent, func(fr *http2.Framer) error {
+		return headers(fr, 1, true)
+	})
+	if _, ok := m.streamWindows[1]; ok {
+		t.Errorf("stream 1 tracked again after trailers")
+	}
+}
+
+func TestFlowMonitorStalls(t *testing.T) {
+	m := newFlowMonitor()
+	feed(t, m.sent, func(fr *http2.Framer) error {
+		if err := fr.WriteSettings(http2.Setting{ID: http2.SettingInitialWindowSize, Val: 1000}); err != nil {
+			return err
+		}
+		if err := headers(fr, 1, true); err != nil {
+			return err
+		}
+		return headers(fr, 3, true)
+	})
+	feed(t, m.received, func(fr *http2.Framer) error {
+		// Stream 1 uses up its window before the response is done.
+		if err := fr.WriteData(1, false, make([]byte, 1000)); err != nil {
+			return err
+		}
+		// Stream 3 uses up its window with the last DATA frame,
+		// which isn't a stall.
+		if err := fr.WriteData(3, true, make([]byte, 1000)); err != nil {
+			return err
+		}
+		if err := fr.WriteRSTStream(1, http2.ErrCodeCancel); err != nil {
+			return err
+		}
+		return fr.WriteGoAway(3, http2.ErrCodeNo, nil)
+	})
+	want := flowStats{resets: 1, goAways: 1, streamStalls: 1}
+	if got := m.stats(); got != want {
+		t.Errorf("stats = %+v; want %+v", got, want)
+	}
+	if len(m.streamWindows) != 0 {
+		t.Errorf("streams still tracked: %v", m.streamWindows)
+	}
+
+	// Use up the connection window.
+	feed(t, m.sent, func(fr *http2.Framer) error {
+		return headers(fr, 5, true)
+	})
+	feed(t, m.received, func(fr *http2.Framer) error {
+		return fr.WriteData(5, true, make([]byte, defaultWindow-2000))
+	})
+	if got := m.stats().connStalls; got != 1 {
+		t.Errorf("connStalls = %v; want 1", got)
+	}
+}
@@ -0,0 +1,450 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package h2test provides utilities for protocol-level testing of
+// HTTP/2 servers built with golang.org/x/net/http2.
+//
+// A ServerTester plays the role of an HTTP/2 client, writing frames to
+// the server and asserting on the frames the server sends back:
+//
+//	hs := &http.Server{Handler: handler}
+//	http2.ConfigureServer(hs, nil)
+//	st := h2test.NewServerTester(t, hs)
+//	defer st.Close()
+//	st.Greet()
+//	st.WriteBodylessRequest(1, ":path", "/status")
+//	hf := st.WantHeaders()
+//	got := st.DecodeHeader(hf.HeaderBlockFragment())
+//
+// ServerTester methods report failures with the testing.TB passed to
+// the constructor, and so must be called from the goroutine running
+// the test.
+package h2test // import "golang.org/x/net/http2/h2test"
+
+import (
+	"bytes"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/hpack"
+)
+
+// initialHeaderTableSize is the HPACK dynamic table size a server
+// starts with, per RFC 7540 section 6.5.2.
+const initialHeaderTableSize = 4096
+
+// A ServerTester is a client connection to an HTTP/2 server under test.
+type ServerTester struct {
+	// Conn is the client side of the connection.
+	Conn net.Conn
+
+	// Framer reads and writes frames on Conn.
+	Framer *http2.Framer
+
+	// Authority is the default :authority pseudo-header value used
+	// by EncodeHeader.
+	Authority string
+
+	t     testing.TB
+	ts    *httptest.Server // nil for in-memory connections
+	donec chan struct{}    // closed when ServeConn returns; nil for ts
+
+	headerBuf bytes.Buffer
+	hpackEnc  *hpack.Encoder
+	hpackDec  *hpack.Decoder
+	decoded   [][2]string
+
+	readLogMu sync.Mutex
+	readLog   bytes.Buffer // headers of frames read, logged on failure
+}
+
+// NewServerTester starts hs on a TLS listener on the loopback
+// interface and connects to it, negotiating "h2" with ALPN. The
+// server must have been configured for HTTP/2 with
+// http2.ConfigureServer. If hs.TLSConfig has no certificates, a test
+// certificate is used.
+func NewServerTester(t testing.TB, hs *http.Server) *ServerTester {
+	ts := httptest.NewUnstartedServer(hs.Handler)
+	ts.Config = hs
+	ts.TLS = hs.TLSConfig // the httptest.Server has its own copy of this TLS config
+	ts.StartTLS()
+
+	cc, err := tls.Dial("tcp", ts.Listener.Addr().String(), &tls.Config{
+		InsecureSkipVerify: true,
+		NextProtos:         []string{http2.NextProtoTLS},
+	})
+	if err != nil {
+		ts.Close()
+		t.Fatal(err)
+	}
+	if p := cc.ConnectionState().NegotiatedProtocol; p != http2.NextProtoTLS {
+		cc.Close()
+		ts.Close()
+		t.Fatalf("negotiated protocol %q; want %q (was http2.ConfigureServer called?)", p, http2.NextProtoTLS)
+	}
+	st := newServerTester(t, cc, ts.Listener.Addr().String())
+	st.ts = ts
+	return st
+}
+
+// NewPipeServerTester serves one end of an in-memory connection
+// created by net.Pipe with srv.ServeConn, and returns a ServerTester for
+// the other end. The connection is unbuffered: a server write blocks
+// until the test reads the frame, so tests must read what the server
+// sends before writing more than the server will consume.
+func NewPipeServerTester(t testing.TB, srv *http2.Server, opts *http2.ServeConnOpts) *ServerTester {
+	cc, sc := net.Pipe()
+	st := newServerTester(t, cc, "example.com")
+	st.donec = make(chan struct{})
+	go func() {
+		defer close(st.donec)
+		defer sc.Close()
+		srv.ServeConn(sc, opts)
+	}()
+	return st
+}
+
+func newServerTester(t testing.TB, cc net.Conn, authority string) *ServerTester {
+	st := &ServerTester{
+		Conn:      cc,
+		Framer:    http2.NewFramer(cc, cc),
+		Authority: authority,
+		t:         t,
+	}
+	st.hpackEnc = hpack.NewEncoder(&st.headerBuf)
+	st.hpackDec = hpack.NewDecoder(initialHeaderTableSize, func(f hpack.HeaderField) {
+		st.decoded = append(st.decoded, [2]string{f.Name, f.Value})
+	})
+	return st
+}
+
+// Close closes the connection and shuts down the server. If the test
+// has failed, it logs the headers of the frames read from the server.
+func (st *ServerTester) Close() {
+	if st.t.Failed() {
+		st.readLogMu.Lock()
+		if st.readLog.Len() > 0 {
+			st.t.Logf("Frames read:\n%s", st.readLog.String())
+		}
+		st.readLogMu.Unlock()
+	}
+	st.Conn.Close()
+	if st.ts != nil {
+		st.ts.Close()
+	}
+	if st.donec != nil {
+		<-st.donec
+	}
+}
+
+// Greet initiates the client's HTTP/2 connection into a state where
+// frames may be sent. It consumes the server's SETTINGS, its
+// acknowledgement of ours, and any connection-level WINDOW_UPDATE,
+// sending a PING to flush the latter if necessary.
+func (st *ServerTester) Greet() {
+	st.GreetAndCheckSettings(func(http2.Setting) error { return nil })
+}
+
+// GreetAndCheckSettings is like Greet, but calls checkSetting for each
+// setting in the server's initial SETTINGS frame. The test fails if
+// checkSetting returns an error.
+func (st *ServerTester) GreetAndCheckSettings(checkSetting func(s http2.Setting) error) {
+	st.WritePreface()
+	st.WriteInitialSettings()
+	if err := st.WantSettings().ForeachSetting(checkSetting); err != nil {
+		st.t.Fatalf("Server's SETTINGS: %v", err)
+	}
+	st.WriteSettingsAck()
+
+	// The server may enlarge the connection flow control window
+	// before or after acknowledging our SETTINGS. If it hasn't done
+	// so by then, a PING round trip ensures any WINDOW_UPDATE it
+	// queued at startup has been read.
+	var gotWindowUpdate bool
+	var sentPing bool
+	pingData := [8]byte{'h', '2', 't', 'e', 's', 't'}
+	for {
+		f, err := st.ReadFrame()
+		if err != nil {
+			st.t.Fatalf("Error while expecting a SETTINGS ACK: %v", err)
+		}
+		switch f := f.(type) {
+		case *http2.SettingsFrame:
+			if !f.IsAck() {
+				st.t.Fatal("Settings Frame didn't have ACK set")
+			}
+			if gotWindowUpdate {
+				return
+			}
+			if err := st.Framer.WritePing(false, pingData); err != nil {
+				st.t.Fatalf("Error writing PING: %v", err)
+			}
+			sentPing = true
+		case *http2.PingFrame:
+			if !sentPing || !f.IsAck() || f.Data != pingData {
+				st.t.Fatalf("Wanting a settings ACK or window update, received %v", f.Header())
+			}
+			return
+		case *http2.WindowUpdateFrame:
+			if f.StreamID != 0 {
+				st.t.Fatalf("WindowUpdate StreamID = %d; want 0", f.StreamID)
+			}
+			gotWindowUpdate = true
+		default:
+			st.t.Fatalf("Wanting a settings ACK or window update, received a %T", f)
+		}
+	}
+}
+
+// WritePreface writes the client connection preface.
+func (st *ServerTester) WritePreface() {
+	n, err := st.Conn.Write([]byte(http2.ClientPreface))
+	if err != nil {
+		st.t.Fatalf("Error writing client preface: %v", err)
+	}
+	if n != len(http2.ClientPreface) {
+		st.t.Fatalf("Writing client preface, wrote %d bytes; want %d", n, len(http2.ClientPreface))
+	}
+}
+
+// WriteInitialSettings writes an empty SETTINGS frame.
+func (st *ServerTester) WriteInitialSettings() {
+	if err := st.Framer.WriteSettings(); err != nil {
+		st.t.Fatalf("Error writing initial SETTINGS frame from client to server: %v", err)
+	}
+}
+
+// WriteSettingsAck acknowledges the server's SETTINGS frame.
+func (st *ServerTester) WriteSettingsAck() {
+	if err := st.Framer.WriteSettingsAck(); err != nil {
+		st.t.Fatalf("Error writing ACK of server's SETTINGS: %v", err)
+	}
+}
+
+// WriteHeaders writes a HEADERS frame.
+func (st *ServerTester) WriteHeaders(p http2.HeadersFrameParam) {
+	if err := st.Framer.WriteHeaders(p); err != nil {
+		st.t.Fatalf("Error writing HEADERS: %v", err)
+	}
+}
+
+// WritePriority writes a PRIORITY frame.
+func (st *ServerTester) WritePriority(id uint32, p http2.PriorityParam) {
+	if err := st.Framer.WritePriority(id, p); err != nil {
+		st.t.Fatalf("Error writing PRIORITY: %v", err)
+	}
+}
+
+// WriteData writes a DATA frame.
+func (st *ServerTester) WriteData(streamID uint32, endStream bool, data []byte) {
+	if err := st.Framer.WriteData(streamID, endStream, data); err != nil {
+		st.t.Fatalf("Error writing DATA: %v", err)
+	}
+}
+
+// WriteDataPadded writes a DATA frame with padding.
+func (st *ServerTester) WriteDataPadded(streamID uint32, endStream bool, data, pad []byte) {
+	if err := st.Framer.WriteDataPadded(streamID, endStream, data, pad); err != nil {
+		st.t.Fatalf("Error writing DATA: %v", err)
+	}
+}
+
+// WriteBodylessRequest writes a HEADERS frame on streamID with
+// EndStream and EndHeaders set. The header block is encoded by
+// EncodeHeader(headers...).
+func (st *ServerTester) WriteBodylessRequest(streamID uint32, headers ...string) {
+	st.WriteHeaders(http2.HeadersFrameParam{
+		StreamID:      streamID,
+		BlockFragment: st.EncodeHeader(headers...),
+		EndStream:     true,
+		EndHeaders:    true,
+	})
+}
+
+func (st *ServerTester) encodeHeaderField(k, v string) {
+	err := st.hpackEnc.WriteField(hpack.HeaderField{Name: k, Value: v})
+	if err != nil {
+		st.t.Fatalf("HPACK encoding error for %q/%q: %v", k, v, err)
+	}
+}
+
+// EncodeHeaderRaw is the magic-free version of EncodeHeader.
+// It takes 0 or more (k, v) pairs and encodes them in order.
+//
+// The returned slice is valid until the next call to EncodeHeader or
+// EncodeHeaderRaw.
+func (st *ServerTester) EncodeHeaderRaw(headers ...string) []byte {
+	if len(headers)%2 == 1 {
+		panic("odd number of kv args")
+	}
+	st.headerBuf.Reset()
+	for len(headers) > 0 {
+		st.encodeHeaderField(headers[0], headers[1])
+		headers = headers[2:]
+	}
+	return st.headerBuf.Bytes()
+}
+
+// EncodeHeader encodes headers and returns their HPACK bytes. headers
+// must contain an even number of key/value pairs. There may be
+// multiple pairs for keys (e.g. "cookie"). The :method, :scheme,
+// :authority, and :path headers default to GET, https, st.Authority,
+// and /; giving a pseudo-header more than once encodes each value,
+// to allow testing of invalid requests.
+//
+// The returned slice is valid until the next call to EncodeHeader or
+// EncodeHeaderRaw.
+func (st *ServerTester) EncodeHeader(headers ...string) []byte {
+	if len(headers)%2 == 1 {
+		panic("odd number of kv args")
+	}
+	pseudoCount := map[string]int{}
+	keys := []string{":method", ":scheme", ":authority", ":path"}
+	vals := map[string][]string{
+		":method":    {"GET"},
+		":scheme":    {"https"},
+		":authority": {st.Authority},
+		":path":      {"/"},
+	}
+	for len(headers) > 0 {
+		k, v := headers[0], headers[1]
+		headers = headers[2:]
+		if _, ok := vals[k]; !ok {
+			keys = append(keys, k)
+		}
+		if strings.HasPrefix(k, ":") {
+			pseudoCount[k]++
+			if pseudoCount[k] == 1 {
+				vals[k] = []string{v}
+			} else {
+				vals[k] = append(vals[k], v)
+			}
+		} else {
+			vals[k] = append(vals[k], v)
+		}
+	}
+	st.headerBuf.Reset()
+	for _, k := range keys {
+		for _, v := range vals[k] {
+			st.encodeHeaderField(k, v)
+		}
+	}
+	return st.headerBuf.Bytes()
+}
+
+// DecodeHeader decodes a complete header block sent by the server,
+// returning its fields as name/value pairs. The decoder's dynamic
+// table persists across calls, so every header block the server sends
+// must be decoded in order.
+func (st *ServerTester) DecodeHeader(headerBlock []byte) (pairs [][2]string) {
+	st.decoded = nil
+	if _, err := st.hpackDec.Write(headerBlock); err != nil {
+		st.t.Fatalf("hpack decoding error: %v", err)
+	}
+	if err := st.hpackDec.Close(); err != nil {
+		st.t.Fatalf("hpack decoding error: %v", err)
+	}
+	return st.decoded
+}
+
+// ReadFrame reads the next frame from the server.
+func (st *ServerTester) ReadFrame() (http2.Frame, error) {
+	f, err := st.Framer.ReadFrame()
+	st.readLogMu.Lock()
+	if err != nil {
+		st.readLog.WriteString("error: " + err.Error() + "\n")
+	} else {
+		st.readLog.WriteString(f.Header().String() + "\n")
+	}
+	st.readLogMu.Unlock()
+	return f, err
+}
+
+// WantFrame reads the next frame from the server and fails the test if
+// it isn't of type typ.
+func (st *ServerTester) WantFrame(typ http2.FrameType) http2.Frame {
+	f, err := st.ReadFrame()
+	if err != nil {
+		st.t.Fatalf("Error while expecting a %v frame: %v", typ, err)
+	}
+	if got := f.Header().Type; got != typ {
+		st.t.Fatalf("got a %v frame; want %v", got, typ)
+	}
+	return f
+}
+
+// WantHeaders reads the next frame, which must be a HEADERS frame.
+func (st *ServerTester) WantHeaders() *http2.HeadersFrame {
+	return st.WantFrame(http2.FrameHeaders).(*http2.HeadersFrame)
+}
+
+// WantContinuation reads the next frame, which must be a CONTINUATION
+// frame.
+func (st *ServerTester) WantContinuation() *http2.ContinuationFrame {
+	return st.WantFrame(http2.FrameContinuation).(*http2.ContinuationFrame)
+}
+
+// WantData reads the next frame, which must be a DATA frame.
+func (st *ServerTester) WantData() *http2.DataFrame {
+	return st.WantFrame(http2.FrameData).(*http2.DataFrame)
+}
+
+// WantSettings reads the next frame, which must be a SETTINGS frame.
+func (st *ServerTester) WantSettings() *http2.SettingsFrame {
+	return st.WantFrame(http2.FrameSettings).(*http2.SettingsFrame)
+}
+
+// WantSettingsAck reads the next frame, which must be a SETTINGS frame
+// with the ACK flag set.
+func (st *ServerTester) WantSettingsAck() {
+	if !st.WantSettings().IsAck() {
+		st.t.Fatal("Settings Frame didn't have ACK set")
+	}
+}
+
+// WantPing reads the next frame, which must be a PING frame.
+func (st *ServerTester) WantPing() *http2.PingFrame {
+	return st.WantFrame(http2.FramePing).(*http2.PingFrame)
+}
+
+// WantGoAway reads the next frame, which must be a GOAWAY frame.
+func (st *ServerTester) WantGoAway() *http2.GoAwayFrame {
+	return st.WantFrame(http2.FrameGoAway).(*http2.GoAwayFrame)
+}
+
+// WantPushPromise reads the next frame, which must be a PUSH_PROMISE
+// frame.
+func (st *ServerTester) WantPushPromise() *http2.PushPromiseFrame {
+	return st.WantFrame(http2.FramePushPromise).(*http2.PushPromiseFrame)
+}
+
+// WantRSTStream reads the next frame, which must be a RST_STREAM frame
+// for streamID with errCode.
+func (st *ServerTester) WantRSTStream(streamID uint32, errCode http2.ErrCode) {
+	rs := st.WantFrame(http2.FrameRSTStream).(*http2.RSTStreamFrame)
+	if rs.StreamID != streamID {
+		st.t.Fatalf("RSTStream StreamID = %d; want %d", rs.StreamID, streamID)
+	}
+	if rs.ErrCode != errCode {
+		st.t.Fatalf("RSTStream ErrCode = %d (%s); want %d (%s)", rs.ErrCode, rs.ErrCode, errCode, errCode)
+	}
+}
+
+// WantWindowUpdate reads the next frame, which must be a WINDOW_UPDATE
+// frame for streamID with increment incr.
+func (st *ServerTester) WantWindowUpdate(streamID, incr uint32) {
+	wu := st.WantFrame(http2.FrameWindowUpdate).(*http2.WindowUpdateFrame)
+	if wu.StreamID != streamID {
+		st.t.Fatalf("WindowUpdate StreamID = %d; want %d", wu.StreamID, streamID)
+	}
+	if wu.Increment != incr {
+		st.t.Fatalf("WindowUpdate increment = %d; want %d", wu.Increment, incr)
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package h2test
+
+import (
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/http2"
+)
+
+func TestServerTesterTLS(t *testing.T) {
+	hs := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Foo", r.Header.Get("X-Test"))
+			io.WriteString(w, r.URL.Path)
+		}),
+		ErrorLog: log.New(ioutil.Discard, "", 0),
+	}
+	if err := http2.ConfigureServer(hs, nil); err != nil {
+		t.Fatal(err)
+	}
+	st := NewServerTester(t, hs)
+	defer st.Close()
+	st.Greet()
+	st.WriteBodylessRequest(1, ":path", "/hello", "x-test", "bar")
+
+	hf := st.WantHeaders()
+	if !hf.HeadersEnded() {
+		t.Fatal("want END_HEADERS flag")
+	}
+	got := st.DecodeHeader(hf.HeaderBlockFragment())
+	want := map[string]string{":status": "200", "foo": "bar"}
+	for _, kv := range got {
+		if v, ok := want[kv[0]]; ok && v != kv[1] {
+			t.Errorf("header %q = %q; want %q", kv[0], kv[1], v)
+		}
+		delete(want, kv[0])
+	}
+	if len(want) > 0 {
+		t.Errorf("missing headers %v in %v", want, got)
+	}
+	df := st.WantData()
+	if string(df.Data()) != "/hello" {
+		t.Errorf("body = %q; want %q", df.Data(), "/hello")
+	}
+}
+
+func TestServerTesterPipe(t *testing.T) {
+	st := NewPipeServerTester(t, &http2.Server{}, &http2.ServeConnOpts{
+		BaseConfig: &http.Server{ErrorLog: log.New(ioutil.Discard, "", 0)},
+		Handler:    http.NotFoundHandler(),
+	})
+	defer st.Close()
+	st.GreetAndCheckSettings(func(s http2.Setting) error {
+		return s.Valid()
+	})
+
+	// DATA on an idle stream is a connection error.
+	st.WriteData(1, true, []byte("x"))
+	if gf := st.WantGoAway(); gf.ErrCode != http2.ErrCodeProtocol {
+		t.Errorf("GOAWAY ErrCode = %v; want %v", gf.ErrCode, http2.ErrCodeProtocol)
+	}
+}
+
+func TestServerTesterStreamError(t *testing.T) {
+	st := NewPipeServerTester(t, &http2.Server{}, &http2.ServeConnOpts{
+		BaseConfig: &http.Server{ErrorLog: log.New(ioutil.Discard, "", 0)},
+		Handler:    http.NotFoundHandler(),
+	})
+	defer st.Close()
+	st.Greet()
+
+	// A stream can't depend on itself.
+	st.Framer.AllowIllegalWrites = true
+	st.WritePriority(1, http2.PriorityParam{StreamDep: 1, Weight: 15})
+	st.WantRSTStream(1, http2.ErrCodeProtocol)
+
+	st.Framer.WritePing(false, [8]byte{1, 2, 3})
+	if pf := st.WantPing(); !pf.IsAck() || pf.Data != [8]byte{1, 2, 3} {
+		t.Errorf("got %v; want PING ACK with the same data", pf)
+	}
+}
+
+func TestEncodeHeader(t *testing.T) {
+	st := newServerTester(t, nil, "example.com")
+	tests := []struct {
+		headers []string
+		want    [][2]string
+	}{
+		{
+			headers: nil,
+			want: [][2]string{
+				{":method", "GET"},
+				{":scheme", "https"},
+				{":authority", "example.com"},
+				{":path", "/"},
+			},
+		},
+		{
+			headers: []string{":method", "POST", "cookie", "a=b", ":path", "/x", "cookie", "c=d"},
+			want: [][2]string{
+				{":method", "POST"},
+				{":scheme", "https"},
+				{":authority", "example.com"},
+				{":path", "/x"},
+				{"cookie", "a=b"},
+				{"cookie", "c=d"},
+			},
+		},
+		{
+			headers: []string{":path", "/a", ":path", "/b"},
+			want: [][2]string{
+				{":method", "GET"},
+				{":scheme", "https"},
+				{":authority", "example.com"},
+				{":path", "/a"},
+				{":path", "/b"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		got := st.DecodeHeader(st.EncodeHeader(tt.headers...))
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("EncodeHeader(%q) decodes to %q; want %q", tt.headers, got, tt.want)
+		}
+	}
+
+	got := st.DecodeHeader(st.EncodeHeaderRaw("b", "1", "a", "2"))
+	if want := [][2]string{{"b", "1"}, {"a", "2"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("EncodeHeaderRaw decodes to %q; want %q", got, want)
+	}
+}
@@ -11,7 +11,6 @@ import (
 
 var (
 	commonBuildOnce   sync.Once
-	commonLowerHeader map[string]string // Go-Canonical-Case -> lower-case
 	commonCanonHeader map[string]string // lower-case -> Go-Canonical-Case
 )
 
@@ -69,19 +68,8 @@ func buildCommonHeaderMaps() {
 		"via",
 		"www-authenticate",
 	}
-	commonLowerHeader = make(map[string]string, len(common))
 	commonCanonHeader = make(map[string]string, len(common))
 	for _, v := range common {
-		chk := http.CanonicalHeaderKey(v)
-		commonLowerHeader[chk] = v
-		commonCanonHeader[v] = chk
+		commonCanonHeader[v] = http.CanonicalHeaderKey(v)
 	}
 }
-
-func lowerHeader(v string) (lower string, ascii bool) {
-	buildCommonHeaderMapsOnce()
-	if s, ok := commonLowerHeader[v]; ok {
-		return s, true
-	}
-	return asciiToLower(v)
-}
@@ -26,8 +26,31 @@ type Encoder struct {
 	// tableSizeUpdate indicates whether "Header Table Size
 	// Update" is required.
 	tableSizeUpdate bool
-	w               io.Writer
-	buf             []byte
+	// noHuffman disables Huffman coding of string literals.
+	noHuffman bool
+	// maxIndexedSize, if non-zero, is the largest field size
+	// eligible for insertion into the dynamic table.
+	maxIndexedSize uint32
+	// indexPolicy, if non-nil, decides which fields are inserted
+	// into the dynamic table.
+	indexPolicy func(HeaderField) bool
+	stats       EncoderStats
+	w           io.Writer
+	buf         []byte
+}
+
+// EncoderStats holds counters of the work done by an Encoder since it
+// was created. The compression ratio is OutputBytes / InputBytes.
+type EncoderStats struct {
+	Fields      uint64 // header fields encoded
+	InputBytes  uint64 // length of the names and values of the encoded fields
+	OutputBytes uint64 // bytes written, including table size updates
+	Insertions  uint64 // entries added to the dynamic table
+	Evictions   uint64 // entries evicted from the dynamic table
+
+	// HuffmanSavedBytes is the number of bytes saved by Huffman
+	// coding string literals.
+	HuffmanSavedBytes uint64
 }
 
 // NewEncoder returns a new Encoder which performs HPACK encoding. An
@@ -44,11 +67,59 @@ func NewEncoder(w io.Writer) *Encoder {
 	return e
 }
 
+// DynamicTableFields returns a copy of the encoder's dynamic table
+// entries, in HPACK index order: the first field has index 62.
+func (e *Encoder) DynamicTableFields() []HeaderField { return e.dynTab.fields() }
+
+// DynamicTableSize returns the current size of the encoder's dynamic
+// table, as defined in RFC 7541 Section 4.1, and its maximum size.
+func (e *Encoder) DynamicTableSize() (size, maxSize uint32) {
+	return e.dynTab.size, e.dynTab.maxSize
+}
+
+// DebugString returns a multi-line description of the encoder's
+// dynamic table, for debugging.
+func (e *Encoder) DebugString() string { return e.dynTab.debugString() }
+
+// Stats returns the encoder's counters. Monitoring code can sample it
+// periodically, for example to tune dynamic table sizes.
+func (e *Encoder) Stats() EncoderStats { return e.stats }
+
+// SetHuffmanEnabled controls whether string literals may be Huffman
+// coded. It is enabled by default, in which case strings are Huffman
+// coded whenever that makes them shorter. Disabling it makes encoded
+// header blocks easier to read on the wire and avoids the cost of
+// Huffman coding, at the expense of larger header blocks.
+func (e *Encoder) SetHuffmanEnabled(v bool) { e.noHuffman = !v }
+
+// SetMaxIndexedFieldSize limits the fields the encoder inserts into the
+// dynamic table to those whose size, as defined in RFC 7541 Section
+// 4.1, is at most n. Larger fields are encoded as literals without
+// indexing, so that a few large values cannot evict many small ones.
+// Zero, the default, means fields are indexed whenever they fit in
+// the table.
+func (e *Encoder) SetMaxIndexedFieldSize(n uint32) { e.maxIndexedSize = n }
+
+// SetIndexingPolicy sets a function deciding whether each field that
+// is not already in a table is inserted into the dynamic table. It is
+// only consulted for fields that would otherwise be indexed: sensitive
+// fields and fields over the size limits are never indexed. If f
+// returns false, the field is encoded as a literal without indexing.
+// A nil f, the default, indexes every eligible field.
+//
+// For example, to keep values of some header names out of the table:
+//
+//	e.SetIndexingPolicy(func(f hpack.HeaderField) bool {
+//		return f.Name != "date" && f.Name != "content-length"
+//	})
+func (e *Encoder) SetIndexingPolicy(f func(HeaderField) bool) { e.indexPolicy = f }
+
 // WriteField encodes f into a single Write to e's underlying Writer.
 // This function may also produce bytes for "Header Table Size Update"
 // if necessary. If produced, it is done before encoding f.
 func (e *Encoder) WriteField(f HeaderField) error {
 	e.buf = e.buf[:0]
+	evictCount := e.dynTab.table.evictCount
 
 	if e.tableSizeUpdate {
 		e.tableSizeUpdate = false
@@ -66,15 +137,24 @@ func (e *Encoder) WriteField(f HeaderField) error {
 		indexing := e.shouldIndex(f)
 		if indexing {
 			e.dynTab.add(f)
+			e.stats.Insertions++
 		}
 
+		// As appendNewName and appendIndexedName, but counting the
+		// bytes saved by Huffman coding.
 		if idx == 0 {
-			e.buf = appendNewName(e.buf, f, indexing)
+			e.buf = append(e.buf, encodeTypeByte(indexing, f.Sensitive))
+			e.buf = e.appendString(e.buf, f.Name)
 		} else {
-			e.buf = appendIndexedName(e.buf, f, idx, indexing)
+			e.buf = appendIndexedNamePrefix(e.buf, idx, indexing, f.Sensitive)
 		}
+		e.buf = e.appendString(e.buf, f.Value)
 	}
+	e.stats.Fields++
+	e.stats.InputBytes += uint64(len(f.Name) + len(f.Value))
+	e.stats.Evictions += e.dynTab.table.evictCount - evictCount
 	n, err := e.w.Write(e.buf)
+	e.stats.OutputBytes += uint64(n)
 	if err == nil && n != len(e.buf) {
 		err = io.ErrShortWrite
 	}
@@ -113,7 +193,7 @@ func (e *Encoder) SetMaxDynamicTableSize(v uint32) {
 		e.minSize = v
 	}
 	e.tableSizeUpdate = true
-	e.dynTab.setMaxSize(v)
+	e.setMaxSize(v)
 }
 
 // SetMaxDynamicTableSizeLimit changes the maximum value that can be
@@ -127,13 +207,27 @@ func (e *Encoder) SetMaxDynamicTableSizeLimit(v uint32) {
 	e.maxSizeLimit = v
 	if e.dynTab.maxSize > v {
 		e.tableSizeUpdate = true
-		e.dynTab.setMaxSize(v)
+		e.setMaxSize(v)
 	}
 }
 
+// setMaxSize sets the maximum size of the dynamic table, counting the
+// entries evicted to fit.
+func (e *Encoder) setMaxSize(v uint32) {
+	evictCount := e.dynTab.table.evictCount
+	e.dynTab.setMaxSize(v)
+	e.stats.Evictions += e.dynTab.table.evictCount - evictCount
+}
+
 // shouldIndex reports whether f should be indexed.
 func (e *Encoder) shouldIndex(f HeaderField) bool {
-	return !f.Sensitive && f.Size() <= e.dynTab.maxSize
+	if f.Sensitive || f.Size() > e.dynTab.maxSize {
+		return false
+	}
+	if e.maxIndexedSize != 0 && f.Size() > e.maxIndexedSize {
+		return false
+	}
+	return e.indexPolicy == nil || e.indexPolicy(f)
 }
 
 // appendIndexed appends index i, as encoded in "Indexed Header Field"
@@ -151,11 +245,12 @@ func appendIndexed(dst []byte, i uint64) []byte {
 //
 // If f.Sensitive is true, "Never Indexed" representation is used. If
 // f.Sensitive is false and indexing is true, "Incremental Indexing"
-// representation is used.
-func appendNewName(dst []byte, f HeaderField, indexing bool) []byte {
+// representation is used. Strings are Huffman coded only if huffman
+// is true.
+func appendNewName(dst []byte, f HeaderField, indexing, huffman bool) []byte {
 	dst = append(dst, encodeTypeByte(indexing, f.Sensitive))
-	dst = appendHpackString(dst, f.Name)
-	return appendHpackString(dst, f.Value)
+	dst = appendHpackString(dst, f.Name, huffman)
+	return appendHpackString(dst, f.Value, huffman)
 }
 
 // appendIndexedName appends f and index i referring indexed name
@@ -164,8 +259,16 @@ func appendNewName(dst []byte, f HeaderField, indexing bool) []byte {
 //
 // If f.Sensitive is true, "Never Indexed" representation is used. If
 // f.Sensitive is false and indexing is true, "Incremental Indexing"
-// representation is used.
-func appendIndexedName(dst []byte, f HeaderField, i uint64, indexing bool) []byte {
+// representation is used. The value is Huffman coded only if huffman
+// is true.
+func appendIndexedName(dst []byte, f HeaderField, i uint64, indexing, huffman bool) []byte {
+	dst = appendIndexedNamePrefix(dst, i, indexing, f.Sensitive)
+	return appendHpackString(dst, f.Value, huffman)
+}
+
+// appendIndexedNamePrefix appends the part of appendIndexedName's
+// representation that precedes the value.
+func appendIndexedNamePrefix(dst []byte, i uint64, indexing, sensitive bool) []byte {
 	first := len(dst)
 	var n byte
 	if indexing {
@@ -174,8 +277,8 @@ func appendIndexedName(dst []byte, f HeaderField, i uint64, indexing bool) []byt
 		n = 4
 	}
 	dst = appendVarInt(dst, n, i)
-	dst[first] |= encodeTypeByte(indexing, f.Sensitive)
-	return appendHpackString(dst, f.Value)
+	dst[first] |= encodeTypeByte(indexing, sensitive)
+	return dst
 }
 
 // appendTableSize appends v, as encoded in "Header Table Size Update"
@@ -208,19 +311,39 @@ func appendVarInt(dst []byte, n byte, i uint64) []byte {
 // appendHpackString appends s, as encoded in "String Literal"
 // representation, to dst and returns the extended buffer.
 //
-// s will be encoded in Huffman codes only when it produces strictly
-// shorter byte string.
-func appendHpackString(dst []byte, s string) []byte {
-	huffmanLength := HuffmanEncodeLength(s)
-	if huffmanLength < uint64(len(s)) {
-		first := len(dst)
-		dst = appendVarInt(dst, 7, huffmanLength)
-		dst = AppendHuffmanString(dst, s)
-		dst[first] |= 0x80
-	} else {
-		dst = appendVarInt(dst, 7, uint64(len(s)))
-		dst = append(dst, s...)
+// If huffman is true, s will be encoded in Huffman codes only when it
+// produces strictly shorter byte string.
+func appendHpackString(dst []byte, s string, huffman bool) []byte {
+	if huffman {
+		if huffmanLength := HuffmanEncodeLength(s); huffmanLength < uint64(len(s)) {
+			return appendHuffmanLiteral(dst, s, huffmanLength)
+		}
 	}
+	dst = appendVarInt(dst, 7, uint64(len(s)))
+	return append(dst, s...)
+}
+
+// appendString is like appendHpackString, with Huffman coding enabled
+// unless disabled by SetHuffmanEnabled, and adds the bytes saved by
+// Huffman coding to e's stats.
+func (e *Encoder) appendString(dst []byte, s string) []byte {
+	if !e.noHuffman {
+		if huffmanLength := HuffmanEncodeLength(s); huffmanLength < uint64(len(s)) {
+			e.stats.HuffmanSavedBytes += uint64(len(s)) - huffmanLength
+			return appendHuffmanLiteral(dst, s, huffmanLength)
+		}
+	}
+	return appendHpackString(dst, s, false)
+}
+
+// appendHuffmanLiteral appends s, Huffman coded in huffmanLength bytes,
+// as encoded in "String Literal" representation, to dst and returns
+// the extended buffer.
+func appendHuffmanLiteral(dst []byte, s string, huffmanLength uint64) []byte {
+	first := len(dst)
+	dst = appendVarInt(dst, 7, huffmanLength)
+	dst = AppendHuffmanString(dst, s)
+	dst[first] |= 0x80
 	return dst
 }
 
@@ -170,7 +170,7 @@ func TestAppendHpackString(t *testing.T) {
 	}
 	for _, tt := range tests {
 		want := removeSpace(tt.wantHex)
-		buf := appendHpackString(nil, tt.s)
+		buf := appendHpackString(nil, tt.s, true)
 		if got := hex.EncodeToString(buf); want != got {
 			t.Errorf("appendHpackString(nil, %q) = %q; want %q", tt.s, got, want)
 		}
@@ -217,7 +217,7 @@ func TestAppendNewName(t *testing.T) {
 	}
 	for _, tt := range tests {
 		want := removeSpace(tt.wantHex)
-		buf := appendNewName(nil, tt.f, tt.indexing)
+		buf := appendNewName(nil, tt.f, tt.indexing, true)
 		if got := hex.EncodeToString(buf); want != got {
 			t.Errorf("appendNewName(nil, %+v, %v) = %q; want %q", tt.f, tt.indexing, got, want)
 		}
@@ -243,7 +243,7 @@ func TestAppendIndexedName(t *testing.T) {
 	}
 	for _, tt := range tests {
 		want := removeSpace(tt.wantHex)
-		buf := appendIndexedName(nil, tt.f, tt.i, tt.indexing)
+		buf := appendIndexedName(nil, tt.f, tt.i, tt.indexing, true)
 		if got := hex.EncodeToString(buf); want != got {
 			t.Errorf("appendIndexedName(nil, %+v, %v) = %q; want %q", tt.f, tt.indexing, got, want)
 		}
@@ -384,3 +384,117 @@ func BenchmarkEncoderSearchTable(b *testing.B) {
 		}
 	}
 }
+
+func TestEncoderSetHuffmanEnabled(t *testing.T) {
+	var buf bytes.Buffer
+	e := NewEncoder(&buf)
+	e.SetHuffmanEnabled(false)
+	f := HeaderField{Name: "custom-key", Value: "custom-value"}
+	if err := e.WriteField(f); err != nil {
+		t.Fatal(err)
+	}
+	// Literal with incremental indexing and a new name, no Huffman coding.
+	want := "\x40\x0acustom-key\x0ccustom-value"
+	if got := buf.String(); got != want {
+		t.Errorf("encoded %q; want %q", got, want)
+	}
+
+	buf.Reset()
+	e.SetHuffmanEnabled(true)
+	f.Value = "other-value"
+	if err := e.WriteField(f); err != nil {
+		t.Fatal(err)
+	}
+	// The name is now indexed (62); the value is Huffman coded.
+	want = "\x7e" + string(appendHpackString(nil, f.Value, true))
+	if got := buf.String(); got != want {
+		t.Errorf("after re-enabling Huffman, encoded %q; want %q", got, want)
+	}
+	if want[1]&0x80 == 0 {
+		t.Errorf("value %q not Huffman coded", f.Value)
+	}
+}
+
+func TestEncoderIndexingPolicy(t *testing.T) {
+	small := pair("x-small", "a")
+	large := pair("x-large", strings.Repeat("b", 100))
+	denied := pair("date", "Mon, 21 Oct 2013 20:13:21 GMT")
+
+	var buf bytes.Buffer
+	e := NewEncoder(&buf)
+	e.SetMaxIndexedFieldSize(small.Size())
+	e.SetIndexingPolicy(func(f HeaderField) bool { return f.Name != "date" })
+	for _, f := range []HeaderField{small, large, denied, {Name: "secret", Value: "s", Sensitive: true}} {
+		if err := e.WriteField(f); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := e.DynamicTableFields(), []HeaderField{small}; !reflect.DeepEqual(got, want) {
+		t.Errorf("dynamic table = %v; want %v", got, want)
+	}
+
+	d := NewDecoder(initialHeaderTableSize, nil)
+	hfs, err := d.DecodeFull(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []HeaderField{small, large, denied, {Name: "secret", Value: "s", Sensitive: true}}; !reflect.DeepEqual(hfs, want) {
+		t.Errorf("decoded %v; want %v", hfs, want)
+	}
+
+	// Removing the limits indexes fields again.
+	e.SetMaxIndexedFieldSize(0)
+	e.SetIndexingPolicy(nil)
+	if err := e.WriteField(large); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(e.DynamicTableFields()); got != 2 {
+		t.Errorf("dynamic table has %d entries after removing limits; want 2", got)
+	}
+}
+
+func TestEncoderStats(t *testing.T) {
+	var buf bytes.Buffer
+	e := NewEncoder(&buf)
+	e.SetMaxDynamicTableSizeLimit(100)
+	fields := []HeaderField{
+		pair(":method", "GET"),                   // static index
+		pair("custom-key", "custom-value"),       // inserted
+		pair("custom-key", "custom-value"),       // dynamic index
+		pair("custom-key", "other-value-longer"), // inserted, evicts one
+		{Name: "secret", Value: "s", Sensitive: true},
+	}
+	saved := func(s string) uint64 {
+		if n := HuffmanEncodeLength(s); n < uint64(len(s)) {
+			return uint64(len(s)) - n
+		}
+		return 0
+	}
+	var in uint64
+	for _, f := range fields {
+		if err := e.WriteField(f); err != nil {
+			t.Fatal(err)
+		}
+		in += uint64(len(f.Name) + len(f.Value))
+	}
+	want := EncoderStats{
+		Fields:      uint64(len(fields)),
+		InputBytes:  in,
+		OutputBytes: uint64(buf.Len()),
+		Insertions:  2,
+		Evictions:   1,
+		HuffmanSavedBytes: saved("custom-key") + saved("custom-value") +
+			saved("other-value-longer") + saved("secret") + saved("s"),
+	}
+	if got := e.Stats(); got != want {
+		t.Errorf("Stats() = %+v; want %+v", got, want)
+	}
+	if want.HuffmanSavedBytes == 0 {
+		t.Error("no bytes saved by Huffman coding")
+	}
+
+	e.SetMaxDynamicTableSize(0)
+	if got := e.Stats().Evictions; got != 2 {
+		t.Errorf("after shrinking the table, Evictions = %d; want 2", got)
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package hpack
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+// FuzzHPACKDecode decodes arbitrary header blocks. Blocks that decode
+// successfully must survive a round trip through the Encoder.
+func FuzzHPACKDecode(f *testing.F) {
+	for _, seed := range []string{
+		// RFC 7541, Appendix C.
+		"400a 6375 7374 6f6d 2d6b 6579 0d63 7573 746f 6d2d 6865 6164 6572",
+		"040c 2f73 616d 706c 652f 7061 7468",
+		"1008 7061 7373 776f 7264 0673 6563 7265 74",
+		"8286 8441 0f77 7777 2e65 7861 6d70 6c65 2e63 6f6d",
+		"8286 84be 5808 6e6f 2d63 6163 6865",
+		"8286 8441 8cf1 e3c2 e5f2 3a6b a0ab 90f4 ff",
+		// Dynamic table size updates.
+		"3fe1 1f",
+		"20",
+		// Invalid Huffman padding.
+		"0085 f2b2 4a87 ff",
+	} {
+		f.Add(dehex(seed))
+	}
+	f.Fuzz(func(t *testing.T, block []byte) {
+		d := NewDecoder(4096, nil)
+		d.SetMaxStringLength(1 << 16)
+		hf, err := d.DecodeFull(block)
+		if err != nil {
+			return
+		}
+
+		var buf bytes.Buffer
+		e := NewEncoder(&buf)
+		for _, f := range hf {
+			if err := e.WriteField(f); err != nil {
+				t.Fatalf("WriteField(%v): %v", f, err)
+			}
+		}
+		d2 := NewDecoder(4096, nil)
+		d2.SetMaxStringLength(1 << 16)
+		got, err := d2.DecodeFull(buf.Bytes())
+		if err != nil {
+			t.Fatalf("decoding re-encoded %v: %v", hf, err)
+		}
+		if len(hf) == 0 && len(got) == 0 {
+			return
+		}
+		if !reflect.DeepEqual(got, hf) {
+			t.Fatalf("round trip of %v = %v", hf, got)
+		}
+	})
+}
@@ -0,0 +1,206 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hpack
+
+import (
+	"sort"
+	"strings"
+)
+
+// WriteHeader encodes a complete header list into e: first the
+// pseudo-header fields in pseudo, in order, then the fields of h, which
+// is typically an http.Header, in sorted order as by WriteHeaderKeys.
+//
+// WriteHeader doesn't remove connection-specific fields such as
+// Connection or Transfer-Encoding; callers must not include them in h.
+func (e *Encoder) WriteHeader(pseudo []HeaderField, h map[string][]string) error {
+	for _, hf := range pseudo {
+		if err := e.WriteField(hf); err != nil {
+			return err
+		}
+	}
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return e.WriteHeaderKeys(h, keys)
+}
+
+// WriteHeaderKeys encodes the fields of h named by keys into e, in the
+// order of keys, as reported by ForeachHeaderField. Callers that sort
+// or select the keys themselves, such as to write only trailers, use it
+// in place of WriteHeader.
+func (e *Encoder) WriteHeaderKeys(h map[string][]string, keys []string) error {
+	return ForeachHeaderField(h, keys, e.WriteField)
+}
+
+// ForeachHeaderField calls f for each field of h named by keys, in the
+// order of keys, as it is to be encoded in an HTTP/2 header block. If f
+// returns a non-nil error, ForeachHeaderField stops and returns it.
+// Callers that need to see each encoded field, such as to log it, use
+// it with Encoder.WriteField in place of WriteHeaderKeys.
+//
+// Field names are lower-cased, as HTTP/2 requires. Fields with names
+// that are not valid ASCII tokens or with invalid values are skipped,
+// matching what net/http does for HTTP/1. Each Cookie value is split
+// into one field per cookie-pair, as permitted by RFC 7540 Section
+// 8.1.2.5, for better compression. Fields are marked Sensitive as
+// reported by SensitiveField.
+func ForeachHeaderField(h map[string][]string, keys []string, f func(HeaderField) error) error {
+	for _, k := range keys {
+		name, ok := lowerHeaderName(k)
+		if !ok {
+			continue
+		}
+		for _, v := range h[k] {
+			if !validHeaderValue(v) {
+				continue
+			}
+			var err error
+			if name == "cookie" {
+				err = foreachCookie(v, f)
+			} else {
+				err = f(HeaderField{Name: name, Value: v, Sensitive: SensitiveField(name, v)})
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// foreachCookie calls f with a field for each cookie-pair in the Cookie
+// value v.
+func foreachCookie(v string, f func(HeaderField) error) error {
+	for v != "" {
+		pair := v
+		if p := strings.IndexByte(v, ';'); p >= 0 {
+			pair, v = v[:p], strings.TrimLeft(v[p+1:], " ")
+		} else {
+			v = ""
+		}
+		if pair == "" {
+			continue
+		}
+		if err := f(HeaderField{Name: "cookie", Value: pair, Sensitive: SensitiveField("cookie", pair)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// commonLowerNames maps the lower-case names of the static table and of
+// other common fields to themselves, so lowerHeaderName can return them
+// without allocating.
+var commonLowerNames = func() map[string]string {
+	m := make(map[string]string)
+	for _, hf := range staticTableEntries {
+		m[hf.Name] = hf.Name
+	}
+	for _, name := range []string{
+		"access-control-allow-credentials",
+		"access-control-allow-headers",
+		"access-control-allow-methods",
+		"access-control-expose-headers",
+		"access-control-max-age",
+		"alt-svc",
+		"connection",
+		"content-security-policy",
+		"keep-alive",
+		"origin",
+		"proxy-connection",
+		"te",
+		"upgrade",
+		"upgrade-insecure-requests",
+		"x-content-type-options",
+		"x-forwarded-for",
+		"x-forwarded-host",
+		"x-forwarded-proto",
+		"x-frame-options",
+		"x-powered-by",
+		"x-real-ip",
+		"x-requested-with",
+		"x-xss-protection",
+	} {
+		m[name] = name
+	}
+	return m
+}()
+
+// lowerHeaderName returns the lower-case form of the header field name
+// k, and whether k is a valid header field name: a non-empty token as
+// defined by RFC 7230 Section 3.2.6. Common names, such as those of
+// the static table, are returned without allocating.
+func lowerHeaderName(k string) (string, bool) {
+	if k == "" {
+		return "", false
+	}
+	upper := false
+	for i := 0; i < len(k); i++ {
+		c := k[i]
+		if !isTokenByte(c) {
+			return "", false
+		}
+		if 'A' <= c && c <= 'Z' {
+			upper = true
+		}
+	}
+	if !upper {
+		return k, true
+	}
+	var arr [32]byte
+	b := arr[:0]
+	for i := 0; i < len(k); i++ {
+		c := k[i]
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		b = append(b, c)
+	}
+	if name, ok := commonLowerNames[string(b)]; ok {
+		return name, true
+	}
+	return string(b), true
+}
+
+// isTokenByte reports whether c may appear in a token, as defined by
+// RFC 7230 Section 3.2.6.
+func isTokenByte(c byte) bool {
+	switch {
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		return true
+	}
+	return strings.IndexByte("!#$%&'*+-.^_`|~", c) >= 0
+}
+
+// validHeaderValue reports whether v is a valid header field value:
+// it has no control characters other than horizontal tab. Bytes 0x80
+// and above are allowed as obs-text, per RFC 7230 Section 3.2.
+func validHeaderValue(v string) bool {
+	for i := 0; i < len(v); i++ {
+		if c := v[i]; (c < ' ' && c != '\t') || c == 0x7f {
+			return false
+		}
+	}
+	return true
+}
+
+// SensitiveField reports whether the header field name: value, where
+// name is lower case, should be encoded as a never-indexed literal,
+// keeping credentials out of the HPACK dynamic tables where a
+// compression side channel could reveal them. See RFC 7541, Section
+// 7.1.3.
+func SensitiveField(name, value string) bool {
+	switch name {
+	case "authorization", "proxy-authorization":
+		return true
+	case "cookie":
+		// Short cookie values are easily guessed.
+		return len(value) < 20
+	}
+	return false
+}
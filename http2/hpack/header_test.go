@@ -0,0 +1,130 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hpack
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestEncoderWriteHeader(t *testing.T) {
+	var buf bytes.Buffer
+	e := NewEncoder(&buf)
+	pseudo := []HeaderField{pair(":method", "GET"), pair(":path", "/"), pair(":scheme", "https")}
+	h := map[string][]string{
+		"X-Multi":         {"1", "2"},
+		"Content-Type":    {"text/plain"},
+		"Cookie":          {"a=1; b=2;c=3", "session=0123456789abcdefghij"},
+		"Authorization":   {"Bearer x"},
+		"Bad Name":        {"skipped"},
+		"X-Bad-Value":     {"bad\nvalue"},
+		"already-lowered": {"ok"},
+	}
+	if err := e.WriteHeader(pseudo, h); err != nil {
+		t.Fatal(err)
+	}
+	got, err := NewDecoder(initialHeaderTableSize, nil).DecodeFull(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []HeaderField{
+		pair(":method", "GET"),
+		pair(":path", "/"),
+		pair(":scheme", "https"),
+		{Name: "authorization", Value: "Bearer x", Sensitive: true},
+		pair("content-type", "text/plain"),
+		{Name: "cookie", Value: "a=1", Sensitive: true},
+		{Name: "cookie", Value: "b=2", Sensitive: true},
+		{Name: "cookie", Value: "c=3", Sensitive: true},
+		pair("cookie", "session=0123456789abcdefghij"),
+		pair("x-multi", "1"),
+		pair("x-multi", "2"),
+		pair("already-lowered", "ok"), // keys are sorted before lower-casing
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("decoded\n%v\nwant\n%v", got, want)
+	}
+}
+
+func TestEncoderWriteHeaderKeys(t *testing.T) {
+	var buf bytes.Buffer
+	e := NewEncoder(&buf)
+	h := map[string][]string{
+		"X-B":          {"b"},
+		"X-A":          {"a"},
+		"Content-Type": {"text/plain"},
+	}
+	if err := e.WriteHeaderKeys(h, []string{"X-B", "X-A", "Missing"}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := NewDecoder(initialHeaderTableSize, nil).DecodeFull(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []HeaderField{pair("x-b", "b"), pair("x-a", "a")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("decoded\n%v\nwant\n%v", got, want)
+	}
+}
+
+func TestLowerHeaderName(t *testing.T) {
+	tests := []struct {
+		in, want string
+		ok       bool
+	}{
+		{"Content-Type", "content-type", true},
+		{"x-lower", "x-lower", true},
+		{"X-Mixed-Case-That-Is-Longer-Than-32-Bytes", "x-mixed-case-that-is-longer-than-32-bytes", true},
+		{"", "", false},
+		{"Bad Name", "", false},
+		{"Caf\xc3\xa9", "", false},
+	}
+	for _, tt := range tests {
+		if got, ok := lowerHeaderName(tt.in); got != tt.want || ok != tt.ok {
+			t.Errorf("lowerHeaderName(%q) = %q, %v; want %q, %v", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+	for _, name := range []string{"Content-Type", "Keep-Alive", "Upgrade", "X-Forwarded-For"} {
+		if n := testing.AllocsPerRun(10, func() { lowerHeaderName(name) }); n != 0 {
+			t.Errorf("lowerHeaderName(%q) allocates %v times; want 0", name, n)
+		}
+	}
+}
+
+func TestForeachHeaderField(t *testing.T) {
+	h := map[string][]string{
+		"X-A":     {"a", "bad\x00value"},
+		"Bad Key": {"v"},
+		"Cookie":  {"a=b; c=d"},
+	}
+	var got []HeaderField
+	err := ForeachHeaderField(h, []string{"X-A", "Bad Key", "Cookie"}, func(hf HeaderField) error {
+		got = append(got, hf)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []HeaderField{
+		pair("x-a", "a"),
+		{Name: "cookie", Value: "a=b", Sensitive: true},
+		{Name: "cookie", Value: "c=d", Sensitive: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("visited\n%v\nwant\n%v", got, want)
+	}
+
+	errStop := errors.New("stop")
+	calls := 0
+	err = ForeachHeaderField(h, []string{"X-A", "Cookie"}, func(HeaderField) error {
+		calls++
+		return errStop
+	})
+	if err != errStop || calls != 1 {
+		t.Errorf("ForeachHeaderField = %v after %d calls; want %v after 1", err, calls, errStop)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"strings"
 )
 
 // A DecodingError is something the spec defines as a decoding error.
@@ -31,6 +32,35 @@ func (e InvalidIndexError) Error() string {
 	return fmt.Sprintf("invalid indexed representation index %d", int(e))
 }
 
+// A DecodeError describes an error returned by Decoder.Write or
+// Decoder.Close, and is returned by Decoder.ErrorDetail. It records
+// where in the header block the failing representation starts and
+// wraps the returned error, such as a DecodingError or ErrStringLength.
+// For ErrInvalidHuffman, it wraps a *HuffmanError instead.
+type DecodeError struct {
+	// Offset is the offset, in bytes from the start of the header
+	// block, of the representation that failed to decode.
+	Offset int64
+
+	// Op names the kind of representation being decoded, such as
+	// "indexed" or "literal with incremental indexing".
+	Op string
+
+	// Recoverable is true if the input was well formed and decoding
+	// stopped only because of a limit set on the Decoder, such as
+	// SetMaxStringLength. The rest of the header block is still lost,
+	// but the peer did not violate the protocol.
+	Recoverable bool
+
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("%v (%s at offset %d)", e.Err, e.Op, e.Offset)
+}
+
+func (e *DecodeError) Unwrap() error { return e.Err }
+
 // A HeaderField is a name-value pair. Both the name and value are
 // treated as opaque sequences of octets.
 type HeaderField struct {
@@ -74,6 +104,24 @@ func (hf HeaderField) Size() uint32 {
 	return uint32(len(hf.Name) + len(hf.Value) + 32)
 }
 
+// FieldSize returns the size of a header field with the given name and
+// value per RFC 7541 section 4.1, without building a HeaderField. Unlike
+// HeaderField.Size, the result can't overflow.
+func FieldSize(name, value string) uint64 {
+	return uint64(len(name)) + uint64(len(value)) + 32
+}
+
+// HeaderListSize returns the size of a header list as limited by the
+// HTTP/2 SETTINGS_MAX_HEADER_LIST_SIZE setting: the sum of the sizes of
+// its fields per RFC 7541 section 4.1.
+func HeaderListSize(fields []HeaderField) uint64 {
+	var n uint64
+	for _, hf := range fields {
+		n += FieldSize(hf.Name, hf.Value)
+	}
+	return n
+}
+
 // A Decoder is the decoding context for incremental processing of
 // header blocks.
 type Decoder struct {
@@ -83,6 +131,8 @@ type Decoder struct {
 	emitEnabled bool // whether calls to emit are enabled
 	maxStrLen   int  // 0 means unlimited
 
+	maxSizeLimit uint32 // cap on dynamic table size updates; 0 means unlimited
+
 	// buf is the unparsed buffer. It's only written to
 	// saveBuf if it was truncated in the middle of a header
 	// block. Because it's usually not owned, we can only
@@ -94,6 +144,19 @@ type Decoder struct {
 	saveBuf bytes.Buffer
 
 	firstField bool // processing the first field of the header block
+
+	// blockOff is the offset of buf (or of saveBuf's contents,
+	// between Writes) from the start of the header block.
+	blockOff int64
+
+	errDetail error // *DecodeError for the last error, or nil
+
+	// While DecodeFullInto runs, fields are appended to collect
+	// instead of being emitted, and non-indexed literal strings
+	// are carved out of arena.
+	collecting bool
+	collect    []HeaderField
+	arena      *strings.Builder
 }
 
 // NewDecoder returns a new decoder with the provided maximum dynamic
@@ -150,6 +213,17 @@ func (d *Decoder) SetMaxDynamicTableSize(v uint32) {
 	d.dynTab.setMaxSize(v)
 }
 
+// SetMaxDynamicTableSizeLimit sets a hard cap on the maximum dynamic
+// table size that the encoded stream may select with dynamic table size
+// updates. It applies in addition to SetAllowedMaxDynamicTableSize, so
+// memory stays bounded even if the allowed size is raised, for example
+// by a mistake in settings negotiation. Updates larger than v are
+// rejected as decoding errors. A value of 0 means no limit and is the
+// default from NewDecoder.
+func (d *Decoder) SetMaxDynamicTableSizeLimit(v uint32) {
+	d.maxSizeLimit = v
+}
+
 // SetAllowedMaxDynamicTableSize sets the upper bound that the encoded
 // stream (via dynamic table size updates) may set the maximum size
 // to.
@@ -186,6 +260,40 @@ func (dt *dynamicTable) evict() {
 	dt.table.evictOldest(n)
 }
 
+// fields returns a copy of the table's entries in HPACK index
+// order, newest first.
+func (dt *dynamicTable) fields() []HeaderField {
+	ents := dt.table.ents
+	fs := make([]HeaderField, len(ents))
+	for i, f := range ents {
+		fs[len(ents)-1-i] = f
+	}
+	return fs
+}
+
+func (dt *dynamicTable) debugString() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "dynamic table: size=%d maxSize=%d", dt.size, dt.maxSize)
+	for i, f := range dt.fields() {
+		fmt.Fprintf(&buf, "\n  [%d] %q = %q", staticTable.len()+1+i, f.Name, f.Value)
+	}
+	return buf.String()
+}
+
+// DynamicTableFields returns a copy of the decoder's dynamic table
+// entries, in HPACK index order: the first field has index 62.
+func (d *Decoder) DynamicTableFields() []HeaderField { return d.dynTab.fields() }
+
+// DynamicTableSize returns the current size of the decoder's dynamic
+// table, as defined in RFC 7541 Section 4.1, and its maximum size.
+func (d *Decoder) DynamicTableSize() (size, maxSize uint32) {
+	return d.dynTab.size, d.dynTab.maxSize
+}
+
+// DebugString returns a multi-line description of the decoder's
+// dynamic table, for debugging.
+func (d *Decoder) DebugString() string { return d.dynTab.debugString() }
+
 func (d *Decoder) maxTableIndex() int {
 	// This should never overflow. RFC 7540 Section 6.5.2 limits the size of
 	// the dynamic table to 2^32 bytes, where each entry will occupy more than
@@ -211,15 +319,18 @@ func (d *Decoder) at(i uint64) (hf HeaderField, ok bool) {
 	return dt.ents[dt.len()-(int(i)-staticTable.len())], true
 }
 
-// Decode decodes an entire block.
-//
-// TODO: remove this method and make it incremental later? This is
-// easier for debugging now.
+// DecodeFull decodes an entire header block that is available in
+// memory, returning all of its fields. It is equivalent to Write
+// followed by Close, with the emitted fields collected rather than
+// passed to the Decoder's emit function, even if emitting is
+// disabled. The emit function and EmitEnabled state are restored
+// before DecodeFull returns.
 func (d *Decoder) DecodeFull(p []byte) ([]HeaderField, error) {
 	var hf []HeaderField
-	saveFunc := d.emit
-	defer func() { d.emit = saveFunc }()
+	saveFunc, saveEnabled := d.emit, d.emitEnabled
+	defer func() { d.emit, d.emitEnabled = saveFunc, saveEnabled }()
 	d.emit = func(f HeaderField) { hf = append(hf, f) }
+	d.emitEnabled = true
 	if _, err := d.Write(p); err != nil {
 		return nil, err
 	}
@@ -229,19 +340,44 @@ func (d *Decoder) DecodeFull(p []byte) ([]HeaderField, error) {
 	return hf, nil
 }
 
+// DecodeFullInto is like DecodeFull, but appends the fields to dst
+// and returns the extended slice. Literal strings that are not added
+// to the dynamic table are written to arena, and the returned fields
+// refer to arena's memory instead of each allocating a string. Those
+// strings remain valid after arena is Reset or reused, so callers
+// decoding many header blocks should Reset arena between blocks to
+// release old ones; this makes the cost of decoding a block roughly
+// one allocation rather than one per field. Fields are not passed to
+// the Decoder's emit function.
+func (d *Decoder) DecodeFullInto(dst []HeaderField, arena *strings.Builder, p []byte) ([]HeaderField, error) {
+	d.collecting, d.collect, d.arena = true, dst, arena
+	defer func() { d.collecting, d.collect, d.arena = false, nil, nil }()
+	if _, err := d.Write(p); err != nil {
+		return dst, err
+	}
+	if err := d.Close(); err != nil {
+		return dst, err
+	}
+	return d.collect, nil
+}
+
 // Close declares that the decoding is complete and resets the Decoder
 // to be reused again for a new header block. If there is any remaining
 // data in the decoder's buffer, Close returns an error.
 func (d *Decoder) Close() error {
+	d.errDetail = nil
 	if d.saveBuf.Len() > 0 {
+		op := reprName(d.saveBuf.Bytes()[0])
 		d.saveBuf.Reset()
-		return DecodingError{errors.New("truncated headers")}
+		return d.decodeError(op, DecodingError{errors.New("truncated headers")})
 	}
 	d.firstField = true
+	d.blockOff = 0
 	return nil
 }
 
 func (d *Decoder) Write(p []byte) (n int, err error) {
+	d.errDetail = nil
 	if len(p) == 0 {
 		// Prevent state machine CPU attacks (making us redo
 		// work up to the point of finding out we don't have
@@ -259,6 +395,7 @@ func (d *Decoder) Write(p []byte) (n int, err error) {
 	}
 
 	for len(d.buf) > 0 {
+		op, before := d.buf[0], len(d.buf)
 		err = d.parseHeaderFieldRepr()
 		if err == errNeedMore {
 			// Extra paranoia, making sure saveBuf won't
@@ -268,17 +405,38 @@ func (d *Decoder) Write(p []byte) (n int, err error) {
 			// but keep this as a last resort.
 			const varIntOverhead = 8 // conservative
 			if d.maxStrLen != 0 && int64(len(d.buf)) > 2*(int64(d.maxStrLen)+varIntOverhead) {
-				return 0, ErrStringLength
+				return 0, d.decodeError(reprName(op), ErrStringLength)
 			}
 			d.saveBuf.Write(d.buf)
 			return len(p), nil
 		}
 		d.firstField = false
 		if err != nil {
-			break
+			return len(p), d.decodeError(reprName(op), err)
 		}
+		d.blockOff += int64(before - len(d.buf))
 	}
-	return len(p), err
+	return len(p), nil
+}
+
+// decodeError records err, which occurred decoding a representation
+// of kind op at d.blockOff, for ErrorDetail, and returns it. A
+// *HuffmanError is recorded as is but returned as ErrInvalidHuffman.
+func (d *Decoder) decodeError(op string, err error) error {
+	d.errDetail = &DecodeError{
+		Offset:      d.blockOff,
+		Op:          op,
+		Recoverable: err == ErrStringLength,
+		Err:         err,
+	}
+	return huffmanSentinel(err)
+}
+
+// ErrorDetail returns a *DecodeError describing where in the header
+// block the error returned by the last call to Write or Close
+// occurred. It returns nil if that call succeeded.
+func (d *Decoder) ErrorDetail() error {
+	return d.errDetail
 }
 
 // errNeedMore is an internal sentinel error value that means the
@@ -297,6 +455,24 @@ const (
 func (v indexType) indexed() bool   { return v == indexedTrue }
 func (v indexType) sensitive() bool { return v == indexedNever }
 
+// reprName returns the name of the representation whose first byte is b,
+// as used in DecodeError.Op.
+func reprName(b byte) string {
+	switch {
+	case b&128 != 0:
+		return "indexed"
+	case b&192 == 64:
+		return "literal with incremental indexing"
+	case b&240 == 0:
+		return "literal without indexing"
+	case b&240 == 16:
+		return "literal never indexed"
+	case b&224 == 32:
+		return "dynamic table size update"
+	}
+	return "invalid representation"
+}
+
 // returns errNeedMore if there isn't enough data available.
 // any other error is fatal.
 // consumes d.buf iff it returns nil.
@@ -358,7 +534,11 @@ func (d *Decoder) parseFieldLiteral(n uint8, it indexType) error {
 	}
 
 	var hf HeaderField
-	wantStr := d.emitEnabled || it.indexed()
+	wantStr := d.emitEnabled || d.collecting || it.indexed()
+	arena := d.arena
+	if it.indexed() {
+		arena = nil // the dynamic table outlives the arena's block
+	}
 	if nameIdx > 0 {
 		ihf, ok := d.at(nameIdx)
 		if !ok {
@@ -366,12 +546,12 @@ func (d *Decoder) parseFieldLiteral(n uint8, it indexType) error {
 		}
 		hf.Name = ihf.Name
 	} else {
-		hf.Name, buf, err = d.readString(buf, wantStr)
+		hf.Name, buf, err = d.readString(buf, wantStr, arena)
 		if err != nil {
 			return err
 		}
 	}
-	hf.Value, buf, err = d.readString(buf, wantStr)
+	hf.Value, buf, err = d.readString(buf, wantStr, arena)
 	if err != nil {
 		return err
 	}
@@ -389,6 +569,10 @@ func (d *Decoder) callEmit(hf HeaderField) error {
 			return ErrStringLength
 		}
 	}
+	if d.collecting {
+		d.collect = append(d.collect, hf)
+		return nil
+	}
 	if d.emitEnabled {
 		d.emit(hf)
 	}
@@ -411,6 +595,9 @@ func (d *Decoder) parseDynamicTableSizeUpdate() error {
 	if size > uint64(d.dynTab.allowedMaxSize) {
 		return DecodingError{errors.New("dynamic table size update too large")}
 	}
+	if d.maxSizeLimit != 0 && size > uint64(d.maxSizeLimit) {
+		return DecodingError{errors.New("dynamic table size update exceeds limit")}
+	}
 	d.dynTab.setMaxSize(uint32(size))
 	d.buf = buf
 	return nil
@@ -467,7 +654,9 @@ func readVarInt(n byte, p []byte) (i uint64, remain []byte, err error) {
 // strings past the MAX_HEADER_LIST_SIZE are ignored, but the server
 // is returning an error anyway, and because they're not indexed, the error
 // won't affect the decoding state.
-func (d *Decoder) readString(p []byte, wantStr bool) (s string, remain []byte, err error) {
+//
+// If arena is non-nil, s is written to it and refers to its memory.
+func (d *Decoder) readString(p []byte, wantStr bool, arena *strings.Builder) (s string, remain []byte, err error) {
 	if len(p) == 0 {
 		return "", p, errNeedMore
 	}
@@ -484,7 +673,11 @@ func (d *Decoder) readString(p []byte, wantStr bool) (s string, remain []byte, e
 	}
 	if !isHuff {
 		if wantStr {
-			s = string(p[:strLen])
+			if arena != nil {
+				s = arenaString(arena, p[:strLen])
+			} else {
+				s = string(p[:strLen])
+			}
 		}
 		return s, p[strLen:], nil
 	}
@@ -497,8 +690,20 @@ func (d *Decoder) readString(p []byte, wantStr bool) (s string, remain []byte, e
 			buf.Reset()
 			return "", nil, err
 		}
-		s = buf.String()
+		if arena != nil {
+			s = arenaString(arena, buf.Bytes())
+		} else {
+			s = buf.String()
+		}
 		buf.Reset() // be nice to GC
 	}
 	return s, p[strLen:], nil
 }
+
+// arenaString appends b to arena and returns the string holding it,
+// which shares arena's memory.
+func arenaString(arena *strings.Builder, b []byte) string {
+	start := arena.Len()
+	arena.Write(b)
+	return arena.String()[start:]
+}
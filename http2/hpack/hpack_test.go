@@ -7,6 +7,7 @@ package hpack
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math/rand"
 	"reflect"
@@ -421,6 +422,67 @@ func TestHuffmanDecodeMaxLengthOnTrailingByte(t *testing.T) {
 	}
 }
 
+func TestHuffmanError(t *testing.T) {
+	tests := []struct {
+		in         []byte
+		wantOffset int
+		wantReason string
+	}{
+		{[]byte{0xff, 0xff, 0xff, 0xff, 0xfc}, 3, "EOS symbol"},
+		{[]byte{0x1f, 0xff}, 2, "padding longer than 7 bits"},
+		{[]byte{0x00}, 1, "padding not a prefix of EOS"},
+	}
+	for _, tt := range tests {
+		if _, err := HuffmanDecodeToString(tt.in); err != ErrInvalidHuffman {
+			t.Errorf("decode(%x) = %v; want ErrInvalidHuffman", tt.in, err)
+		}
+		// A literal header field without indexing, new name "a",
+		// with the Huffman-encoded value tt.in.
+		block := append([]byte{0x00, 0x01, 'a', 0x80 | byte(len(tt.in))}, tt.in...)
+		d := NewDecoder(initialHeaderTableSize, nil)
+		if _, err := d.DecodeFull(block); err != ErrInvalidHuffman {
+			t.Errorf("DecodeFull(%x) = %v; want ErrInvalidHuffman", block, err)
+			continue
+		}
+		de, ok := d.ErrorDetail().(*DecodeError)
+		if !ok {
+			t.Errorf("ErrorDetail = %#v; want *DecodeError", d.ErrorDetail())
+			continue
+		}
+		he, ok := de.Err.(*HuffmanError)
+		if !ok {
+			t.Errorf("ErrorDetail wraps %#v; want *HuffmanError", de.Err)
+			continue
+		}
+		if he.Offset != tt.wantOffset || he.Reason != tt.wantReason {
+			t.Errorf("decode(%x) = %+v; want offset %d, reason %q", tt.in, he, tt.wantOffset, tt.wantReason)
+		}
+	}
+}
+
+func TestAppendHuffmanDecodeLimit(t *testing.T) {
+	s := "www.example.com"
+	enc := AppendHuffmanString(nil, s)
+	got, err := AppendHuffmanDecodeLimit([]byte("x:"), enc, len(s))
+	if err != nil || string(got) != "x:"+s {
+		t.Errorf("at limit = %q, %v; want %q, nil", got, err, "x:"+s)
+	}
+	if got, err := AppendHuffmanDecodeLimit([]byte("x:"), enc, len(s)-1); err != ErrStringLength || string(got) != "x:" {
+		t.Errorf("over limit = %q, %v; want %q, ErrStringLength", got, err, "x:")
+	}
+	// Input that can't possibly fit is rejected without decoding.
+	long := bytes.Repeat([]byte{0xff}, 100)
+	if _, err := AppendHuffmanDecodeLimit(nil, long, 10); err != ErrStringLength {
+		t.Errorf("long input = %v; want ErrStringLength", err)
+	}
+	if got, err := AppendHuffmanDecodeLimit(nil, nil, 0); err != nil || len(got) != 0 {
+		t.Errorf("empty input with zero limit = %q, %v; want empty, nil", got, err)
+	}
+	if _, err := AppendHuffmanDecodeLimit(nil, enc, 0); err != ErrStringLength {
+		t.Errorf("zero limit = %v; want ErrStringLength", err)
+	}
+}
+
 func TestHuffmanDecodeCorruptPadding(t *testing.T) {
 	in := []byte{0x00}
 	var buf bytes.Buffer
@@ -483,6 +545,25 @@ func BenchmarkHuffmanDecode(b *testing.B) {
 	}
 }
 
+func TestAppendHuffmanDecode(t *testing.T) {
+	for _, s := range []string{"", "www.example.com", "no-cache", "custom-key", "\x00\xff binary"} {
+		enc := AppendHuffmanString(nil, s)
+		got, err := AppendHuffmanDecode([]byte("prefix:"), enc)
+		if err != nil {
+			t.Errorf("AppendHuffmanDecode(%q): %v", s, err)
+			continue
+		}
+		if want := "prefix:" + s; string(got) != want {
+			t.Errorf("AppendHuffmanDecode = %q; want %q", got, want)
+		}
+	}
+	dst := []byte("keep")
+	got, err := AppendHuffmanDecode(dst, []byte{0xff, 0xff, 0xff, 0xff})
+	if err != ErrInvalidHuffman || string(got) != "keep" {
+		t.Errorf("AppendHuffmanDecode(invalid) = %q, %v; want %q, %v", got, err, "keep", ErrInvalidHuffman)
+	}
+}
+
 func TestAppendHuffmanString(t *testing.T) {
 	tests := []struct {
 		in, want string
@@ -589,7 +670,7 @@ func TestHuffmanDecodeFuzz(t *testing.T) {
 
 		buf.Reset()
 		if err := huffmanDecode(&buf, 0, zbuf.Bytes()); err != nil {
-			if err == ErrInvalidHuffman {
+			if errors.Is(err, ErrInvalidHuffman) {
 				numFail++
 				continue
 			}
@@ -709,6 +790,59 @@ func TestEmitEnabled(t *testing.T) {
 	}
 }
 
+func TestEmitDisabledKeepsDynamicTable(t *testing.T) {
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf)
+	enc.WriteField(HeaderField{Name: "x-first", Value: "one"})
+	first := append([]byte(nil), buf.Bytes()...)
+	buf.Reset()
+	enc.WriteField(HeaderField{Name: "x-first", Value: "one"}) // now an indexed reference
+	second := buf.Bytes()
+
+	var got []HeaderField
+	dec := NewDecoder(initialHeaderTableSize, func(hf HeaderField) {
+		got = append(got, hf)
+	})
+	dec.SetEmitEnabled(false)
+	if _, err := dec.Write(first); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("emitted %v with emit disabled", got)
+	}
+	dec.SetEmitEnabled(true)
+	if _, err := dec.Write(second); err != nil {
+		t.Fatal(err)
+	}
+	if want := []HeaderField{pair("x-first", "one")}; !reflect.DeepEqual(got, want) {
+		t.Errorf("after re-enabling emit, got %v; want %v", got, want)
+	}
+}
+
+func TestEmitDisabledNoAllocs(t *testing.T) {
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf)
+	enc.SetMaxDynamicTableSizeLimit(0)
+	enc.SetMaxDynamicTableSize(0) // literals without indexing
+	enc.WriteField(HeaderField{Name: "x-huge", Value: strings.Repeat("v", 100)})
+	enc.WriteField(HeaderField{Name: "x-plain", Value: "\xff\xff\xff"})
+	p := buf.Bytes()
+
+	dec := NewDecoder(initialHeaderTableSize, func(HeaderField) {})
+	dec.SetEmitEnabled(false)
+	if _, err := dec.Write(p); err != nil {
+		t.Fatal(err)
+	}
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, err := dec.Write(p); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("Write with emit disabled made %v allocations; want 0", allocs)
+	}
+}
+
 func TestSaveBufLimit(t *testing.T) {
 	const maxStr = 1 << 10
 	var got []HeaderField
@@ -742,6 +876,59 @@ func TestSaveBufLimit(t *testing.T) {
 	}
 }
 
+func TestDecoderMaxStringLength(t *testing.T) {
+	const maxStr = 16
+	literal := func(name, value string, huffman bool) []byte {
+		frag := []byte{encodeTypeByte(false, false)}
+		for _, s := range []string{name, value} {
+			if huffman {
+				first := len(frag)
+				frag = appendVarInt(frag, 7, HuffmanEncodeLength(s))
+				frag[first] |= 0x80
+				frag = AppendHuffmanString(frag, s)
+			} else {
+				frag = appendVarInt(frag, 7, uint64(len(s)))
+				frag = append(frag, s...)
+			}
+		}
+		return frag
+	}
+	atMax := strings.Repeat("a", maxStr)
+	overMax := strings.Repeat("a", maxStr+1)
+	tests := []struct {
+		name    string
+		frag    []byte
+		wantErr error
+	}{
+		{"plain at max", literal("foo", atMax, false), nil},
+		{"plain over max", literal("foo", overMax, false), ErrStringLength},
+		{"huffman at max", literal("foo", atMax, true), nil},
+		{"huffman over max", literal("foo", overMax, true), ErrStringLength},
+		{"name over max", literal(overMax, "bar", false), ErrStringLength},
+	}
+	for _, tt := range tests {
+		dec := NewDecoder(initialHeaderTableSize, func(HeaderField) {})
+		dec.SetMaxStringLength(maxStr)
+		_, err := dec.Write(tt.frag)
+		if err != tt.wantErr {
+			t.Errorf("%s: Write = %v; want %v", tt.name, err, tt.wantErr)
+		}
+	}
+
+	// An over-long string split across Writes is also rejected
+	// without buffering the whole string.
+	dec := NewDecoder(initialHeaderTableSize, func(HeaderField) {})
+	dec.SetMaxStringLength(maxStr)
+	frag := literal("foo", strings.Repeat("a", 1<<10), false)
+	var err error
+	for i := 0; i < len(frag) && err == nil; i++ {
+		_, err = dec.Write(frag[i : i+1])
+	}
+	if err != ErrStringLength {
+		t.Errorf("byte-at-a-time Write = %v; want %v", err, ErrStringLength)
+	}
+}
+
 func TestDynamicSizeUpdate(t *testing.T) {
 	var buf bytes.Buffer
 	enc := NewEncoder(&buf)
@@ -768,3 +955,297 @@ func TestDynamicSizeUpdate(t *testing.T) {
 		t.Fatalf("dynamic table size update not at the beginning of a header block")
 	}
 }
+
+func TestDynamicTableIntrospection(t *testing.T) {
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf)
+	dec := NewDecoder(initialHeaderTableSize, func(HeaderField) {})
+	for _, hf := range []HeaderField{
+		pair("x-a", "1"),
+		pair("x-b", "2"),
+		{Name: "authorization", Value: "secret", Sensitive: true},
+	} {
+		enc.WriteField(hf)
+	}
+	if _, err := dec.Write(buf.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []HeaderField{pair("x-b", "2"), pair("x-a", "1")}
+	if got := enc.DynamicTableFields(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Encoder.DynamicTableFields = %v; want %v", got, want)
+	}
+	if got := dec.DynamicTableFields(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Decoder.DynamicTableFields = %v; want %v", got, want)
+	}
+	wantSize := want[0].Size() + want[1].Size()
+	for name, tab := range map[string]interface {
+		DynamicTableSize() (uint32, uint32)
+	}{"Encoder": enc, "Decoder": dec} {
+		if size, max := tab.DynamicTableSize(); size != wantSize || max != initialHeaderTableSize {
+			t.Errorf("%s.DynamicTableSize = %v, %v; want %v, %v", name, size, max, wantSize, initialHeaderTableSize)
+		}
+	}
+
+	const wantDebug = "dynamic table: size=72 maxSize=4096\n" +
+		"  [62] \"x-b\" = \"2\"\n" +
+		"  [63] \"x-a\" = \"1\""
+	if got := enc.DebugString(); got != wantDebug {
+		t.Errorf("Encoder.DebugString =\n%s\nwant:\n%s", got, wantDebug)
+	}
+	if got := dec.DebugString(); got != wantDebug {
+		t.Errorf("Decoder.DebugString =\n%s\nwant:\n%s", got, wantDebug)
+	}
+}
+
+func TestDecodeFullRestoresEmit(t *testing.T) {
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf)
+	enc.WriteField(pair("foo", "bar"))
+	enc.WriteField(pair("baz", "qux"))
+
+	var emitted []HeaderField
+	d := NewDecoder(initialHeaderTableSize, func(hf HeaderField) { emitted = append(emitted, hf) })
+	d.SetEmitEnabled(false)
+	got, err := d.DecodeFull(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []HeaderField{pair("foo", "bar"), pair("baz", "qux")}; !reflect.DeepEqual(got, want) {
+		t.Errorf("DecodeFull = %v; want %v", got, want)
+	}
+	if len(emitted) != 0 {
+		t.Errorf("DecodeFull called the emit func with %v", emitted)
+	}
+	if d.EmitEnabled() {
+		t.Errorf("EmitEnabled = true after DecodeFull; want false")
+	}
+}
+
+func TestDecodeFullInto(t *testing.T) {
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf)
+	fields := []HeaderField{
+		pair(":method", "GET"),
+		pair("custom-key", "custom-value"),
+		{Name: "authorization", Value: "secret", Sensitive: true},
+		{Name: "x-never", Value: "indexed", Sensitive: true},
+	}
+	for _, hf := range fields {
+		enc.WriteField(hf)
+	}
+	enc.SetHuffmanEnabled(false)
+	enc.WriteField(HeaderField{Name: "x-raw", Value: "plain", Sensitive: true})
+	fields = append(fields, HeaderField{Name: "x-raw", Value: "plain", Sensitive: true})
+
+	var emitted int
+	d := NewDecoder(initialHeaderTableSize, func(HeaderField) { emitted++ })
+	var arena strings.Builder
+	dst := []HeaderField{pair("keep", "me")}
+	got, err := d.DecodeFullInto(dst, &arena, buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := append([]HeaderField{pair("keep", "me")}, fields...); !reflect.DeepEqual(got, want) {
+		t.Errorf("DecodeFullInto = %v; want %v", got, want)
+	}
+	if emitted != 0 {
+		t.Errorf("DecodeFullInto called the emit func %d times", emitted)
+	}
+	if arena.Len() == 0 {
+		t.Errorf("DecodeFullInto did not use the arena")
+	}
+	arena.Reset()
+	if want := []HeaderField{pair("custom-key", "custom-value")}; !reflect.DeepEqual(d.DynamicTableFields(), want) {
+		t.Errorf("dynamic table = %v; want %v", d.DynamicTableFields(), want)
+	}
+
+	// The Decoder returns to emitting afterwards.
+	if _, err := d.Write(buf.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if emitted != len(fields) {
+		t.Errorf("emitted %d fields after DecodeFullInto; want %d", emitted, len(fields))
+	}
+}
+
+func TestDecodeFullIntoAllocs(t *testing.T) {
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf)
+	enc.WriteField(pair(":method", "GET"))
+	enc.WriteField(HeaderField{Name: "x-huffman", Value: "some header value", Sensitive: true})
+	enc.SetHuffmanEnabled(false)
+	enc.WriteField(HeaderField{Name: "x-raw", Value: "another header value", Sensitive: true})
+
+	d := NewDecoder(initialHeaderTableSize, nil)
+	dst := make([]HeaderField, 0, 8)
+	var arena strings.Builder
+	arena.Grow(256)
+	allocs := testing.AllocsPerRun(100, func() {
+		arena.Reset()
+		arena.Grow(256)
+		var err error
+		if dst, err = d.DecodeFullInto(dst[:0], &arena, buf.Bytes()); err != nil {
+			t.Fatal(err)
+		}
+	})
+	// The only allocation is the arena's buffer.
+	if allocs > 1 {
+		t.Errorf("DecodeFullInto allocs = %v; want at most 1", allocs)
+	}
+}
+
+func benchmarkDecodeBlock(b *testing.B) []byte {
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf)
+	for _, hf := range []HeaderField{
+		pair(":method", "GET"),
+		pair(":path", "/index.html"),
+		{Name: "user-agent", Value: "Mozilla/5.0 (X11; Linux x86_64)", Sensitive: true},
+		{Name: "cookie", Value: "session=0123456789abcdef", Sensitive: true},
+		{Name: "x-request-id", Value: "f81d4fae-7dec-11d0-a765-00a0c91e6bf6", Sensitive: true},
+	} {
+		enc.WriteField(hf)
+	}
+	return buf.Bytes()
+}
+
+func BenchmarkDecodeFull(b *testing.B) {
+	block := benchmarkDecodeBlock(b)
+	d := NewDecoder(initialHeaderTableSize, nil)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := d.DecodeFull(block); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeFullInto(b *testing.B) {
+	block := benchmarkDecodeBlock(b)
+	d := NewDecoder(initialHeaderTableSize, nil)
+	var dst []HeaderField
+	var arena strings.Builder
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		arena.Reset()
+		var err error
+		if dst, err = d.DecodeFullInto(dst[:0], &arena, block); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	tests := []struct {
+		name        string
+		in          []string // passed to successive Writes
+		close       bool
+		wantOffset  int64
+		wantOp      string
+		wantErr     error
+		recoverable bool
+	}{
+		{
+			name:       "invalid index",
+			in:         []string{"\x82\x86\xff\x00"},
+			wantOffset: 2,
+			wantOp:     "indexed",
+			wantErr:    DecodingError{InvalidIndexError(127)},
+		},
+		{
+			name:       "invalid index across writes",
+			in:         []string{"\x82\x86", "\x04\x01/\x7f\x00"},
+			wantOffset: 2 + 3,
+			wantOp:     "literal with incremental indexing",
+			wantErr:    DecodingError{InvalidIndexError(63)},
+		},
+		{
+			name:       "truncated",
+			in:         []string{"\x82\x04\x05/ab"},
+			close:      true,
+			wantOffset: 1,
+			wantOp:     "literal without indexing",
+		},
+		{
+			name:        "string too long",
+			in:          []string{"\x82\x00\x03foo\x09too-large"},
+			wantOffset:  1,
+			wantOp:      "literal without indexing",
+			wantErr:     ErrStringLength,
+			recoverable: true,
+		},
+	}
+	for _, tt := range tests {
+		d := NewDecoder(initialHeaderTableSize, func(HeaderField) {})
+		d.SetMaxStringLength(8)
+		var err error
+		for _, p := range tt.in {
+			if _, err = d.Write([]byte(p)); err != nil {
+				break
+			}
+		}
+		if tt.close {
+			if err != nil {
+				t.Fatalf("%s: Write = %v", tt.name, err)
+			}
+			err = d.Close()
+		}
+		if err == nil {
+			t.Errorf("%s: got no error", tt.name)
+			continue
+		}
+		de, ok := d.ErrorDetail().(*DecodeError)
+		if !ok {
+			t.Errorf("%s: ErrorDetail = %#v; want *DecodeError", tt.name, d.ErrorDetail())
+			continue
+		}
+		if de.Offset != tt.wantOffset || de.Op != tt.wantOp || de.Recoverable != tt.recoverable {
+			t.Errorf("%s: got offset=%d op=%q recoverable=%v; want offset=%d op=%q recoverable=%v",
+				tt.name, de.Offset, de.Op, de.Recoverable, tt.wantOffset, tt.wantOp, tt.recoverable)
+		}
+		if tt.wantErr != nil && (err != tt.wantErr || de.Err != tt.wantErr) {
+			t.Errorf("%s: error = %v, ErrorDetail wraps %v; want %v", tt.name, err, de.Err, tt.wantErr)
+		}
+	}
+}
+
+func TestHeaderListSize(t *testing.T) {
+	if got, want := FieldSize("foo", "bar"), uint64(pair("foo", "bar").Size()); got != want {
+		t.Errorf("FieldSize = %d; want %d", got, want)
+	}
+	fields := []HeaderField{pair(":status", "200"), pair("content-type", "text/plain"), {Name: "cookie", Value: "a=b", Sensitive: true}}
+	if got, want := HeaderListSize(fields), uint64(7+3+32+12+10+32+6+3+32); got != want {
+		t.Errorf("HeaderListSize = %d; want %d", got, want)
+	}
+	if got := HeaderListSize(nil); got != 0 {
+		t.Errorf("HeaderListSize(nil) = %d; want 0", got)
+	}
+}
+
+func TestDecoderMaxDynamicTableSizeLimit(t *testing.T) {
+	update := func(size uint32) []byte {
+		return append(appendTableSize(nil, size), 0x82)
+	}
+	d := NewDecoder(initialHeaderTableSize, func(HeaderField) {})
+	d.SetAllowedMaxDynamicTableSize(1 << 20)
+	d.SetMaxDynamicTableSizeLimit(8192)
+	if _, err := d.Write(update(8192)); err != nil {
+		t.Fatalf("update to limit: %v", err)
+	}
+	d.Close()
+	if _, size := d.DynamicTableSize(); size != 8192 {
+		t.Errorf("max table size = %d; want 8192", size)
+	}
+	_, err := d.Write(update(8193))
+	if !errors.As(err, new(DecodingError)) {
+		t.Errorf("update over limit: Write = %v; want DecodingError", err)
+	}
+
+	// Without a limit, updates up to the allowed size are accepted.
+	d = NewDecoder(initialHeaderTableSize, func(HeaderField) {})
+	d.SetAllowedMaxDynamicTableSize(1 << 20)
+	if _, err := d.Write(update(1 << 20)); err != nil {
+		t.Errorf("update without limit: %v", err)
+	}
+}
@@ -7,6 +7,7 @@ package hpack
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"io"
 	"sync"
 )
@@ -23,7 +24,7 @@ func HuffmanDecode(w io.Writer, v []byte) (int, error) {
 	buf.Reset()
 	defer bufPool.Put(buf)
 	if err := huffmanDecode(buf, 0, v); err != nil {
-		return 0, err
+		return 0, huffmanSentinel(err)
 	}
 	return w.Write(buf.Bytes())
 }
@@ -34,26 +35,92 @@ func HuffmanDecodeToString(v []byte) (string, error) {
 	buf.Reset()
 	defer bufPool.Put(buf)
 	if err := huffmanDecode(buf, 0, v); err != nil {
-		return "", err
+		return "", huffmanSentinel(err)
 	}
 	return buf.String(), nil
 }
 
+// AppendHuffmanDecode appends the decoding of the Huffman-encoded
+// string in v to dst and returns the extended buffer. On error, dst
+// is returned unmodified.
+func AppendHuffmanDecode(dst, v []byte) ([]byte, error) {
+	buf := bytes.NewBuffer(dst)
+	if err := huffmanDecode(buf, 0, v); err != nil {
+		return dst, huffmanSentinel(err)
+	}
+	return buf.Bytes(), nil
+}
+
 // ErrInvalidHuffman is returned for errors found decoding
 // Huffman-encoded strings.
 var ErrInvalidHuffman = errors.New("hpack: invalid Huffman-encoded data")
 
-// huffmanDecode decodes v to buf.
-// If maxLen is greater than 0, attempts to write more to buf than
-// maxLen bytes will return ErrStringLength.
+// A HuffmanError describes a Huffman-encoded string that is invalid
+// per RFC 7541 section 5.2. When a Decoder returns ErrInvalidHuffman,
+// the DecodeError reported by Decoder.ErrorDetail wraps a *HuffmanError
+// saying where and why decoding failed.
+type HuffmanError struct {
+	Offset int    // offset in the encoded string at which the error was found
+	Reason string // what was wrong, such as "padding longer than 7 bits"
+}
+
+func (e *HuffmanError) Error() string {
+	return fmt.Sprintf("%v: %s at offset %d", ErrInvalidHuffman, e.Reason, e.Offset)
+}
+
+// Is reports whether target is ErrInvalidHuffman.
+func (e *HuffmanError) Is(target error) bool { return target == ErrInvalidHuffman }
+
+// AppendHuffmanDecodeLimit is like AppendHuffmanDecode, but returns
+// ErrStringLength if the decoded string would be longer than maxLen
+// bytes; a maxLen of 0 or less allows only the empty string. Inputs
+// too long to decode to at most maxLen bytes are rejected before any
+// decoding work is done.
+func AppendHuffmanDecodeLimit(dst, v []byte, maxLen int) ([]byte, error) {
+	if maxLen <= 0 {
+		maxLen = -1 // huffmanDecode treats 0 as unlimited
+	}
+	buf := bytes.NewBuffer(dst)
+	if err := huffmanDecode(buf, maxLen, v); err != nil {
+		return dst, huffmanSentinel(err)
+	}
+	return buf.Bytes(), nil
+}
+
+// huffmanSentinel returns ErrInvalidHuffman in place of a
+// *HuffmanError, and any other err unchanged.
+func huffmanSentinel(err error) error {
+	if _, ok := err.(*HuffmanError); ok {
+		return ErrInvalidHuffman
+	}
+	return err
+}
+
+// huffmanDecode decodes v to buf, returning a *HuffmanError if v is
+// invalid. If maxLen is not 0, attempts to write more to buf than
+// maxLen bytes will return ErrStringLength; a negative
+// maxLen allows only an empty string.
 func huffmanDecode(buf *bytes.Buffer, maxLen int, v []byte) error {
+	if maxLen != 0 {
+		// No symbol is longer than 30 bits, and padding is at
+		// most 7 bits, so longer inputs must either decode to
+		// more than maxLen bytes or be invalid.
+		limit := maxLen
+		if limit < 0 {
+			limit = 0
+		}
+		if uint64(len(v))*8 > uint64(limit)*30+7 {
+			return ErrStringLength
+		}
+	}
+	start := buf.Len()
 	rootHuffmanNode := getRootHuffmanNode()
 	n := rootHuffmanNode
 	// cur is the bit buffer that has not been fed into n.
 	// cbits is the number of low order bits in cur that are valid.
 	// sbits is the number of bits of the symbol prefix being decoded.
 	cur, cbits, sbits := uint(0), uint8(0), uint8(0)
-	for _, b := range v {
+	for i, b := range v {
 		cur = cur<<8 | uint(b)
 		cbits += 8
 		sbits += 8
@@ -61,10 +128,11 @@ func huffmanDecode(buf *bytes.Buffer, maxLen int, v []byte) error {
 			idx := byte(cur >> (cbits - 8))
 			n = n.children[idx]
 			if n == nil {
-				return ErrInvalidHuffman
+				// The only unassigned codes are prefixed by EOS.
+				return &HuffmanError{Offset: i, Reason: "EOS symbol"}
 			}
 			if n.children == nil {
-				if maxLen != 0 && buf.Len() == maxLen {
+				if maxLen != 0 && buf.Len()-start >= maxLen {
 					return ErrStringLength
 				}
 				buf.WriteByte(n.sym)
@@ -79,12 +147,12 @@ func huffmanDecode(buf *bytes.Buffer, maxLen int, v []byte) error {
 	for cbits > 0 {
 		n = n.children[byte(cur<<(8-cbits))]
 		if n == nil {
-			return ErrInvalidHuffman
+			return &HuffmanError{Offset: len(v), Reason: "EOS symbol"}
 		}
 		if n.children != nil || n.codeLen > cbits {
 			break
 		}
-		if maxLen != 0 && buf.Len() == maxLen {
+		if maxLen != 0 && buf.Len()-start >= maxLen {
 			return ErrStringLength
 		}
 		buf.WriteByte(n.sym)
@@ -95,11 +163,11 @@ func huffmanDecode(buf *bytes.Buffer, maxLen int, v []byte) error {
 	if sbits > 7 {
 		// Either there was an incomplete symbol, or overlong padding.
 		// Both are decoding errors per RFC 7541 section 5.2.
-		return ErrInvalidHuffman
+		return &HuffmanError{Offset: len(v), Reason: "padding longer than 7 bits"}
 	}
 	if mask := uint(1<<cbits - 1); cur&mask != mask {
 		// Trailing bits must be a prefix of EOS per RFC 7541 section 5.2.
-		return ErrInvalidHuffman
+		return &HuffmanError{Offset: len(v), Reason: "padding not a prefix of EOS"}
 	}
 
 	return nil
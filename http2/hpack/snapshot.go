@@ -0,0 +1,85 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hpack
+
+import "errors"
+
+// Table snapshots are themselves HPACK header blocks: a dynamic table
+// size update setting the table's maximum size, followed by each entry,
+// oldest first, as a literal with incremental indexing. Decoding a
+// snapshot into an empty table reproduces the original table, and
+// snapshots can be inspected with any HPACK tool.
+
+var errSnapshotTooLarge = errors.New("hpack: table snapshot exceeds maximum table size")
+
+func (dt *dynamicTable) snapshot() []byte {
+	b := appendTableSize(nil, dt.maxSize)
+	for _, f := range dt.table.ents {
+		b = appendNewName(b, HeaderField{Name: f.Name, Value: f.Value}, true, false)
+	}
+	return b
+}
+
+// restoreTable decodes the snapshot b into a new table, whose maximum
+// size may be at most limit.
+func restoreTable(b []byte, limit uint32) (dynamicTable, error) {
+	d := NewDecoder(limit, nil)
+	d.SetEmitEnabled(false)
+	if _, err := d.Write(b); err != nil {
+		return dynamicTable{}, err
+	}
+	if err := d.Close(); err != nil {
+		return dynamicTable{}, err
+	}
+	if len(b) == 0 || b[0]&224 != 32 {
+		return dynamicTable{}, DecodingError{errors.New("table snapshot missing size update")}
+	}
+	return d.dynTab, nil
+}
+
+// SnapshotTable returns the state of the decoder's dynamic table, in a
+// form that can be passed to RestoreTable on a Decoder or Encoder.
+// Snapshots make it possible to build deterministic test fixtures, to
+// compare tables with other HPACK implementations, and to investigate
+// tables that have diverged from a peer's.
+func (d *Decoder) SnapshotTable() []byte { return d.dynTab.snapshot() }
+
+// RestoreTable replaces the decoder's dynamic table with the one
+// recorded in snapshot, as returned by SnapshotTable. The snapshot's
+// maximum table size must not exceed the size allowed by
+// SetAllowedMaxDynamicTableSize.
+func (d *Decoder) RestoreTable(snapshot []byte) error {
+	dt, err := restoreTable(snapshot, d.dynTab.allowedMaxSize)
+	if err != nil {
+		return err
+	}
+	if d.maxSizeLimit != 0 && dt.maxSize > d.maxSizeLimit {
+		return errSnapshotTooLarge
+	}
+	dt.allowedMaxSize = d.dynTab.allowedMaxSize
+	d.dynTab = dt
+	return nil
+}
+
+// SnapshotTable returns the state of the encoder's dynamic table, in a
+// form that can be passed to RestoreTable on a Decoder or Encoder.
+func (e *Encoder) SnapshotTable() []byte { return e.dynTab.snapshot() }
+
+// RestoreTable replaces the encoder's dynamic table with the one
+// recorded in snapshot, as returned by SnapshotTable. The snapshot's
+// maximum table size must not exceed the limit set by
+// SetMaxDynamicTableSizeLimit. No "Header Table Size Update" is sent
+// for the restored table: the peer's decoder is assumed to hold the
+// same table.
+func (e *Encoder) RestoreTable(snapshot []byte) error {
+	dt, err := restoreTable(snapshot, e.maxSizeLimit)
+	if err != nil {
+		return err
+	}
+	e.dynTab = dt
+	e.tableSizeUpdate = false
+	e.minSize = uint32Max
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hpack
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestTableSnapshotRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf)
+	enc.SetMaxDynamicTableSize(256)
+	dec := NewDecoder(initialHeaderTableSize, nil)
+	for _, hf := range []HeaderField{
+		pair("x-a", "1"),
+		pair("x-b", "2"),
+		pair(":authority", "www.example.com"),
+		{Name: "authorization", Value: "secret", Sensitive: true},
+	} {
+		enc.WriteField(hf)
+	}
+	if _, err := dec.DecodeFull(buf.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(enc.SnapshotTable(), dec.SnapshotTable()) {
+		t.Fatalf("encoder and decoder snapshots differ:\n%s\n%s", enc.DebugString(), dec.DebugString())
+	}
+	snap := dec.SnapshotTable()
+
+	// Restore into a fresh encoder/decoder pair and check they
+	// stay in sync.
+	var buf2 bytes.Buffer
+	enc2 := NewEncoder(&buf2)
+	dec2 := NewDecoder(initialHeaderTableSize, nil)
+	if err := enc2.RestoreTable(snap); err != nil {
+		t.Fatalf("Encoder.RestoreTable: %v", err)
+	}
+	if err := dec2.RestoreTable(snap); err != nil {
+		t.Fatalf("Decoder.RestoreTable: %v", err)
+	}
+	for _, x := range []interface {
+		DynamicTableFields() []HeaderField
+		DynamicTableSize() (uint32, uint32)
+	}{enc2, dec2} {
+		if got, want := x.DynamicTableFields(), dec.DynamicTableFields(); !reflect.DeepEqual(got, want) {
+			t.Errorf("%T restored fields = %v; want %v", x, got, want)
+		}
+		gotSize, gotMax := x.DynamicTableSize()
+		wantSize, wantMax := dec.DynamicTableSize()
+		if gotSize != wantSize || gotMax != wantMax {
+			t.Errorf("%T restored size = %d/%d; want %d/%d", x, gotSize, gotMax, wantSize, wantMax)
+		}
+	}
+
+	// The restored encoder refers to restored entries by index.
+	enc2.WriteField(pair("x-a", "1"))
+	if got, want := buf2.Bytes(), []byte{0x80 | 64}; !bytes.Equal(got, want) {
+		t.Errorf("encoded %x; want %x", got, want)
+	}
+	hfs, err := dec2.DecodeFull(buf2.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []HeaderField{pair("x-a", "1")}; !reflect.DeepEqual(hfs, want) {
+		t.Errorf("decoded %v; want %v", hfs, want)
+	}
+}
+
+func TestRestoreTableErrors(t *testing.T) {
+	d := NewDecoder(initialHeaderTableSize, nil)
+	d.SetMaxDynamicTableSizeLimit(1024)
+	tests := []struct {
+		name string
+		in   []byte
+	}{
+		{"empty", nil},
+		{"no size update", appendNewName(nil, pair("a", "b"), true, false)},
+		{"over allowed", appendTableSize(nil, initialHeaderTableSize+1)},
+		{"over limit", appendTableSize(nil, 2048)},
+		{"truncated", appendNewName(appendTableSize(nil, 100), pair("a", "b"), true, false)[:4]},
+	}
+	for _, tt := range tests {
+		if err := d.RestoreTable(tt.in); err == nil {
+			t.Errorf("%s: RestoreTable succeeded; want error", tt.name)
+		}
+	}
+	e := NewEncoder(nil)
+	if err := e.RestoreTable(appendTableSize(nil, initialHeaderTableSize+1)); err == nil {
+		t.Errorf("Encoder.RestoreTable over limit succeeded; want error")
+	}
+}
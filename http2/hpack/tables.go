@@ -191,6 +191,29 @@ var staticTableEntries = [...]HeaderField{
 	{Name: "www-authenticate"},
 }
 
+// StaticTableLen is the number of entries in the HPACK static table,
+// defined in RFC 7541 Appendix A. Static table entries have indexes
+// 1 through StaticTableLen; dynamic table entries follow.
+const StaticTableLen = len(staticTableEntries)
+
+// StaticTableField returns the static table entry with HPACK index i.
+// It reports false if i is not in the range [1, StaticTableLen].
+func StaticTableField(i uint64) (hf HeaderField, ok bool) {
+	if i < 1 || i > uint64(StaticTableLen) {
+		return HeaderField{}, false
+	}
+	return staticTableEntries[i-1], true
+}
+
+// StaticTableIndex returns the HPACK index of the static table entry
+// matching f. If an entry matches both f's name and value,
+// nameValueMatch is true. Otherwise i is the index of an entry
+// matching only f's name, or 0 if there is none. As when encoding,
+// a sensitive f only matches by name.
+func StaticTableIndex(f HeaderField) (i uint64, nameValueMatch bool) {
+	return staticTable.search(f)
+}
+
 func newStaticTable() *headerFieldTable {
 	t := &headerFieldTable{}
 	t.init()
@@ -212,3 +212,35 @@ func TestStaticTable(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestStaticTableAccessors(t *testing.T) {
+	if StaticTableLen != 61 {
+		t.Errorf("StaticTableLen = %d; want 61", StaticTableLen)
+	}
+	for _, i := range []uint64{0, uint64(StaticTableLen) + 1} {
+		if hf, ok := StaticTableField(i); ok {
+			t.Errorf("StaticTableField(%d) = %v, true; want false", i, hf)
+		}
+	}
+	if hf, ok := StaticTableField(2); !ok || hf != (HeaderField{Name: ":method", Value: "GET"}) {
+		t.Errorf("StaticTableField(2) = %v, %v; want :method GET", hf, ok)
+	}
+	for i := uint64(1); i <= uint64(StaticTableLen); i++ {
+		hf, _ := StaticTableField(i)
+		j, nameValue := StaticTableIndex(hf)
+		if !nameValue {
+			t.Errorf("StaticTableIndex(%v) found no name-value match", hf)
+			continue
+		}
+		if got, _ := StaticTableField(j); got != hf {
+			t.Errorf("StaticTableIndex(%v) = %d, which holds %v", hf, j, got)
+		}
+	}
+	i, nameValue := StaticTableIndex(HeaderField{Name: ":method", Value: "PATCH"})
+	if hf, _ := StaticTableField(i); hf.Name != ":method" || nameValue {
+		t.Errorf("StaticTableIndex(:method PATCH) = %d (%v), %v; want a :method entry, false", i, hf, nameValue)
+	}
+	if i, nameValue := StaticTableIndex(HeaderField{Name: "x-unknown"}); i != 0 || nameValue {
+		t.Errorf("StaticTableIndex(x-unknown) = %d, %v; want 0, false", i, nameValue)
+	}
+}
@@ -346,3 +346,30 @@ func TestNoUnicodeStrings(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestSensitiveHeaderEncoding(t *testing.T) {
+	tests := []struct {
+		k, v string
+		want bool
+	}{
+		{"authorization", "Bearer secret", true},
+		{"proxy-authorization", "Basic Zm9vOmJhcg==", true},
+		{"cookie", "session=abc", true},
+		{"cookie", "tracking=0123456789abcdef0123", false},
+		{"user-agent", "Go-http-client/2.0", false},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		encKV(hpack.NewEncoder(&buf), tt.k, tt.v)
+		fields, err := hpack.NewDecoder(initialHeaderTableSize, nil).DecodeFull(buf.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(fields) != 1 || fields[0].Name != tt.k || fields[0].Value != tt.v {
+			t.Fatalf("decoded %v; want %s: %s", fields, tt.k, tt.v)
+		}
+		if fields[0].Sensitive != tt.want {
+			t.Errorf("%s: %s encoded with Sensitive = %v; want %v", tt.k, tt.v, fields[0].Sensitive, tt.want)
+		}
+	}
+}
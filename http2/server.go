@@ -74,6 +74,10 @@ var responseWriterStatePool = sync.Pool{
 	},
 }
 
+// ignoredWriteQuotaOnce limits the log message about a WriteQuota that
+// the write scheduler can't honor to one per process.
+var ignoredWriteQuotaOnce sync.Once
+
 // Test hooks.
 var (
 	testHookOnConn        func()
@@ -130,16 +134,37 @@ type Server struct {
 	// If nil, a default scheduler is chosen.
 	NewWriteScheduler func() WriteScheduler
 
+	// WriteQuota, if non-zero, limits how much is written from one
+	// stream in a row while other streams of the same priority have
+	// frames waiting. It's honored by the default scheduler and by
+	// those returned by NewRoundRobinWriteScheduler and
+	// NewRFC9218WriteScheduler. Other schedulers, including those
+	// returned by NewPriorityWriteScheduler, ignore it; the first
+	// connection to use such a scheduler logs that.
+	WriteQuota WriteQuota
+
 	// CountError, if non-nil, is called on HTTP/2 server errors.
 	// It's intended to increment a metric for monitoring, such
 	// as an expvar or Prometheus metric.
 	// The errType consists of only ASCII word characters.
 	CountError func(errType string)
 
+	// CaptureFrames, if non-nil, is called for each new connection
+	// to get a writer to record the connection's frames to, as by
+	// Framer.SetCapture. If it returns nil, the connection's frames
+	// aren't recorded. If the writer is an io.Closer, it's closed
+	// when the connection is done.
+	// See DebugCapture for a ready-made implementation.
+	CaptureFrames func(c net.Conn) io.Writer
+
 	// Internal state. This is a pointer (rather than embedded directly)
 	// so that we don't embed a Mutex in this struct, which will make the
 	// struct non-copyable, which might break some callers.
 	state *serverInternalState
+
+	// clock, if non-nil, replaces the system clock for the
+	// server's timeouts. It's set by tests.
+	clock clock
 }
 
 func (s *Server) initialConnRecvWindowSize() int32 {
@@ -156,6 +181,17 @@ func (s *Server) initialStreamRecvWindowSize() int32 {
 	return 1 << 20
 }
 
+func (s *Server) now() time.Time {
+	return s.clockOrReal().Now()
+}
+
+func (s *Server) clockOrReal() clock {
+	if s.clock != nil {
+		return s.clock
+	}
+	return realClock{}
+}
+
 func (s *Server) maxReadFrameSize() uint32 {
 	if v := s.MaxReadFrameSize; v >= minMaxFrameSize && v <= maxFrameSize {
 		return v
@@ -402,6 +438,15 @@ func (s *Server) ServeConn(c net.Conn, opts *ServeConnOpts) {
 	} else {
 		sc.writeSched = NewRandomWriteScheduler()
 	}
+	if s.WriteQuota != (WriteQuota{}) {
+		if ws, ok := sc.writeSched.(quotaWriteScheduler); ok {
+			ws.setWriteQuota(s.WriteQuota)
+		} else {
+			ignoredWriteQuotaOnce.Do(func() {
+				sc.logf("http2: WriteQuota is ignored by write scheduler %T", sc.writeSched)
+			})
+		}
+	}
 
 	// These start at the RFC-specified defaults. If there is a higher
 	// configured value for inflow, that will be updated when we send a
@@ -411,12 +456,18 @@ func (s *Server) ServeConn(c net.Conn, opts *ServeConnOpts) {
 	sc.hpackEncoder = hpack.NewEncoder(&sc.headerWriteBuf)
 
 	fr := NewFramer(sc.bw, c)
-	if s.CountError != nil {
-		fr.countError = s.CountError
-	}
+	fr.CountError = s.CountError
 	fr.ReadMetaHeaders = hpack.NewDecoder(initialHeaderTableSize, nil)
 	fr.MaxHeaderListSize = sc.maxHeaderListSize()
 	fr.SetMaxReadFrameSize(s.maxReadFrameSize())
+	if s.CaptureFrames != nil {
+		if w := s.CaptureFrames(c); w != nil {
+			fr.SetCapture(w)
+			if cl, ok := w.(io.Closer); ok {
+				defer cl.Close()
+			}
+		}
+	}
 	sc.framer = fr
 
 	if tc, ok := c.(connectionStater); ok {
@@ -515,7 +566,7 @@ type serverConn struct {
 	sawFirstSettings            bool // got the initial SETTINGS frame after the preface
 	needToSendSettingsAck       bool
 	unackedSettings             int    // how many SETTINGS have we sent without ACKs?
-	queuedControlFrames         int    // control frames in the writeSched queue
+	queuedControlFrames         int    // control frames in controlQueue
 	clientMaxStreams            uint32 // SETTINGS_MAX_CONCURRENT_STREAMS from client (our PUSH_PROMISE limit)
 	advMaxStreams               uint32 // our SETTINGS_MAX_CONCURRENT_STREAMS advertised the client
 	curClientStreams            uint32 // number of open streams initiated by the client
@@ -535,8 +586,10 @@ type serverConn struct {
 	inFrameScheduleLoop         bool              // whether we're in the scheduleFrameWrite loop
 	needToSendGoAway            bool              // we need to schedule a GOAWAY frame write
 	goAwayCode                  ErrCode
-	shutdownTimer               *time.Timer // nil until used
-	idleTimer                   *time.Timer // nil if unused
+	goAwayDebug                 []byte     // optional GOAWAY debug data, from Framer.ErrorDetail
+	shutdownTimer               timer      // nil until used
+	idleTimer                   timer      // nil if unused
+	controlQueue                writeQueue // frames written ahead of writeSched; see FrameWriteRequest.isFastLane
 
 	// Owned by the writeFrameAsync goroutine:
 	headerWriteBuf bytes.Buffer
@@ -585,10 +638,10 @@ type stream struct {
 	flow             flow  // limits writing from Handler to client
 	inflow           flow  // what the client is allowed to POST/etc to us
 	state            streamState
-	resetQueued      bool        // RST_STREAM queued for write; set by sc.resetStream
-	gotTrailerHeader bool        // HEADER frame for trailers was seen
-	wroteHeaders     bool        // whether we wrote headers (not status 100)
-	writeDeadline    *time.Timer // nil if unused
+	resetQueued      bool  // RST_STREAM queued for write; set by sc.resetStream
+	gotTrailerHeader bool  // HEADER frame for trailers was seen
+	wroteHeaders     bool  // whether we wrote headers (not status 100)
+	writeDeadline    timer // nil if unused
 
 	trailer    http.Header // accumulated trailers
 	reqTrailer http.Header // handler's Request.Trailer
@@ -851,13 +904,13 @@ func (sc *serverConn) serve() {
 	sc.setConnState(http.StateIdle)
 
 	if sc.srv.IdleTimeout != 0 {
-		sc.idleTimer = time.AfterFunc(sc.srv.IdleTimeout, sc.onIdleTimer)
+		sc.idleTimer = sc.srv.clockOrReal().AfterFunc(sc.srv.IdleTimeout, sc.onIdleTimer)
 		defer sc.idleTimer.Stop()
 	}
 
 	go sc.readFrames() // closed by defer sc.conn.Close above
 
-	settingsTimer := time.AfterFunc(firstSettingsTimeout, sc.onSettingsTimer)
+	settingsTimer := sc.srv.clockOrReal().AfterFunc(firstSettingsTimeout, sc.onSettingsTimer)
 	defer settingsTimer.Stop()
 
 	loopNum := 0
@@ -986,10 +1039,10 @@ func (sc *serverConn) readPreface() error {
 			errc <- nil
 		}
 	}()
-	timer := time.NewTimer(prefaceTimeout) // TODO: configurable on *Server?
+	timer := sc.srv.clockOrReal().NewTimer(prefaceTimeout) // TODO: configurable on *Server?
 	defer timer.Stop()
 	select {
-	case <-timer.C:
+	case <-timer.C():
 		return errPrefaceTimeout
 	case err := <-errc:
 		if err == nil {
@@ -1134,7 +1187,11 @@ func (sc *serverConn) writeFrame(wr FrameWriteRequest) {
 				sc.conn.Close()
 			}
 		}
-		sc.writeSched.Push(wr)
+		if wr.isFastLane() {
+			sc.controlQueue.push(wr)
+		} else {
+			sc.writeSched.Push(wr)
+		}
 	}
 	sc.scheduleFrameWrite()
 }
@@ -1251,8 +1308,9 @@ func (sc *serverConn) wroteFrame(res frameWriteResult) {
 // If a frame is already being written, nothing happens. This will be called again
 // when the frame is done being written.
 //
-// If a frame isn't being written and we need to send one, the best frame
-// to send is selected by writeSched.
+// If a frame isn't being written and we need to send one, frames in
+// controlQueue go first; otherwise the best frame to send is selected by
+// writeSched.
 //
 // If a frame isn't being written and there's nothing else to send, we
 // flush the write buffer.
@@ -1269,6 +1327,7 @@ func (sc *serverConn) scheduleFrameWrite() {
 				write: &writeGoAway{
 					maxStreamID: sc.maxClientStreamID,
 					code:        sc.goAwayCode,
+					debugData:   sc.goAwayDebug,
 				},
 			})
 			continue
@@ -1279,10 +1338,20 @@ func (sc *serverConn) scheduleFrameWrite() {
 			continue
 		}
 		if !sc.inGoAway || sc.goAwayCode == ErrCodeNo {
-			if wr, ok := sc.writeSched.Pop(); ok {
+			if !sc.controlQueue.empty() {
+				wr := sc.controlQueue.shift()
 				if wr.isControl() {
 					sc.queuedControlFrames--
 				}
+				if wr.stream != nil && wr.stream.state == stateClosed {
+					// A WINDOW_UPDATE for a stream that closed
+					// while it was queued.
+					continue
+				}
+				sc.startFrameWrite(wr)
+				continue
+			}
+			if wr, ok := sc.writeSched.Pop(); ok {
 				sc.startFrameWrite(wr)
 				continue
 			}
@@ -1344,7 +1413,7 @@ func (sc *serverConn) goAway(code ErrCode) {
 
 func (sc *serverConn) shutDownIn(d time.Duration) {
 	sc.serveG.check()
-	sc.shutdownTimer = time.AfterFunc(d, sc.onShutdownTimer)
+	sc.shutdownTimer = sc.srv.clockOrReal().AfterFunc(d, sc.onShutdownTimer)
 }
 
 func (sc *serverConn) resetStream(se StreamError) {
@@ -1381,7 +1450,7 @@ func (sc *serverConn) processFrameFromReader(res readFrameResult) bool {
 	} else {
 		f := res.f
 		if VerboseLogs {
-			sc.vlogf("http2: server read frame %v", summarizeFrame(f))
+			sc.vlogf("http2: server read frame %v", SummarizeFrame(f))
 		}
 		err = sc.processFrame(f)
 		if err == nil {
@@ -1397,6 +1466,13 @@ func (sc *serverConn) processFrameFromReader(res readFrameResult) bool {
 		sc.goAway(ErrCodeFlowControl)
 		return true
 	case ConnectionError:
+		if res.err != nil {
+			// The frame reader is blocked until readMore is
+			// called, so ErrorDetail still describes this error.
+			if d := sc.framer.ErrorDetail(); d != nil && !sc.inGoAway {
+				sc.goAwayDebug = []byte(d.Error())
+			}
+		}
 		sc.logf("http2: server connection error from %v: %v", sc.conn.RemoteAddr(), ev)
 		sc.goAway(ErrCode(ev))
 		return true // goAway will handle shutdown
@@ -1436,6 +1512,8 @@ func (sc *serverConn) processFrame(f Frame) error {
 		return sc.processResetStream(f)
 	case *PriorityFrame:
 		return sc.processPriority(f)
+	case *PriorityUpdateFrame:
+		return sc.processPriorityUpdate(f)
 	case *GoAwayFrame:
 		return sc.processGoAway(f)
 	case *PushPromiseFrame:
@@ -1880,6 +1958,14 @@ func (sc *serverConn) processHeaders(f *MetaHeadersFrame) error {
 		}
 		sc.writeSched.AdjustStream(st.id, f.Priority)
 	}
+	if ws, ok := sc.writeSched.(extensiblePriorityScheduler); ok {
+		for _, hf := range f.RegularFields() {
+			if hf.Name == "priority" {
+				ws.setHeaderPriority(st.id, ParseExtensiblePriority(hf.Value))
+				break
+			}
+		}
+	}
 
 	rw, req, err := sc.newWriterAndRequest(st, f)
 	if err != nil {
@@ -1967,6 +2053,29 @@ func (sc *serverConn) processPriority(f *PriorityFrame) error {
 	return nil
 }
 
+func (sc *serverConn) processPriorityUpdate(f *PriorityUpdateFrame) error {
+	sc.serveG.check()
+	if sc.inGoAway {
+		return nil
+	}
+	ws, ok := sc.writeSched.(extensiblePriorityScheduler)
+	if !ok {
+		return nil
+	}
+	id := f.PrioritizedStreamID
+	if id%2 != 1 {
+		// RFC 9218, Section 7.1: only request streams can be
+		// reprioritized; ignore updates for pushed streams.
+		return nil
+	}
+	if id <= sc.maxClientStreamID && sc.streams[id] == nil {
+		// The stream is closed.
+		return nil
+	}
+	ws.updatePriority(id, ParseExtensiblePriority(f.Priority))
+	return nil
+}
+
 func (sc *serverConn) newStream(id, pusherID uint32, state streamState) *stream {
 	sc.serveG.check()
 	if id == 0 {
@@ -1987,7 +2096,7 @@ func (sc *serverConn) newStream(id, pusherID uint32, state streamState) *stream
 	st.inflow.conn = &sc.inflow // link to conn-level counter
 	st.inflow.add(sc.srv.initialStreamRecvWindowSize())
 	if sc.hs.WriteTimeout != 0 {
-		st.writeDeadline = time.AfterFunc(sc.hs.WriteTimeout, st.onWriteTimeout)
+		st.writeDeadline = sc.srv.clockOrReal().AfterFunc(sc.hs.WriteTimeout, st.onWriteTimeout)
 	}
 
 	sc.streams[id] = st
@@ -2460,7 +2569,7 @@ func (rws *responseWriterState) writeChunk(p []byte) (n int, err error) {
 		var date string
 		if _, ok := rws.snapHeader["Date"]; !ok {
 			// TODO(bradfitz): be faster here, like net/http? measure.
-			date = time.Now().UTC().Format(http.TimeFormat)
+			date = rws.conn.srv.now().UTC().Format(http.TimeFormat)
 		}
 
 		for _, v := range rws.snapHeader["Trailer"] {
@@ -1234,6 +1234,179 @@ func TestServer_RejectsLargeFrames(t *testing.T) {
 	}
 }
 
+func TestServer_GoAwayDebugDataFromErrorDetail(t *testing.T) {
+	st := newServerTester(t, nil)
+	defer st.Close()
+	st.greet()
+
+	// A DATA frame on stream 0 is a connection error, and the
+	// Framer records why in its ErrorDetail.
+	st.fr.AllowIllegalWrites = true
+	if err := st.fr.WriteData(0, true, []byte("foo")); err != nil {
+		t.Fatal(err)
+	}
+
+	gf := st.wantGoAway()
+	if gf.ErrCode != ErrCodeProtocol {
+		t.Errorf("GOAWAY err = %v; want %v", gf.ErrCode, ErrCodeProtocol)
+	}
+	if got, want := string(gf.DebugData()), "DATA frame with stream ID 0"; got != want {
+		t.Errorf("GOAWAY debug data = %q; want %q", got, want)
+	}
+}
+
+func TestServer_ExtensiblePriorities(t *testing.T) {
+	inHandler := make(chan bool)
+	leaveHandler := make(chan bool)
+	st := newServerTester(t, func(w http.ResponseWriter, r *http.Request) {
+		inHandler <- true
+		<-leaveHandler
+	}, func(s *Server) {
+		s.NewWriteScheduler = NewRFC9218WriteScheduler
+	})
+	defer st.Close()
+	st.greet()
+
+	priority := func(id uint32) ExtensiblePriority {
+		ch := make(chan ExtensiblePriority, 1)
+		st.sc.serveMsgCh <- func(int) {
+			ch <- st.sc.writeSched.(*rfc9218WriteScheduler).streams[id].priority
+		}
+		return <-ch
+	}
+
+	// Stream 1 takes its priority from the Priority header field.
+	st.writeHeaders(HeadersFrameParam{
+		StreamID:      1,
+		BlockFragment: st.encodeHeader("priority", "u=1"),
+		EndStream:     true,
+		EndHeaders:    true,
+	})
+	<-inHandler
+	if got, want := priority(1), (ExtensiblePriority{Urgency: 1}); got != want {
+		t.Errorf("stream 1 priority = %v; want %v", got, want)
+	}
+
+	// A PRIORITY_UPDATE frame sent before stream 3 is opened
+	// overrides its Priority header field.
+	if err := st.fr.WritePriorityUpdate(3, "u=5, i"); err != nil {
+		t.Fatal(err)
+	}
+	st.writeHeaders(HeadersFrameParam{
+		StreamID:      3,
+		BlockFragment: st.encodeHeader("priority", "u=0"),
+		EndStream:     true,
+		EndHeaders:    true,
+	})
+	<-inHandler
+	if got, want := priority(3), (ExtensiblePriority{Urgency: 5, Incremental: true}); got != want {
+		t.Errorf("stream 3 priority = %v; want %v", got, want)
+	}
+
+	// Open streams can be reprioritized.
+	if err := st.fr.WritePriorityUpdate(1, "u=6"); err != nil {
+		t.Fatal(err)
+	}
+	// Wait for the server to process the update.
+	if err := st.fr.WritePing(false, [8]byte{}); err != nil {
+		t.Fatal(err)
+	}
+	st.wantPing()
+	if got, want := priority(1), (ExtensiblePriority{Urgency: 6}); got != want {
+		t.Errorf("stream 1 priority after update = %v; want %v", got, want)
+	}
+
+	leaveHandler <- true
+	leaveHandler <- true
+	for i := 0; i < 2; i++ {
+		hf := st.wantHeaders()
+		if !hf.StreamEnded() {
+			t.Fatalf("response HEADERS on stream %d missing END_STREAM", hf.StreamID)
+		}
+	}
+}
+
+func TestServer_WriteQuota(t *testing.T) {
+	quota := WriteQuota{MaxFrames: 4, MaxBytes: 1 << 16}
+	st := newServerTester(t, func(w http.ResponseWriter, r *http.Request) {}, func(s *Server) {
+		s.NewWriteScheduler = NewRoundRobinWriteScheduler
+		s.WriteQuota = quota
+	})
+	defer st.Close()
+	st.greet()
+
+	ch := make(chan WriteQuota, 1)
+	st.sc.serveMsgCh <- func(int) {
+		ch <- st.sc.writeSched.(*roundRobinWriteScheduler).quota
+	}
+	if got := <-ch; got != quota {
+		t.Errorf("scheduler quota = %+v; want %+v", got, quota)
+	}
+}
+
+func TestServer_WriteQuotaDefaultScheduler(t *testing.T) {
+	quota := WriteQuota{MaxFrames: 4}
+	st := newServerTester(t, func(w http.ResponseWriter, r *http.Request) {}, func(s *Server) {
+		s.WriteQuota = quota
+	})
+	defer st.Close()
+	st.greet()
+
+	ch := make(chan WriteQuota, 1)
+	st.sc.serveMsgCh <- func(int) {
+		ch <- st.sc.writeSched.(*randomWriteScheduler).quota
+	}
+	if got := <-ch; got != quota {
+		t.Errorf("default scheduler quota = %+v; want %+v", got, quota)
+	}
+}
+
+// stalledWriteScheduler is a WriteScheduler that never yields a frame.
+type stalledWriteScheduler struct {
+	WriteScheduler
+}
+
+func (stalledWriteScheduler) Pop() (FrameWriteRequest, bool) { return FrameWriteRequest{}, false }
+
+// Control frames and WINDOW_UPDATE are written ahead of the write
+// scheduler, so they aren't delayed by a backlog of stream frames.
+func TestServer_ControlFramesBypassWriteScheduler(t *testing.T) {
+	puppet := newHandlerPuppet()
+	st := newServerTester(t, func(w http.ResponseWriter, r *http.Request) {
+		puppet.act(w, r)
+	}, func(s *Server) {
+		s.NewWriteScheduler = func() WriteScheduler {
+			return stalledWriteScheduler{NewRandomWriteScheduler()}
+		}
+	})
+	defer st.Close()
+	defer puppet.done()
+
+	st.greet()
+
+	st.writeHeaders(HeadersFrameParam{
+		StreamID:      1,
+		BlockFragment: st.encodeHeader(":method", "POST"),
+		EndStream:     false,
+		EndHeaders:    true,
+	})
+	st.writeData(1, false, []byte("abc"))
+	puppet.do(readBodyHandler(t, "abc"))
+	st.wantWindowUpdate(0, 3)
+	st.wantWindowUpdate(1, 3)
+
+	if err := st.fr.WritePing(false, [8]byte{1}); err != nil {
+		t.Fatal(err)
+	}
+	if pf := st.wantPing(); !pf.IsAck() || pf.Data != [8]byte{1} {
+		t.Fatalf("got PING %v; want ACK with the same data", pf)
+	}
+
+	// The handler's response can never be written, so drop the
+	// connection to let the handler exit.
+	st.cc.Close()
+}
+
 func TestServer_Handler_Sends_WindowUpdate(t *testing.T) {
 	puppet := newHandlerPuppet()
 	st := newServerTester(t, func(w http.ResponseWriter, r *http.Request) {
@@ -2961,6 +3134,37 @@ func TestCompressionErrorOnWrite(t *testing.T) {
 	}
 }
 
+func TestServer_ContinuationFlood(t *testing.T) {
+	st := newServerTester(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request")
+	}, func(ts *httptest.Server) {
+		ts.Config.MaxHeaderBytes = 1024
+	})
+	st.addLogFilter("connection error: PROTOCOL_ERROR")
+	defer st.Close()
+	st.greet()
+
+	st.writeHeaders(HeadersFrameParam{
+		StreamID:      1,
+		BlockFragment: st.encodeHeader(),
+		EndStream:     true,
+	})
+	for i := 0; i < 100; i++ {
+		hbf := st.encodeHeaderRaw(fmt.Sprintf("x-%v", i), strings.Repeat("a", 100))
+		if err := st.fr.WriteContinuation(1, false, hbf); err != nil {
+			break // the server has hung up
+		}
+	}
+
+	ga := st.wantGoAway()
+	if ga.ErrCode != ErrCodeProtocol {
+		t.Errorf("GOAWAY err = %v; want %v", ga.ErrCode, ErrCodeProtocol)
+	}
+	if got, want := string(ga.DebugData()), ErrHeaderListTooLarge.Error(); got != want {
+		t.Errorf("GOAWAY debug data = %q; want %q", got, want)
+	}
+}
+
 func TestCompressionErrorOnClose(t *testing.T) {
 	st := newServerTester(t, func(w http.ResponseWriter, r *http.Request) {
 		// No response body.
@@ -3147,6 +3351,37 @@ func TestServerDoesntWriteInvalidHeaders(t *testing.T) {
 	})
 }
 
+func TestEncodeHeadersVerboseLogsEncodedFields(t *testing.T) {
+	defer func(old bool) { VerboseLogs = old }(VerboseLogs)
+	VerboseLogs = true
+	var logBuf bytes.Buffer
+	log.SetOutput(&logBuf)
+	defer log.SetOutput(os.Stderr)
+
+	var buf bytes.Buffer
+	h := http.Header{
+		"Ok1":               {"x"},
+		"Bad:Colon":         {"x"},
+		"Bad2":              {"x\x00y"},
+		"Transfer-Encoding": {"chunked", "trailers"},
+	}
+	encodeHeaders(hpack.NewEncoder(&buf), h, nil)
+
+	var logged []string
+	for _, line := range strings.Split(strings.TrimSpace(logBuf.String()), "\n") {
+		if i := strings.Index(line, "http2: "); i >= 0 {
+			logged = append(logged, line[i:])
+		}
+	}
+	want := []string{
+		`http2: server encoding header "ok1" = "x"`,
+		`http2: server encoding header "transfer-encoding" = "trailers"`,
+	}
+	if !reflect.DeepEqual(logged, want) {
+		t.Errorf("logged\n%q\nwant\n%q", logged, want)
+	}
+}
+
 func BenchmarkServerGets(b *testing.B) {
 	defer disableGoroutineTracking()()
 	b.ReportAllocs()
@@ -3611,7 +3846,7 @@ func TestServerHandleCustomConn(t *testing.T) {
 			return
 		}
 		if sf, ok := f.(*SettingsFrame); !ok || sf.IsAck() {
-			t.Errorf("Got %v; want non-ACK SettingsFrame", summarizeFrame(f))
+			t.Errorf("Got %v; want non-ACK SettingsFrame", SummarizeFrame(f))
 			return
 		}
 		f, err = fr.ReadFrame()
@@ -3620,7 +3855,7 @@ func TestServerHandleCustomConn(t *testing.T) {
 			return
 		}
 		if sf, ok := f.(*SettingsFrame); !ok || !sf.IsAck() {
-			t.Errorf("Got %v; want ACK SettingsFrame", summarizeFrame(f))
+			t.Errorf("Got %v; want ACK SettingsFrame", SummarizeFrame(f))
 			return
 		}
 		var henc hpackEncoder
@@ -3874,17 +4109,17 @@ func TestUnreadFlowControlReturned_Server(t *testing.T) {
 }
 
 func TestServerIdleTimeout(t *testing.T) {
-	if testing.Short() {
-		t.Skip("skipping in short mode")
-	}
-
+	const timeout = 500 * time.Millisecond
+	clock := newFakeClock()
 	st := newServerTester(t, func(w http.ResponseWriter, r *http.Request) {
 	}, func(h2s *Server) {
-		h2s.IdleTimeout = 500 * time.Millisecond
+		h2s.IdleTimeout = timeout
+		h2s.clock = clock
 	})
 	defer st.Close()
 
 	st.greet()
+	clock.advance(timeout)
 	ga := st.wantGoAway()
 	if ga.ErrCode != ErrCodeNo {
 		t.Errorf("GOAWAY error = %v; want ErrCodeNo", ga.ErrCode)
@@ -3892,15 +4127,16 @@ func TestServerIdleTimeout(t *testing.T) {
 }
 
 func TestServerIdleTimeout_AfterRequest(t *testing.T) {
-	if testing.Short() {
-		t.Skip("skipping in short mode")
-	}
 	const timeout = 250 * time.Millisecond
-
+	clock := newFakeClock()
+	started := make(chan struct{})
+	unblock := make(chan struct{})
 	st := newServerTester(t, func(w http.ResponseWriter, r *http.Request) {
-		time.Sleep(timeout * 2)
+		close(started)
+		<-unblock
 	}, func(h2s *Server) {
 		h2s.IdleTimeout = timeout
+		h2s.clock = clock
 	})
 	defer st.Close()
 
@@ -3909,16 +4145,56 @@ func TestServerIdleTimeout_AfterRequest(t *testing.T) {
 	// Send a request which takes twice the timeout. Verifies the
 	// idle timeout doesn't fire while we're in a request:
 	st.bodylessReq1()
+	<-started
+	clock.advance(timeout * 2)
+	close(unblock)
 	st.wantHeaders()
 
 	// But the idle timeout should be rearmed after the request
 	// is done:
+	clock.awaitTimer(t, timeout)
+	clock.advance(timeout)
 	ga := st.wantGoAway()
 	if ga.ErrCode != ErrCodeNo {
 		t.Errorf("GOAWAY error = %v; want ErrCodeNo", ga.ErrCode)
 	}
 }
 
+func TestServerPrefaceTimeout(t *testing.T) {
+	clock := newFakeClock()
+	st := newServerTester(t, nil, func(h2s *Server) {
+		h2s.clock = clock
+	}, optQuiet)
+	defer st.Close()
+
+	st.wantSettings()
+	clock.awaitTimer(t, prefaceTimeout)
+	clock.advance(prefaceTimeout)
+	for {
+		if _, err := st.readFrame(); err != nil {
+			break
+		}
+	}
+}
+
+func TestServerFirstSettingsTimeout(t *testing.T) {
+	clock := newFakeClock()
+	st := newServerTester(t, nil, func(h2s *Server) {
+		h2s.clock = clock
+	}, optQuiet)
+	defer st.Close()
+
+	st.writePreface()
+	st.wantSettings()
+	clock.awaitTimer(t, firstSettingsTimeout)
+	clock.advance(firstSettingsTimeout)
+	for {
+		if _, err := st.readFrame(); err != nil {
+			break
+		}
+	}
+}
+
 // grpc-go closes the Request.Body currently with a Read.
 // Verify that it doesn't race.
 // See https://github.com/grpc/grpc-go/pull/938
@@ -4030,7 +4306,7 @@ func TestServerHandlerConnectionClose(t *testing.T) {
 				sawGoAway = true
 				unblockHandler <- true
 				if f.LastStreamID != 1 || f.ErrCode != ErrCodeNo {
-					t.Errorf("unexpected GOAWAY frame: %v", summarizeFrame(f))
+					t.Errorf("unexpected GOAWAY frame: %v", SummarizeFrame(f))
 				}
 			case *HeadersFrame:
 				goth := st.decodeHeader(f.HeaderBlockFragment())
@@ -4044,10 +4320,10 @@ func TestServerHandlerConnectionClose(t *testing.T) {
 				sawRes = true
 			case *DataFrame:
 				if f.StreamID != 1 || !f.StreamEnded() || len(f.Data()) != 0 {
-					t.Errorf("unexpected DATA frame: %v", summarizeFrame(f))
+					t.Errorf("unexpected DATA frame: %v", SummarizeFrame(f))
 				}
 			default:
-				t.Logf("unexpected frame: %v", summarizeFrame(f))
+				t.Logf("unexpected frame: %v", SummarizeFrame(f))
 			}
 		}
 		if !sawGoAway {
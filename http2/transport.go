@@ -47,10 +47,6 @@ const (
 	// we buffer per stream.
 	transportDefaultStreamFlow = 4 << 20
 
-	// transportDefaultStreamMinRefresh is the minimum number of bytes we'll send
-	// a stream-level WINDOW_UPDATE for at a time.
-	transportDefaultStreamMinRefresh = 4 << 10
-
 	defaultUserAgent = "Go-http-client/2.0"
 
 	// initialMaxConcurrentStreams is a connections maxConcurrentStreams until
@@ -105,9 +101,80 @@ type Transport struct {
 	// means to use a default limit (currently 10MB). If you actually
 	// want to advertise an unlimited value to the peer, Transport
 	// interprets the highest possible value here (0xffffffff or 1<<32-1)
-	// to mean no limit.
+	// to mean no limit. If zero and the Transport was created by
+	// ConfigureTransports, the HTTP/1 Transport's MaxResponseHeaderBytes
+	// is used when set.
 	MaxHeaderListSize uint32
 
+	// MaxReadFrameSize is the http2 SETTINGS_MAX_FRAME_SIZE to send in the
+	// initial settings frame. It is the size in bytes of the largest frame
+	// payload that the sender is willing to receive. If 0, no setting is
+	// sent, and the value is provided by the peer, which should be 16384
+	// according to the spec. Values outside the range permitted by the
+	// spec are clamped to it.
+	MaxReadFrameSize uint32
+
+	// MaxDecoderHeaderTableSize optionally specifies the http2
+	// SETTINGS_HEADER_TABLE_SIZE to send in the initial settings frame. It
+	// informs the remote endpoint of the maximum size of the header compression
+	// table used to decode header blocks, in octets. If zero, the default value
+	// of 4096 is used.
+	MaxDecoderHeaderTableSize uint32
+
+	// MaxEncoderHeaderTableSize optionally specifies an upper limit for the
+	// header compression table used for encoding request headers. Received
+	// SETTINGS_HEADER_TABLE_SIZE settings are capped at this limit. If zero,
+	// the default value of 4096 is used.
+	MaxEncoderHeaderTableSize uint32
+
+	// MaxConcurrentStreams optionally specifies the http2
+	// SETTINGS_MAX_CONCURRENT_STREAMS to send in the initial settings
+	// frame. Since the Transport disables server push, it only limits
+	// streams initiated by the server. If zero, no setting is sent.
+	MaxConcurrentStreams uint32
+
+	// MaxReceiveBufferPerConnection is the size of the connection-level
+	// flow control window for response bodies, in bytes. Values less
+	// than the spec default of 65535 are ignored. If zero, a default
+	// of about 1GB is used.
+	MaxReceiveBufferPerConnection int32
+
+	// MaxReceiveBufferPerStream is the http2 SETTINGS_INITIAL_WINDOW_SIZE
+	// to send in the initial settings frame. It is how many bytes of each
+	// response body the Transport buffers before the server must wait for
+	// the caller to read. If zero, a default of 4MB is used.
+	MaxReceiveBufferPerStream int32
+
+	// IdleConnTimeout is the maximum amount of time an idle
+	// (keep-alive) connection will remain idle before closing
+	// itself. Before closing, the Transport sends the server a
+	// GOAWAY frame.
+	// Zero means no limit, unless the Transport was created by
+	// ConfigureTransports, in which case the HTTP/1 Transport's
+	// IdleConnTimeout is used.
+	IdleConnTimeout time.Duration
+
+	// ExpectContinueTimeout, if non-zero, specifies the amount of
+	// time to wait for a server's first response headers after fully
+	// writing the request headers if the request has an
+	// "Expect: 100-continue" header. Zero means no timeout and
+	// causes the body to be sent immediately, without
+	// waiting for the server to approve, unless the Transport was
+	// created by ConfigureTransports, in which case the HTTP/1
+	// Transport's ExpectContinueTimeout is used.
+	ExpectContinueTimeout time.Duration
+
+	// DisableConnectionCoalescing, if true, prevents the Transport
+	// from reusing a connection to one host for requests to another.
+	// By default, when the Transport dials its own connections, a
+	// request to a new host may reuse an existing connection if the
+	// host resolves to the connection's remote address and the
+	// connection's certificate is valid for the host, as permitted
+	// by RFC 7540, Section 9.1.1. Connections are never coalesced
+	// when DialTLS is set, or when TLSClientConfig sets
+	// InsecureSkipVerify, VerifyPeerCertificate or VerifyConnection.
+	DisableConnectionCoalescing bool
+
 	// StrictMaxConcurrentStreams controls whether the server's
 	// SETTINGS_MAX_CONCURRENT_STREAMS should be respected
 	// globally. If false, new TCP connections are created to the
@@ -142,6 +209,14 @@ type Transport struct {
 	// The errType consists of only ASCII word characters.
 	CountError func(errType string)
 
+	// CaptureFrames, if non-nil, is called for each new connection
+	// to get a writer to record the connection's frames to, as by
+	// Framer.SetCapture. If it returns nil, the connection's frames
+	// aren't recorded. If the writer is an io.Closer, it's closed
+	// when the connection is done.
+	// See DebugCapture for a ready-made implementation.
+	CaptureFrames func(c net.Conn) io.Writer
+
 	// t1, if non-nil, is the standard library Transport using
 	// this transport. Its settings are used (but not its
 	// RoundTrip method, etc).
@@ -153,6 +228,12 @@ type Transport struct {
 
 func (t *Transport) maxHeaderListSize() uint32 {
 	if t.MaxHeaderListSize == 0 {
+		if t.t1 != nil && t.t1.MaxResponseHeaderBytes > 0 {
+			if n := t.t1.MaxResponseHeaderBytes; n < 0xffffffff {
+				return uint32(n)
+			}
+			return 0
+		}
 		return 10 << 20
 	}
 	if t.MaxHeaderListSize == 0xffffffff {
@@ -161,6 +242,63 @@ func (t *Transport) maxHeaderListSize() uint32 {
 	return t.MaxHeaderListSize
 }
 
+func (t *Transport) maxFrameReadSize() uint32 {
+	if t.MaxReadFrameSize == 0 {
+		return 0 // use the default provided by the peer
+	}
+	if t.MaxReadFrameSize < minMaxFrameSize {
+		return minMaxFrameSize
+	}
+	if t.MaxReadFrameSize > maxFrameSize {
+		return maxFrameSize
+	}
+	return t.MaxReadFrameSize
+}
+
+func (t *Transport) maxDecoderHeaderTableSize() uint32 {
+	if v := t.MaxDecoderHeaderTableSize; v > 0 {
+		return v
+	}
+	return initialHeaderTableSize
+}
+
+func (t *Transport) maxEncoderHeaderTableSize() uint32 {
+	if v := t.MaxEncoderHeaderTableSize; v > 0 {
+		return v
+	}
+	return initialHeaderTableSize
+}
+
+// connRecvWindowSize returns the total connection-level flow control
+// window, including the initial 64k granted by the spec.
+func (t *Transport) connRecvWindowSize() int32 {
+	if v := t.MaxReceiveBufferPerConnection; v > initialWindowSize {
+		return v
+	}
+	return transportDefaultConnFlow + initialWindowSize
+}
+
+func (t *Transport) streamRecvWindowSize() int32 {
+	if v := t.MaxReceiveBufferPerStream; v > 0 {
+		return v
+	}
+	return transportDefaultStreamFlow
+}
+
+func (t *Transport) canCoalesceConns() bool {
+	if t.DisableConnectionCoalescing || t.DialTLS != nil {
+		return false
+	}
+	// Coalescing only checks that the connection's certificate is
+	// valid for the new host. Skip it when certificates aren't
+	// verified, or are verified by callbacks that wouldn't see the
+	// new host.
+	if cfg := t.TLSClientConfig; cfg != nil {
+		return !cfg.InsecureSkipVerify && cfg.VerifyPeerCertificate == nil && cfg.VerifyConnection == nil
+	}
+	return true
+}
+
 func (t *Transport) disableCompression() bool {
 	return t.DisableCompression || (t.t1 != nil && t.t1.DisableCompression)
 }
@@ -258,6 +396,7 @@ type ClientConn struct {
 	// readLoop goroutine fields:
 	readerDone chan struct{} // closed on error
 	readerErr  error         // set before readerDone is closed
+	capture    io.Closer     // from Transport.CaptureFrames; closed by readLoop cleanup or closeUnstarted
 
 	idleTimeout time.Duration // or 0 for never
 	idleTimer   *time.Timer
@@ -265,7 +404,7 @@ type ClientConn struct {
 	mu              sync.Mutex // guards following
 	cond            *sync.Cond // hold mu; broadcast on flow/closed changes
 	flow            flow       // our conn-level flow control quota (cs.flow is per stream)
-	inflow          flow       // peer's conn-level flow control
+	inflow          inflow     // peer's conn-level flow control
 	doNotReuse      bool       // whether conn is marked to not be reused for any future requests
 	closing         bool
 	closed          bool
@@ -329,10 +468,10 @@ type clientStream struct {
 	respHeaderRecv chan struct{}  // closed when headers are received
 	res            *http.Response // set if respHeaderRecv is closed
 
-	flow        flow  // guarded by cc.mu
-	inflow      flow  // guarded by cc.mu
-	bytesRemain int64 // -1 means unknown; owned by transportResponseBody.Read
-	readErr     error // sticky read error; owned by transportResponseBody.Read
+	flow        flow   // guarded by cc.mu
+	inflow      inflow // guarded by cc.mu
+	bytesRemain int64  // -1 means unknown; owned by transportResponseBody.Read
+	readErr     error  // sticky read error; owned by transportResponseBody.Read
 
 	reqBody              io.ReadCloser
 	reqBodyContentLength int64 // -1 means unknown
@@ -525,15 +664,18 @@ func (t *Transport) RoundTripOpt(req *http.Request, opt RoundTripOpt) (*http.Res
 // connected from previous requests but are now sitting idle.
 // It does not interrupt any connections currently in use.
 func (t *Transport) CloseIdleConnections() {
-	if cp, ok := t.connPool().(clientConnPoolIdleCloser); ok {
+	switch cp := t.connPool().(type) {
+	case clientConnPoolIdleCloser:
 		cp.closeIdleConnections()
+	case idleCloser:
+		cp.CloseIdleConnections()
 	}
 }
 
 var (
-	errClientConnClosed    = errors.New("http2: client conn is closed")
-	errClientConnUnusable  = errors.New("http2: client conn not usable")
-	errClientConnGotGoAway = errors.New("http2: Transport received Server's graceful shutdown GOAWAY")
+	errClientConnClosed   = errors.New("http2: client conn is closed")
+	errClientConnUnusable = errors.New("http2: client conn not usable")
+	errClientConnLostPing = errors.New("http2: client connection lost")
 )
 
 // shouldRetryRequest is called by RoundTrip when a request fails to get
@@ -544,6 +686,12 @@ func shouldRetryRequest(req *http.Request, err error) (*http.Request, error) {
 	if !canRetryError(err) {
 		return nil, err
 	}
+	// A connection that stopped answering PINGs may have delivered
+	// the request to the server before it went silent, so only
+	// replay requests that are safe to send twice.
+	if err == errClientConnLostPing && !reqIsIdempotent(req) {
+		return nil, err
+	}
 	// If the Body is nil (or http.NoBody), it's safe to reuse
 	// this request and its Body.
 	if req.Body == nil || req.Body == http.NoBody {
@@ -569,11 +717,14 @@ func shouldRetryRequest(req *http.Request, err error) (*http.Request, error) {
 		return req, nil
 	}
 
-	return nil, fmt.Errorf("http2: Transport: cannot retry err [%v] after Request.Body was written; define Request.GetBody to avoid this error", err)
+	return nil, fmt.Errorf("http2: Transport: cannot retry err [%w] after Request.Body was written; define Request.GetBody to avoid this error", err)
 }
 
 func canRetryError(err error) bool {
-	if err == errClientConnUnusable || err == errClientConnGotGoAway {
+	if err == errClientConnUnusable || err == errClientConnLostPing {
+		return true
+	}
+	if ge, ok := err.(GoAwayError); ok && ge.unprocessed {
 		return true
 	}
 	if se, ok := err.(StreamError); ok {
@@ -586,6 +737,23 @@ func canRetryError(err error) bool {
 	return false
 }
 
+// reqIsIdempotent reports whether req may be sent more than once
+// without changing its effect, as defined by RFC 7231 section 4.2.2,
+// or because the caller supplied an Idempotency-Key header.
+func reqIsIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case "", "GET", "HEAD", "OPTIONS", "TRACE", "PUT", "DELETE":
+		return true
+	}
+	if _, ok := req.Header["Idempotency-Key"]; ok {
+		return true
+	}
+	if _, ok := req.Header["X-Idempotency-Key"]; ok {
+		return true
+	}
+	return false
+}
+
 func (t *Transport) dialClientConn(ctx context.Context, addr string, singleUse bool) (*ClientConn, error) {
 	host, _, err := net.SplitHostPort(addr)
 	if err != nil {
@@ -639,6 +807,9 @@ func (t *Transport) disableKeepAlives() bool {
 }
 
 func (t *Transport) expectContinueTimeout() time.Duration {
+	if t.ExpectContinueTimeout != 0 {
+		return t.ExpectContinueTimeout
+	}
 	if t.t1 == nil {
 		return 0
 	}
@@ -685,15 +856,21 @@ func (t *Transport) newClientConn(c net.Conn, singleUse bool) (*ClientConn, erro
 	})
 	cc.br = bufio.NewReader(c)
 	cc.fr = NewFramer(cc.bw, cc.br)
-	if t.CountError != nil {
-		cc.fr.countError = t.CountError
+	cc.fr.CountError = t.CountError
+	if max := t.maxFrameReadSize(); max != 0 {
+		cc.fr.SetMaxReadFrameSize(max)
+	}
+	cc.fr.ReadMetaHeaders = hpack.NewDecoder(t.maxDecoderHeaderTableSize(), nil)
+	if t.CaptureFrames != nil {
+		if w := t.CaptureFrames(c); w != nil {
+			cc.fr.SetCapture(w)
+			cc.capture, _ = w.(io.Closer)
+		}
 	}
-	cc.fr.ReadMetaHeaders = hpack.NewDecoder(initialHeaderTableSize, nil)
 	cc.fr.MaxHeaderListSize = t.maxHeaderListSize()
 
-	// TODO: SetMaxDynamicTableSize, SetMaxDynamicTableSizeLimit on
-	// henc in response to SETTINGS frames?
 	cc.henc = hpack.NewEncoder(&cc.hbuf)
+	cc.henc.SetMaxDynamicTableSizeLimit(t.maxEncoderHeaderTableSize())
 
 	if t.AllowHTTP {
 		cc.nextStreamID = 3
@@ -706,19 +883,34 @@ func (t *Transport) newClientConn(c net.Conn, singleUse bool) (*ClientConn, erro
 
 	initialSettings := []Setting{
 		{ID: SettingEnablePush, Val: 0},
-		{ID: SettingInitialWindowSize, Val: transportDefaultStreamFlow},
+		{ID: SettingInitialWindowSize, Val: uint32(t.streamRecvWindowSize())},
+	}
+	if max := t.maxFrameReadSize(); max != 0 {
+		initialSettings = append(initialSettings, Setting{ID: SettingMaxFrameSize, Val: max})
 	}
 	if max := t.maxHeaderListSize(); max != 0 {
 		initialSettings = append(initialSettings, Setting{ID: SettingMaxHeaderListSize, Val: max})
 	}
+	if max := t.maxDecoderHeaderTableSize(); max != initialHeaderTableSize {
+		initialSettings = append(initialSettings, Setting{ID: SettingHeaderTableSize, Val: max})
+	}
+	if max := t.MaxConcurrentStreams; max != 0 {
+		initialSettings = append(initialSettings, Setting{ID: SettingMaxConcurrentStreams, Val: max})
+	}
 
 	cc.bw.Write(clientPreface)
-	cc.fr.WriteSettings(initialSettings...)
-	cc.fr.WriteWindowUpdate(0, transportDefaultConnFlow)
-	cc.inflow.add(transportDefaultConnFlow + initialWindowSize)
+	if err := cc.fr.WriteSettings(initialSettings...); err != nil {
+		cc.closeUnstarted()
+		return nil, err
+	}
+	connFlow := t.connRecvWindowSize()
+	if connFlow > initialWindowSize {
+		cc.fr.WriteWindowUpdate(0, uint32(connFlow-initialWindowSize))
+	}
+	cc.inflow.init(connFlow)
 	cc.bw.Flush()
 	if cc.werr != nil {
-		cc.Close()
+		cc.closeUnstarted()
 		return nil, cc.werr
 	}
 
@@ -726,6 +918,15 @@ func (t *Transport) newClientConn(c net.Conn, singleUse bool) (*ClientConn, erro
 	return cc, nil
 }
 
+// closeUnstarted closes cc, which failed before its readLoop started,
+// along with the capture writer that readLoop would otherwise close.
+func (cc *ClientConn) closeUnstarted() {
+	cc.Close()
+	if cc.capture != nil {
+		cc.capture.Close()
+	}
+}
+
 func (cc *ClientConn) healthCheck() {
 	pingTimeout := cc.t.pingTimeout()
 	// We don't need to periodically ping in the health check, because the readLoop of ClientConn will
@@ -761,9 +962,15 @@ func (cc *ClientConn) setGoAway(f *GoAwayFrame) {
 		cc.goAway.ErrCode = old.ErrCode
 	}
 	last := f.LastStreamID
+	err := GoAwayError{
+		LastStreamID: last,
+		ErrCode:      cc.goAway.ErrCode,
+		DebugData:    cc.goAwayDebug,
+		unprocessed:  true,
+	}
 	for streamID, cs := range cc.streams {
 		if streamID > last {
-			cs.abortStreamLocked(errClientConnGotGoAway)
+			cs.abortStreamLocked(err)
 		}
 	}
 }
@@ -932,15 +1139,34 @@ func (cc *ClientConn) closeIfIdle() {
 	}
 	cc.closed = true
 	nextID := cc.nextStreamID
-	// TODO: do clients send GOAWAY too? maybe? Just Close:
 	cc.mu.Unlock()
 
 	if VerboseLogs {
 		cc.vlogf("http2: Transport closing idle conn %p (forSingleUse=%v, maxStream=%v)", cc, cc.singleUse, nextID-2)
 	}
+	// Tell the server we're going away, so it doesn't mistake the
+	// closed socket for a network failure. Errors are ignored; the
+	// connection is being closed either way. A peer that stopped
+	// reading can block the write indefinitely, so don't wait for it
+	// longer than idleGoAwayTimeout: closing the conn unblocks it.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		cc.sendGoAway()
+	}()
+	t := time.NewTimer(idleGoAwayTimeout)
+	select {
+	case <-done:
+	case <-t.C:
+	}
+	t.Stop()
 	cc.closeConn()
 }
 
+// idleGoAwayTimeout is how long closeIfIdle waits for the GOAWAY frame
+// to be written before closing the connection anyway.
+var idleGoAwayTimeout = 250 * time.Millisecond
+
 func (cc *ClientConn) isDoNotReuseAndIdle() bool {
 	cc.mu.Lock()
 	defer cc.mu.Unlock()
@@ -975,7 +1201,11 @@ func (cc *ClientConn) Shutdown(ctx context.Context) error {
 	shutdownEnterWaitStateHook()
 	select {
 	case <-done:
-		return cc.closeConn()
+		// The conn may already have been closed once its last
+		// stream finished, such as after a GOAWAY from the server,
+		// so the error from closing it again isn't meaningful.
+		cc.closeConn()
+		return nil
 	case <-ctx.Done():
 		cc.mu.Lock()
 		// Free the goroutine above
@@ -1032,8 +1262,11 @@ func (cc *ClientConn) Close() error {
 }
 
 // closes the client connection immediately. In-flight requests are interrupted.
+// Requests that have not yet received response headers fail with
+// errClientConnLostPing, which RoundTripOpt retries on a new connection
+// when the request is idempotent.
 func (cc *ClientConn) closeForLostPing() error {
-	err := errors.New("http2: client connection lost")
+	err := errClientConnLostPing
 	if f := cc.t.CountError; f != nil {
 		f("conn_close_lost_ping")
 	}
@@ -1052,6 +1285,9 @@ func commaSeparatedTrailers(req *http.Request) (string, error) {
 		case "Transfer-Encoding", "Trailer", "Content-Length":
 			return "", fmt.Errorf("invalid Trailer key %q", k)
 		}
+		if !httpguts.ValidHeaderFieldName(k) {
+			return "", fmt.Errorf("invalid HTTP trailer name %q", k)
+		}
 		keys = append(keys, k)
 	}
 	if len(keys) > 0 {
@@ -1291,6 +1527,7 @@ func (cs *clientStream) writeRequest(req *http.Request) (err error) {
 	if !hasBody {
 		cs.sentEndStream = true
 	} else {
+		skipBody := false
 		if continueTimeout != 0 {
 			traceWait100Continue(cs.trace)
 			timer := time.NewTimer(continueTimeout)
@@ -1299,6 +1536,15 @@ func (cs *clientStream) writeRequest(req *http.Request) (err error) {
 				err = nil
 			case <-cs.on100:
 				err = nil
+			case <-cs.respHeaderRecv:
+				// The server sent its final response without a
+				// 100 Continue, so it doesn't want the body.
+				// Prefer a 100 Continue that raced with it.
+				select {
+				case <-cs.on100:
+				default:
+					skipBody = true
+				}
 			case <-cs.abort:
 				err = cs.abortErr
 			case <-ctx.Done():
@@ -1313,7 +1559,11 @@ func (cs *clientStream) writeRequest(req *http.Request) (err error) {
 			}
 		}
 
-		if err = cs.writeRequestBody(req); err != nil {
+		if skipBody {
+			// Leave the stream half-open; cleanupWriteRequest
+			// resets it with NO_ERROR once the response is done.
+			cs.abortRequestBodyWrite()
+		} else if err = cs.writeRequestBody(req); err != nil {
 			if err != errStopReqBodyWrite {
 				traceWroteRequest(cs.trace, err)
 				return err
@@ -1865,8 +2115,7 @@ func (cc *ClientConn) encodeHeaders(req *http.Request, addGzipHeader bool, trail
 	// modifying the hpack state.
 	hlSize := uint64(0)
 	enumerateHeaders(func(name, value string) {
-		hf := hpack.HeaderField{Name: name, Value: value}
-		hlSize += uint64(hf.Size())
+		hlSize += hpack.FieldSize(name, value)
 	})
 
 	if hlSize > cc.peerMaxHeaderListSize {
@@ -1922,8 +2171,10 @@ func (cc *ClientConn) encodeTrailers(trailer http.Header) ([]byte, error) {
 	hlSize := uint64(0)
 	for k, vv := range trailer {
 		for _, v := range vv {
-			hf := hpack.HeaderField{Name: k, Value: v}
-			hlSize += uint64(hf.Size())
+			if !httpguts.ValidHeaderFieldValue(v) {
+				return nil, fmt.Errorf("invalid HTTP trailer value %q for trailer %q", v, k)
+			}
+			hlSize += hpack.FieldSize(k, v)
 		}
 	}
 	if hlSize > cc.peerMaxHeaderListSize {
@@ -1950,7 +2201,7 @@ func (cc *ClientConn) writeHeader(name, value string) {
 	if VerboseLogs {
 		log.Printf("http2: Transport encoding header %q = %q", name, value)
 	}
-	cc.henc.WriteField(hpack.HeaderField{Name: name, Value: value})
+	cc.henc.WriteField(hpack.HeaderField{Name: name, Value: value, Sensitive: hpack.SensitiveField(name, value)})
 }
 
 type resAndError struct {
@@ -1963,8 +2214,7 @@ type resAndError struct {
 func (cc *ClientConn) addStreamLocked(cs *clientStream) {
 	cs.flow.add(int32(cc.initialWindowSize))
 	cs.flow.setConnFlow(&cc.flow)
-	cs.inflow.add(transportDefaultStreamFlow)
-	cs.inflow.setConnFlow(&cc.inflow)
+	cs.inflow.init(cc.t.streamRecvWindowSize())
 	cs.ID = cc.nextStreamID
 	cc.nextStreamID += 2
 	cc.streams[cs.ID] = cs
@@ -1989,7 +2239,7 @@ func (cc *ClientConn) forgetStreamID(id uint32) {
 	// wake up RoundTrip if there is a pending request.
 	cc.cond.Broadcast()
 
-	closeOnIdle := cc.singleUse || cc.doNotReuse || cc.t.disableKeepAlives()
+	closeOnIdle := cc.singleUse || cc.doNotReuse || cc.t.disableKeepAlives() || cc.goAway != nil
 	if closeOnIdle && cc.streamsReserved == 0 && len(cc.streams) == 0 {
 		if VerboseLogs {
 			cc.vlogf("http2: Transport closing idle conn %p (forSingleUse=%v, maxStream=%v)", cc, cc.singleUse, cc.nextStreamID-2)
@@ -2020,14 +2270,27 @@ func (cc *ClientConn) readLoop() {
 }
 
 // GoAwayError is returned by the Transport when the server closes the
-// TCP connection after sending a GOAWAY frame.
+// TCP connection after sending a GOAWAY frame, or when the server's
+// GOAWAY indicates that it will not process a request. An ErrCode of
+// ErrCodeNo means the server is shutting down gracefully; any other
+// value reports a protocol error, with the server's reason, if any,
+// in DebugData.
 type GoAwayError struct {
 	LastStreamID uint32
 	ErrCode      ErrCode
 	DebugData    string
+
+	// unprocessed is whether the request's stream was above
+	// LastStreamID, so the server did not process it and the
+	// request is safe to retry.
+	unprocessed bool
 }
 
 func (e GoAwayError) Error() string {
+	if e.unprocessed {
+		return fmt.Sprintf("http2: server sent GOAWAY without processing the request; LastStreamID=%v, ErrCode=%v, debug=%q",
+			e.LastStreamID, e.ErrCode, e.DebugData)
+	}
 	return fmt.Sprintf("http2: server sent GOAWAY and closed the connection; LastStreamID=%v, ErrCode=%v, debug=%q",
 		e.LastStreamID, e.ErrCode, e.DebugData)
 }
@@ -2043,6 +2306,9 @@ func isEOFOrNetReadError(err error) bool {
 func (rl *clientConnReadLoop) cleanup() {
 	cc := rl.cc
 	cc.t.connPool().MarkDead(cc)
+	if cc.capture != nil {
+		defer cc.capture.Close()
+	}
 	defer cc.closeConn()
 	defer close(cc.readerDone)
 
@@ -2135,7 +2401,7 @@ func (rl *clientConnReadLoop) run() error {
 			return err
 		}
 		if VerboseLogs {
-			cc.vlogf("http2: Transport received %s", summarizeFrame(f))
+			cc.vlogf("http2: Transport received %s", SummarizeFrame(f))
 		}
 		if !gotSettings {
 			if _, ok := f.(*SettingsFrame); !ok {
@@ -2162,12 +2428,14 @@ func (rl *clientConnReadLoop) run() error {
 			err = rl.processWindowUpdate(f)
 		case *PingFrame:
 			err = rl.processPing(f)
+		case *PriorityUpdateFrame:
+			err = rl.processPriorityUpdate(f)
 		default:
 			cc.logf("Transport: unhandled response frame type %T", f)
 		}
 		if err != nil {
 			if VerboseLogs {
-				cc.vlogf("http2: Transport conn %p received error from processing frame %v: %v", cc, summarizeFrame(f), err)
+				cc.vlogf("http2: Transport conn %p received error from processing frame %v: %v", cc, SummarizeFrame(f), err)
 			}
 			return err
 		}
@@ -2422,21 +2690,12 @@ func (b transportResponseBody) Read(p []byte) (n int, err error) {
 	}
 
 	cc.mu.Lock()
-	var connAdd, streamAdd int32
-	// Check the conn-level first, before the stream-level.
-	if v := cc.inflow.available(); v < transportDefaultConnFlow/2 {
-		connAdd = transportDefaultConnFlow - v
-		cc.inflow.add(connAdd)
-	}
+	// Return the consumed bytes to the peer. Updates are batched by
+	// inflow.add, so small reads don't each cost a WINDOW_UPDATE.
+	connAdd := cc.inflow.add(n)
+	var streamAdd int32
 	if err == nil { // No need to refresh if the stream is over or failed.
-		// Consider any buffered body data (read from the conn but not
-		// consumed by the client) when computing flow control for this
-		// stream.
-		v := int(cs.inflow.available()) + cs.bufPipe.Len()
-		if v < transportDefaultStreamFlow-transportDefaultStreamMinRefresh {
-			streamAdd = int32(transportDefaultStreamFlow - v)
-			cs.inflow.add(streamAdd)
-		}
+		streamAdd = cs.inflow.add(n)
 	}
 	cc.mu.Unlock()
 
@@ -2464,20 +2723,17 @@ func (b transportResponseBody) Close() error {
 	if unread > 0 {
 		cc.mu.Lock()
 		// Return connection-level flow control.
-		if unread > 0 {
-			cc.inflow.add(int32(unread))
-		}
+		connAdd := cc.inflow.add(unread)
 		cc.mu.Unlock()
 
 		// TODO(dneil): Acquiring this mutex can block indefinitely.
 		// Move flow control return to a goroutine?
-		cc.wmu.Lock()
-		// Return connection-level flow control.
-		if unread > 0 {
-			cc.fr.WriteWindowUpdate(0, uint32(unread))
+		if connAdd > 0 {
+			cc.wmu.Lock()
+			cc.fr.WriteWindowUpdate(0, uint32(connAdd))
+			cc.bw.Flush()
+			cc.wmu.Unlock()
 		}
-		cc.bw.Flush()
-		cc.wmu.Unlock()
 	}
 
 	cs.bufPipe.BreakWithError(errClosedResponseBody)
@@ -2517,13 +2773,18 @@ func (rl *clientConnReadLoop) processData(f *DataFrame) error {
 		// But at least return their flow control:
 		if f.Length > 0 {
 			cc.mu.Lock()
-			cc.inflow.add(int32(f.Length))
+			ok := cc.inflow.take(f.Length)
+			connAdd := cc.inflow.add(int(f.Length))
 			cc.mu.Unlock()
-
-			cc.wmu.Lock()
-			cc.fr.WriteWindowUpdate(0, uint32(f.Length))
-			cc.bw.Flush()
-			cc.wmu.Unlock()
+			if !ok {
+				return ConnectionError(ErrCodeFlowControl)
+			}
+			if connAdd > 0 {
+				cc.wmu.Lock()
+				cc.fr.WriteWindowUpdate(0, uint32(connAdd))
+				cc.bw.Flush()
+				cc.wmu.Unlock()
+			}
 		}
 		return nil
 	}
@@ -2554,9 +2815,7 @@ func (rl *clientConnReadLoop) processData(f *DataFrame) error {
 		}
 		// Check connection-level flow control.
 		cc.mu.Lock()
-		if cs.inflow.available() >= int32(f.Length) {
-			cs.inflow.take(int32(f.Length))
-		} else {
+		if !takeInflows(&cc.inflow, &cs.inflow, f.Length) {
 			cc.mu.Unlock()
 			return ConnectionError(ErrCodeFlowControl)
 		}
@@ -2578,19 +2837,22 @@ func (rl *clientConnReadLoop) processData(f *DataFrame) error {
 			}
 		}
 
+		var connAdd, streamAdd int32
 		if refund > 0 {
-			cc.inflow.add(int32(refund))
+			connAdd = cc.inflow.add(refund)
 			if !didReset {
-				cs.inflow.add(int32(refund))
+				streamAdd = cs.inflow.add(refund)
 			}
 		}
 		cc.mu.Unlock()
 
-		if refund > 0 {
+		if connAdd > 0 || streamAdd > 0 {
 			cc.wmu.Lock()
-			cc.fr.WriteWindowUpdate(0, uint32(refund))
-			if !didReset {
-				cc.fr.WriteWindowUpdate(cs.ID, uint32(refund))
+			if connAdd > 0 {
+				cc.fr.WriteWindowUpdate(0, uint32(connAdd))
+			}
+			if streamAdd > 0 {
+				cc.fr.WriteWindowUpdate(cs.ID, uint32(streamAdd))
 			}
 			cc.bw.Flush()
 			cc.wmu.Unlock()
@@ -2704,6 +2966,8 @@ func (rl *clientConnReadLoop) processSettingsNoWrite(f *SettingsFrame) error {
 			seenMaxConcurrentStreams = true
 		case SettingMaxHeaderListSize:
 			cc.peerMaxHeaderListSize = uint64(s.Val)
+		case SettingHeaderTableSize:
+			cc.henc.SetMaxDynamicTableSize(s.Val)
 		case SettingInitialWindowSize:
 			// Values above the maximum flow-control
 			// window size of 2^31-1 MUST be treated as a
@@ -2724,7 +2988,9 @@ func (rl *clientConnReadLoop) processSettingsNoWrite(f *SettingsFrame) error {
 
 			cc.initialWindowSize = s.Val
 		default:
-			// TODO(bradfitz): handle more settings? SETTINGS_HEADER_TABLE_SIZE probably.
+			// Unknown setting: "An endpoint that receives a SETTINGS
+			// frame with any unknown or unsupported identifier MUST
+			// ignore that setting."
 			cc.vlogf("Unhandled Setting: %v", s)
 		}
 		return nil
@@ -2864,6 +3130,13 @@ func (rl *clientConnReadLoop) processPushPromise(f *PushPromiseFrame) error {
 	return ConnectionError(ErrCodeProtocol)
 }
 
+func (rl *clientConnReadLoop) processPriorityUpdate(f *PriorityUpdateFrame) error {
+	// Only clients send PRIORITY_UPDATE. RFC 9218 Section 7.1 says:
+	// "A client MUST treat receipt of a PRIORITY_UPDATE frame as a
+	// connection error of type PROTOCOL_ERROR."
+	return ConnectionError(ErrCodeProtocol)
+}
+
 func (cc *ClientConn) writeStreamReset(streamID uint32, code ErrCode, err error) {
 	// TODO: map err to more interesting error codes, once the
 	// HTTP community comes up with some. But currently for
@@ -2983,6 +3256,12 @@ func (rt noDialH2RoundTripper) RoundTrip(req *http.Request) (*http.Response, err
 }
 
 func (t *Transport) idleConnTimeout() time.Duration {
+	// to keep things backwards compatible, we use non-zero values of
+	// IdleConnTimeout, followed by using the IdleConnTimeout on the underlying
+	// http1 transport, followed by 0
+	if t.IdleConnTimeout != 0 {
+		return t.IdleConnTimeout
+	}
 	if t.t1 != nil {
 		return t.t1.IdleConnTimeout
 	}
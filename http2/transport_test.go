@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/hex"
 	"errors"
 	"flag"
@@ -402,6 +403,124 @@ func TestTransportGroupsPendingDials(t *testing.T) {
 	}
 }
 
+// Tests that CloseIdleConnections leaves connections with active
+// streams open, and closes them once they become idle.
+func TestTransportCloseIdleConnectionsSkipsActive(t *testing.T) {
+	unblock := make(chan struct{})
+	st := newServerTester(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+		w.(http.Flusher).Flush()
+		<-unblock
+		io.WriteString(w, "done")
+	}, optOnlyServer)
+	defer st.Close()
+	var closes int32
+	tr := &Transport{
+		TLSClientConfig: tlsConfigInsecure,
+		DialTLS: func(network, addr string, cfg *tls.Config) (net.Conn, error) {
+			c, err := tls.Dial(network, addr, cfg)
+			return &testNetConn{
+				Conn:    c,
+				onClose: func() { atomic.AddInt32(&closes, 1) },
+			}, err
+		},
+	}
+	defer tr.CloseIdleConnections()
+
+	req, _ := http.NewRequest("GET", st.ts.URL, nil)
+	res, err := tr.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr.CloseIdleConnections()
+	if got := atomic.LoadInt32(&closes); got != 0 {
+		t.Fatalf("active connection closed by CloseIdleConnections")
+	}
+	close(unblock)
+	slurp, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil || string(slurp) != "done" {
+		t.Fatalf("body = %q, %v; want %q, nil", slurp, err, "done")
+	}
+	tr.CloseIdleConnections()
+	if got := atomic.LoadInt32(&closes); got != 1 {
+		t.Errorf("saw %d closes after connection went idle; want 1", got)
+	}
+}
+
+// Tests that CloseIdleConnections doesn't hold the pool lock while the
+// GOAWAY sent on an idle connection is blocked by a peer that stopped
+// reading, and that the connection is closed anyway.
+func TestTransportCloseIdleConnectionsStalledPeer(t *testing.T) {
+	c1, c2 := net.Pipe()
+	defer c2.Close()
+	greeted := make(chan error, 1)
+	go func() {
+		// Read the client preface, SETTINGS and WINDOW_UPDATE, then
+		// stop reading.
+		if _, err := io.ReadFull(c2, make([]byte, len(ClientPreface))); err != nil {
+			greeted <- err
+			return
+		}
+		fr := NewFramer(nil, c2)
+		for i := 0; i < 2; i++ {
+			if _, err := fr.ReadFrame(); err != nil {
+				greeted <- err
+				return
+			}
+		}
+		greeted <- nil
+	}()
+	tr := &Transport{}
+	cc, err := tr.newClientConn(c1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := <-greeted; err != nil {
+		t.Fatal(err)
+	}
+	p := &clientConnPool{t: tr}
+	p.mu.Lock()
+	p.addConnLocked("dummy.tld:443", cc)
+	p.mu.Unlock()
+
+	closed := make(chan struct{})
+	go func() {
+		p.closeIdleConnections()
+		close(closed)
+	}()
+	// Wait for the GOAWAY write to start.
+	for {
+		cc.mu.Lock()
+		closing := cc.closing
+		cc.mu.Unlock()
+		if closing {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	locked := make(chan struct{})
+	go func() {
+		p.mu.Lock()
+		p.mu.Unlock()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+	case <-time.After(5 * time.Second):
+		t.Fatal("pool lock held while writing GOAWAY to a stalled peer")
+	}
+	// The GOAWAY write must not block closing the connection.
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("CloseIdleConnections blocked by a stalled peer")
+	}
+	if _, err := c1.Write([]byte("x")); err == nil {
+		t.Fatal("connection not closed")
+	}
+}
+
 func retry(tries int, delay time.Duration, fn func() error) error {
 	var err error
 	for i := 0; i < tries; i++ {
@@ -671,6 +790,21 @@ func TestTransportDialTLS(t *testing.T) {
 	}
 }
 
+func TestConfigureTransportsMaxHeaderListSize(t *testing.T) {
+	t1 := &http.Transport{MaxResponseHeaderBytes: 1 << 16}
+	t2, err := ConfigureTransports(t1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := t2.maxHeaderListSize(), uint32(1<<16); got != want {
+		t.Errorf("maxHeaderListSize = %v; want %v", got, want)
+	}
+	t2.MaxHeaderListSize = 1 << 12
+	if got, want := t2.maxHeaderListSize(), uint32(1<<12); got != want {
+		t.Errorf("with explicit MaxHeaderListSize, maxHeaderListSize = %v; want %v", got, want)
+	}
+}
+
 func TestConfigureTransport(t *testing.T) {
 	t1 := &http.Transport{}
 	err := ConfigureTransport(t1)
@@ -1837,6 +1971,36 @@ func TestTransportChecksRequestHeaderListSize(t *testing.T) {
 	checkRoundTrip(req, errRequestHeaderListSize, "Single large trailer")
 }
 
+func TestTransportRejectsInvalidTrailers(t *testing.T) {
+	st := newServerTester(t, func(w http.ResponseWriter, r *http.Request) {
+		ioutil.ReadAll(r.Body)
+	}, optOnlyServer)
+	defer st.Close()
+	tr := &Transport{TLSClientConfig: tlsConfigInsecure}
+	defer tr.CloseIdleConnections()
+
+	tests := []struct {
+		name, value string
+		wantErr     string
+	}{
+		{"Bad Name", "ok", "invalid HTTP trailer name"},
+		{"Good-Name", "bad\r\nvalue", "invalid HTTP trailer value"},
+	}
+	for _, tt := range tests {
+		req, _ := http.NewRequest("POST", st.ts.URL, strings.NewReader("body"))
+		req.Trailer = http.Header{tt.name: {tt.value}}
+		res, err := tr.RoundTrip(req)
+		if err == nil {
+			res.Body.Close()
+			t.Errorf("trailer %q: %q: RoundTrip succeeded; want error", tt.name, tt.value)
+			continue
+		}
+		if !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("trailer %q: %q: RoundTrip error = %v; want %q", tt.name, tt.value, err, tt.wantErr)
+		}
+	}
+}
+
 func TestTransportChecksResponseHeaderListSize(t *testing.T) {
 	ct := newClientTester(t)
 	ct.client = func() error {
@@ -2793,6 +2957,40 @@ func TestTransportFlowControl(t *testing.T) {
 	}
 }
 
+// Tests that a request the server declines via GOAWAY, and which
+// can't be retried, fails with a GoAwayError carrying the server's
+// error code and debug data.
+func TestTransportGoAwayUnprocessedError(t *testing.T) {
+	ct := newClientTester(t)
+	clientDone := make(chan struct{})
+	const debugData = "draining"
+	ct.client = func() error {
+		defer close(clientDone)
+		// A body without GetBody can't be replayed after being sent.
+		body := struct{ io.Reader }{strings.NewReader("body")}
+		req, _ := http.NewRequest("POST", "https://dummy.tld/", body)
+		_, err := ct.tr.RoundTrip(req)
+		var ge GoAwayError
+		if !errors.As(err, &ge) {
+			return fmt.Errorf("RoundTrip error = %v; want GoAwayError", err)
+		}
+		if ge.ErrCode != ErrCodeNo || ge.LastStreamID != 0 || ge.DebugData != debugData {
+			return fmt.Errorf("GoAwayError = %#v; want ErrCodeNo, LastStreamID 0, DebugData %q", ge, debugData)
+		}
+		return nil
+	}
+	ct.server = func() error {
+		ct.greet()
+		if _, err := ct.firstHeaders(); err != nil {
+			return err
+		}
+		ct.fr.WriteGoAway(0, ErrCodeNo, []byte(debugData))
+		<-clientDone
+		return nil
+	}
+	ct.run()
+}
+
 // golang.org/issue/14627 -- if the server sends a GOAWAY frame, make
 // the Transport remember it and return it back to users (via
 // RoundTrip or request body reads) if needed (e.g. if the server
@@ -2932,8 +3130,8 @@ func testTransportReturnsUnusedFlowControl(t *testing.T, oneDataFrame bool) {
 		// - Send one DATA frame with 5000 bytes.
 		// - Send two DATA frames with 1 and 4999 bytes each.
 		//
-		// In both cases, the client should consume one byte of data,
-		// refund that byte, then refund the following 4999 bytes.
+		// In both cases, the client should consume one byte of data
+		// and refund all 5000 bytes in a single batched update.
 		//
 		// In the second case, the server waits for the client connection to
 		// close before seconding the second DATA frame. This tests the case
@@ -2961,22 +3159,22 @@ func testTransportReturnsUnusedFlowControl(t *testing.T, oneDataFrame bool) {
 			case *SettingsFrame:
 			case *RSTStreamFrame:
 				if sawRST {
-					return fmt.Errorf("saw second RSTStreamFrame: %v", summarizeFrame(f))
+					return fmt.Errorf("saw second RSTStreamFrame: %v", SummarizeFrame(f))
 				}
 				if f.ErrCode != ErrCodeCancel {
-					return fmt.Errorf("Expected a RSTStreamFrame with code cancel; got %v", summarizeFrame(f))
+					return fmt.Errorf("Expected a RSTStreamFrame with code cancel; got %v", SummarizeFrame(f))
 				}
 				sawRST = true
 			case *WindowUpdateFrame:
 				if sawWUF {
-					return fmt.Errorf("saw second WindowUpdateFrame: %v", summarizeFrame(f))
+					return fmt.Errorf("saw second WindowUpdateFrame: %v", SummarizeFrame(f))
 				}
-				if f.Increment != 4999 {
-					return fmt.Errorf("Expected WindowUpdateFrames for 5000 bytes; got %v", summarizeFrame(f))
+				if f.Increment != 5000 {
+					return fmt.Errorf("Expected WindowUpdateFrames for 5000 bytes; got %v", SummarizeFrame(f))
 				}
 				sawWUF = true
 			default:
-				return fmt.Errorf("Unexpected frame: %v", summarizeFrame(f))
+				return fmt.Errorf("Unexpected frame: %v", SummarizeFrame(f))
 			}
 		}
 		return nil
@@ -3108,23 +3306,29 @@ func TestTransportReturnsDataPaddingFlowControl(t *testing.T) {
 		var buf bytes.Buffer
 		enc := hpack.NewEncoder(&buf)
 		enc.WriteField(hpack.HeaderField{Name: ":status", Value: "200"})
-		enc.WriteField(hpack.HeaderField{Name: "content-length", Value: "5000"})
+		// Padding is refunded without waiting for the body to be read,
+		// but small refunds are batched until they reach inflowMinRefresh.
+		pad := make([]byte, 255)
+		padded := len(pad) + 1 // one byte for the length of the padding
+		frames := (inflowMinRefresh + padded - 1) / padded
+		enc.WriteField(hpack.HeaderField{Name: "content-length", Value: strconv.Itoa(frames * 5000)})
 		ct.fr.WriteHeaders(HeadersFrameParam{
 			StreamID:      hf.StreamID,
 			EndHeaders:    true,
 			EndStream:     false,
 			BlockFragment: buf.Bytes(),
 		})
-		pad := make([]byte, 5)
-		ct.fr.WriteDataPadded(hf.StreamID, false, make([]byte, 5000), pad) // without ending stream
+		for i := 0; i < frames; i++ {
+			ct.fr.WriteDataPadded(hf.StreamID, false, make([]byte, 5000), pad) // without ending stream
+		}
 
 		f, err := ct.readNonSettingsFrame()
 		if err != nil {
 			return fmt.Errorf("ReadFrame while waiting for first WindowUpdateFrame: %v", err)
 		}
-		wantBack := uint32(len(pad)) + 1 // one byte for the length of the padding
+		wantBack := uint32(frames * padded)
 		if wuf, ok := f.(*WindowUpdateFrame); !ok || wuf.Increment != wantBack || wuf.StreamID != 0 {
-			return fmt.Errorf("Expected conn WindowUpdateFrame for %d bytes; got %v", wantBack, summarizeFrame(f))
+			return fmt.Errorf("Expected conn WindowUpdateFrame for %d bytes; got %v", wantBack, SummarizeFrame(f))
 		}
 
 		f, err = ct.readNonSettingsFrame()
@@ -3132,7 +3336,7 @@ func TestTransportReturnsDataPaddingFlowControl(t *testing.T) {
 			return fmt.Errorf("ReadFrame while waiting for second WindowUpdateFrame: %v", err)
 		}
 		if wuf, ok := f.(*WindowUpdateFrame); !ok || wuf.Increment != wantBack || wuf.StreamID == 0 {
-			return fmt.Errorf("Expected stream WindowUpdateFrame for %d bytes; got %v", wantBack, summarizeFrame(f))
+			return fmt.Errorf("Expected stream WindowUpdateFrame for %d bytes; got %v", wantBack, SummarizeFrame(f))
 		}
 		unblockClient <- true
 		return nil
@@ -3186,7 +3390,7 @@ func TestTransportReturnsErrorOnBadResponseHeaders(t *testing.T) {
 				continue
 			}
 			if rst, ok := fr.(*RSTStreamFrame); !ok || rst.StreamID != 1 || rst.ErrCode != ErrCodeProtocol {
-				t.Errorf("Frame = %v; want RST_STREAM for stream 1 with ErrCodeProtocol", summarizeFrame(fr))
+				t.Errorf("Frame = %v; want RST_STREAM for stream 1 with ErrCodeProtocol", SummarizeFrame(fr))
 			}
 			break
 		}
@@ -3486,6 +3690,74 @@ func TestTransportNoRaceOnRequestObjectAfterRequestComplete(t *testing.T) {
 	req.Header = http.Header{}
 }
 
+func TestTransportIdleConnTimeoutSendsGoAway(t *testing.T) {
+	ct := newClientTester(t)
+	ct.tr.IdleConnTimeout = 10 * time.Millisecond
+	serverDone := make(chan struct{})
+	ct.client = func() error {
+		req, _ := http.NewRequest("GET", "https://dummy.tld/", nil)
+		res, err := ct.tr.RoundTrip(req)
+		if err != nil {
+			return err
+		}
+		res.Body.Close()
+		<-serverDone
+		return nil
+	}
+	ct.server = func() error {
+		defer close(serverDone)
+		ct.greet()
+		hf, err := ct.firstHeaders()
+		if err != nil {
+			return err
+		}
+		var buf bytes.Buffer
+		enc := hpack.NewEncoder(&buf)
+		enc.WriteField(hpack.HeaderField{Name: ":status", Value: "200"})
+		ct.fr.WriteHeaders(HeadersFrameParam{
+			StreamID:      hf.StreamID,
+			EndHeaders:    true,
+			EndStream:     true,
+			BlockFragment: buf.Bytes(),
+		})
+		for {
+			f, err := ct.fr.ReadFrame()
+			if err != nil {
+				return fmt.Errorf("connection closed without GOAWAY: %v", err)
+			}
+			switch f := f.(type) {
+			case *WindowUpdateFrame, *SettingsFrame:
+			case *GoAwayFrame:
+				if f.ErrCode != ErrCodeNo {
+					return fmt.Errorf("GOAWAY error code = %v; want %v", f.ErrCode, ErrCodeNo)
+				}
+				if _, err := ct.fr.ReadFrame(); err == nil {
+					return errors.New("connection still open after GOAWAY")
+				}
+				return nil
+			default:
+				return fmt.Errorf("unexpected frame: %v", SummarizeFrame(f))
+			}
+		}
+	}
+	ct.run()
+}
+
+func TestTransportIdleConnTimeoutPrecedence(t *testing.T) {
+	t1 := &http.Transport{IdleConnTimeout: time.Minute}
+	t2, err := ConfigureTransports(t1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := t2.idleConnTimeout(), time.Minute; got != want {
+		t.Errorf("idleConnTimeout = %v; want %v", got, want)
+	}
+	t2.IdleConnTimeout = time.Second
+	if got, want := t2.idleConnTimeout(), time.Second; got != want {
+		t.Errorf("with explicit IdleConnTimeout, idleConnTimeout = %v; want %v", got, want)
+	}
+}
+
 func TestTransportCloseAfterLostPing(t *testing.T) {
 	clientDone := make(chan struct{})
 	ct := newClientTester(t)
@@ -3494,7 +3766,8 @@ func TestTransportCloseAfterLostPing(t *testing.T) {
 	ct.client = func() error {
 		defer ct.cc.(*net.TCPConn).CloseWrite()
 		defer close(clientDone)
-		req, _ := http.NewRequest("GET", "https://dummy.tld/", nil)
+		// POST is not idempotent, so the request is not retried.
+		req, _ := http.NewRequest("POST", "https://dummy.tld/", nil)
 		_, err := ct.tr.RoundTrip(req)
 		if err == nil || !strings.Contains(err.Error(), "client connection lost") {
 			return fmt.Errorf("expected to get error about \"connection lost\", got %v", err)
@@ -3509,6 +3782,39 @@ func TestTransportCloseAfterLostPing(t *testing.T) {
 	ct.run()
 }
 
+func TestTransportRetryAfterLostPing(t *testing.T) {
+	st := newServerTester(t,
+		func(w http.ResponseWriter, r *http.Request) {},
+		optOnlyServer,
+	)
+	defer st.Close()
+	var dials int32
+	tr := &Transport{
+		TLSClientConfig: tlsConfigInsecure,
+		DialTLS: func(network, addr string, cfg *tls.Config) (net.Conn, error) {
+			if atomic.AddInt32(&dials, 1) > 1 {
+				return tls.Dial(network, addr, cfg)
+			}
+			// The first connection swallows everything and never replies.
+			s, c := net.Pipe()
+			go io.Copy(ioutil.Discard, s)
+			return c, nil
+		},
+		PingTimeout:     10 * time.Millisecond,
+		ReadIdleTimeout: 10 * time.Millisecond,
+	}
+	defer tr.CloseIdleConnections()
+	c := &http.Client{Transport: tr}
+	res, err := c.Get(st.ts.URL)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	res.Body.Close()
+	if got := atomic.LoadInt32(&dials); got != 2 {
+		t.Errorf("got %d dials; want 2", got)
+	}
+}
+
 func TestTransportPingWriteBlocks(t *testing.T) {
 	st := newServerTester(t,
 		func(w http.ResponseWriter, r *http.Request) {},
@@ -4010,6 +4316,62 @@ func TestTransportRequestsLowServerLimit(t *testing.T) {
 	}
 }
 
+// tests that without Transport.StrictMaxConcurrentStreams, a new
+// connection is dialed once the server's limit is reached on the
+// existing one.
+func TestTransportDialsPastServerLimit(t *testing.T) {
+	inHandler := make(chan struct{})
+	unblock := make(chan struct{})
+	st := newServerTester(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/block" {
+			inHandler <- struct{}{}
+			<-unblock
+		}
+	}, optOnlyServer, func(s *Server) {
+		s.MaxConcurrentStreams = 1
+	})
+	defer st.Close()
+
+	var dials int32
+	tr := &Transport{
+		TLSClientConfig: tlsConfigInsecure,
+		DialTLS: func(network, addr string, cfg *tls.Config) (net.Conn, error) {
+			atomic.AddInt32(&dials, 1)
+			return tls.Dial(network, addr, cfg)
+		},
+	}
+	defer tr.CloseIdleConnections()
+
+	get := func(path string) error {
+		req, _ := http.NewRequest("GET", st.ts.URL+path, nil)
+		res, err := tr.RoundTrip(req)
+		if err != nil {
+			return err
+		}
+		return res.Body.Close()
+	}
+	// Complete one request so the server's SETTINGS have been seen.
+	if err := get("/"); err != nil {
+		t.Fatal(err)
+	}
+
+	const reqCount = 2
+	errc := make(chan error, reqCount)
+	for i := 0; i < reqCount; i++ {
+		go func() { errc <- get("/block") }()
+		<-inHandler
+	}
+	close(unblock)
+	for i := 0; i < reqCount; i++ {
+		if err := <-errc; err != nil {
+			t.Error(err)
+		}
+	}
+	if got := atomic.LoadInt32(&dials); got != reqCount {
+		t.Errorf("dialed %v connections for %v concurrent requests, want %v", got, reqCount, reqCount)
+	}
+}
+
 // tests Transport.StrictMaxConcurrentStreams
 func TestTransportRequestsStallAtServerLimit(t *testing.T) {
 	const maxConcurrent = 2
@@ -4417,6 +4779,31 @@ func TestTransportHandlesInvalidStatuslessResponse(t *testing.T) {
 	ct.run()
 }
 
+func TestTransportRejectsPriorityUpdate(t *testing.T) {
+	ct := newClientTester(t)
+	ct.client = func() error {
+		req, _ := http.NewRequest("GET", "https://dummy.tld/", nil)
+		_, err := ct.tr.RoundTrip(req)
+		if err != ConnectionError(ErrCodeProtocol) {
+			return fmt.Errorf("RoundTrip error = %v; want %v", err, ConnectionError(ErrCodeProtocol))
+		}
+		return nil
+	}
+	ct.server = func() error {
+		ct.greet()
+		for {
+			f, err := ct.fr.ReadFrame()
+			if err != nil {
+				return err
+			}
+			if f, ok := f.(*HeadersFrame); ok {
+				return ct.fr.WritePriorityUpdate(f.StreamID, "u=1")
+			}
+		}
+	}
+	ct.run()
+}
+
 func BenchmarkClientRequestHeaders(b *testing.B) {
 	b.Run("   0 Headers", func(b *testing.B) { benchSimpleRoundTrip(b, 0, 0) })
 	b.Run("  10 Headers", func(b *testing.B) { benchSimpleRoundTrip(b, 10, 0) })
@@ -4983,6 +5370,31 @@ func TestTransportNewClientConnCloseOnWriteError(t *testing.T) {
 	}
 }
 
+// closeRecorder is an io.WriteCloser that records whether it was closed.
+type closeRecorder struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (cr *closeRecorder) Close() error {
+	cr.closed = true
+	return nil
+}
+
+func TestTransportNewClientConnClosesCaptureOnWriteError(t *testing.T) {
+	capture := new(closeRecorder)
+	tr := &Transport{
+		CaptureFrames: func(net.Conn) io.Writer { return capture },
+	}
+	writeErr := errors.New("write error")
+	if _, err := tr.NewClientConn(&fakeConnErr{writeErr: writeErr}); err != writeErr {
+		t.Fatalf("expected %v, got %v", writeErr, err)
+	}
+	if !capture.closed {
+		t.Error("expected closed capture writer")
+	}
+}
+
 func TestTransportRoundtripCloseOnWriteError(t *testing.T) {
 	req, err := http.NewRequest("GET", "https://dummy.tld/", nil)
 	if err != nil {
@@ -5188,6 +5600,71 @@ func TestTransportExpectContinue(t *testing.T) {
 	}
 }
 
+// Tests that a final response sent without a 100 Continue stops the
+// request body from being sent, even if the server doesn't reset the
+// stream.
+func TestTransportExpectContinueEarlyResponse(t *testing.T) {
+	ct := newClientTester(t)
+	ct.tr.ExpectContinueTimeout = 10 * time.Second
+	body := &trackingReader{rdr: strings.NewReader("hello")}
+	clientDone := make(chan struct{})
+	ct.client = func() error {
+		defer close(clientDone)
+		req, _ := http.NewRequest("POST", "https://dummy.tld/", body)
+		req.Header.Set("Expect", "100-continue")
+		startTime := time.Now()
+		res, err := ct.tr.RoundTrip(req)
+		if err != nil {
+			return err
+		}
+		res.Body.Close()
+		if delta := time.Since(startTime); delta >= ct.tr.ExpectContinueTimeout {
+			return errors.New("request didn't finish before expect continue timeout")
+		}
+		if res.StatusCode != 200 {
+			return fmt.Errorf("status code = %v; want 200", res.StatusCode)
+		}
+		if body.WasRead() {
+			return errors.New("request body was read")
+		}
+		return nil
+	}
+	ct.server = func() error {
+		ct.greet()
+		hf, err := ct.firstHeaders()
+		if err != nil {
+			return err
+		}
+		var buf bytes.Buffer
+		enc := hpack.NewEncoder(&buf)
+		enc.WriteField(hpack.HeaderField{Name: ":status", Value: "200"})
+		ct.fr.WriteHeaders(HeadersFrameParam{
+			StreamID:      hf.StreamID,
+			EndHeaders:    true,
+			EndStream:     true,
+			BlockFragment: buf.Bytes(),
+		})
+		for {
+			f, err := ct.fr.ReadFrame()
+			if err != nil {
+				return err
+			}
+			switch f := f.(type) {
+			case *WindowUpdateFrame, *SettingsFrame:
+			case *RSTStreamFrame:
+				if f.ErrCode != ErrCodeNo {
+					return fmt.Errorf("got %v; want RST_STREAM with NO_ERROR", SummarizeFrame(f))
+				}
+				<-clientDone
+				return nil
+			default:
+				return fmt.Errorf("unexpected frame: %v", SummarizeFrame(f))
+			}
+		}
+	}
+	ct.run()
+}
+
 type closeChecker struct {
 	io.ReadCloser
 	closed chan struct{}
@@ -5918,3 +6395,199 @@ func TestTransportSlowWrites(t *testing.T) {
 	}
 	resp.Body.Close()
 }
+
+func TestTransportCustomInitialSettings(t *testing.T) {
+	ct := newClientTester(t)
+	ct.tr.MaxReadFrameSize = 1 << 20
+	ct.tr.MaxDecoderHeaderTableSize = 1 << 16
+	ct.tr.MaxConcurrentStreams = 10
+	ct.tr.MaxReceiveBufferPerConnection = 1 << 22
+	ct.tr.MaxReceiveBufferPerStream = 1 << 18
+	ct.client = func() error {
+		req, _ := http.NewRequest("GET", "https://dummy.tld/", nil)
+		res, err := ct.tr.RoundTrip(req)
+		if err != nil {
+			return err
+		}
+		return res.Body.Close()
+	}
+	ct.server = func() error {
+		buf := make([]byte, len(ClientPreface))
+		if _, err := io.ReadFull(ct.sc, buf); err != nil {
+			return fmt.Errorf("reading client preface: %v", err)
+		}
+		f, err := ct.fr.ReadFrame()
+		if err != nil {
+			return err
+		}
+		sf, ok := f.(*SettingsFrame)
+		if !ok {
+			return fmt.Errorf("wanted client settings frame; got %v", SummarizeFrame(f))
+		}
+		want := map[SettingID]uint32{
+			SettingEnablePush:           0,
+			SettingInitialWindowSize:    1 << 18,
+			SettingMaxFrameSize:         1 << 20,
+			SettingHeaderTableSize:      1 << 16,
+			SettingMaxConcurrentStreams: 10,
+		}
+		for id, v := range want {
+			if got, ok := sf.Value(id); !ok || got != v {
+				return fmt.Errorf("setting %v = %v, %v; want %v", id, got, ok, v)
+			}
+		}
+		f, err = ct.fr.ReadFrame()
+		if err != nil {
+			return err
+		}
+		wantIncr := uint32(1<<22 - initialWindowSize)
+		if wuf, ok := f.(*WindowUpdateFrame); !ok || wuf.StreamID != 0 || wuf.Increment != wantIncr {
+			return fmt.Errorf("got %v; want conn WindowUpdateFrame for %d bytes", SummarizeFrame(f), wantIncr)
+		}
+		if err := ct.fr.WriteSettings(); err != nil {
+			return err
+		}
+		hf, err := ct.firstHeaders()
+		if err != nil {
+			return err
+		}
+		var hbuf bytes.Buffer
+		enc := hpack.NewEncoder(&hbuf)
+		enc.WriteField(hpack.HeaderField{Name: ":status", Value: "200"})
+		return ct.fr.WriteHeaders(HeadersFrameParam{
+			StreamID:      hf.StreamID,
+			EndHeaders:    true,
+			EndStream:     true,
+			BlockFragment: hbuf.Bytes(),
+		})
+	}
+	ct.run()
+}
+
+// singleConnPool is a user-defined ClientConnPool that always hands
+// out the same connection.
+type singleConnPool struct {
+	cc *ClientConn
+
+	mu         sync.Mutex
+	closeCalls int
+}
+
+func (p *singleConnPool) GetClientConn(req *http.Request, addr string) (*ClientConn, error) {
+	if !p.cc.ReserveNewRequest() {
+		return nil, errors.New("connection not usable")
+	}
+	return p.cc, nil
+}
+
+func (p *singleConnPool) MarkDead(cc *ClientConn) {}
+
+func (p *singleConnPool) CloseIdleConnections() {
+	p.mu.Lock()
+	p.closeCalls++
+	p.mu.Unlock()
+	p.cc.Shutdown(context.Background())
+}
+
+func TestTransportCustomConnPool(t *testing.T) {
+	st := newServerTester(t, func(w http.ResponseWriter, r *http.Request) {}, optOnlyServer)
+	defer st.Close()
+	tr := &Transport{TLSClientConfig: tlsConfigInsecure}
+	cfg := tlsConfigInsecure.Clone()
+	cfg.NextProtos = []string{NextProtoTLS}
+	c, err := tls.Dial("tcp", st.ts.Listener.Addr().String(), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cc, err := tr.NewClientConn(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := &singleConnPool{cc: cc}
+	tr.ConnPool = pool
+
+	for i := 0; i < 3; i++ {
+		req, _ := http.NewRequest("GET", st.ts.URL, nil)
+		res, err := tr.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("RoundTrip %d: %v", i, err)
+		}
+		res.Body.Close()
+	}
+	tr.CloseIdleConnections()
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	if pool.closeCalls != 1 {
+		t.Errorf("pool.CloseIdleConnections called %d times; want 1", pool.closeCalls)
+	}
+}
+
+func TestTransportCoalescesConnections(t *testing.T) {
+	for _, mode := range []string{"enabled", "disabled", "insecure"} {
+		t.Run(mode, func(t *testing.T) {
+			testTransportCoalescesConnections(t, mode)
+		})
+	}
+}
+
+func testTransportCoalescesConnections(t *testing.T, mode string) {
+	remoteAddrs := make(chan string, 2)
+	st := newServerTester(t, func(w http.ResponseWriter, r *http.Request) {
+		remoteAddrs <- r.RemoteAddr
+	}, optOnlyServer)
+	defer st.Close()
+
+	var lookups int32
+	defer func(old func(context.Context, string) ([]net.IPAddr, error)) { lookupIPAddr = old }(lookupIPAddr)
+	lookupIPAddr = func(ctx context.Context, host string) ([]net.IPAddr, error) {
+		atomic.AddInt32(&lookups, 1)
+		if host != "example.com" {
+			return nil, fmt.Errorf("unexpected lookup of %q", host)
+		}
+		return []net.IPAddr{{IP: net.ParseIP("127.0.0.1")}}, nil
+	}
+
+	tr := &Transport{
+		DisableConnectionCoalescing: mode == "disabled",
+	}
+	if mode == "insecure" {
+		tr.TLSClientConfig = tlsConfigInsecure
+	} else {
+		roots := x509.NewCertPool()
+		roots.AddCert(st.ts.Certificate())
+		tr.TLSClientConfig = &tls.Config{RootCAs: roots}
+	}
+	defer tr.CloseIdleConnections()
+
+	u, _ := url.Parse(st.ts.URL)
+	_, port, _ := net.SplitHostPort(u.Host)
+	req, _ := http.NewRequest("GET", st.ts.URL, nil)
+	res, err := tr.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	first := <-remoteAddrs
+
+	// The test certificate is valid for example.com.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	req, _ = http.NewRequestWithContext(ctx, "GET", "https://"+net.JoinHostPort("example.com", port)+"/", nil)
+	res, err = tr.RoundTrip(req)
+	if mode != "enabled" {
+		if err == nil {
+			res.Body.Close()
+		}
+		if n := atomic.LoadInt32(&lookups); n != 0 {
+			t.Errorf("coalescing %s, but saw %d lookups", mode, n)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if second := <-remoteAddrs; second != first {
+		t.Errorf("second request came from %v; want reused connection from %v", second, first)
+	}
+}
@@ -11,7 +11,6 @@ import (
 	"net/http"
 	"net/url"
 
-	"golang.org/x/net/http/httpguts"
 	"golang.org/x/net/http2/hpack"
 )
 
@@ -85,10 +84,11 @@ func (s writeSettings) writeFrame(ctx writeContext) error {
 type writeGoAway struct {
 	maxStreamID uint32
 	code        ErrCode
+	debugData   []byte
 }
 
 func (p *writeGoAway) writeFrame(ctx writeContext) error {
-	err := ctx.Framer().WriteGoAway(p.maxStreamID, p.code, nil)
+	err := ctx.Framer().WriteGoAway(p.maxStreamID, p.code, p.debugData)
 	ctx.Flush() // ignore error: we're hanging up on them anyway
 	return err
 }
@@ -192,7 +192,7 @@ func encKV(enc *hpack.Encoder, k, v string) {
 	if VerboseLogs {
 		log.Printf("http2: server encoding header %q = %q", k, v)
 	}
-	enc.WriteField(hpack.HeaderField{Name: k, Value: v})
+	enc.WriteField(hpack.HeaderField{Name: k, Value: v, Sensitive: hpack.SensitiveField(k, v)})
 }
 
 func (w *writeResHeaders) staysWithinBuffer(max int) bool {
@@ -330,6 +330,10 @@ func (wu writeWindowUpdate) writeFrame(ctx writeContext) error {
 
 // encodeHeaders encodes an http.Header. If keys is not nil, then (k, h[k])
 // is encoded only if k is in keys.
+//
+// Fields with invalid names or values are skipped by the encoder. Per
+// golang.org/issue/14048, these should already be rejected at a higher
+// level.
 func encodeHeaders(enc *hpack.Encoder, h http.Header, keys []string) {
 	if keys == nil {
 		sorter := sorterPool.Get().(*sorter)
@@ -339,32 +343,34 @@ func encodeHeaders(enc *hpack.Encoder, h http.Header, keys []string) {
 		defer sorterPool.Put(sorter)
 		keys = sorter.Keys(h)
 	}
-	for _, k := range keys {
-		vv := h[k]
-		k, ascii := lowerHeader(k)
-		if !ascii {
-			// Skip writing invalid headers. Per RFC 7540, Section 8.1.2, header
-			// field names have to be ASCII characters (just as in HTTP/1.x).
-			continue
-		}
-		if !validWireHeaderFieldName(k) {
-			// Skip it as backup paranoia. Per
-			// golang.org/issue/14048, these should
-			// already be rejected at a higher level.
+	// Transfer-Encoding is written only as "trailers", so encode the
+	// keys around it in runs.
+	// TODO: more of "8.1.2.2 Connection-Specific Header Fields"
+	start := 0
+	for i, k := range keys {
+		if !asciiEqualFold(k, "transfer-encoding") {
 			continue
 		}
-		isTE := k == "transfer-encoding"
-		for _, v := range vv {
-			if !httpguts.ValidHeaderFieldValue(v) {
-				// TODO: return an error? golang.org/issue/14048
-				// For now just omit it.
-				continue
-			}
-			// TODO: more of "8.1.2.2 Connection-Specific Header Fields"
-			if isTE && v != "trailers" {
-				continue
+		encHeaderKeys(enc, h, keys[start:i])
+		for _, v := range h[k] {
+			if v == "trailers" {
+				encKV(enc, "transfer-encoding", v)
 			}
-			encKV(enc, k, v)
 		}
+		start = i + 1
 	}
+	encHeaderKeys(enc, h, keys[start:])
+}
+
+// encHeaderKeys encodes the fields of h named by keys, logging each
+// field the encoder actually writes under VerboseLogs.
+func encHeaderKeys(enc *hpack.Encoder, h http.Header, keys []string) {
+	if !VerboseLogs {
+		enc.WriteHeaderKeys(h, keys)
+		return
+	}
+	hpack.ForeachHeaderField(h, keys, func(hf hpack.HeaderField) error {
+		log.Printf("http2: server encoding header %q = %q", hf.Name, hf.Value)
+		return enc.WriteField(hf)
+	})
 }
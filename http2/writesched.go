@@ -4,7 +4,10 @@
 
 package http2
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+)
 
 // WriteScheduler is the interface implemented by HTTP/2 write schedulers.
 // Methods are never called concurrently.
@@ -37,6 +40,31 @@ type WriteScheduler interface {
 	Pop() (wr FrameWriteRequest, ok bool)
 }
 
+// A WriteQueueInspector is a WriteScheduler that can report the frames
+// it holds. All the WriteSchedulers in this package implement it.
+//
+// Like the WriteScheduler methods, QueueDepths is called on the
+// connection's serving goroutine. To monitor a connection for
+// head-of-line blocking, wrap the scheduler returned by
+// Server.NewWriteScheduler and check its depths as frames are pushed.
+type WriteQueueInspector interface {
+	WriteScheduler
+
+	// QueueDepths appends to dst the depth of each stream with queued
+	// frames, in increasing order of stream ID, and returns the
+	// extended slice. Frames that the scheduler doesn't queue with a
+	// stream, such as SETTINGS and PING, are reported as stream 0.
+	QueueDepths(dst []StreamQueueDepth) []StreamQueueDepth
+}
+
+// StreamQueueDepth describes the frames a write scheduler holds for one
+// stream.
+type StreamQueueDepth struct {
+	StreamID uint32
+	Frames   int // number of queued frames
+	Bytes    int // DATA payload bytes in the queued frames
+}
+
 // OpenStreamOptions specifies extra options for WriteScheduler.OpenStream.
 type OpenStreamOptions struct {
 	// PusherID is zero if the stream was initiated by the client. Otherwise,
@@ -84,6 +112,18 @@ func (wr FrameWriteRequest) isControl() bool {
 	return wr.stream == nil
 }
 
+// isFastLane reports whether the server writes wr ahead of all frames
+// queued in its WriteScheduler: frames that don't belong to a stream,
+// RST_STREAM, and WINDOW_UPDATE. These are small, and delaying them
+// behind a backlog of DATA can stall the peer.
+func (wr FrameWriteRequest) isFastLane() bool {
+	if wr.isControl() {
+		return true
+	}
+	_, ok := wr.write.(writeWindowUpdate)
+	return ok
+}
+
 // DataSize returns the number of flow control bytes that must be consumed
 // to write this entire frame. This is 0 for non-DATA frames.
 func (wr FrameWriteRequest) DataSize() int {
@@ -184,7 +224,8 @@ func (wr *FrameWriteRequest) replyToWriter(err error) {
 
 // writeQueue is used by implementations of WriteScheduler.
 type writeQueue struct {
-	s []FrameWriteRequest
+	s          []FrameWriteRequest
+	prev, next *writeQueue
 }
 
 func (q *writeQueue) empty() bool { return len(q.s) == 0 }
@@ -225,6 +266,24 @@ func (q *writeQueue) consume(n int32) (FrameWriteRequest, bool) {
 	return consumed, true
 }
 
+// appendDepth appends the depth of q, if q isn't empty, to dst as the
+// depth of streamID.
+func (q *writeQueue) appendDepth(dst []StreamQueueDepth, streamID uint32) []StreamQueueDepth {
+	if q.empty() {
+		return dst
+	}
+	d := StreamQueueDepth{StreamID: streamID, Frames: len(q.s)}
+	for _, wr := range q.s {
+		d.Bytes += wr.DataSize()
+	}
+	return append(dst, d)
+}
+
+// sortQueueDepths sorts d by stream ID.
+func sortQueueDepths(d []StreamQueueDepth) {
+	sort.Slice(d, func(i, j int) bool { return d[i].StreamID < d[j].StreamID })
+}
+
 type writeQueuePool []*writeQueue
 
 // put inserts an unused writeQueue into the pool.
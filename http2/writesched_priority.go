@@ -429,6 +429,15 @@ func (ws *priorityWriteScheduler) Pop() (wr FrameWriteRequest, ok bool) {
 	return wr, ok
 }
 
+func (ws *priorityWriteScheduler) QueueDepths(dst []StreamQueueDepth) []StreamQueueDepth {
+	n := len(dst)
+	for id, node := range ws.nodes {
+		dst = node.q.appendDepth(dst, id)
+	}
+	sortQueueDepths(dst[n:])
+	return dst
+}
+
 func (ws *priorityWriteScheduler) addClosedOrIdleNode(list *[]*priorityNode, maxSize int, n *priorityNode) {
 	if maxSize == 0 {
 		return
@@ -0,0 +1,318 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package http2
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// defaultUrgency is the urgency of requests with no priority
+	// signal. See RFC 9218, Section 4.1.
+	defaultUrgency = 3
+
+	// maxUrgency is the lowest priority urgency.
+	maxUrgency = 7
+)
+
+// An ExtensiblePriority is a request priority as defined by RFC 9218,
+// signaled by the Priority header field and PRIORITY_UPDATE frames.
+type ExtensiblePriority struct {
+	// Urgency ranges from 0 (most urgent) to 7 (least urgent).
+	// Requests without a priority signal have urgency 3.
+	Urgency uint8
+
+	// Incremental reports whether the response can be used as it
+	// arrives, so that it may be interleaved with other responses
+	// of the same urgency.
+	Incremental bool
+}
+
+func (p ExtensiblePriority) String() string {
+	if p.Incremental {
+		return fmt.Sprintf("u=%d, i", p.Urgency)
+	}
+	return fmt.Sprintf("u=%d", p.Urgency)
+}
+
+// ParseExtensiblePriority parses a Priority Field Value, such as
+// "u=1, i". As required by RFC 9218, Section 4, unknown parameters and
+// parameters with invalid values are ignored, and parameters that are
+// absent take their default values.
+func ParseExtensiblePriority(v string) ExtensiblePriority {
+	p := ExtensiblePriority{Urgency: defaultUrgency}
+	for _, member := range strings.Split(v, ",") {
+		// Parameters on dictionary members are ignored.
+		if i := strings.IndexByte(member, ';'); i >= 0 {
+			member = member[:i]
+		}
+		key, val := strings.Trim(member, " \t"), ""
+		if i := strings.IndexByte(key, '='); i >= 0 {
+			key, val = key[:i], key[i+1:]
+		}
+		switch key {
+		case "u":
+			if len(val) == 1 && '0' <= val[0] && val[0] <= '0'+maxUrgency {
+				p.Urgency = val[0] - '0'
+			}
+		case "i":
+			switch val {
+			case "", "?1":
+				p.Incremental = true
+			case "?0":
+				p.Incremental = false
+			}
+		}
+	}
+	return p
+}
+
+// extensiblePriorityScheduler is implemented by write schedulers that
+// use RFC 9218 priorities, to receive priority signals from the server.
+type extensiblePriorityScheduler interface {
+	// setHeaderPriority sets the priority of the newly opened
+	// stream streamID from its Priority header field, unless a
+	// PRIORITY_UPDATE frame for the stream was received first.
+	setHeaderPriority(streamID uint32, p ExtensiblePriority)
+
+	// updatePriority applies a PRIORITY_UPDATE frame. It may be
+	// called before streamID is opened.
+	updatePriority(streamID uint32, p ExtensiblePriority)
+}
+
+// maxPendingPriorityUpdates bounds the number of PRIORITY_UPDATE
+// frames remembered for streams that are not yet open.
+const maxPendingPriorityUpdates = 100
+
+type rfc9218Stream struct {
+	writeQueue
+	priority ExtensiblePriority
+	updated  bool // priority was set by a PRIORITY_UPDATE frame
+}
+
+type rfc9218WriteScheduler struct {
+	// control contains control frames (SETTINGS, PING, etc.).
+	control writeQueue
+
+	// streams maps stream ID to the open stream.
+	streams map[uint32]*rfc9218Stream
+
+	// heads holds one circular list of stream queues per urgency,
+	// in the order the streams were opened. Each head is the next
+	// stream of that urgency to write, or nil if there are none.
+	heads [maxUrgency + 1]*writeQueue
+
+	// pending holds priorities from PRIORITY_UPDATE frames for
+	// streams that are not yet open.
+	pending map[uint32]ExtensiblePriority
+
+	// lastOpened is the highest stream ID opened so far, indexed
+	// by the parity of the ID: client-initiated streams are odd and
+	// pushed streams even, and each are opened in increasing order.
+	// Pending priorities for lower IDs are for streams that will
+	// never open.
+	lastOpened [2]uint32
+
+	// quota limits the frames written from a stream during its turn.
+	// turns tracks the current turn of each urgency.
+	quota WriteQuota
+	turns [maxUrgency + 1]writeTurn
+}
+
+// NewRFC9218WriteScheduler constructs a WriteScheduler that uses the
+// Extensible Priorities defined by RFC 9218, ignoring RFC 7540
+// priorities.
+//
+// Control frames like SETTINGS and PING are written first. Then
+// streams are served in order of urgency. Within an urgency,
+// non-incremental responses are written one at a time in the order
+// their streams were opened, and incremental responses take turns,
+// one frame at a time. Server.WriteQuota makes non-incremental
+// responses take turns too, and sets how much incremental responses
+// write per turn.
+//
+// The server sets each stream's priority from the request's Priority
+// header field, and updates it when the client sends PRIORITY_UPDATE
+// frames.
+func NewRFC9218WriteScheduler() WriteScheduler {
+	return &rfc9218WriteScheduler{
+		streams: make(map[uint32]*rfc9218Stream),
+		pending: make(map[uint32]ExtensiblePriority),
+	}
+}
+
+func (ws *rfc9218WriteScheduler) OpenStream(streamID uint32, options OpenStreamOptions) {
+	if ws.streams[streamID] != nil {
+		panic(fmt.Errorf("stream %d already opened", streamID))
+	}
+	st := &rfc9218Stream{priority: ExtensiblePriority{Urgency: defaultUrgency}}
+	if p, ok := ws.pending[streamID]; ok {
+		delete(ws.pending, streamID)
+		st.priority = p
+		st.updated = true
+	}
+	if parity := streamID % 2; streamID > ws.lastOpened[parity] {
+		ws.lastOpened[parity] = streamID
+		for id := range ws.pending {
+			if id%2 == parity && id < streamID {
+				delete(ws.pending, id)
+			}
+		}
+	}
+	ws.streams[streamID] = st
+	ws.link(st)
+}
+
+func (ws *rfc9218WriteScheduler) CloseStream(streamID uint32) {
+	st := ws.streams[streamID]
+	if st == nil {
+		return
+	}
+	ws.unlink(st)
+	ws.turns[st.priority.Urgency].forget(&st.writeQueue)
+	delete(ws.streams, streamID)
+}
+
+func (ws *rfc9218WriteScheduler) AdjustStream(streamID uint32, priority PriorityParam) {
+	// no-op: RFC 7540 priorities are ignored
+}
+
+func (ws *rfc9218WriteScheduler) setWriteQuota(quota WriteQuota) {
+	ws.quota = quota
+}
+
+func (ws *rfc9218WriteScheduler) setHeaderPriority(streamID uint32, p ExtensiblePriority) {
+	if st := ws.streams[streamID]; st != nil && !st.updated {
+		ws.setPriority(st, p)
+	}
+}
+
+func (ws *rfc9218WriteScheduler) updatePriority(streamID uint32, p ExtensiblePriority) {
+	st := ws.streams[streamID]
+	if st == nil {
+		if streamID <= ws.lastOpened[streamID%2] {
+			// The stream was already opened and closed.
+			return
+		}
+		if _, ok := ws.pending[streamID]; ok || len(ws.pending) < maxPendingPriorityUpdates {
+			ws.pending[streamID] = p
+		}
+		return
+	}
+	st.updated = true
+	ws.setPriority(st, p)
+}
+
+func (ws *rfc9218WriteScheduler) setPriority(st *rfc9218Stream, p ExtensiblePriority) {
+	if p.Urgency > maxUrgency {
+		p.Urgency = maxUrgency
+	}
+	if p == st.priority {
+		return
+	}
+	ws.unlink(st)
+	ws.turns[st.priority.Urgency].forget(&st.writeQueue)
+	st.priority = p
+	ws.link(st)
+}
+
+// link adds st to the end of the list for its urgency.
+func (ws *rfc9218WriteScheduler) link(st *rfc9218Stream) {
+	q := &st.writeQueue
+	head := &ws.heads[st.priority.Urgency]
+	if *head == nil {
+		*head = q
+		q.next = q
+		q.prev = q
+		return
+	}
+	q.prev = (*head).prev
+	q.next = *head
+	q.prev.next = q
+	q.next.prev = q
+}
+
+// unlink removes st from the list for its urgency.
+func (ws *rfc9218WriteScheduler) unlink(st *rfc9218Stream) {
+	q := &st.writeQueue
+	head := &ws.heads[st.priority.Urgency]
+	if q.next == q {
+		*head = nil
+	} else {
+		q.prev.next = q.next
+		q.next.prev = q.prev
+		if *head == q {
+			*head = q.next
+		}
+	}
+	q.prev, q.next = nil, nil
+}
+
+func (ws *rfc9218WriteScheduler) Push(wr FrameWriteRequest) {
+	if wr.isControl() {
+		ws.control.push(wr)
+		return
+	}
+	st := ws.streams[wr.StreamID()]
+	if st == nil {
+		// This is a closed stream, so wr should be an
+		// RST_STREAM rather than HEADERS or DATA.
+		// Queue it with the control frames.
+		if wr.DataSize() > 0 {
+			panic("add DATA on non-open stream")
+		}
+		ws.control.push(wr)
+		return
+	}
+	st.push(wr)
+}
+
+func (ws *rfc9218WriteScheduler) Pop() (FrameWriteRequest, bool) {
+	// Control and RST_STREAM frames first.
+	if !ws.control.empty() {
+		return ws.control.shift(), true
+	}
+	for u := range ws.heads {
+		head := ws.heads[u]
+		if head == nil {
+			continue
+		}
+		q := head
+		turn := &ws.turns[u]
+		for {
+			if wr, ok := q.consume(turn.allowance(ws.quota, q)); ok {
+				incremental := ws.streams[wr.StreamID()].priority.Incremental
+				if ws.quota == (WriteQuota{}) {
+					if incremental {
+						// Let the next stream of this
+						// urgency go next.
+						ws.heads[u] = q.next
+					}
+				} else if turn.charge(ws.quota, q, wr) {
+					ws.heads[u] = q.next
+				} else if incremental {
+					ws.heads[u] = q
+				}
+				return wr, true
+			}
+			q = q.next
+			if q == head {
+				break
+			}
+		}
+	}
+	return FrameWriteRequest{}, false
+}
+
+func (ws *rfc9218WriteScheduler) QueueDepths(dst []StreamQueueDepth) []StreamQueueDepth {
+	n := len(dst)
+	dst = ws.control.appendDepth(dst, 0)
+	for streamID, st := range ws.streams {
+		dst = st.appendDepth(dst, streamID)
+	}
+	sortQueueDepths(dst[n:])
+	return dst
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package http2
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseExtensiblePriority(t *testing.T) {
+	tests := []struct {
+		in   string
+		want ExtensiblePriority
+	}{
+		{"", ExtensiblePriority{3, false}},
+		{"u=0", ExtensiblePriority{0, false}},
+		{"u=7, i", ExtensiblePriority{7, true}},
+		{"i, u=5", ExtensiblePriority{5, true}},
+		{"u=1,i=?1", ExtensiblePriority{1, true}},
+		{"u=1, i=?0", ExtensiblePriority{1, false}},
+		{"u=2;foo=bar, i;x", ExtensiblePriority{2, true}},
+		{"u=8", ExtensiblePriority{3, false}},  // out of range
+		{"u=-1", ExtensiblePriority{3, false}}, // out of range
+		{"u=a, i=1", ExtensiblePriority{3, false}},
+		{"x=1, u=4", ExtensiblePriority{4, false}},
+	}
+	for _, tt := range tests {
+		if got := ParseExtensiblePriority(tt.in); got != tt.want {
+			t.Errorf("ParseExtensiblePriority(%q) = %v; want %v", tt.in, got, tt.want)
+		}
+	}
+	for _, p := range []ExtensiblePriority{{0, false}, {3, true}, {7, true}} {
+		if got := ParseExtensiblePriority(p.String()); got != p {
+			t.Errorf("ParseExtensiblePriority(%q) = %v; want %v", p.String(), got, p)
+		}
+	}
+}
+
+// pushRFC9218Data opens stream id and queues the given number of DATA frames of
+// maxFrameSize bytes on it.
+func pushRFC9218Data(ws WriteScheduler, sc *serverConn, id uint32, frames int) {
+	st := &stream{id: id, sc: sc}
+	st.flow.add(1 << 20)
+	ws.OpenStream(id, OpenStreamOptions{})
+	ws.Push(FrameWriteRequest{
+		write:  &writeData{streamID: id, p: make([]byte, int(sc.maxFrameSize)*frames)},
+		stream: st,
+	})
+}
+
+func popAllStreamIDs(ws WriteScheduler) []uint32 {
+	var ids []uint32
+	for {
+		wr, ok := ws.Pop()
+		if !ok {
+			return ids
+		}
+		ids = append(ids, wr.StreamID())
+	}
+}
+
+func TestRFC9218SchedulerUrgency(t *testing.T) {
+	sc := &serverConn{maxFrameSize: 16}
+	ws := NewRFC9218WriteScheduler()
+	eps := ws.(extensiblePriorityScheduler)
+	pushRFC9218Data(ws, sc, 1, 2) // default urgency 3
+	pushRFC9218Data(ws, sc, 3, 2)
+	eps.setHeaderPriority(3, ExtensiblePriority{Urgency: 1})
+	pushRFC9218Data(ws, sc, 5, 2)
+	eps.setHeaderPriority(5, ExtensiblePriority{Urgency: 7})
+	ws.Push(makeWriteNonStreamRequest())
+
+	want := []uint32{0, 3, 3, 1, 1, 5, 5}
+	if got := popAllStreamIDs(ws); !reflect.DeepEqual(got, want) {
+		t.Errorf("popped %v; want %v", got, want)
+	}
+}
+
+func TestRFC9218SchedulerIncremental(t *testing.T) {
+	sc := &serverConn{maxFrameSize: 16}
+	ws := NewRFC9218WriteScheduler()
+	eps := ws.(extensiblePriorityScheduler)
+	// Non-incremental streams are written one after the other.
+	pushRFC9218Data(ws, sc, 1, 2)
+	pushRFC9218Data(ws, sc, 3, 2)
+	// Incremental streams are interleaved.
+	for _, id := range []uint32{5, 7} {
+		pushRFC9218Data(ws, sc, id, 2)
+		eps.setHeaderPriority(id, ExtensiblePriority{Urgency: 2, Incremental: true})
+	}
+	want := []uint32{5, 7, 5, 7, 1, 1, 3, 3}
+	if got := popAllStreamIDs(ws); !reflect.DeepEqual(got, want) {
+		t.Errorf("popped %v; want %v", got, want)
+	}
+}
+
+func TestRFC9218SchedulerPriorityUpdate(t *testing.T) {
+	sc := &serverConn{maxFrameSize: 16}
+	ws := NewRFC9218WriteScheduler()
+	eps := ws.(extensiblePriorityScheduler)
+
+	// A PRIORITY_UPDATE received before the stream opens takes
+	// precedence over the Priority header field.
+	eps.updatePriority(3, ExtensiblePriority{Urgency: 0})
+	pushRFC9218Data(ws, sc, 1, 1)
+	pushRFC9218Data(ws, sc, 3, 1)
+	eps.setHeaderPriority(3, ExtensiblePriority{Urgency: 6})
+	pushRFC9218Data(ws, sc, 5, 2)
+
+	// Stream 5 is reprioritized after its first frame.
+	wr, ok := ws.Pop()
+	if !ok || wr.StreamID() != 3 {
+		t.Fatalf("Pop = %v, %v; want stream 3", wr.StreamID(), ok)
+	}
+	wr, ok = ws.Pop()
+	if !ok || wr.StreamID() != 1 {
+		t.Fatalf("Pop = %v, %v; want stream 1", wr.StreamID(), ok)
+	}
+	pushRFC9218Data(ws, sc, 7, 1)
+	eps.updatePriority(5, ExtensiblePriority{Urgency: 7})
+	want := []uint32{7, 5, 5}
+	if got := popAllStreamIDs(ws); !reflect.DeepEqual(got, want) {
+		t.Errorf("popped %v; want %v", got, want)
+	}
+
+	rws := ws.(*rfc9218WriteScheduler)
+	for id := uint32(101); id < 101+2*2*maxPendingPriorityUpdates; id += 2 {
+		eps.updatePriority(id, ExtensiblePriority{Urgency: 1})
+	}
+	if got := len(rws.pending); got != maxPendingPriorityUpdates {
+		t.Errorf("pending updates = %d; want %d", got, maxPendingPriorityUpdates)
+	}
+
+	// Opening a stream forgets the updates for lower stream IDs,
+	// which will never open, making room for new ones.
+	const next = 101 + 2*maxPendingPriorityUpdates
+	ws.OpenStream(next, OpenStreamOptions{})
+	if got := len(rws.pending); got != 0 {
+		t.Errorf("pending updates after opening stream %d = %d; want 0", next, got)
+	}
+	eps.updatePriority(next+2, ExtensiblePriority{Urgency: 1})
+	if _, ok := rws.pending[next+2]; !ok {
+		t.Errorf("update for stream %d dropped", next+2)
+	}
+	// Updates for streams that were already closed are ignored.
+	eps.updatePriority(101, ExtensiblePriority{Urgency: 1})
+	if _, ok := rws.pending[101]; ok {
+		t.Errorf("update for closed stream 101 kept")
+	}
+}
+
+func TestRFC9218SchedulerCloseStream(t *testing.T) {
+	sc := &serverConn{maxFrameSize: 16}
+	ws := NewRFC9218WriteScheduler()
+	for _, id := range []uint32{1, 3, 5} {
+		pushRFC9218Data(ws, sc, id, 1)
+	}
+	ws.CloseStream(1)
+	ws.CloseStream(5)
+	ws.Push(makeWriteRSTStream(5))
+	if err := checkPopAll(ws, []uint32{5, 3}); err != nil {
+		t.Error(err)
+	}
+	ws.CloseStream(3)
+	rws := ws.(*rfc9218WriteScheduler)
+	for u, head := range rws.heads {
+		if head != nil {
+			t.Errorf("urgency %d list not empty after closing all streams", u)
+		}
+	}
+}
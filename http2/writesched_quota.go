@@ -0,0 +1,80 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package http2
+
+import "math"
+
+// A WriteQuota limits how much a write scheduler writes from one stream
+// in a row while other streams that are ready to write wait their turn.
+// Once a stream has used up its quota, it goes to the back of the line.
+//
+// Quotas are honored by the schedulers returned by
+// NewRandomWriteScheduler, the default, NewRoundRobinWriteScheduler and
+// NewRFC9218WriteScheduler, but not by NewPriorityWriteScheduler, which
+// follows the RFC 7540 dependency tree instead. See Server.WriteQuota.
+type WriteQuota struct {
+	// MaxFrames is the maximum number of consecutive frames written
+	// from one stream. Zero means no limit.
+	MaxFrames int
+
+	// MaxBytes is the maximum number of consecutive DATA payload
+	// bytes written from one stream. DATA frames are split as needed
+	// to stay within the quota. Zero means no limit.
+	MaxBytes int
+}
+
+// quotaWriteScheduler is implemented by write schedulers that honor a
+// WriteQuota.
+type quotaWriteScheduler interface {
+	setWriteQuota(WriteQuota)
+}
+
+// writeTurn tracks the frames written from one stream's queue during
+// its current turn.
+type writeTurn struct {
+	q      *writeQueue // queue whose turn it is, or nil
+	frames int
+	bytes  int
+}
+
+// allowance returns the maximum number of DATA bytes that q may write
+// in its next frame.
+func (t *writeTurn) allowance(quota WriteQuota, q *writeQueue) int32 {
+	if quota.MaxBytes <= 0 {
+		return math.MaxInt32
+	}
+	n := quota.MaxBytes
+	if t.q == q {
+		n -= t.bytes
+	}
+	if n > math.MaxInt32 {
+		n = math.MaxInt32
+	}
+	return int32(n)
+}
+
+// charge records that wr was written from q, and reports whether q has
+// used up its quota, ending its turn.
+func (t *writeTurn) charge(quota WriteQuota, q *writeQueue, wr FrameWriteRequest) bool {
+	if t.q != q {
+		*t = writeTurn{q: q}
+	}
+	t.frames++
+	t.bytes += wr.DataSize()
+	if (quota.MaxFrames > 0 && t.frames >= quota.MaxFrames) ||
+		(quota.MaxBytes > 0 && t.bytes >= quota.MaxBytes) {
+		*t = writeTurn{}
+		return true
+	}
+	return false
+}
+
+// forget ends q's turn, if it has one. It is called when q is released,
+// so that a reused queue doesn't inherit the turn.
+func (t *writeTurn) forget(q *writeQueue) {
+	if t.q == q {
+		*t = writeTurn{}
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package http2
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRoundRobinSchedulerWriteQuota(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		quota WriteQuota
+		want  []uint32
+	}{{
+		name:  "MaxFrames",
+		quota: WriteQuota{MaxFrames: 2},
+		want:  []uint32{1, 1, 3, 3, 1, 3},
+	}, {
+		// Each stream's 48 bytes are split into frames of 16 and 8
+		// bytes to fit the quota.
+		name:  "MaxBytes",
+		quota: WriteQuota{MaxBytes: 24},
+		want:  []uint32{1, 1, 3, 3, 1, 1, 3, 3},
+	}} {
+		t.Run(test.name, func(t *testing.T) {
+			sc := &serverConn{maxFrameSize: 16}
+			ws := NewRoundRobinWriteScheduler()
+			ws.(quotaWriteScheduler).setWriteQuota(test.quota)
+			pushRFC9218Data(ws, sc, 1, 3)
+			pushRFC9218Data(ws, sc, 3, 3)
+			if got := popAllStreamIDs(ws); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("popped %v; want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRoundRobinSchedulerWriteQuotaCloseStream(t *testing.T) {
+	ws := NewRoundRobinWriteScheduler()
+	ws.(quotaWriteScheduler).setWriteQuota(WriteQuota{MaxFrames: 2})
+	ws.OpenStream(1, OpenStreamOptions{})
+	ws.OpenStream(3, OpenStreamOptions{})
+	ws.Push(makeWriteHeadersRequest(1))
+	ws.Push(makeWriteHeadersRequest(1))
+	ws.Push(makeWriteHeadersRequest(3))
+	if wr, ok := ws.Pop(); !ok || wr.StreamID() != 1 {
+		t.Fatalf("Pop() = %v, %v; want stream 1", wr.StreamID(), ok)
+	}
+	// Stream 5 reuses stream 1's queue, but not its turn.
+	ws.CloseStream(1)
+	ws.OpenStream(5, OpenStreamOptions{})
+	for i := 0; i < 3; i++ {
+		ws.Push(makeWriteHeadersRequest(5))
+	}
+	if err := checkPopAll(ws, []uint32{3, 5, 5, 5}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRandomSchedulerWriteQuota(t *testing.T) {
+	sc := &serverConn{maxFrameSize: 16}
+	ws := NewRandomWriteScheduler()
+	ws.(quotaWriteScheduler).setWriteQuota(WriteQuota{MaxFrames: 2})
+	pushRFC9218Data(ws, sc, 1, 4)
+	pushRFC9218Data(ws, sc, 3, 4)
+	// Streams are picked arbitrarily, but none writes more than two
+	// frames in a row while the other has frames waiting.
+	got := popAllStreamIDs(ws)
+	if len(got) != 8 {
+		t.Fatalf("popped %v; want 8 frames", got)
+	}
+	run := 0
+	for i, id := range got {
+		if i > 0 && got[i-1] == id {
+			run++
+		} else {
+			run = 1
+		}
+		if run <= 2 {
+			continue
+		}
+		for _, later := range got[i:] {
+			if later != id {
+				t.Fatalf("popped %v; stream %v wrote more than 2 frames in a row", got, id)
+			}
+		}
+	}
+}
+
+func TestRFC9218SchedulerWriteQuota(t *testing.T) {
+	sc := &serverConn{maxFrameSize: 16}
+	ws := NewRFC9218WriteScheduler()
+	ws.(quotaWriteScheduler).setWriteQuota(WriteQuota{MaxFrames: 2})
+	eps := ws.(extensiblePriorityScheduler)
+	// Non-incremental streams take turns.
+	pushRFC9218Data(ws, sc, 1, 3)
+	pushRFC9218Data(ws, sc, 3, 3)
+	// Incremental streams write up to the quota each turn.
+	for _, id := range []uint32{5, 7} {
+		pushRFC9218Data(ws, sc, id, 3)
+		eps.setHeaderPriority(id, ExtensiblePriority{Urgency: 2, Incremental: true})
+	}
+	want := []uint32{5, 5, 7, 7, 5, 7, 1, 1, 3, 3, 1, 3}
+	if got := popAllStreamIDs(ws); !reflect.DeepEqual(got, want) {
+		t.Errorf("popped %v; want %v", got, want)
+	}
+}
+
+func TestWriteSchedulerQueueDepths(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		ws   WriteScheduler
+	}{
+		{"random", NewRandomWriteScheduler()},
+		{"priority", NewPriorityWriteScheduler(nil)},
+		{"roundrobin", NewRoundRobinWriteScheduler()},
+		{"rfc9218", NewRFC9218WriteScheduler()},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			ws := test.ws
+			sc := &serverConn{maxFrameSize: 16}
+			ws.OpenStream(3, OpenStreamOptions{})
+			ws.Push(makeWriteHeadersRequest(3))
+			pushRFC9218Data(ws, sc, 1, 2)
+			ws.Push(makeWriteHeadersRequest(1))
+			ws.OpenStream(5, OpenStreamOptions{})
+			ws.Push(makeWriteNonStreamRequest())
+
+			dst := []StreamQueueDepth{{StreamID: 99}}
+			got := ws.(WriteQueueInspector).QueueDepths(dst)
+			want := []StreamQueueDepth{
+				{StreamID: 99},
+				{StreamID: 0, Frames: 1},
+				{StreamID: 1, Frames: 2, Bytes: 32},
+				{StreamID: 3, Frames: 1},
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("QueueDepths = %v; want %v", got, want)
+			}
+
+			popAllStreamIDs(ws)
+			if got := ws.(WriteQueueInspector).QueueDepths(nil); len(got) != 0 {
+				t.Errorf("QueueDepths after popping all frames = %v; want none", got)
+			}
+		})
+	}
+}
@@ -4,12 +4,12 @@
 
 package http2
 
-import "math"
-
 // NewRandomWriteScheduler constructs a WriteScheduler that ignores HTTP/2
 // priorities. Control frames like SETTINGS and PING are written before DATA
 // frames, but if no control frames are queued and multiple streams have queued
 // HEADERS or DATA frames, Pop selects a ready stream arbitrarily.
+// Server.WriteQuota keeps a stream from being selected again once it
+// has used up its quota, as long as other streams are ready.
 func NewRandomWriteScheduler() WriteScheduler {
 	return &randomWriteScheduler{sq: make(map[uint32]*writeQueue)}
 }
@@ -25,6 +25,10 @@ type randomWriteScheduler struct {
 
 	// pool of empty queues for reuse.
 	queuePool writeQueuePool
+
+	quota WriteQuota
+	turn  writeTurn
+	spent uint32 // stream that last used up its quota, or 0
 }
 
 func (ws *randomWriteScheduler) OpenStream(streamID uint32, options OpenStreamOptions) {
@@ -36,14 +40,27 @@ func (ws *randomWriteScheduler) CloseStream(streamID uint32) {
 	if !ok {
 		return
 	}
+	ws.release(streamID, q)
+}
+
+// release deletes the emptied or closed queue q of streamID.
+func (ws *randomWriteScheduler) release(streamID uint32, q *writeQueue) {
 	delete(ws.sq, streamID)
 	ws.queuePool.put(q)
+	ws.turn.forget(q)
+	if ws.spent == streamID {
+		ws.spent = 0
+	}
 }
 
 func (ws *randomWriteScheduler) AdjustStream(streamID uint32, priority PriorityParam) {
 	// no-op: priorities are ignored
 }
 
+func (ws *randomWriteScheduler) setWriteQuota(quota WriteQuota) {
+	ws.quota = quota
+}
+
 func (ws *randomWriteScheduler) Push(wr FrameWriteRequest) {
 	if wr.isControl() {
 		ws.zero.push(wr)
@@ -64,14 +81,43 @@ func (ws *randomWriteScheduler) Pop() (FrameWriteRequest, bool) {
 		return ws.zero.shift(), true
 	}
 	// Iterate over all non-idle streams until finding one that can be consumed.
+	// A stream that has used up its quota is tried last.
 	for streamID, q := range ws.sq {
-		if wr, ok := q.consume(math.MaxInt32); ok {
-			if q.empty() {
-				delete(ws.sq, streamID)
-				ws.queuePool.put(q)
-			}
+		if streamID == ws.spent {
+			continue
+		}
+		if wr, ok := ws.consume(streamID, q); ok {
 			return wr, true
 		}
 	}
+	if q, ok := ws.sq[ws.spent]; ok {
+		return ws.consume(ws.spent, q)
+	}
 	return FrameWriteRequest{}, false
 }
+
+// consume pops the next frame from q, the queue of streamID, within its
+// quota.
+func (ws *randomWriteScheduler) consume(streamID uint32, q *writeQueue) (FrameWriteRequest, bool) {
+	wr, ok := q.consume(ws.turn.allowance(ws.quota, q))
+	if !ok {
+		return wr, false
+	}
+	if ws.quota != (WriteQuota{}) && ws.turn.charge(ws.quota, q, wr) {
+		ws.spent = streamID
+	}
+	if q.empty() {
+		ws.release(streamID, q)
+	}
+	return wr, true
+}
+
+func (ws *randomWriteScheduler) QueueDepths(dst []StreamQueueDepth) []StreamQueueDepth {
+	n := len(dst)
+	dst = ws.zero.appendDepth(dst, 0)
+	for streamID, q := range ws.sq {
+		dst = q.appendDepth(dst, streamID)
+	}
+	sortQueueDepths(dst[n:])
+	return dst
+}
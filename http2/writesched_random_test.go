@@ -62,3 +62,56 @@ func TestRandomScheduler(t *testing.T) {
 	}
 
 }
+
+func TestRandomSchedulerSelectsStreamsArbitrarily(t *testing.T) {
+	seen := make(map[uint32]bool)
+	for i := 0; i < 100 && len(seen) < 2; i++ {
+		ws := NewRandomWriteScheduler()
+		for id := uint32(1); id <= 4; id++ {
+			ws.Push(makeWriteHeadersRequest(id))
+		}
+		wr, ok := ws.Pop()
+		if !ok {
+			t.Fatal("Pop = false; want true")
+		}
+		seen[wr.StreamID()] = true
+	}
+	if len(seen) < 2 {
+		t.Errorf("first stream popped was always %v; want it to vary", seen)
+	}
+}
+
+func TestRandomSchedulerFlowControl(t *testing.T) {
+	ws := NewRandomWriteScheduler()
+	sc := &serverConn{maxFrameSize: 16}
+	st1 := &stream{id: 1, sc: sc}
+	st2 := &stream{id: 2, sc: sc}
+	ws.Push(FrameWriteRequest{&writeData{1, make([]byte, 16), false}, st1, nil})
+	ws.Push(FrameWriteRequest{&writeData{2, make([]byte, 16), false}, st2, nil})
+
+	if wr, ok := ws.Pop(); ok {
+		t.Fatalf("Pop(limited by flow control) = %v, true; want false", wr)
+	}
+
+	// A stream blocked by flow control doesn't hold up other streams.
+	st2.flow.add(8)
+	if wr, ok := ws.Pop(); !ok || wr.StreamID() != 2 || wr.DataSize() != 8 {
+		t.Fatalf("Pop = %v, %v; want 8 bytes of stream 2", wr, ok)
+	}
+	if wr, ok := ws.Pop(); ok {
+		t.Fatalf("Pop(flow control exhausted) = %v, true; want false", wr)
+	}
+	st1.flow.add(16)
+	st2.flow.add(8)
+	got := make(map[uint32]int)
+	for {
+		wr, ok := ws.Pop()
+		if !ok {
+			break
+		}
+		got[wr.StreamID()] += wr.DataSize()
+	}
+	if got[1] != 16 || got[2] != 8 {
+		t.Errorf("after adding flow control, wrote %v; want 16 bytes of stream 1, 8 of stream 2", got)
+	}
+}
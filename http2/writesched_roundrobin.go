@@ -0,0 +1,144 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package http2
+
+import "fmt"
+
+type roundRobinWriteScheduler struct {
+	// control contains control frames (SETTINGS, PING, etc.).
+	control writeQueue
+
+	// streams maps stream ID to a queue.
+	streams map[uint32]*writeQueue
+
+	// Stream queues are stored in a circular linked list.
+	// head is the next stream to write, or nil if there are no streams open.
+	head *writeQueue
+
+	// pool of empty queues for reuse.
+	queuePool writeQueuePool
+
+	// quota limits the frames written from head during its turn.
+	// If zero, each stream writes one frame per turn.
+	quota WriteQuota
+	turn  writeTurn
+}
+
+// NewRoundRobinWriteScheduler constructs a WriteScheduler that ignores
+// HTTP/2 priorities. Control frames like SETTINGS and PING are written
+// before DATA frames. When there are no control frames to send, streams
+// with queued HEADERS or DATA frames take turns, one frame at a time,
+// in the order they were opened. A stream with a large response can't
+// starve the other streams on the connection. Server.WriteQuota allows
+// streams to write more than one frame per turn.
+func NewRoundRobinWriteScheduler() WriteScheduler {
+	return &roundRobinWriteScheduler{
+		streams: make(map[uint32]*writeQueue),
+	}
+}
+
+func (ws *roundRobinWriteScheduler) OpenStream(streamID uint32, options OpenStreamOptions) {
+	if ws.streams[streamID] != nil {
+		panic(fmt.Errorf("stream %d already opened", streamID))
+	}
+	q := ws.queuePool.get()
+	ws.streams[streamID] = q
+	if ws.head == nil {
+		ws.head = q
+		q.next = q
+		q.prev = q
+	} else {
+		// Insert the new stream before ws.head,
+		// putting it at the end of the ring.
+		q.prev = ws.head.prev
+		q.next = ws.head
+		q.prev.next = q
+		q.next.prev = q
+	}
+}
+
+func (ws *roundRobinWriteScheduler) CloseStream(streamID uint32) {
+	q := ws.streams[streamID]
+	if q == nil {
+		return
+	}
+	if q.next == q {
+		// This was the only open stream.
+		ws.head = nil
+	} else {
+		q.prev.next = q.next
+		q.next.prev = q.prev
+		if ws.head == q {
+			ws.head = q.next
+		}
+	}
+	q.prev, q.next = nil, nil
+	delete(ws.streams, streamID)
+	ws.turn.forget(q)
+	ws.queuePool.put(q)
+}
+
+func (ws *roundRobinWriteScheduler) AdjustStream(streamID uint32, priority PriorityParam) {
+	// no-op: priorities are ignored
+}
+
+func (ws *roundRobinWriteScheduler) setWriteQuota(quota WriteQuota) {
+	ws.quota = quota
+}
+
+func (ws *roundRobinWriteScheduler) Push(wr FrameWriteRequest) {
+	if wr.isControl() {
+		ws.control.push(wr)
+		return
+	}
+	q := ws.streams[wr.StreamID()]
+	if q == nil {
+		// This is a closed stream, so wr should be an
+		// RST_STREAM rather than HEADERS or DATA.
+		// Queue it with the control frames.
+		if wr.DataSize() > 0 {
+			panic("add DATA on non-open stream")
+		}
+		ws.control.push(wr)
+		return
+	}
+	q.push(wr)
+}
+
+func (ws *roundRobinWriteScheduler) Pop() (FrameWriteRequest, bool) {
+	// Control and RST_STREAM frames first.
+	if !ws.control.empty() {
+		return ws.control.shift(), true
+	}
+	if ws.head == nil {
+		return FrameWriteRequest{}, false
+	}
+	q := ws.head
+	for {
+		if wr, ok := q.consume(ws.turn.allowance(ws.quota, q)); ok {
+			if ws.quota == (WriteQuota{}) || ws.turn.charge(ws.quota, q, wr) {
+				ws.head = q.next
+			} else {
+				ws.head = q
+			}
+			return wr, true
+		}
+		q = q.next
+		if q == ws.head {
+			break
+		}
+	}
+	return FrameWriteRequest{}, false
+}
+
+func (ws *roundRobinWriteScheduler) QueueDepths(dst []StreamQueueDepth) []StreamQueueDepth {
+	n := len(dst)
+	dst = ws.control.appendDepth(dst, 0)
+	for streamID, q := range ws.streams {
+		dst = q.appendDepth(dst, streamID)
+	}
+	sortQueueDepths(dst[n:])
+	return dst
+}
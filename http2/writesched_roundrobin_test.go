@@ -0,0 +1,117 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package http2
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRoundRobinScheduler(t *testing.T) {
+	const maxFrameSize = 16
+	sc := &serverConn{maxFrameSize: maxFrameSize}
+	ws := NewRoundRobinWriteScheduler()
+	streams := make([]*stream, 4)
+	for i := range streams {
+		streamID := uint32(i) + 1
+		streams[i] = &stream{
+			id: streamID,
+			sc: sc,
+		}
+		streams[i].flow.add(1 << 20) // arbitrary large value
+		ws.OpenStream(streamID, OpenStreamOptions{})
+		wr := FrameWriteRequest{
+			write: &writeData{
+				streamID:  streamID,
+				p:         make([]byte, maxFrameSize*(i+1)),
+				endStream: false,
+			},
+			stream: streams[i],
+		}
+		ws.Push(wr)
+	}
+	const controlFrames = 2
+	for i := 0; i < controlFrames; i++ {
+		ws.Push(makeWriteNonStreamRequest())
+	}
+
+	// We should get the control frames first.
+	for i := 0; i < controlFrames; i++ {
+		wr, ok := ws.Pop()
+		if !ok || wr.StreamID() != 0 {
+			t.Fatalf("wr.Pop() = stream %v, %v; want 0, true", wr.StreamID(), ok)
+		}
+	}
+
+	// Each stream should write maxFrameSize bytes until it runs out of data.
+	// Stream 1 has one frame of data, 2 has two frames, etc.
+	var got []uint32
+	for {
+		wr, ok := ws.Pop()
+		if !ok {
+			break
+		}
+		if wr.DataSize() != maxFrameSize {
+			t.Fatalf("wr.Pop() = %v data bytes, want %v", wr.DataSize(), maxFrameSize)
+		}
+		got = append(got, wr.StreamID())
+	}
+	want := []uint32{1, 2, 3, 4, 2, 3, 4, 3, 4, 4}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("popped streams %v, want %v", got, want)
+	}
+
+	for _, st := range streams {
+		ws.CloseStream(st.id)
+	}
+	if wr, ok := ws.Pop(); ok {
+		t.Fatalf("Pop() after closing all streams = %v, true; want false", wr)
+	}
+}
+
+func TestRoundRobinSchedulerCloseStream(t *testing.T) {
+	ws := NewRoundRobinWriteScheduler()
+	for id := uint32(1); id <= 3; id++ {
+		ws.OpenStream(id, OpenStreamOptions{})
+		ws.Push(makeWriteHeadersRequest(id))
+		ws.Push(makeWriteHeadersRequest(id))
+	}
+	if wr, ok := ws.Pop(); !ok || wr.StreamID() != 1 {
+		t.Fatalf("Pop() = %v, %v; want stream 1", wr.StreamID(), ok)
+	}
+	// Closing the next stream in the rotation discards its frames
+	// and moves on to the stream after it.
+	ws.CloseStream(2)
+	// RST_STREAM on a closed stream is queued as a control frame.
+	ws.Push(makeHandlerPanicRST(2))
+	if err := checkPopAll(ws, []uint32{2, 3, 1, 3}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRoundRobinSchedulerFlowControl(t *testing.T) {
+	ws := NewRoundRobinWriteScheduler()
+	sc := &serverConn{maxFrameSize: 16}
+	st1 := &stream{id: 1, sc: sc}
+	st2 := &stream{id: 2, sc: sc}
+	ws.OpenStream(1, OpenStreamOptions{})
+	ws.OpenStream(2, OpenStreamOptions{})
+	ws.Push(FrameWriteRequest{&writeData{1, make([]byte, 16), false}, st1, nil})
+	ws.Push(FrameWriteRequest{&writeData{2, make([]byte, 16), false}, st2, nil})
+
+	if wr, ok := ws.Pop(); ok {
+		t.Fatalf("Pop(limited by flow control) = %v, true; want false", wr)
+	}
+	// Stream 1 is blocked, so stream 2 is written.
+	st2.flow.add(8)
+	if wr, ok := ws.Pop(); !ok || wr.StreamID() != 2 || wr.DataSize() != 8 {
+		t.Fatalf("Pop() = %v, %v; want 8 bytes of stream 2", wr, ok)
+	}
+	st1.flow.add(16)
+	st2.flow.add(8)
+	if err := checkPopAll(ws, []uint32{1, 2}); err != nil {
+		t.Error(err)
+	}
+}
@@ -84,29 +84,31 @@ func parseExtensions(typ Type, b []byte, l int) ([]Extension, int, error) {
 		if 4 > ol || ol > len(b) {
 			break
 		}
+		// A malformed object in a multipart message is kept as a
+		// raw extension, so that the well-formed objects around it,
+		// such as the MPLS label stack reported to traceroute, are
+		// not lost with it.
 		switch b[2] {
 		case classMPLSLabelStack:
 			ext, err := parseMPLSLabelStack(b[:ol])
 			if err != nil {
-				return nil, -1, err
+				ext = newRawExtension(b[:ol])
 			}
 			exts = append(exts, ext)
 		case classInterfaceInfo:
 			ext, err := parseInterfaceInfo(b[:ol])
 			if err != nil {
-				return nil, -1, err
+				ext = newRawExtension(b[:ol])
 			}
 			exts = append(exts, ext)
 		case classInterfaceIdent:
 			ext, err := parseInterfaceIdent(b[:ol])
 			if err != nil {
-				return nil, -1, err
+				ext = newRawExtension(b[:ol])
 			}
 			exts = append(exts, ext)
 		default:
-			ext := &RawExtension{Data: make([]byte, ol)}
-			copy(ext.Data, b[:ol])
-			exts = append(exts, ext)
+			exts = append(exts, newRawExtension(b[:ol]))
 		}
 		b = b[ol:]
 	}
@@ -156,6 +158,12 @@ type RawExtension struct {
 	Data []byte // data
 }
 
+func newRawExtension(b []byte) *RawExtension {
+	ext := &RawExtension{Data: make([]byte, len(b))}
+	copy(ext.Data, b)
+	return ext
+}
+
 // Len implements the Len method of Extension interface.
 func (p *RawExtension) Len(proto int) int {
 	if p == nil {
@@ -330,3 +330,64 @@ func TestParseInterfaceName(t *testing.T) {
 		}
 	}
 }
+
+func TestParseMalformedExtension(t *testing.T) {
+	b := []byte{
+		0x0b, 0x00, 0x00, 0x00, // time exceeded
+		0x00, 0x20, 0x00, 0x00, // original datagram of 32 words
+	}
+	b = append(b, make([]byte, 128)...)
+	b = append(b,
+		0x20, 0x00, 0x00, 0x00, // extension header without checksum
+
+		0x00, 0x08, 0x01, 0x01, // MPLS label stack
+		0x00, 0x01, 0x01, 0x40,
+
+		0x00, 0x08, 0x02, attrName, // interface information with invalid name
+		0x00, 'e', 'n', '0',
+	)
+	m, err := ParseMessage(iana.ProtocolICMP, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	te, ok := m.Body.(*TimeExceeded)
+	if !ok {
+		t.Fatalf("got %T; want *TimeExceeded", m.Body)
+	}
+	if len(te.Data) != 128 {
+		t.Errorf("got %d bytes of original datagram; want 128", len(te.Data))
+	}
+	want := []Extension{
+		&MPLSLabelStack{
+			Class:  classMPLSLabelStack,
+			Type:   typeIncomingMPLSLabelStack,
+			Labels: []MPLSLabel{{Label: 16, S: true, TTL: 64}},
+		},
+		&RawExtension{Data: b[len(b)-8:]},
+	}
+	if !reflect.DeepEqual(te.Extensions, want) {
+		t.Errorf("got %#v; want %#v", te.Extensions, want)
+	}
+
+	b = []byte{
+		0x2a, 0x00, 0x00, 0x00, // extended echo request
+		0x00, 0x01, 0x02, 0x01, // id, sequence number, local
+
+		0x20, 0x00, 0x00, 0x00, // extension header without checksum
+
+		0x00, 0x06, classInterfaceIdent, typeInterfaceByIndex, // interface identification with short index
+		0x00, 0x01,
+	}
+	m, err = ParseMessage(iana.ProtocolICMP, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	er, ok := m.Body.(*ExtendedEchoRequest)
+	if !ok {
+		t.Fatalf("got %T; want *ExtendedEchoRequest", m.Body)
+	}
+	want = []Extension{&RawExtension{Data: b[len(b)-6:]}}
+	if !reflect.DeepEqual(er.Extensions, want) {
+		t.Errorf("got %#v; want %#v", er.Extensions, want)
+	}
+}
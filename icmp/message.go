@@ -112,6 +112,30 @@ func (m *Message) Marshal(psh []byte) ([]byte, error) {
 	return b[len(psh):], nil
 }
 
+// VerifyChecksum reports whether the checksum field of the ICMP
+// message b is correct, as when b was received through a raw socket
+// that doesn't check it.
+//
+// For an ICMPv6 message, psh must be the pseudo header for IPv6 made
+// by IPv6PseudoHeader from the source and destination addresses of
+// the packet. For an ICMPv4 message, psh must be nil.
+func VerifyChecksum(b, psh []byte) bool {
+	if len(b) < 4 {
+		return false
+	}
+	if psh != nil {
+		if len(psh) != ipv6PseudoHeaderLen {
+			return false
+		}
+		p := make([]byte, len(psh), len(psh)+len(b))
+		copy(p, psh)
+		off := 2 * net.IPv6len
+		binary.BigEndian.PutUint32(p[off:off+4], uint32(len(b)))
+		b = append(p, b...)
+	}
+	return checksum(b) == 0
+}
+
 var parseFns = map[Type]func(int, Type, []byte) (MessageBody, error){
 	ipv4.ICMPTypeDestinationUnreachable: parseDstUnreach,
 	ipv4.ICMPTypeTimeExceeded:           parseTimeExceeded,
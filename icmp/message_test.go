@@ -341,3 +341,40 @@ func TestMarshalAndParseRawMessage(t *testing.T) {
 		}
 	})
 }
+
+func TestVerifyChecksum(t *testing.T) {
+	src, dst := net.ParseIP("fe80::1"), net.ParseIP("ff02::1")
+	psh := icmp.IPv6PseudoHeader(src, dst)
+	for _, data := range [][]byte{nil, []byte("HELLO-R-U-THERE"), []byte("odd")} {
+		for _, tt := range []struct {
+			typ icmp.Type
+			psh []byte
+		}{
+			{ipv4.ICMPTypeEcho, nil},
+			{ipv6.ICMPTypeEchoRequest, psh},
+		} {
+			m := icmp.Message{
+				Type: tt.typ,
+				Body: &icmp.Echo{ID: 1, Seq: 2, Data: data},
+			}
+			b, err := m.Marshal(tt.psh)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !icmp.VerifyChecksum(b, tt.psh) {
+				t.Errorf("%v with %q: VerifyChecksum = false; want true", tt.typ, data)
+			}
+			b[len(b)-1] ^= 0x01
+			if icmp.VerifyChecksum(b, tt.psh) {
+				t.Errorf("%v with %q: VerifyChecksum of corrupted message = true; want false", tt.typ, data)
+			}
+			b[len(b)-1] ^= 0x01
+			if tt.psh != nil && icmp.VerifyChecksum(b, icmp.IPv6PseudoHeader(src, net.ParseIP("ff02::2"))) {
+				t.Errorf("%v with %q: VerifyChecksum with wrong destination = true; want false", tt.typ, data)
+			}
+		}
+	}
+	if icmp.VerifyChecksum([]byte{0x08, 0x00}, nil) {
+		t.Error("VerifyChecksum of short message = true; want false")
+	}
+}
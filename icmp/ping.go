@@ -0,0 +1,245 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package icmp
+
+import (
+	"context"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/internal/iana"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+)
+
+// A Pinger sends ICMP echo requests and waits for the matching echo
+// replies.
+//
+// A Pinger is safe for concurrent use by multiple goroutines, but
+// pings are sent one at a time.
+type Pinger struct {
+	// Data is the payload of echo requests. If nil, the requests
+	// carry no payload.
+	Data []byte
+
+	mu       sync.Mutex
+	c        *PacketConn
+	proto    int
+	datagram bool // non-privileged datagram-oriented endpoint
+	id       int
+	seq      int
+	rb       []byte
+}
+
+// NewPinger returns a new Pinger that listens on address. The network
+// and address are as for ListenPacket; network must be "udp4",
+// "udp6", or "ip4" or "ip6" followed by a colon and an ICMP protocol
+// number or name.
+//
+// On a non-privileged datagram-oriented endpoint the kernel chooses
+// the identifier of echo requests and delivers only the replies to
+// them, so only sequence numbers are matched. On a privileged raw
+// endpoint, both identifiers and sequence numbers are matched.
+func NewPinger(network, address string) (*Pinger, error) {
+	p := &Pinger{id: os.Getpid() & 0xffff, rb: make([]byte, 1500)}
+	switch network {
+	case "udp4":
+		p.proto, p.datagram = iana.ProtocolICMP, true
+	case "udp6":
+		p.proto, p.datagram = iana.ProtocolIPv6ICMP, true
+	default:
+		i := strings.IndexByte(network, ':')
+		if i < 0 {
+			i = len(network)
+		}
+		switch network[:i] {
+		case "ip4":
+			p.proto = iana.ProtocolICMP
+		case "ip6":
+			p.proto = iana.ProtocolIPv6ICMP
+		default:
+			return nil, errInvalidProtocol
+		}
+	}
+	c, err := ListenPacket(network, address)
+	if err != nil {
+		return nil, err
+	}
+	p.c = c
+	return p, nil
+}
+
+// Ping sends an echo request to dst and returns the round-trip time
+// of the matching echo reply. It waits until the reply arrives or ctx
+// is done. Other messages read from the endpoint, including replies
+// to earlier pings that timed out, are discarded.
+func (p *Pinger) Ping(ctx context.Context, dst net.IP) (time.Duration, error) {
+	h, err := p.exchange(ctx, dst, 0, false)
+	if err != nil {
+		return 0, err
+	}
+	return h.RTT, nil
+}
+
+// exchange sends an echo request to dst and waits for the matching
+// echo reply, or for an error message quoting the request when
+// errmsgs is set. A positive hopLimit sets the TTL or hop limit of
+// the request, as by writeTo.
+func (p *Pinger) exchange(ctx context.Context, dst net.IP, hopLimit int, errmsgs bool) (*Hop, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.c == nil {
+		return nil, errInvalidConn
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	p.seq = (p.seq + 1) & 0xffff
+	m := Message{Body: &Echo{ID: p.id, Seq: p.seq, Data: p.Data}}
+	var reply Type
+	if p.proto == iana.ProtocolICMP {
+		m.Type, reply = ipv4.ICMPTypeEcho, ipv4.ICMPTypeEchoReply
+	} else {
+		m.Type, reply = ipv6.ICMPTypeEchoRequest, ipv6.ICMPTypeEchoReply
+	}
+	wb, err := m.Marshal(nil)
+	if err != nil {
+		return nil, err
+	}
+	var addr net.Addr = &net.IPAddr{IP: dst}
+	if p.datagram {
+		addr = &net.UDPAddr{IP: dst}
+	}
+
+	deadline, _ := ctx.Deadline()
+	if err := p.c.SetReadDeadline(deadline); err != nil {
+		return nil, err
+	}
+	stop, done := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(done)
+		select {
+		case <-ctx.Done():
+			p.c.SetReadDeadline(time.Unix(1, 0)) // interrupt ReadFrom
+		case <-stop:
+		}
+	}()
+	defer func() {
+		close(stop)
+		<-done
+	}()
+
+	start := time.Now()
+	if err := p.writeTo(wb, addr, hopLimit); err != nil {
+		return nil, err
+	}
+	for {
+		n, peer, err := p.c.ReadFrom(p.rb)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, err
+		}
+		rtt := time.Since(start)
+		rm, err := ParseMessage(p.proto, p.rb[:n])
+		if err != nil {
+			continue
+		}
+		ip := peerIP(peer)
+		if rm.Type == reply {
+			e, ok := rm.Body.(*Echo)
+			if !ok || !p.matches(e.ID, e.Seq) || !ip.Equal(dst) {
+				continue
+			}
+		} else if !errmsgs || !p.quotesRequest(rm) {
+			continue
+		}
+		return &Hop{TTL: hopLimit, Dst: dst, Peer: ip, RTT: rtt, Message: rm}, nil
+	}
+}
+
+func (p *Pinger) matches(id, seq int) bool {
+	return seq == p.seq && (p.datagram || id == p.id)
+}
+
+// writeTo writes the echo request b to addr. A positive hopLimit sets
+// the TTL or hop limit of the request with a control message, leaving
+// the endpoint's alone. Where the platform can't send one, such as
+// IPv4 off Linux, the endpoint's TTL or hop limit is set for the write
+// and then restored.
+func (p *Pinger) writeTo(b []byte, addr net.Addr, hopLimit int) error {
+	if hopLimit <= 0 {
+		_, err := p.c.WriteTo(b, addr)
+		return err
+	}
+	if p4 := p.c.IPv4PacketConn(); p4 != nil {
+		if cm := (&ipv4.ControlMessage{TTL: hopLimit}); cm.MarshalLen() > 0 {
+			_, err := p4.WriteTo(b, cm, addr)
+			return err
+		}
+	}
+	if p6 := p.c.IPv6PacketConn(); p6 != nil {
+		if cm := (&ipv6.ControlMessage{HopLimit: hopLimit}); cm.Marshal() != nil {
+			_, err := p6.WriteTo(b, cm, addr)
+			return err
+		}
+	}
+	restore, err := p.setHopLimit(hopLimit)
+	if err != nil {
+		return err
+	}
+	defer restore()
+	_, err = p.c.WriteTo(b, addr)
+	return err
+}
+
+// setHopLimit sets the TTL or hop limit of the endpoint to hopLimit,
+// and returns a function that restores the previous one.
+func (p *Pinger) setHopLimit(hopLimit int) (func(), error) {
+	if p4 := p.c.IPv4PacketConn(); p4 != nil {
+		old, err := p4.TTL()
+		if err != nil {
+			return nil, err
+		}
+		if err := p4.SetTTL(hopLimit); err != nil {
+			return nil, err
+		}
+		return func() { p4.SetTTL(old) }, nil
+	}
+	if p6 := p.c.IPv6PacketConn(); p6 != nil {
+		old, err := p6.HopLimit()
+		if err != nil {
+			return nil, err
+		}
+		if err := p6.SetHopLimit(hopLimit); err != nil {
+			return nil, err
+		}
+		return func() { p6.SetHopLimit(old) }, nil
+	}
+	return nil, errInvalidConn
+}
+
+// Close closes the endpoint of p. Any blocked Ping is unblocked and
+// returns an error.
+func (p *Pinger) Close() error {
+	if p.c == nil {
+		return errInvalidConn
+	}
+	return p.c.Close()
+}
+
+func peerIP(a net.Addr) net.IP {
+	switch a := a.(type) {
+	case *net.IPAddr:
+		return a.IP
+	case *net.UDPAddr:
+		return a.IP
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package icmp_test
+
+import (
+	"context"
+	"net"
+	"runtime"
+	"testing"
+	"time"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+	"golang.org/x/net/nettest"
+)
+
+func TestPinger(t *testing.T) {
+	if !nettest.SupportsIPv4() {
+		t.Skip("ipv4 is not supported")
+	}
+	t.Run("NonPrivileged", func(t *testing.T) {
+		if m, ok := supportsNonPrivilegedICMP(); !ok {
+			t.Skip(m)
+		}
+		testPinger(t, "udp4")
+	})
+	t.Run("Privileged", func(t *testing.T) {
+		if !nettest.SupportsRawSocket() {
+			t.Skipf("not supported on %s/%s", runtime.GOOS, runtime.GOARCH)
+		}
+		testPinger(t, "ip4:icmp")
+	})
+}
+
+func testPinger(t *testing.T, network string) {
+	p, err := icmp.NewPinger(network, "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+	p.Data = []byte("HELLO-R-U-THERE")
+
+	for i := 0; i < 3; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		rtt, err := p.Ping(ctx, net.IPv4(127, 0, 0, 1))
+		cancel()
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if rtt <= 0 {
+			t.Fatalf("#%d: got rtt %v; want positive", i, rtt)
+		}
+	}
+
+	hops, err := p.Trace(context.Background(), net.IPv4(127, 0, 0, 1), 4, 3*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hops) != 1 || !hops[0].Reached() || hops[0].TTL != 1 || !hops[0].Peer.Equal(net.IPv4(127, 0, 0, 1)) {
+		t.Fatalf("got %+v; want a single hop reaching the destination", hops)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := p.Ping(ctx, net.IPv4(127, 0, 0, 1)); err != context.Canceled {
+		t.Fatalf("got %v; want %v", err, context.Canceled)
+	}
+}
+
+func TestNewPingerInvalidNetwork(t *testing.T) {
+	if _, err := icmp.NewPinger("tcp4", "127.0.0.1"); err == nil {
+		t.Fatal("got nil; want an error")
+	}
+}
+
+func TestHopReached(t *testing.T) {
+	dst4, router4 := net.IPv4(192, 0, 2, 1), net.IPv4(198, 51, 100, 1)
+	dst6, router6 := net.ParseIP("2001:db8::1"), net.ParseIP("2001:db8:1::1")
+	for i, tt := range []struct {
+		h    *icmp.Hop
+		want bool
+	}{
+		{nil, false},
+		{&icmp.Hop{TTL: 1, Dst: dst4}, false},
+		{&icmp.Hop{TTL: 1, Dst: dst4, Peer: router4, Message: &icmp.Message{Type: ipv4.ICMPTypeTimeExceeded}}, false},
+		{&icmp.Hop{TTL: 2, Dst: dst4, Peer: dst4, Message: &icmp.Message{Type: ipv4.ICMPTypeEchoReply}}, true},
+		{&icmp.Hop{TTL: 2, Dst: dst4, Peer: dst4, Message: &icmp.Message{Type: ipv4.ICMPTypeDestinationUnreachable}}, true},
+		{&icmp.Hop{TTL: 2, Dst: dst4, Peer: router4, Message: &icmp.Message{Type: ipv4.ICMPTypeDestinationUnreachable}}, false},
+		{&icmp.Hop{TTL: 2, Dst: dst6, Peer: dst6, Message: &icmp.Message{Type: ipv6.ICMPTypeDestinationUnreachable}}, true},
+		{&icmp.Hop{TTL: 2, Dst: dst6, Peer: router6, Message: &icmp.Message{Type: ipv6.ICMPTypeDestinationUnreachable}}, false},
+	} {
+		if got := tt.h.Reached(); got != tt.want {
+			t.Errorf("#%d: got %v; want %v", i, got, tt.want)
+		}
+	}
+}
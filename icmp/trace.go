@@ -0,0 +1,130 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package icmp
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"net"
+	"time"
+
+	"golang.org/x/net/internal/iana"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+)
+
+var errInvalidHopLimit = errors.New("invalid hop limit")
+
+// A Hop represents the outcome of an echo request sent with a limited
+// TTL or hop limit.
+type Hop struct {
+	TTL     int           // TTL or hop limit of the request
+	Dst     net.IP        // destination of the request
+	Peer    net.IP        // node that replied, nil if no reply arrived in time
+	RTT     time.Duration // round-trip time, zero if no reply arrived in time
+	Message *Message      // reply, nil if no reply arrived in time
+}
+
+// Reached reports whether the request reached its destination, which
+// answered with an echo reply or a destination unreachable message.
+// A destination unreachable message from a router on the way, which
+// ends the path short of the destination, doesn't count.
+func (h *Hop) Reached() bool {
+	if h == nil || h.Message == nil || h.Peer == nil || !h.Peer.Equal(h.Dst) {
+		return false
+	}
+	switch h.Message.Type {
+	case ipv4.ICMPTypeEchoReply, ipv4.ICMPTypeDestinationUnreachable,
+		ipv6.ICMPTypeEchoReply, ipv6.ICMPTypeDestinationUnreachable:
+		return true
+	}
+	return false
+}
+
+// Probe sends an echo request to dst with the TTL or hop limit set to
+// hopLimit, and waits for the echo reply or for an error message, such
+// as time exceeded, that quotes the request. When ctx expires before
+// either arrives, it returns a Hop with no Peer and a nil error.
+//
+// Error messages are only delivered to privileged raw endpoints on
+// some platforms, Linux included; on a non-privileged
+// datagram-oriented endpoint intermediate hops may never reply.
+func (p *Pinger) Probe(ctx context.Context, dst net.IP, hopLimit int) (*Hop, error) {
+	if hopLimit < 1 || hopLimit > 255 {
+		return nil, errInvalidHopLimit
+	}
+	h, err := p.exchange(ctx, dst, hopLimit, true)
+	if err == context.DeadlineExceeded {
+		return &Hop{TTL: hopLimit, Dst: dst}, nil
+	}
+	return h, err
+}
+
+// Trace probes the path to dst hop by hop, with the TTL or hop limit
+// running from 1 to maxHops, and returns a Hop for each probe. Each
+// probe waits at most timeout for a reply. Trace stops after the
+// first probe that reaches dst, or when ctx is done.
+func (p *Pinger) Trace(ctx context.Context, dst net.IP, maxHops int, timeout time.Duration) ([]Hop, error) {
+	if maxHops < 1 || maxHops > 255 {
+		return nil, errInvalidHopLimit
+	}
+	var hops []Hop
+	for ttl := 1; ttl <= maxHops; ttl++ {
+		pctx, cancel := context.WithTimeout(ctx, timeout)
+		h, err := p.Probe(pctx, dst, ttl)
+		cancel()
+		if err == nil && h.Peer == nil && ctx.Err() != nil {
+			err = ctx.Err()
+		}
+		if err != nil {
+			return hops, err
+		}
+		hops = append(hops, *h)
+		if h.Reached() {
+			break
+		}
+	}
+	return hops, nil
+}
+
+// quotesRequest reports whether m is an error message that quotes the
+// echo request last sent by p.
+func (p *Pinger) quotesRequest(m *Message) bool {
+	var b []byte
+	switch body := m.Body.(type) {
+	case *TimeExceeded:
+		b = body.Data
+	case *DstUnreach:
+		b = body.Data
+	case *ParamProb:
+		b = body.Data
+	case *PacketTooBig:
+		b = body.Data
+	default:
+		return false
+	}
+	var req byte
+	switch p.proto {
+	case iana.ProtocolICMP:
+		if len(b) < ipv4.HeaderLen || b[9] != iana.ProtocolICMP {
+			return false
+		}
+		hdrlen := int(b[0]&0x0f) << 2
+		if hdrlen < ipv4.HeaderLen || hdrlen > len(b) {
+			return false
+		}
+		b, req = b[hdrlen:], byte(ipv4.ICMPTypeEcho)
+	case iana.ProtocolIPv6ICMP:
+		if len(b) < ipv6.HeaderLen || b[6] != iana.ProtocolIPv6ICMP {
+			return false
+		}
+		b, req = b[ipv6.HeaderLen:], byte(ipv6.ICMPTypeEchoRequest)
+	}
+	if len(b) < 8 || b[0] != req {
+		return false
+	}
+	return p.matches(int(binary.BigEndian.Uint16(b[4:6])), int(binary.BigEndian.Uint16(b[6:8])))
+}
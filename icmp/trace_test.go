@@ -0,0 +1,114 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package icmp
+
+import (
+	"context"
+	"net"
+	"runtime"
+	"testing"
+	"time"
+
+	"golang.org/x/net/internal/iana"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+	"golang.org/x/net/nettest"
+)
+
+func TestPingerQuotesRequest(t *testing.T) {
+	ip4 := []byte{
+		0x45, 0x00, 0x00, 0x1c, 0x00, 0x00, 0x00, 0x00,
+		0x01, 0x01, 0x00, 0x00, 0x0a, 0x00, 0x00, 0x01,
+		0xc0, 0x00, 0x02, 0x01,
+	}
+	ip6 := make([]byte, ipv6.HeaderLen)
+	ip6[0], ip6[6], ip6[7] = 0x60, iana.ProtocolIPv6ICMP, 1
+	quote := func(hdr []byte, typ byte, id, seq int) []byte {
+		b := append([]byte{}, hdr...)
+		return append(b, typ, 0, 0, 0, byte(id>>8), byte(id), byte(seq>>8), byte(seq))
+	}
+
+	for i, tt := range []struct {
+		proto    int
+		datagram bool
+		m        *Message
+		want     bool
+	}{
+		{iana.ProtocolICMP, false, &Message{Type: ipv4.ICMPTypeTimeExceeded, Body: &TimeExceeded{Data: quote(ip4, 8, 0x1234, 7)}}, true},
+		{iana.ProtocolICMP, false, &Message{Type: ipv4.ICMPTypeDestinationUnreachable, Body: &DstUnreach{Data: quote(ip4, 8, 0x1234, 7)}}, true},
+		{iana.ProtocolICMP, false, &Message{Type: ipv4.ICMPTypeTimeExceeded, Body: &TimeExceeded{Data: quote(ip4, 8, 0x1234, 8)}}, false},
+		{iana.ProtocolICMP, false, &Message{Type: ipv4.ICMPTypeTimeExceeded, Body: &TimeExceeded{Data: quote(ip4, 8, 0x4321, 7)}}, false},
+		{iana.ProtocolICMP, true, &Message{Type: ipv4.ICMPTypeTimeExceeded, Body: &TimeExceeded{Data: quote(ip4, 8, 0x4321, 7)}}, true},
+		{iana.ProtocolICMP, false, &Message{Type: ipv4.ICMPTypeTimeExceeded, Body: &TimeExceeded{Data: quote(ip4, 0, 0x1234, 7)}}, false},
+		{iana.ProtocolICMP, false, &Message{Type: ipv4.ICMPTypeTimeExceeded, Body: &TimeExceeded{Data: ip4}}, false},
+		{iana.ProtocolICMP, false, &Message{Type: ipv4.ICMPTypeEchoReply, Body: &Echo{ID: 0x1234, Seq: 7}}, false},
+
+		{iana.ProtocolIPv6ICMP, false, &Message{Type: ipv6.ICMPTypeTimeExceeded, Body: &TimeExceeded{Data: quote(ip6, 128, 0x1234, 7)}}, true},
+		{iana.ProtocolIPv6ICMP, false, &Message{Type: ipv6.ICMPTypePacketTooBig, Body: &PacketTooBig{Data: quote(ip6, 128, 0x1234, 7)}}, true},
+		{iana.ProtocolIPv6ICMP, false, &Message{Type: ipv6.ICMPTypeTimeExceeded, Body: &TimeExceeded{Data: quote(ip6, 129, 0x1234, 7)}}, false},
+	} {
+		p := &Pinger{proto: tt.proto, datagram: tt.datagram, id: 0x1234, seq: 7}
+		if got := p.quotesRequest(tt.m); got != tt.want {
+			t.Errorf("#%d: got %v; want %v", i, got, tt.want)
+		}
+	}
+}
+
+func TestPingerProbeHopLimit(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skipf("no per-packet TTL on %s", runtime.GOOS)
+	}
+	if !nettest.SupportsRawSocket() {
+		t.Skipf("not supported on %s/%s", runtime.GOOS, runtime.GOARCH)
+	}
+
+	p, err := NewPinger("ip4:icmp", "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+	ttl, err := p.c.IPv4PacketConn().TTL()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A second endpoint sees the request on the loopback interface.
+	c, err := net.ListenPacket("ip4:icmp", "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	r, err := ipv4.NewRawConn(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.SetReadDeadline(time.Now().Add(3 * time.Second))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	h, err := p.Probe(ctx, net.IPv4(127, 0, 0, 1), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !h.Reached() {
+		t.Errorf("got %+v; want a hop reaching the destination", h)
+	}
+	b := make([]byte, 1500)
+	for {
+		hdr, pl, _, err := r.ReadFrom(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(pl) > 0 && pl[0] == byte(ipv4.ICMPTypeEcho) {
+			if hdr.TTL != 3 {
+				t.Errorf("got request with TTL %d; want 3", hdr.TTL)
+			}
+			break
+		}
+	}
+	if got, err := p.c.IPv4PacketConn().TTL(); err != nil || got != ttl {
+		t.Errorf("got endpoint TTL %d, %v; want %d unchanged", got, err, ttl)
+	}
+}
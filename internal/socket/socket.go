@@ -95,6 +95,20 @@ func ControlMessageSpace(dataLen int) int {
 	return controlMessageSpace(dataLen)
 }
 
+// loadCmsghdr returns the header at the head of m. It copies the
+// header, so that m needn't be aligned for the header's fields, as
+// strict-alignment platforms would require.
+func loadCmsghdr(m []byte) cmsghdr {
+	var h cmsghdr
+	copy((*[unsafe.Sizeof(h)]byte)(unsafe.Pointer(&h))[:], m)
+	return h
+}
+
+// storeCmsghdr stores h at the head of m, which needn't be aligned.
+func storeCmsghdr(m []byte, h *cmsghdr) {
+	copy(m, (*[unsafe.Sizeof(*h)]byte)(unsafe.Pointer(h))[:])
+}
+
 // A ControlMessage represents the head message in a stream of control
 // messages.
 //
@@ -131,8 +145,9 @@ func (m ControlMessage) MarshalHeader(lvl, typ, dataLen int) error {
 	if len(m) < controlHeaderLen() {
 		return errors.New("short message")
 	}
-	h := (*cmsghdr)(unsafe.Pointer(&m[0]))
+	var h cmsghdr
 	h.set(controlMessageLen(dataLen), lvl, typ)
+	storeCmsghdr(m, &h)
 	return nil
 }
 
@@ -143,7 +158,7 @@ func (m ControlMessage) ParseHeader() (lvl, typ, dataLen int, err error) {
 	if len(m) < l {
 		return 0, 0, 0, errors.New("short message")
 	}
-	h := (*cmsghdr)(unsafe.Pointer(&m[0]))
+	h := loadCmsghdr(m)
 	return h.lvl(), h.typ(), int(uint64(h.len()) - uint64(l)), nil
 }
 
@@ -154,8 +169,9 @@ func (m ControlMessage) Marshal(lvl, typ int, data []byte) (ControlMessage, erro
 	if len(m) < ControlMessageSpace(l) {
 		return nil, errors.New("short message")
 	}
-	h := (*cmsghdr)(unsafe.Pointer(&m[0]))
+	var h cmsghdr
 	h.set(controlMessageLen(l), lvl, typ)
+	storeCmsghdr(m, &h)
 	if l > 0 {
 		copy(m.Data(l), data)
 	}
@@ -168,7 +184,7 @@ func (m ControlMessage) Marshal(lvl, typ int, data []byte) (ControlMessage, erro
 func (m ControlMessage) Parse() ([]ControlMessage, error) {
 	var ms []ControlMessage
 	for len(m) >= controlHeaderLen() {
-		h := (*cmsghdr)(unsafe.Pointer(&m[0]))
+		h := loadCmsghdr(m)
 		l := h.len()
 		if l <= 0 {
 			return nil, errors.New("invalid header length")
@@ -135,6 +135,14 @@ func TestControlMessage(t *testing.T) {
 			nopad := w[:len(w)-tailPadLen]
 			ws = append(ws, [][]byte{nopad}...)
 		}
+		// Test messages that aren't aligned for the header fields.
+		for _, w := range ws {
+			for _, off := range []int{1, 3} {
+				b := make([]byte, off+len(w))[off:]
+				copy(b, w)
+				ws = append(ws, b)
+			}
+		}
 		for _, w := range ws {
 			ms, err := socket.ControlMessage(w).Parse()
 			if err != nil {
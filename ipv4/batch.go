@@ -61,7 +61,7 @@ type Message = socket.Message
 // syscall.MSG_PEEK.
 //
 // On a successful read it returns the number of messages received, up
-// to len(ms).
+// to len(ms). It returns immediately if ms is empty.
 //
 // On Linux, a batch read will be optimized.
 // On other platforms, this method will read only a single message.
@@ -75,6 +75,9 @@ func (c *payloadHandler) ReadBatch(ms []Message, flags int) (int, error) {
 	if !c.ok() {
 		return 0, errInvalidConn
 	}
+	if len(ms) == 0 {
+		return 0, nil
+	}
 	switch runtime.GOOS {
 	case "linux":
 		n, err := c.RecvMsgs([]socket.Message(ms), flags)
@@ -102,6 +105,7 @@ func (c *payloadHandler) ReadBatch(ms []Message, flags int) (int, error) {
 // syscall.MSG_DONTROUTE.
 //
 // It returns the number of messages written on a successful write.
+// It returns immediately if ms is empty.
 //
 // On Linux, a batch write will be optimized.
 // On other platforms, this method will write only a single message.
@@ -109,6 +113,9 @@ func (c *payloadHandler) WriteBatch(ms []Message, flags int) (int, error) {
 	if !c.ok() {
 		return 0, errInvalidConn
 	}
+	if len(ms) == 0 {
+		return 0, nil
+	}
 	switch runtime.GOOS {
 	case "linux":
 		n, err := c.SendMsgs([]socket.Message(ms), flags)
@@ -133,7 +140,7 @@ func (c *payloadHandler) WriteBatch(ms []Message, flags int) (int, error) {
 // syscall.MSG_PEEK.
 //
 // On a successful read it returns the number of messages received, up
-// to len(ms).
+// to len(ms). It returns immediately if ms is empty.
 //
 // On Linux, a batch read will be optimized.
 // On other platforms, this method will read only a single message.
@@ -141,6 +148,9 @@ func (c *packetHandler) ReadBatch(ms []Message, flags int) (int, error) {
 	if !c.ok() {
 		return 0, errInvalidConn
 	}
+	if len(ms) == 0 {
+		return 0, nil
+	}
 	switch runtime.GOOS {
 	case "linux":
 		n, err := c.RecvMsgs([]socket.Message(ms), flags)
@@ -168,6 +178,7 @@ func (c *packetHandler) ReadBatch(ms []Message, flags int) (int, error) {
 // syscall.MSG_DONTROUTE.
 //
 // It returns the number of messages written on a successful write.
+// It returns immediately if ms is empty.
 //
 // On Linux, a batch write will be optimized.
 // On other platforms, this method will write only a single message.
@@ -175,6 +186,9 @@ func (c *packetHandler) WriteBatch(ms []Message, flags int) (int, error) {
 	if !c.ok() {
 		return 0, errInvalidConn
 	}
+	if len(ms) == 0 {
+		return 0, nil
+	}
 	switch runtime.GOOS {
 	case "linux":
 		n, err := c.SendMsgs([]socket.Message(ms), flags)
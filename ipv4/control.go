@@ -29,6 +29,7 @@ const (
 	FlagSrc                                // pass the source address on the received packet
 	FlagDst                                // pass the destination address on the received packet
 	FlagInterface                          // pass the interface index on the received packet
+	FlagTOS                                // pass the type-of-service on the received packet
 )
 
 // A ControlMessage represents per packet basis IP-level socket options.
@@ -41,7 +42,12 @@ type ControlMessage struct {
 	// method of PacketConn or RawConn allows to send the options
 	// to the protocol stack.
 	//
-	TTL     int    // time-to-live, receiving only
+	// On Linux, a positive TTL or TOS, which must fit in a byte,
+	// overrides the socket's setting for the packet being sent.
+	// Elsewhere they are receiving only.
+	//
+	TTL     int    // time-to-live
+	TOS     int    // type-of-service
 	Src     net.IP // source address, specifying only
 	Dst     net.IP // destination address, receiving only
 	IfIndex int    // interface index, must be 1 <= value when specifying
@@ -51,22 +57,107 @@ func (cm *ControlMessage) String() string {
 	if cm == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ttl=%d src=%v dst=%v ifindex=%d", cm.TTL, cm.Src, cm.Dst, cm.IfIndex)
+	return fmt.Sprintf("ttl=%d tos=%#x src=%v dst=%v ifindex=%d", cm.TTL, cm.TOS, cm.Src, cm.Dst, cm.IfIndex)
 }
 
 // Marshal returns the binary encoding of cm.
 func (cm *ControlMessage) Marshal() []byte {
+	l := cm.MarshalLen()
+	if l == 0 {
+		return nil
+	}
+	b := make([]byte, l)
+	cm.MarshalTo(b)
+	return b
+}
+
+// MarshalLen returns the length of the binary encoding of cm, which is
+// zero if there's nothing to specify.
+func (cm *ControlMessage) MarshalLen() int {
+	opts, n := cm.sendOpts()
+	l := 0
+	for _, opt := range opts[:n] {
+		l += socket.ControlMessageSpace(ctlOpts[opt].length)
+	}
+	return l
+}
+
+// MarshalTo is like Marshal, but stores the binary encoding of cm in
+// b, so that a buffer can be reused for many packets. It returns the
+// number of bytes stored, which is MarshalLen.
+func (cm *ControlMessage) MarshalTo(b []byte) (int, error) {
+	l := cm.MarshalLen()
+	if len(b) < l {
+		return 0, errShortBuffer
+	}
+	m := b[:l]
+	for i := range m {
+		m[i] = 0
+	}
+	opts, n := cm.sendOpts()
+	for _, opt := range opts[:n] {
+		m = ctlOpts[opt].marshal(m, cm)
+	}
+	return l, nil
+}
+
+// sendOpts returns the ancillary data socket options, in order, that
+// encode cm for sending.
+func (cm *ControlMessage) sendOpts() (opts [3]int, n int) {
 	if cm == nil {
+		return opts, 0
+	}
+	switch {
+	case ctlOpts[ctlPacketInfo].name > 0 && (cm.Src.To4() != nil || cm.IfIndex > 0):
+		opts[n] = ctlPacketInfo
+		n++
+	case ctlOpts[ctlSrc].name > 0 && cm.Src.To4() != nil:
+		// Without IP_PKTINFO, BSD variants take the source
+		// address alone, and the outgoing interface follows
+		// from it.
+		opts[n] = ctlSrc
+		n++
+	}
+	if ctlOpts[ctlSendTTL].name > 0 && cm.TTL > 0 {
+		opts[n] = ctlSendTTL
+		n++
+	}
+	if ctlOpts[ctlSendTOS].name > 0 && cm.TOS > 0 {
+		opts[n] = ctlSendTOS
+		n++
+	}
+	return opts, n
+}
+
+// oobPool holds buffers for the control messages of outgoing packets.
+var oobPool = sync.Pool{
+	New: func() interface{} {
+		b := make([]byte, 0, 64)
+		return &b
+	},
+}
+
+// getOOB returns the binary encoding of cm in a buffer from oobPool,
+// or nil if there's nothing to specify. The caller must return the
+// buffer with putOOB.
+func getOOB(cm *ControlMessage) *[]byte {
+	l := cm.MarshalLen()
+	if l == 0 {
 		return nil
 	}
-	var m socket.ControlMessage
-	if ctlOpts[ctlPacketInfo].name > 0 && (cm.Src.To4() != nil || cm.IfIndex > 0) {
-		m = socket.NewControlMessage([]int{ctlOpts[ctlPacketInfo].length})
+	bp := oobPool.Get().(*[]byte)
+	if cap(*bp) < l {
+		*bp = make([]byte, l)
 	}
-	if len(m) > 0 {
-		ctlOpts[ctlPacketInfo].marshal(m, cm)
+	*bp = (*bp)[:l]
+	cm.MarshalTo(*bp)
+	return bp
+}
+
+func putOOB(bp *[]byte) {
+	if bp != nil {
+		oobPool.Put(bp)
 	}
-	return m
 }
 
 // Parse parses b as a control message and stores the result in cm.
@@ -86,6 +177,8 @@ func (cm *ControlMessage) Parse(b []byte) error {
 		switch {
 		case typ == ctlOpts[ctlTTL].name && l >= ctlOpts[ctlTTL].length:
 			ctlOpts[ctlTTL].parse(cm, m.Data(l))
+		case typ == ctlOpts[ctlTOS].name && l >= ctlOpts[ctlTOS].length:
+			ctlOpts[ctlTOS].parse(cm, m.Data(l))
 		case typ == ctlOpts[ctlDst].name && l >= ctlOpts[ctlDst].length:
 			ctlOpts[ctlDst].parse(cm, m.Data(l))
 		case typ == ctlOpts[ctlInterface].name && l >= ctlOpts[ctlInterface].length:
@@ -106,6 +199,9 @@ func NewControlMessage(cf ControlFlags) []byte {
 	if opt.isset(FlagTTL) && ctlOpts[ctlTTL].name > 0 {
 		l += socket.ControlMessageSpace(ctlOpts[ctlTTL].length)
 	}
+	if opt.isset(FlagTOS) && ctlOpts[ctlTOS].name > 0 {
+		l += socket.ControlMessageSpace(ctlOpts[ctlTOS].length)
+	}
 	if ctlOpts[ctlPacketInfo].name > 0 {
 		if opt.isset(FlagSrc | FlagDst | FlagInterface) {
 			l += socket.ControlMessageSpace(ctlOpts[ctlPacketInfo].length)
@@ -132,6 +228,9 @@ const (
 	ctlDst               // header field
 	ctlInterface         // inbound or outbound interface
 	ctlPacketInfo        // inbound or outbound packet path
+	ctlTOS               // header field
+	ctlSendTTL           // header field, outbound only
+	ctlSendTOS           // header field, outbound only
 	ctlMax
 )
 
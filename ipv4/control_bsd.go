@@ -31,6 +31,21 @@ func parseDst(cm *ControlMessage, b []byte) {
 	copy(cm.Dst, b[:net.IPv4len])
 }
 
+// IP_SENDSRCADDR has the same value as IP_RECVDSTADDR, the option it
+// mirrors for sending, and isn't defined for all platforms.
+const sockoptSendSrcAddr = unix.IP_RECVDSTADDR
+
+func marshalSrc(b []byte, cm *ControlMessage) []byte {
+	m := socket.ControlMessage(b)
+	m.MarshalHeader(iana.ProtocolIP, sockoptSendSrcAddr, net.IPv4len)
+	if cm != nil {
+		if ip := cm.Src.To4(); ip != nil {
+			copy(m.Data(net.IPv4len), ip)
+		}
+	}
+	return m.Next(net.IPv4len)
+}
+
 func marshalInterface(b []byte, cm *ControlMessage) []byte {
 	m := socket.ControlMessage(b)
 	m.MarshalHeader(iana.ProtocolIP, sockoptReceiveInterface, syscall.SizeofSockaddrDatalink)
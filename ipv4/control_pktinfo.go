@@ -17,23 +17,28 @@ import (
 	"golang.org/x/sys/unix"
 )
 
+// The data of a control message may not be aligned for the fields of
+// inetPktinfo, so it's copied to and from an aligned inetPktinfo.
+
 func marshalPacketInfo(b []byte, cm *ControlMessage) []byte {
 	m := socket.ControlMessage(b)
 	m.MarshalHeader(iana.ProtocolIP, unix.IP_PKTINFO, sizeofInetPktinfo)
 	if cm != nil {
-		pi := (*inetPktinfo)(unsafe.Pointer(&m.Data(sizeofInetPktinfo)[0]))
+		var pi inetPktinfo
 		if ip := cm.Src.To4(); ip != nil {
 			copy(pi.Spec_dst[:], ip)
 		}
 		if cm.IfIndex > 0 {
 			pi.setIfindex(cm.IfIndex)
 		}
+		copy(m.Data(sizeofInetPktinfo), (*[sizeofInetPktinfo]byte)(unsafe.Pointer(&pi))[:])
 	}
 	return m.Next(sizeofInetPktinfo)
 }
 
 func parsePacketInfo(cm *ControlMessage, b []byte) {
-	pi := (*inetPktinfo)(unsafe.Pointer(&b[0]))
+	var pi inetPktinfo
+	copy((*[sizeofInetPktinfo]byte)(unsafe.Pointer(&pi))[:], b)
 	cm.IfIndex = int(pi.Ifindex)
 	if len(cm.Dst) < net.IPv4len {
 		cm.Dst = make(net.IP, net.IPv4len)
@@ -5,6 +5,9 @@
 package ipv4_test
 
 import (
+	"bytes"
+	"net"
+	"runtime"
 	"testing"
 
 	"golang.org/x/net/ipv4"
@@ -19,3 +22,80 @@ func TestControlMessageParseWithFuzz(t *testing.T) {
 		cm.Parse([]byte(fuzz))
 	}
 }
+
+func TestControlMessageMarshalSrc(t *testing.T) {
+	switch runtime.GOOS {
+	case "darwin", "freebsd", "ios", "linux", "netbsd", "openbsd", "solaris":
+	default:
+		t.Skipf("not supported on %s", runtime.GOOS)
+	}
+	src := net.IPv4(192, 0, 2, 1)
+	cm := ipv4.ControlMessage{Src: src}
+	b := cm.Marshal()
+	if len(b) == 0 {
+		t.Fatal("got empty control message; want one specifying the source address")
+	}
+	if !bytes.Contains(b, src.To4()) {
+		t.Fatalf("got %#v; want it to contain %v", b, src)
+	}
+}
+
+func TestControlMessageParseMisaligned(t *testing.T) {
+	switch runtime.GOOS {
+	case "darwin", "ios", "linux", "solaris":
+	default:
+		t.Skipf("not supported on %s", runtime.GOOS)
+	}
+	want := ipv4.ControlMessage{Src: net.IPv4(192, 0, 2, 1), IfIndex: 3}
+	b := want.Marshal()
+	for off := 1; off < 8; off++ {
+		ob := make([]byte, off+len(b))[off:]
+		copy(ob, b)
+		var got ipv4.ControlMessage
+		if err := got.Parse(ob); err != nil {
+			t.Fatalf("offset %d: %v", off, err)
+		}
+		// The source address is sent as the packet's local
+		// address, which isn't parsed, so only compare IfIndex.
+		if got.IfIndex != want.IfIndex {
+			t.Errorf("offset %d: got %v; want ifindex=%d", off, &got, want.IfIndex)
+		}
+	}
+}
+
+func TestControlMessageMarshalTo(t *testing.T) {
+	cms := []*ipv4.ControlMessage{
+		nil,
+		{},
+		{Src: net.IPv4(192, 0, 2, 1)},
+		{IfIndex: 1},
+		{Src: net.IPv4(192, 0, 2, 1), IfIndex: 1, TTL: 7, TOS: 0x28},
+	}
+	b := make([]byte, 128)
+	for i := range b {
+		b[i] = 0xff
+	}
+	for _, cm := range cms {
+		want := cm.Marshal()
+		if l := cm.MarshalLen(); l != len(want) {
+			t.Errorf("%v: MarshalLen = %d; want %d", cm, l, len(want))
+		}
+		n, err := cm.MarshalTo(b)
+		if err != nil {
+			t.Fatalf("%v: %v", cm, err)
+		}
+		if !bytes.Equal(b[:n], want) {
+			t.Errorf("%v: MarshalTo stored %#v; want %#v", cm, b[:n], want)
+		}
+		if len(want) > 0 {
+			if _, err := cm.MarshalTo(b[:len(want)-1]); err == nil {
+				t.Errorf("%v: MarshalTo with short buffer succeeded", cm)
+			}
+		}
+	}
+
+	cm := cms[len(cms)-1]
+	if allocs := testing.AllocsPerRun(100, func() { cm.MarshalTo(b) }); allocs > 0 {
+		t.Errorf("MarshalTo allocated %v times; want 0", allocs)
+	}
+}
@@ -29,6 +29,16 @@ func setControlMessage(c *socket.Conn, opt *rawOpt, cf ControlFlags, on bool) er
 			opt.clear(FlagTTL)
 		}
 	}
+	if so, ok := sockOpts[ssoReceiveTOS]; ok && cf&FlagTOS != 0 {
+		if err := so.SetInt(c, boolint(on)); err != nil {
+			return err
+		}
+		if on {
+			opt.set(FlagTOS)
+		} else {
+			opt.clear(FlagTOS)
+		}
+	}
 	if so, ok := sockOpts[ssoPacketInfo]; ok {
 		if cf&(FlagSrc|FlagDst|FlagInterface) != 0 {
 			if err := so.SetInt(c, boolint(on)); err != nil {
@@ -24,11 +24,15 @@ func (c *dgramOpt) MulticastTTL() (int, error) {
 }
 
 // SetMulticastTTL sets the time-to-live field value for future
-// outgoing multicast packets.
+// outgoing multicast packets. The value limits the scope of the
+// packets, and must be between 0 and 255.
 func (c *dgramOpt) SetMulticastTTL(ttl int) error {
 	if !c.ok() {
 		return errInvalidConn
 	}
+	if ttl < 0 || ttl > 255 {
+		return errInvalidTTL
+	}
 	so, ok := sockOpts[ssoMulticastTTL]
 	if !ok {
 		return errNotImplemented
@@ -76,7 +80,7 @@ func (c *dgramOpt) MulticastLoopback() (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	return on == 1, nil
+	return on != 0, nil
 }
 
 // SetMulticastLoopback sets whether transmitted multicast packets
@@ -113,7 +117,11 @@ func (c *dgramOpt) JoinGroup(ifi *net.Interface, group net.Addr) error {
 	if grp == nil {
 		return errMissingAddress
 	}
-	return so.setGroup(c.Conn, ifi, grp)
+	if err := so.setGroup(c.Conn, ifi, grp); err != nil {
+		return err
+	}
+	c.groups.join(ifi, grp, nil)
+	return nil
 }
 
 // LeaveGroup leaves the group address group on the interface ifi
@@ -131,7 +139,11 @@ func (c *dgramOpt) LeaveGroup(ifi *net.Interface, group net.Addr) error {
 	if grp == nil {
 		return errMissingAddress
 	}
-	return so.setGroup(c.Conn, ifi, grp)
+	if err := so.setGroup(c.Conn, ifi, grp); err != nil {
+		return err
+	}
+	c.groups.leave(ifi, grp, nil)
+	return nil
 }
 
 // JoinSourceSpecificGroup joins the source-specific group comprising
@@ -156,7 +168,11 @@ func (c *dgramOpt) JoinSourceSpecificGroup(ifi *net.Interface, group, source net
 	if src == nil {
 		return errMissingAddress
 	}
-	return so.setSourceGroup(c.Conn, ifi, grp, src)
+	if err := so.setSourceGroup(c.Conn, ifi, grp, src); err != nil {
+		return err
+	}
+	c.groups.join(ifi, grp, src)
+	return nil
 }
 
 // LeaveSourceSpecificGroup leaves the source-specific group on the
@@ -177,7 +193,11 @@ func (c *dgramOpt) LeaveSourceSpecificGroup(ifi *net.Interface, group, source ne
 	if src == nil {
 		return errMissingAddress
 	}
-	return so.setSourceGroup(c.Conn, ifi, grp, src)
+	if err := so.setSourceGroup(c.Conn, ifi, grp, src); err != nil {
+		return err
+	}
+	c.groups.leave(ifi, grp, src)
+	return nil
 }
 
 // ExcludeSourceSpecificGroup excludes the source-specific group from
@@ -224,27 +244,29 @@ func (c *dgramOpt) IncludeSourceSpecificGroup(ifi *net.Interface, group, source
 }
 
 // ICMPFilter returns an ICMP filter.
-// Currently only Linux supports this.
+// On platforms other than Linux, it returns the filter that
+// SetICMPFilter keeps on the connection.
 func (c *dgramOpt) ICMPFilter() (*ICMPFilter, error) {
 	if !c.ok() {
 		return nil, errInvalidConn
 	}
 	so, ok := sockOpts[ssoICMPFilter]
 	if !ok {
-		return nil, errNotImplemented
+		return c.filter.get()
 	}
 	return so.getICMPFilter(c.Conn)
 }
 
 // SetICMPFilter deploys the ICMP filter.
-// Currently only Linux supports this.
+// On platforms other than Linux, the filter is kept on the connection
+// and ReadFrom discards the ICMP messages it blocks.
 func (c *dgramOpt) SetICMPFilter(f *ICMPFilter) error {
 	if !c.ok() {
 		return errInvalidConn
 	}
 	so, ok := sockOpts[ssoICMPFilter]
 	if !ok {
-		return errNotImplemented
+		return c.filter.set(f)
 	}
 	return so.setICMPFilter(c.Conn, f)
 }
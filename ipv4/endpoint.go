@@ -50,6 +50,8 @@ type PacketConn struct {
 
 type dgramOpt struct {
 	*socket.Conn
+	groups membershipSet
+	filter *icmpFilterEmulation
 }
 
 func (c *dgramOpt) ok() bool { return c != nil && c.Conn != nil }
@@ -62,6 +64,19 @@ func (c *PacketConn) SetControlMessage(cf ControlFlags, on bool) error {
 	return setControlMessage(c.dgramOpt.Conn, &c.payloadHandler.rawOpt, cf, on)
 }
 
+// ControlMessageFlags returns the per packet IP-level socket options
+// that are enabled, which decide the fields of the control messages
+// returned by ReadFrom. Options that SetControlMessage skipped because
+// the platform doesn't support them are not included.
+func (c *PacketConn) ControlMessageFlags() ControlFlags {
+	if !c.payloadHandler.ok() {
+		return 0
+	}
+	c.payloadHandler.rawOpt.RLock()
+	defer c.payloadHandler.rawOpt.RUnlock()
+	return c.payloadHandler.rawOpt.cflags
+}
+
 // SetDeadline sets the read and write deadlines associated with the
 // endpoint.
 func (c *PacketConn) SetDeadline(t time.Time) error {
@@ -101,14 +116,28 @@ func (c *PacketConn) Close() error {
 // transport.
 func NewPacketConn(c net.PacketConn) *PacketConn {
 	cc, _ := socket.NewConn(c.(net.Conn))
+	f := newICMPFilterEmulation()
 	p := &PacketConn{
 		genericOpt:     genericOpt{Conn: cc},
-		dgramOpt:       dgramOpt{Conn: cc},
-		payloadHandler: payloadHandler{PacketConn: c, Conn: cc},
+		dgramOpt:       dgramOpt{Conn: cc, filter: f},
+		payloadHandler: payloadHandler{PacketConn: c, Conn: cc, filter: f},
 	}
 	return p
 }
 
+// NewPacketConnWithControl is like NewPacketConn, but also enables
+// the per packet IP-level socket options specified by cf, so that
+// ReadFrom returns them in its control message. Options that the
+// platform doesn't support are skipped, and missing from the control
+// messages.
+func NewPacketConnWithControl(c net.PacketConn, cf ControlFlags) (*PacketConn, error) {
+	p := NewPacketConn(c)
+	if err := p.SetControlMessage(cf, true); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
 // A RawConn represents a packet network endpoint that uses the IPv4
 // transport. It is used to control several IP-level socket options
 // including IPv4 header manipulation. It also provides datagram
@@ -128,6 +157,19 @@ func (c *RawConn) SetControlMessage(cf ControlFlags, on bool) error {
 	return setControlMessage(c.dgramOpt.Conn, &c.packetHandler.rawOpt, cf, on)
 }
 
+// ControlMessageFlags returns the per packet IP-level socket options
+// that are enabled, which decide the fields of the control messages
+// returned by ReadFrom. Options that SetControlMessage skipped because
+// the platform doesn't support them are not included.
+func (c *RawConn) ControlMessageFlags() ControlFlags {
+	if !c.packetHandler.ok() {
+		return 0
+	}
+	c.packetHandler.rawOpt.RLock()
+	defer c.packetHandler.rawOpt.RUnlock()
+	return c.packetHandler.rawOpt.cflags
+}
+
 // SetDeadline sets the read and write deadlines associated with the
 // endpoint.
 func (c *RawConn) SetDeadline(t time.Time) error {
@@ -170,10 +212,11 @@ func NewRawConn(c net.PacketConn) (*RawConn, error) {
 	if err != nil {
 		return nil, err
 	}
+	f := newICMPFilterEmulation()
 	r := &RawConn{
 		genericOpt:    genericOpt{Conn: cc},
-		dgramOpt:      dgramOpt{Conn: cc},
-		packetHandler: packetHandler{IPConn: c.(*net.IPConn), Conn: cc},
+		dgramOpt:      dgramOpt{Conn: cc, filter: f},
+		packetHandler: packetHandler{IPConn: c.(*net.IPConn), Conn: cc, filter: f},
 	}
 	so, ok := sockOpts[ssoHeaderPrepend]
 	if !ok {
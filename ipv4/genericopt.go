@@ -4,6 +4,12 @@
 
 package ipv4
 
+import (
+	"net"
+
+	"golang.org/x/net/internal/socket"
+)
+
 // TOS returns the type-of-service field value for outgoing packets.
 func (c *genericOpt) TOS() (int, error) {
 	if !c.ok() {
@@ -53,3 +59,63 @@ func (c *genericOpt) SetTTL(ttl int) error {
 	}
 	return so.SetInt(c.Conn, ttl)
 }
+
+// SetBindToDevice binds the endpoint to the interface ifi, so that it
+// only receives packets arriving on ifi, and sends packets through
+// ifi. A nil ifi unbinds the endpoint.
+// Currently only Darwin and Linux support this. On Linux, binding
+// requires the CAP_NET_RAW capability, unless the kernel is 5.7 or
+// later.
+func (c *genericOpt) SetBindToDevice(ifi *net.Interface) error {
+	if !c.ok() {
+		return errInvalidConn
+	}
+	so, ok := sockOpts[ssoBindToDevice]
+	if !ok {
+		return errNotImplemented
+	}
+	return so.setBindToDevice(c.Conn, ifi)
+}
+
+// SocketOption reads the value of the socket option at level with name
+// into b, and returns the number of bytes read. It's for options that
+// this package has no accessors for; their levels, names and values
+// are platform-dependent.
+func (c *genericOpt) SocketOption(level, name int, b []byte) (int, error) {
+	if !c.ok() {
+		return 0, errInvalidConn
+	}
+	o := socket.Option{Level: level, Name: name, Len: len(b)}
+	return o.Get(c.Conn, b)
+}
+
+// SetSocketOption sets the socket option at level with name to b.
+// It's for options that this package has no accessors for; their
+// levels, names and values are platform-dependent.
+func (c *genericOpt) SetSocketOption(level, name int, b []byte) error {
+	if !c.ok() {
+		return errInvalidConn
+	}
+	o := socket.Option{Level: level, Name: name, Len: len(b)}
+	return o.Set(c.Conn, b)
+}
+
+// IntSocketOption is like SocketOption, but for an option whose value
+// is a C int.
+func (c *genericOpt) IntSocketOption(level, name int) (int, error) {
+	if !c.ok() {
+		return 0, errInvalidConn
+	}
+	o := socket.Option{Level: level, Name: name, Len: 4}
+	return o.GetInt(c.Conn)
+}
+
+// SetIntSocketOption is like SetSocketOption, but for an option whose
+// value is a C int.
+func (c *genericOpt) SetIntSocketOption(level, name, v int) error {
+	if !c.ok() {
+		return errInvalidConn
+	}
+	o := socket.Option{Level: level, Name: name, Len: 4}
+	return o.SetInt(c.Conn, v)
+}
@@ -20,6 +20,10 @@ const (
 
 type HeaderFlags int
 
+// maxOptionsLen is the maximum length of the options of a header, which
+// must fit in its 4-bit header length field in units of 4 bytes.
+const maxOptionsLen = 0x0f<<2 - HeaderLen
+
 const (
 	MoreFragments HeaderFlags = 1 << iota // more fragments flag
 	DontFragment                          // don't fragment flag
@@ -61,6 +65,9 @@ func (h *Header) Marshal() ([]byte, error) {
 	if h.Len < HeaderLen {
 		return nil, errHeaderTooShort
 	}
+	if len(h.Options)%4 != 0 || len(h.Options) > maxOptionsLen {
+		return nil, errInvalidOptions
+	}
 	hdrlen := HeaderLen + len(h.Options)
 	b := make([]byte, hdrlen)
 	b[0] = byte(Version<<4 | (hdrlen >> 2 & 0x0f))
@@ -113,6 +120,9 @@ func (h *Header) Parse(b []byte) error {
 		return errHeaderTooShort
 	}
 	hdrlen := int(b[0]&0x0f) << 2
+	if hdrlen < HeaderLen {
+		return errHeaderTooShort
+	}
 	if len(b) < hdrlen {
 		return errExtHeaderTooShort
 	}
@@ -147,13 +157,15 @@ func (h *Header) Parse(b []byte) error {
 	h.Flags = HeaderFlags(h.FragOff&0xe000) >> 13
 	h.FragOff = h.FragOff & 0x1fff
 	optlen := hdrlen - HeaderLen
-	if optlen > 0 && len(b) >= hdrlen {
+	if optlen > 0 {
 		if cap(h.Options) < optlen {
 			h.Options = make([]byte, optlen)
 		} else {
 			h.Options = h.Options[:optlen]
 		}
 		copy(h.Options, b[HeaderLen:hdrlen])
+	} else {
+		h.Options = h.Options[:0]
 	}
 	return nil
 }
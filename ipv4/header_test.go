@@ -163,6 +163,8 @@ func TestMarshalHeader(t *testing.T) {
 	}{
 		{nil, errNilHeader},
 		{&Header{Len: HeaderLen - 1}, errHeaderTooShort},
+		{&Header{Len: HeaderLen, Dst: net.IPv4(192, 168, 0, 1), Options: []byte{0x01}}, errInvalidOptions},
+		{&Header{Len: HeaderLen, Dst: net.IPv4(192, 168, 0, 1), Options: make([]byte, 44)}, errInvalidOptions},
 	} {
 		if _, err := tt.h.Marshal(); err != tt.err {
 			t.Errorf("#%d: got %v; want %v", i, err, tt.err)
@@ -208,6 +210,7 @@ func TestParseHeader(t *testing.T) {
 		{nil, nil, errNilHeader},
 		{&Header{}, nil, errNilHeader},
 		{&Header{}, make([]byte, HeaderLen-1), errHeaderTooShort},
+		{&Header{}, append([]byte{0x44}, make([]byte, HeaderLen-1)...), errHeaderTooShort},
 		{&Header{}, []byte{
 			0x46, 0x00, 0x00, 0x00,
 			0x00, 0x00, 0x00, 0x00,
@@ -257,3 +260,44 @@ func TestParseHeader(t *testing.T) {
 		}
 	}
 }
+
+func TestHeaderOptionsRoundTrip(t *testing.T) {
+	h := &Header{
+		Version:  Version,
+		Len:      HeaderLen,
+		TOS:      1,
+		TotalLen: 0xbeef,
+		ID:       0xcafe,
+		TTL:      255,
+		Protocol: 89,
+		Src:      net.IPv4(172, 16, 254, 254),
+		Dst:      net.IPv4(224, 0, 0, 5),
+		Options:  []byte{0x94, 0x04, 0x00, 0x00}, // router alert
+	}
+	b, err := h.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) != HeaderLen+len(h.Options) {
+		t.Fatalf("got %d bytes; want %d", len(b), HeaderLen+len(h.Options))
+	}
+	var got Header
+	if err := got.Parse(b); err != nil {
+		t.Fatal(err)
+	}
+	if got.Len != len(b) || !bytes.Equal(got.Options, h.Options) {
+		t.Fatalf("got len=%d options=%#v; want len=%d options=%#v", got.Len, got.Options, len(b), h.Options)
+	}
+
+	// A reused Header doesn't keep the options of an earlier packet.
+	h.Options = nil
+	if b, err = h.Marshal(); err != nil {
+		t.Fatal(err)
+	}
+	if err := got.Parse(b); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Options) != 0 {
+		t.Fatalf("got options=%#v; want none", got.Options)
+	}
+}
@@ -18,6 +18,11 @@ var (
 	errNilHeader         = errors.New("nil header")
 	errHeaderTooShort    = errors.New("header too short")
 	errExtHeaderTooShort = errors.New("extension header too short")
+	errInvalidOptions    = errors.New("invalid options")
+	errInvalidPMTUDMode  = errors.New("invalid path MTU discovery mode")
+	errInvalidTTL        = errors.New("invalid time-to-live")
+	errShortBuffer       = errors.New("short buffer")
+	errInvalidIfName     = errors.New("invalid interface name")
 	errInvalidConnType   = errors.New("invalid conn type")
 	errNotImplemented    = errors.New("not implemented on " + runtime.GOOS + "/" + runtime.GOARCH)
 
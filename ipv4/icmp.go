@@ -4,7 +4,11 @@
 
 package ipv4
 
-import "golang.org/x/net/internal/iana"
+import (
+	"sync"
+
+	"golang.org/x/net/internal/iana"
+)
 
 // An ICMPType represents a type of ICMP message.
 type ICMPType int
@@ -30,6 +34,11 @@ func (typ ICMPType) Protocol() int {
 // only for IPv6 but IPv4. A node means a device that implements IP.
 // A router means a node that forwards IP packets not explicitly
 // addressed to itself, and a host means a node that is not a router.
+//
+// Only ICMP types below 32 can be blocked. On platforms other than
+// Linux, the kernel can't filter ICMP messages, so SetICMPFilter keeps
+// the filter on the connection instead and ReadFrom discards the
+// messages it blocks. ReadBatch doesn't apply such a filter.
 type ICMPFilter struct {
 	icmpFilter
 }
@@ -55,3 +64,54 @@ func (f *ICMPFilter) SetAll(block bool) {
 func (f *ICMPFilter) WillBlock(typ ICMPType) bool {
 	return f.willBlock(typ)
 }
+
+// An icmpFilterEmulation holds the ICMP filter of a connection on
+// platforms where the kernel can't filter ICMP messages, for ReadFrom
+// to apply. It is shared by the parts of a PacketConn or RawConn.
+type icmpFilterEmulation struct {
+	mu sync.RWMutex
+	f  *ICMPFilter // nil until SetICMPFilter is called
+}
+
+// newICMPFilterEmulation returns a new icmpFilterEmulation, or nil if
+// the kernel filters ICMP messages itself.
+func newICMPFilterEmulation() *icmpFilterEmulation {
+	if _, ok := sockOpts[ssoICMPFilter]; ok {
+		return nil
+	}
+	return new(icmpFilterEmulation)
+}
+
+func (e *icmpFilterEmulation) get() (*ICMPFilter, error) {
+	if e == nil {
+		return nil, errNotImplemented
+	}
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	f := new(ICMPFilter)
+	if e.f != nil {
+		*f = *e.f
+	}
+	return f, nil
+}
+
+func (e *icmpFilterEmulation) set(f *ICMPFilter) error {
+	if e == nil {
+		return errNotImplemented
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	ff := *f
+	e.f = &ff
+	return nil
+}
+
+// blocks reports whether the filter blocks the ICMP message b.
+func (e *icmpFilterEmulation) blocks(b []byte) bool {
+	if e == nil || len(b) == 0 {
+		return false
+	}
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.f != nil && e.f.WillBlock(ICMPType(b[0]))
+}
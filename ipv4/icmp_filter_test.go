@@ -0,0 +1,95 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ipv4
+
+import (
+	"net"
+	"runtime"
+	"testing"
+	"time"
+
+	"golang.org/x/net/internal/iana"
+	"golang.org/x/net/nettest"
+)
+
+func TestICMPFilterEmulation(t *testing.T) {
+	if !nettest.SupportsRawSocket() {
+		t.Skipf("not supported on %s/%s", runtime.GOOS, runtime.GOARCH)
+	}
+
+	// Both readers receive the echo request sent to the loopback
+	// address before its reply, unless the filter discards it.
+	var filter ICMPFilter
+	filter.Block(ICMPTypeEcho)
+
+	c1, err := net.ListenPacket("ip4:icmp", "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c1.Close()
+	p := NewPacketConn(c1)
+	p.dgramOpt.filter = new(icmpFilterEmulation)
+	p.payloadHandler.filter = p.dgramOpt.filter
+	if err := p.dgramOpt.filter.set(&filter); err != nil {
+		t.Fatal(err)
+	}
+
+	c2, err := net.ListenPacket("ip4:icmp", "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c2.Close()
+	r, err := NewRawConn(c2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.dgramOpt.filter = new(icmpFilterEmulation)
+	r.packetHandler.filter = r.dgramOpt.filter
+	if err := r.dgramOpt.filter.set(&filter); err != nil {
+		t.Fatal(err)
+	}
+
+	if f, err := p.dgramOpt.filter.get(); err != nil || *f != filter {
+		t.Fatalf("got %v, %v; want %v", f, err, filter)
+	}
+
+	// An echo request with identifier 1 and sequence number 1.
+	echo := []byte{byte(ICMPTypeEcho), 0, 0, 0, 0, 1, 0, 1, 'H', 'E', 'L', 'O'}
+	s := checksum(echo)
+	echo[2], echo[3] = byte(s>>8), byte(s)
+	if _, err := p.WriteTo(echo, nil, &net.IPAddr{IP: net.IPv4(127, 0, 0, 1)}); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(3 * time.Second)
+	p.SetReadDeadline(deadline)
+	r.SetReadDeadline(deadline)
+	b := make([]byte, 128)
+	n, _, _, err := p.ReadFrom(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n == 0 || ICMPType(b[0]) != ICMPTypeEchoReply {
+		t.Errorf("PacketConn.ReadFrom: got % x; want an echo reply", b[:n])
+	}
+	h, pl, _, err := r.ReadFrom(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.Protocol != iana.ProtocolICMP || len(pl) == 0 || ICMPType(pl[0]) != ICMPTypeEchoReply {
+		t.Errorf("RawConn.ReadFrom: got %v, % x; want an echo reply", h, pl)
+	}
+}
+
+func checksum(b []byte) uint16 {
+	var s uint32
+	for i := 0; i+1 < len(b); i += 2 {
+		s += uint32(b[i])<<8 | uint32(b[i+1])
+	}
+	for s>>16 != 0 {
+		s = s&0xffff + s>>16
+	}
+	return ^uint16(s)
+}
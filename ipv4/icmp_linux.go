@@ -4,12 +4,21 @@
 
 package ipv4
 
+// The kernel only filters the ICMP types below 32; messages of other
+// types are always delivered.
+
 func (f *icmpFilter) accept(typ ICMPType) {
-	f.Data &^= 1 << (uint32(typ) & 31)
+	if typ < 0 || typ > 31 {
+		return
+	}
+	f.Data &^= 1 << uint32(typ)
 }
 
 func (f *icmpFilter) block(typ ICMPType) {
-	f.Data |= 1 << (uint32(typ) & 31)
+	if typ < 0 || typ > 31 {
+		return
+	}
+	f.Data |= 1 << uint32(typ)
 }
 
 func (f *icmpFilter) setAll(block bool) {
@@ -21,5 +30,8 @@ func (f *icmpFilter) setAll(block bool) {
 }
 
 func (f *icmpFilter) willBlock(typ ICMPType) bool {
-	return f.Data&(1<<(uint32(typ)&31)) != 0
+	if typ < 0 || typ > 31 {
+		return false
+	}
+	return f.Data&(1<<uint32(typ)) != 0
 }
@@ -9,18 +9,38 @@ package ipv4
 
 const sizeofICMPFilter = 0x0
 
+// An icmpFilter can't be installed on a socket, but keeps the same
+// bitmap as the Linux kernel's, for ReadFrom to filter received
+// messages with.
 type icmpFilter struct {
+	data uint32
 }
 
 func (f *icmpFilter) accept(typ ICMPType) {
+	if typ < 0 || typ > 31 {
+		return
+	}
+	f.data &^= 1 << uint32(typ)
 }
 
 func (f *icmpFilter) block(typ ICMPType) {
+	if typ < 0 || typ > 31 {
+		return
+	}
+	f.data |= 1 << uint32(typ)
 }
 
 func (f *icmpFilter) setAll(block bool) {
+	if block {
+		f.data = 1<<32 - 1
+	} else {
+		f.data = 0
+	}
 }
 
 func (f *icmpFilter) willBlock(typ ICMPType) bool {
-	return false
+	if typ < 0 || typ > 31 {
+		return false
+	}
+	return f.data&(1<<uint32(typ)) != 0
 }
@@ -33,12 +33,6 @@ func TestICMPString(t *testing.T) {
 }
 
 func TestICMPFilter(t *testing.T) {
-	switch runtime.GOOS {
-	case "linux":
-	default:
-		t.Skipf("not supported on %s", runtime.GOOS)
-	}
-
 	var f ipv4.ICMPFilter
 	for _, toggle := range []bool{false, true} {
 		f.SetAll(toggle)
@@ -58,6 +52,15 @@ func TestICMPFilter(t *testing.T) {
 			}
 		}
 	}
+	// Types above 31 are never blocked.
+	f.SetAll(false)
+	f.Block(ipv4.ICMPTypeExtendedEchoRequest)
+	if f.WillBlock(ipv4.ICMPTypeExtendedEchoRequest) {
+		t.Errorf("ipv4.ICMPFilter.WillBlock(%v) = true; want false", ipv4.ICMPTypeExtendedEchoRequest)
+	}
+	if f.WillBlock(ipv4.ICMPTypeExtendedEchoRequest - 32) {
+		t.Errorf("ipv4.ICMPFilter.Block(%v) blocked type %d", ipv4.ICMPTypeExtendedEchoRequest, ipv4.ICMPTypeExtendedEchoRequest-32)
+	}
 }
 
 func TestSetICMPFilter(t *testing.T) {
@@ -0,0 +1,122 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ipv4
+
+import (
+	"net"
+	"sort"
+	"sync"
+)
+
+// A Membership represents a multicast group joined by an endpoint.
+type Membership struct {
+	Interface *net.Interface // interface passed to the join method, may be nil
+	Group     net.IP         // group address
+	Source    net.IP         // source address of a source-specific group, nil otherwise
+}
+
+func (m *Membership) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	ifname := ""
+	if m.Interface != nil {
+		ifname = m.Interface.Name
+	}
+	if m.Source == nil {
+		return "if=" + ifname + " group=" + m.Group.String()
+	}
+	return "if=" + ifname + " group=" + m.Group.String() + " src=" + m.Source.String()
+}
+
+type membershipKey struct {
+	ifindex int
+	group   [net.IPv4len]byte
+	source  [net.IPv4len]byte // zero for an any-source group
+}
+
+// A membershipSet records the groups joined through an endpoint.
+// Groups joined or left by other means, such as another endpoint
+// sharing the socket, are not reflected.
+type membershipSet struct {
+	mu sync.Mutex
+	m  map[membershipKey]Membership
+}
+
+func newMembershipKey(ifi *net.Interface, grp, src net.IP) membershipKey {
+	var k membershipKey
+	if ifi != nil {
+		k.ifindex = ifi.Index
+	}
+	copy(k.group[:], grp.To4())
+	if src != nil {
+		copy(k.source[:], src.To4())
+	}
+	return k
+}
+
+func (s *membershipSet) join(ifi *net.Interface, grp, src net.IP) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.m == nil {
+		s.m = make(map[membershipKey]Membership)
+	}
+	s.m[newMembershipKey(ifi, grp, src)] = Membership{Interface: ifi, Group: grp, Source: src}
+}
+
+// leave removes the group joined with src on ifi. If src is nil, it
+// removes the any-source group and all source-specific groups of grp
+// on ifi, as leaving an any-source group does both.
+func (s *membershipSet) leave(ifi *net.Interface, grp, src net.IP) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	k := newMembershipKey(ifi, grp, src)
+	if src != nil {
+		delete(s.m, k)
+		return
+	}
+	for mk := range s.m {
+		if mk.ifindex == k.ifindex && mk.group == k.group {
+			delete(s.m, mk)
+		}
+	}
+}
+
+func (s *membershipSet) list() []Membership {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.m) == 0 {
+		return nil
+	}
+	keys := make([]membershipKey, 0, len(s.m))
+	for k := range s.m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.ifindex != b.ifindex {
+			return a.ifindex < b.ifindex
+		}
+		if a.group != b.group {
+			return string(a.group[:]) < string(b.group[:])
+		}
+		return string(a.source[:]) < string(b.source[:])
+	})
+	ms := make([]Membership, len(keys))
+	for i, k := range keys {
+		ms[i] = s.m[k]
+	}
+	return ms
+}
+
+// JoinedGroups returns the multicast groups joined through the
+// endpoint by JoinGroup and JoinSourceSpecificGroup, and not yet left
+// through it, ordered by interface index, group and source.
+func (c *dgramOpt) JoinedGroups() []Membership {
+	if !c.ok() {
+		return nil
+	}
+	return c.groups.list()
+}
@@ -263,3 +263,62 @@ func TestIPPerInterfaceSingleRawConnWithSingleGroupListener(t *testing.T) {
 		}
 	}
 }
+
+func TestPacketConnJoinedGroups(t *testing.T) {
+	switch runtime.GOOS {
+	case "fuchsia", "hurd", "js", "nacl", "plan9", "windows", "zos":
+		t.Skipf("not supported on %s", runtime.GOOS)
+	}
+	if testing.Short() {
+		t.Skip("to avoid external network")
+	}
+
+	c, err := net.ListenPacket("udp4", "0.0.0.0:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	p := ipv4.NewPacketConn(c)
+
+	ift, err := net.Interfaces()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ifi *net.Interface
+	for i := range ift {
+		if _, err := nettest.MulticastSource("ip4", &ift[i]); err == nil {
+			ifi = &ift[i]
+			break
+		}
+	}
+	if ifi == nil {
+		t.Skip("no multicast interface")
+	}
+
+	if ms := p.JoinedGroups(); len(ms) != 0 {
+		t.Fatalf("got %v; want no groups", ms)
+	}
+	for _, gaddr := range udpMultipleGroupListenerTests {
+		if err := p.JoinGroup(ifi, gaddr); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ms := p.JoinedGroups()
+	if len(ms) != len(udpMultipleGroupListenerTests) {
+		t.Fatalf("got %v; want %d groups", ms, len(udpMultipleGroupListenerTests))
+	}
+	for i, m := range ms {
+		want := udpMultipleGroupListenerTests[i].(*net.UDPAddr).IP
+		if m.Interface != ifi || !m.Group.Equal(want) || m.Source != nil {
+			t.Errorf("#%d: got %v; want if=%s group=%v", i, &m, ifi.Name, want)
+		}
+	}
+	for _, gaddr := range udpMultipleGroupListenerTests {
+		if err := p.LeaveGroup(ifi, gaddr); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if ms := p.JoinedGroups(); len(ms) != 0 {
+		t.Fatalf("got %v after leaving; want no groups", ms)
+	}
+}
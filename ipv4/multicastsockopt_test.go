@@ -125,6 +125,11 @@ func testMulticastSocketOptions(t *testing.T, c testIPv4MulticastConn, ifi *net.
 		t.Errorf("got %v; want %v", v, ttl)
 		return
 	}
+	for _, ttl := range []int{-1, 256} {
+		if err := c.SetMulticastTTL(ttl); err == nil {
+			t.Errorf("SetMulticastTTL(%d) succeeded; want error", ttl)
+		}
+	}
 
 	for _, toggle := range []bool{true, false} {
 		if err := c.SetMulticastLoopback(toggle); err != nil {
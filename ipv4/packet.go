@@ -7,6 +7,7 @@ package ipv4
 import (
 	"net"
 
+	"golang.org/x/net/internal/iana"
 	"golang.org/x/net/internal/socket"
 )
 
@@ -18,6 +19,7 @@ type packetHandler struct {
 	*net.IPConn
 	*socket.Conn
 	rawOpt
+	filter *icmpFilterEmulation
 }
 
 func (c *packetHandler) ok() bool { return c != nil && c.IPConn != nil && c.Conn != nil }
@@ -35,15 +37,20 @@ func (c *packetHandler) ReadFrom(b []byte) (h *Header, p []byte, cm *ControlMess
 		OOB:     NewControlMessage(c.rawOpt.cflags),
 	}
 	c.rawOpt.RUnlock()
-	if err := c.RecvMsg(&m, 0); err != nil {
-		return nil, nil, nil, &net.OpError{Op: "read", Net: c.IPConn.LocalAddr().Network(), Source: c.IPConn.LocalAddr(), Err: err}
-	}
-	var hs []byte
-	if hs, p, err = slicePacket(b[:m.N]); err != nil {
-		return nil, nil, nil, &net.OpError{Op: "read", Net: c.IPConn.LocalAddr().Network(), Source: c.IPConn.LocalAddr(), Err: err}
-	}
-	if h, err = ParseHeader(hs); err != nil {
-		return nil, nil, nil, &net.OpError{Op: "read", Net: c.IPConn.LocalAddr().Network(), Source: c.IPConn.LocalAddr(), Err: err}
+	for {
+		if err := c.RecvMsg(&m, 0); err != nil {
+			return nil, nil, nil, &net.OpError{Op: "read", Net: c.IPConn.LocalAddr().Network(), Source: c.IPConn.LocalAddr(), Err: err}
+		}
+		var hs []byte
+		if hs, p, err = slicePacket(b[:m.N]); err != nil {
+			return nil, nil, nil, &net.OpError{Op: "read", Net: c.IPConn.LocalAddr().Network(), Source: c.IPConn.LocalAddr(), Err: err}
+		}
+		if h, err = ParseHeader(hs); err != nil {
+			return nil, nil, nil, &net.OpError{Op: "read", Net: c.IPConn.LocalAddr().Network(), Source: c.IPConn.LocalAddr(), Err: err}
+		}
+		if h.Protocol != iana.ProtocolICMP || !c.filter.blocks(p) {
+			break
+		}
 	}
 	if m.NN > 0 {
 		if compatFreeBSD32 {
@@ -92,8 +99,10 @@ func (c *packetHandler) WriteTo(h *Header, p []byte, cm *ControlMessage) error {
 	if !c.ok() {
 		return errInvalidConn
 	}
-	m := socket.Message{
-		OOB: cm.Marshal(),
+	var m socket.Message
+	if oob := getOOB(cm); oob != nil {
+		defer putOOB(oob)
+		m.OOB = *oob
 	}
 	wh, err := h.Marshal()
 	if err != nil {
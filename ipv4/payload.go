@@ -18,6 +18,7 @@ type payloadHandler struct {
 	net.PacketConn
 	*socket.Conn
 	rawOpt
+	filter *icmpFilterEmulation
 }
 
 func (c *payloadHandler) ok() bool { return c != nil && c.PacketConn != nil && c.Conn != nil }
@@ -10,6 +10,7 @@ package ipv4
 import (
 	"net"
 
+	"golang.org/x/net/internal/iana"
 	"golang.org/x/net/internal/socket"
 )
 
@@ -34,17 +35,22 @@ func (c *payloadHandler) ReadFrom(b []byte) (n int, cm *ControlMessage, src net.
 		}
 	case *net.IPConn:
 		h := make([]byte, HeaderLen)
-		m.Buffers = [][]byte{h, b}
-		if err := c.RecvMsg(&m, 0); err != nil {
-			return 0, nil, nil, &net.OpError{Op: "read", Net: c.PacketConn.LocalAddr().Network(), Source: c.PacketConn.LocalAddr(), Err: err}
-		}
-		hdrlen := int(h[0]&0x0f) << 2
-		if hdrlen > len(h) {
-			d := hdrlen - len(h)
-			copy(b, b[d:])
-			m.N -= d
-		} else {
-			m.N -= hdrlen
+		for {
+			m.Buffers = [][]byte{h, b}
+			if err := c.RecvMsg(&m, 0); err != nil {
+				return 0, nil, nil, &net.OpError{Op: "read", Net: c.PacketConn.LocalAddr().Network(), Source: c.PacketConn.LocalAddr(), Err: err}
+			}
+			hdrlen := int(h[0]&0x0f) << 2
+			if hdrlen > len(h) {
+				d := hdrlen - len(h)
+				copy(b, b[d:])
+				m.N -= d
+			} else {
+				m.N -= hdrlen
+			}
+			if int(h[9]) != iana.ProtocolICMP || !c.filter.blocks(b[:m.N]) {
+				break
+			}
 		}
 	default:
 		return 0, nil, nil, &net.OpError{Op: "read", Net: c.PacketConn.LocalAddr().Network(), Source: c.PacketConn.LocalAddr(), Err: errInvalidConnType}
@@ -74,9 +80,12 @@ func (c *payloadHandler) WriteTo(b []byte, cm *ControlMessage, dst net.Addr) (n
 	}
 	m := socket.Message{
 		Buffers: [][]byte{b},
-		OOB:     cm.Marshal(),
 		Addr:    dst,
 	}
+	if oob := getOOB(cm); oob != nil {
+		defer putOOB(oob)
+		m.OOB = *oob
+	}
 	err = c.SendMsg(&m, 0)
 	if err != nil {
 		err = &net.OpError{Op: "write", Net: c.PacketConn.LocalAddr().Network(), Source: c.PacketConn.LocalAddr(), Addr: opAddr(dst), Err: err}
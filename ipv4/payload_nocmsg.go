@@ -17,10 +17,16 @@ func (c *payloadHandler) ReadFrom(b []byte) (n int, cm *ControlMessage, src net.
 	if !c.ok() {
 		return 0, nil, nil, errInvalidConn
 	}
-	if n, src, err = c.PacketConn.ReadFrom(b); err != nil {
-		return 0, nil, nil, err
+	for {
+		if n, src, err = c.PacketConn.ReadFrom(b); err != nil {
+			return 0, nil, nil, err
+		}
+		// An IPConn strips the IPv4 header, so the filter, which is
+		// only set on ICMP connections, applies to every message.
+		if _, ok := c.PacketConn.(*net.IPConn); !ok || !c.filter.blocks(b[:n]) {
+			return
+		}
 	}
-	return
 }
 
 // WriteTo writes a payload of the IPv4 datagram, to the destination
@@ -0,0 +1,150 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ipv4
+
+import (
+	"fmt"
+	"net"
+)
+
+// A PMTUDMode represents a path MTU discovery mode, which decides
+// whether outgoing packets have the don't fragment flag set.
+type PMTUDMode int
+
+const (
+	PMTUDDont      PMTUDMode = iota // never set the don't fragment flag
+	PMTUDWant                       // use the per-route setting
+	PMTUDDo                         // always set the don't fragment flag
+	PMTUDProbe                      // set the don't fragment flag, ignoring the path MTU
+	PMTUDInterface                  // never set the don't fragment flag, and use the interface MTU, ignoring the path MTU
+	PMTUDOmit                       // like PMTUDInterface, but let packets larger than the interface MTU be fragmented
+)
+
+var pmtudModes = map[PMTUDMode]string{
+	PMTUDDont:      "dont",
+	PMTUDWant:      "want",
+	PMTUDDo:        "do",
+	PMTUDProbe:     "probe",
+	PMTUDInterface: "interface",
+	PMTUDOmit:      "omit",
+}
+
+func (m PMTUDMode) String() string {
+	s, ok := pmtudModes[m]
+	if !ok {
+		return "<nil>"
+	}
+	return s
+}
+
+// PMTUD returns the path MTU discovery mode for outgoing packets.
+//
+// On Darwin and FreeBSD, which only have the don't fragment flag, it
+// returns PMTUDDo or PMTUDDont.
+func (c *genericOpt) PMTUD() (PMTUDMode, error) {
+	if !c.ok() {
+		return 0, errInvalidConn
+	}
+	if so, ok := sockOpts[ssoMTUDiscover]; ok {
+		v, err := so.GetInt(c.Conn)
+		return PMTUDMode(v), err
+	}
+	so, ok := sockOpts[ssoDontFragment]
+	if !ok {
+		return 0, errNotImplemented
+	}
+	v, err := so.GetInt(c.Conn)
+	if err != nil {
+		return 0, err
+	}
+	if v != 0 {
+		return PMTUDDo, nil
+	}
+	return PMTUDDont, nil
+}
+
+// SetPMTUD sets the path MTU discovery mode for future outgoing
+// packets.
+//
+// On Darwin and FreeBSD, which only have the don't fragment flag,
+// PMTUDDo and PMTUDProbe set the flag, and the other modes clear it.
+func (c *genericOpt) SetPMTUD(mode PMTUDMode) error {
+	if !c.ok() {
+		return errInvalidConn
+	}
+	if _, ok := pmtudModes[mode]; !ok {
+		return errInvalidPMTUDMode
+	}
+	if so, ok := sockOpts[ssoMTUDiscover]; ok {
+		return so.SetInt(c.Conn, int(mode))
+	}
+	so, ok := sockOpts[ssoDontFragment]
+	if !ok {
+		return errNotImplemented
+	}
+	return so.SetInt(c.Conn, boolint(mode == PMTUDDo || mode == PMTUDProbe))
+}
+
+// PathMTU returns the path MTU known to the kernel for the
+// destination of a connected endpoint. After a write fails because a
+// packet with the don't fragment flag set is too large, it returns
+// the MTU reported by the network. Unconnected endpoints can read the
+// MTU of each failed packet with ReadMTUError instead.
+// Currently only Linux supports this.
+func (c *genericOpt) PathMTU() (int, error) {
+	if !c.ok() {
+		return 0, errInvalidConn
+	}
+	so, ok := sockOpts[ssoPathMTU]
+	if !ok {
+		return 0, errNotImplemented
+	}
+	return so.GetInt(c.Conn)
+}
+
+// An MTUError reports that an outgoing packet was too large for the
+// path to its destination.
+type MTUError struct {
+	Dst net.IP // destination address of the packet
+	MTU int    // path MTU, the largest packet size the path allows
+
+	// Reporter is the address of the router that reported the
+	// error in an ICMP message, or nil if the error is local.
+	Reporter net.IP
+}
+
+func (e *MTUError) Error() string {
+	return fmt.Sprintf("ipv4: packet to %v exceeds path MTU %d", e.Dst, e.MTU)
+}
+
+// SetRecvErrors sets whether the kernel queues the errors of outgoing
+// packets, including those reported by ICMP messages, for
+// ReadMTUError to read.
+// Currently only Linux supports this.
+func (c *genericOpt) SetRecvErrors(on bool) error {
+	if !c.ok() {
+		return errInvalidConn
+	}
+	so, ok := sockOpts[ssoRecvErr]
+	if !ok {
+		return errNotImplemented
+	}
+	return so.SetInt(c.Conn, boolint(on))
+}
+
+// ReadMTUError returns the next MTU error queued for the endpoint once
+// SetRecvErrors is enabled, discarding the other errors queued before
+// it. It doesn't block: if no MTU error is queued, it returns nil, nil.
+// Currently only Linux supports this.
+func (c *PacketConn) ReadMTUError() (*MTUError, error) {
+	if !c.payloadHandler.ok() {
+		return nil, errInvalidConn
+	}
+	e, err := readMTUError(c.payloadHandler.Conn)
+	if err != nil {
+		return nil, &net.OpError{Op: "read", Net: c.payloadHandler.PacketConn.LocalAddr().Network(), Source: c.payloadHandler.PacketConn.LocalAddr(), Err: err}
+	}
+	return e, nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ipv4
+
+import (
+	"errors"
+	"net"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/net/internal/iana"
+	"golang.org/x/net/internal/socket"
+
+	"golang.org/x/sys/unix"
+)
+
+func readMTUError(c *socket.Conn) (*MTUError, error) {
+	// The error is followed by the address of the node that reported
+	// it.
+	oob := make([]byte, socket.ControlMessageSpace(sizeofSockExtendedErr+sizeofSockaddrInet))
+	for {
+		m := socket.Message{OOB: oob}
+		if err := c.RecvMsg(&m, unix.MSG_ERRQUEUE|unix.MSG_DONTWAIT); err != nil {
+			if errors.Is(err, syscall.EAGAIN) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		if e := parseMTUError(m.OOB[:m.NN], m.Addr); e != nil {
+			return e, nil
+		}
+	}
+}
+
+// parseMTUError returns the MTU error in the control message b,
+// received from the error queue for a packet sent to dst, or nil if b
+// holds another error.
+func parseMTUError(b []byte, dst net.Addr) *MTUError {
+	ms, err := socket.ControlMessage(b).Parse()
+	if err != nil {
+		return nil
+	}
+	for _, m := range ms {
+		lvl, typ, l, err := m.ParseHeader()
+		if err != nil || lvl != iana.ProtocolIP || typ != unix.IP_RECVERR || l < sizeofSockExtendedErr {
+			continue
+		}
+		data := m.Data(l)
+		ee := (*sockExtendedErr)(unsafe.Pointer(&data[0]))
+		if syscall.Errno(ee.Errno) != syscall.EMSGSIZE {
+			continue
+		}
+		e := &MTUError{MTU: int(ee.Info)}
+		switch a := dst.(type) {
+		case *net.UDPAddr:
+			e.Dst = a.IP
+		case *net.IPAddr:
+			e.Dst = a.IP
+		}
+		if ee.Origin == unix.SO_EE_ORIGIN_ICMP && l >= sizeofSockExtendedErr+sizeofSockaddrInet {
+			sa := (*sockaddrInet)(unsafe.Pointer(&data[sizeofSockExtendedErr]))
+			if sa.Family == syscall.AF_INET {
+				e.Reporter = net.IPv4(sa.Addr[0], sa.Addr[1], sa.Addr[2], sa.Addr[3])
+			}
+		}
+		return e
+	}
+	return nil
+}
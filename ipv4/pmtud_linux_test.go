@@ -0,0 +1,89 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ipv4
+
+import (
+	"net"
+	"syscall"
+	"testing"
+	"unsafe"
+
+	"golang.org/x/net/internal/iana"
+	"golang.org/x/net/internal/socket"
+
+	"golang.org/x/sys/unix"
+)
+
+// marshalRecvErr returns an IP_RECVERR control message holding ee and
+// the address of the node that reported it.
+func marshalRecvErr(ee sockExtendedErr, reporter net.IP) []byte {
+	l := sizeofSockExtendedErr + sizeofSockaddrInet
+	b := make([]byte, socket.ControlMessageSpace(l))
+	m := socket.ControlMessage(b)
+	m.MarshalHeader(iana.ProtocolIP, unix.IP_RECVERR, l)
+	data := m.Data(l)
+	copy(data, (*[sizeofSockExtendedErr]byte)(unsafe.Pointer(&ee))[:])
+	if reporter != nil {
+		sa := sockaddrInet{Family: syscall.AF_INET}
+		copy(sa.Addr[:], reporter.To4())
+		copy(data[sizeofSockExtendedErr:], (*[sizeofSockaddrInet]byte)(unsafe.Pointer(&sa))[:])
+	}
+	return b
+}
+
+func TestParseMTUError(t *testing.T) {
+	dst := &net.UDPAddr{IP: net.IPv4(192, 0, 2, 1), Port: 443}
+	router := net.IPv4(198, 51, 100, 1)
+
+	for _, tt := range []struct {
+		name string
+		oob  []byte
+		want *MTUError
+	}{
+		{
+			"icmp",
+			marshalRecvErr(sockExtendedErr{
+				Errno:  uint32(syscall.EMSGSIZE),
+				Origin: unix.SO_EE_ORIGIN_ICMP,
+				Type:   3, // destination unreachable
+				Code:   4, // fragmentation needed
+				Info:   1280,
+			}, router),
+			&MTUError{Dst: dst.IP, MTU: 1280, Reporter: router},
+		},
+		{
+			"local",
+			marshalRecvErr(sockExtendedErr{
+				Errno:  uint32(syscall.EMSGSIZE),
+				Origin: unix.SO_EE_ORIGIN_LOCAL,
+				Info:   1500,
+			}, nil),
+			&MTUError{Dst: dst.IP, MTU: 1500},
+		},
+		{
+			"port unreachable",
+			marshalRecvErr(sockExtendedErr{
+				Errno:  uint32(syscall.ECONNREFUSED),
+				Origin: unix.SO_EE_ORIGIN_ICMP,
+				Type:   3,
+				Code:   3,
+			}, net.IPv4(192, 0, 2, 1)),
+			nil,
+		},
+		{"empty", nil, nil},
+	} {
+		got := parseMTUError(tt.oob, dst)
+		if (got == nil) != (tt.want == nil) {
+			t.Errorf("%s: got %v; want %v", tt.name, got, tt.want)
+			continue
+		}
+		if got == nil {
+			continue
+		}
+		if !got.Dst.Equal(tt.want.Dst) || got.MTU != tt.want.MTU || !got.Reporter.Equal(tt.want.Reporter) || (got.Reporter == nil) != (tt.want.Reporter == nil) {
+			t.Errorf("%s: got %+v; want %+v", tt.name, got, tt.want)
+		}
+	}
+}
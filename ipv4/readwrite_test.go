@@ -298,6 +298,27 @@ func TestPacketConnConcurrentReadWriteUnicastUDP(t *testing.T) {
 	wg.Wait()
 }
 
+func TestPacketConnEmptyBatch(t *testing.T) {
+	switch runtime.GOOS {
+	case "fuchsia", "hurd", "js", "nacl", "plan9", "windows":
+		t.Skipf("not supported on %s", runtime.GOOS)
+	}
+
+	c, err := nettest.NewLocalPacketListener("udp4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	p := ipv4.NewPacketConn(c)
+
+	if n, err := p.WriteBatch(nil, 0); n != 0 || err != nil {
+		t.Errorf("WriteBatch(nil) = %d, %v; want 0, nil", n, err)
+	}
+	if n, err := p.ReadBatch(nil, 0); n != 0 || err != nil {
+		t.Errorf("ReadBatch(nil) = %d, %v; want 0, nil", n, err)
+	}
+}
+
 func TestPacketConnConcurrentReadWriteUnicast(t *testing.T) {
 	switch runtime.GOOS {
 	case "fuchsia", "hurd", "js", "nacl", "plan9", "windows":
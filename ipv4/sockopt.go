@@ -14,6 +14,7 @@ const (
 	ssoMulticastInterface        // outbound interface for multicast packet
 	ssoMulticastLoopback         // loopback for multicast packet
 	ssoReceiveTTL                // header field on received packet
+	ssoReceiveTOS                // header field on received packet
 	ssoReceiveDst                // header field on received packet
 	ssoReceiveInterface          // inbound interface on received packet
 	ssoPacketInfo                // incbound or outbound packet path
@@ -27,6 +28,13 @@ const (
 	ssoBlockSourceGroup          // any-source or source-specific multicast
 	ssoUnblockSourceGroup        // any-source or source-specific multicast
 	ssoAttachFilter              // attach BPF for filtering inbound traffic
+	ssoMTUDiscover               // path MTU discovery mode
+	ssoDontFragment              // don't fragment flag for outgoing packets
+	ssoPathMTU                   // path MTU of connected socket
+	ssoRecvErr                   // queueing of errors of outgoing packets
+	ssoTransparent               // transparent proxying
+	ssoFreebind                  // binding to non-local address
+	ssoBindToDevice              // interface bound to the socket
 )
 
 // Sticky socket option value types
@@ -35,6 +43,7 @@ const (
 	ssoTypeIPMreqn
 	ssoTypeGroupReq
 	ssoTypeGroupSourceReq
+	ssoTypeDeviceName
 )
 
 // A sockOpt represents a binding for sticky socket option.
@@ -0,0 +1,47 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ipv4_test
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/nettest"
+	"golang.org/x/sys/unix"
+)
+
+func TestPacketConnSetBindToDevice(t *testing.T) {
+	ifi, err := nettest.RoutedInterface("ip4", net.FlagUp|net.FlagLoopback)
+	if err != nil {
+		t.Skip("no loopback interface")
+	}
+	c, err := nettest.NewLocalPacketListener("udp4")
+	if err != nil {
+		t.Skip(err)
+	}
+	defer c.Close()
+	p := ipv4.NewPacketConn(c)
+
+	if err := p.SetBindToDevice(ifi); err != nil {
+		if errors.Is(err, unix.EPERM) {
+			t.Skip(err)
+		}
+		t.Fatal(err)
+	}
+	b := make([]byte, unix.IFNAMSIZ)
+	n, err := p.SocketOption(unix.SOL_SOCKET, unix.SO_BINDTODEVICE, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimRight(string(b[:n]), "\x00"); got != ifi.Name {
+		t.Errorf("bound to %q; want %q", got, ifi.Name)
+	}
+	if err := p.SetBindToDevice(nil); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -67,6 +67,29 @@ func (so *sockOpt) setSourceGroup(c *socket.Conn, ifi *net.Interface, grp, src n
 	return so.setGroupSourceReq(c, ifi, grp, src)
 }
 
+func (so *sockOpt) setBindToDevice(c *socket.Conn, ifi *net.Interface) error {
+	switch so.typ {
+	case ssoTypeDeviceName:
+		// An empty, NUL-terminated name unbinds the socket.
+		var name string
+		if ifi != nil {
+			name = ifi.Name
+		}
+		if len(name) >= so.Len {
+			return errInvalidIfName
+		}
+		o := so.Option
+		o.Len = len(name) + 1
+		return o.Set(c, append([]byte(name), 0))
+	default:
+		var index int
+		if ifi != nil {
+			index = ifi.Index
+		}
+		return so.SetInt(c, index)
+	}
+}
+
 func (so *sockOpt) setBPF(c *socket.Conn, f []bpf.RawInstruction) error {
 	return so.setAttachFilter(c, f)
 }
@@ -38,6 +38,10 @@ func (so *sockOpt) setSourceGroup(c *socket.Conn, ifi *net.Interface, grp, src n
 	return errNotImplemented
 }
 
+func (so *sockOpt) setBindToDevice(c *socket.Conn, ifi *net.Interface) error {
+	return errNotImplemented
+}
+
 func (so *sockOpt) setBPF(c *socket.Conn, f []bpf.RawInstruction) error {
 	return errNotImplemented
 }
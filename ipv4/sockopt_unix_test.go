@@ -0,0 +1,53 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+// +build aix darwin dragonfly freebsd linux netbsd openbsd solaris
+
+package ipv4_test
+
+import (
+	"testing"
+
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/nettest"
+	"golang.org/x/sys/unix"
+)
+
+func TestPacketConnSocketOption(t *testing.T) {
+	c, err := nettest.NewLocalPacketListener("udp4")
+	if err != nil {
+		t.Skip(err)
+	}
+	defer c.Close()
+	p := ipv4.NewPacketConn(c)
+
+	const ttl = 42
+	if err := p.SetIntSocketOption(unix.IPPROTO_IP, unix.IP_TTL, ttl); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := p.TTL(); err != nil {
+		t.Fatal(err)
+	} else if v != ttl {
+		t.Errorf("got TTL %d; want %d", v, ttl)
+	}
+	if v, err := p.IntSocketOption(unix.IPPROTO_IP, unix.IP_TTL); err != nil {
+		t.Fatal(err)
+	} else if v != ttl {
+		t.Errorf("got %d; want %d", v, ttl)
+	}
+
+	b := make([]byte, 4)
+	if n, err := p.SocketOption(unix.IPPROTO_IP, unix.IP_TTL, b); err != nil {
+		t.Fatal(err)
+	} else if n != len(b) {
+		t.Errorf("got %d bytes; want %d", n, len(b))
+	}
+	if err := p.SetSocketOption(unix.IPPROTO_IP, unix.IP_TTL, b); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.SocketOption(unix.IPPROTO_IP, unix.IP_TTL, nil); err == nil {
+		t.Error("SocketOption with empty buffer succeeded")
+	}
+}
@@ -22,6 +22,7 @@ const sockoptReceiveInterface = unix.IP_RECVIF
 var (
 	ctlOpts = [ctlMax]ctlOpt{
 		ctlTTL:       {unix.IP_RECVTTL, 1, marshalTTL, parseTTL},
+		ctlSrc:       {sockoptSendSrcAddr, net.IPv4len, marshalSrc, nil},
 		ctlDst:       {unix.IP_RECVDSTADDR, net.IPv4len, marshalDst, parseDst},
 		ctlInterface: {unix.IP_RECVIF, syscall.SizeofSockaddrDatalink, marshalInterface, parseInterface},
 	}
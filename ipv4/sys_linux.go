@@ -19,6 +19,9 @@ var (
 	ctlOpts = [ctlMax]ctlOpt{
 		ctlTTL:        {unix.IP_TTL, 1, marshalTTL, parseTTL},
 		ctlPacketInfo: {unix.IP_PKTINFO, sizeofInetPktinfo, marshalPacketInfo, parsePacketInfo},
+		ctlTOS:        {unix.IP_TOS, 1, marshalTOS, parseTOS},
+		ctlSendTTL:    {unix.IP_TTL, 4, marshalSendTTL, nil},
+		ctlSendTOS:    {unix.IP_TOS, 4, marshalSendTOS, nil},
 	}
 
 	sockOpts = map[int]*sockOpt{
@@ -28,6 +31,7 @@ var (
 		ssoMulticastInterface: {Option: socket.Option{Level: iana.ProtocolIP, Name: unix.IP_MULTICAST_IF, Len: unix.SizeofIPMreqn}, typ: ssoTypeIPMreqn},
 		ssoMulticastLoopback:  {Option: socket.Option{Level: iana.ProtocolIP, Name: unix.IP_MULTICAST_LOOP, Len: 4}},
 		ssoReceiveTTL:         {Option: socket.Option{Level: iana.ProtocolIP, Name: unix.IP_RECVTTL, Len: 4}},
+		ssoReceiveTOS:         {Option: socket.Option{Level: iana.ProtocolIP, Name: unix.IP_RECVTOS, Len: 4}},
 		ssoPacketInfo:         {Option: socket.Option{Level: iana.ProtocolIP, Name: unix.IP_PKTINFO, Len: 4}},
 		ssoHeaderPrepend:      {Option: socket.Option{Level: iana.ProtocolIP, Name: unix.IP_HDRINCL, Len: 4}},
 		ssoICMPFilter:         {Option: socket.Option{Level: iana.ProtocolReserved, Name: unix.ICMP_FILTER, Len: sizeofICMPFilter}},
@@ -38,6 +42,12 @@ var (
 		ssoBlockSourceGroup:   {Option: socket.Option{Level: iana.ProtocolIP, Name: unix.MCAST_BLOCK_SOURCE, Len: sizeofGroupSourceReq}, typ: ssoTypeGroupSourceReq},
 		ssoUnblockSourceGroup: {Option: socket.Option{Level: iana.ProtocolIP, Name: unix.MCAST_UNBLOCK_SOURCE, Len: sizeofGroupSourceReq}, typ: ssoTypeGroupSourceReq},
 		ssoAttachFilter:       {Option: socket.Option{Level: unix.SOL_SOCKET, Name: unix.SO_ATTACH_FILTER, Len: unix.SizeofSockFprog}},
+		ssoMTUDiscover:        {Option: socket.Option{Level: iana.ProtocolIP, Name: unix.IP_MTU_DISCOVER, Len: 4}},
+		ssoPathMTU:            {Option: socket.Option{Level: iana.ProtocolIP, Name: unix.IP_MTU, Len: 4}},
+		ssoRecvErr:            {Option: socket.Option{Level: iana.ProtocolIP, Name: unix.IP_RECVERR, Len: 4}},
+		ssoTransparent:        {Option: socket.Option{Level: iana.ProtocolIP, Name: unix.IP_TRANSPARENT, Len: 4}},
+		ssoFreebind:           {Option: socket.Option{Level: iana.ProtocolIP, Name: unix.IP_FREEBIND, Len: 4}},
+		ssoBindToDevice:       {Option: socket.Option{Level: unix.SOL_SOCKET, Name: unix.SO_BINDTODEVICE, Len: unix.IFNAMSIZ}, typ: ssoTypeDeviceName},
 	}
 )
 
@@ -0,0 +1,69 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ipv4
+
+// Transparent reports whether the endpoint is in transparent proxy
+// mode.
+// Currently only Linux supports this.
+func (c *genericOpt) Transparent() (bool, error) {
+	return c.getBool(ssoTransparent)
+}
+
+// SetTransparent sets whether the endpoint is in transparent proxy
+// mode, in which it can send from and receive on non-local
+// addresses, as needed with the TPROXY firewall target. Enabling it
+// requires the CAP_NET_ADMIN capability.
+//
+// To bind to a non-local address, the mode must be set before the
+// socket is bound, for instance by calling SetsockoptInt from the
+// Control function of a net.ListenConfig.
+// Currently only Linux supports this.
+func (c *genericOpt) SetTransparent(on bool) error {
+	return c.setBool(ssoTransparent, on)
+}
+
+// FreeBind reports whether the endpoint may be bound to an address
+// that is non-local or doesn't exist yet.
+// Currently only Linux supports this.
+func (c *genericOpt) FreeBind() (bool, error) {
+	return c.getBool(ssoFreebind)
+}
+
+// SetFreeBind sets whether the endpoint may be bound to an address
+// that is non-local or doesn't exist yet, such as an anycast address
+// that is only configured while a service is up.
+//
+// Like SetTransparent, it only affects binding if called before the
+// socket is bound.
+// Currently only Linux supports this.
+func (c *genericOpt) SetFreeBind(on bool) error {
+	return c.setBool(ssoFreebind, on)
+}
+
+func (c *genericOpt) getBool(name int) (bool, error) {
+	if !c.ok() {
+		return false, errInvalidConn
+	}
+	so, ok := sockOpts[name]
+	if !ok {
+		return false, errNotImplemented
+	}
+	v, err := so.GetInt(c.Conn)
+	if err != nil {
+		return false, err
+	}
+	return v != 0, nil
+}
+
+func (c *genericOpt) setBool(name int, on bool) error {
+	if !c.ok() {
+		return errInvalidConn
+	}
+	so, ok := sockOpts[name]
+	if !ok {
+		return errNotImplemented
+	}
+	return so.SetInt(c.Conn, boolint(on))
+}
@@ -70,6 +70,102 @@ func TestPacketConnReadWriteUnicastUDP(t *testing.T) {
 	}
 }
 
+func TestPacketConnReadTOS(t *testing.T) {
+	switch runtime.GOOS {
+	case "darwin", "freebsd", "linux":
+	default:
+		t.Skipf("not supported on %s", runtime.GOOS)
+	}
+	if _, err := nettest.RoutedInterface("ip4", net.FlagUp|net.FlagLoopback); err != nil {
+		t.Skipf("not available on %s", runtime.GOOS)
+	}
+
+	c, err := nettest.NewLocalPacketListener("udp4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	cf := ipv4.FlagTTL | ipv4.FlagTOS | ipv4.FlagDst | ipv4.FlagInterface
+	p, err := ipv4.NewPacketConnWithControl(c, cf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	const tos = 0x28
+	if err := p.SetTOS(tos); err != nil {
+		t.Fatal(err)
+	}
+	dst := c.LocalAddr()
+	wb := []byte("HELLO-R-U-THERE")
+	if err := p.SetDeadline(time.Now().Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.WriteTo(wb, nil, dst); err != nil {
+		t.Fatal(err)
+	}
+	rb := make([]byte, 128)
+	_, cm, _, err := p.ReadFrom(rb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cm == nil {
+		t.Fatal("no control message")
+	}
+	if cm.TOS != tos {
+		t.Errorf("got TOS %#x; want %#x (%v)", cm.TOS, tos, cm)
+	}
+	if cm.TTL <= 0 || cm.IfIndex <= 0 || !cm.Dst.Equal(dst.(*net.UDPAddr).IP) {
+		t.Errorf("got %v; want positive TTL and interface index, and destination %v", cm, dst)
+	}
+}
+
+func TestPacketConnWriteTTLTOS(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skipf("not supported on %s", runtime.GOOS)
+	}
+	if _, err := nettest.RoutedInterface("ip4", net.FlagUp|net.FlagLoopback); err != nil {
+		t.Skipf("not available on %s", runtime.GOOS)
+	}
+
+	c, err := nettest.NewLocalPacketListener("udp4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	p, err := ipv4.NewPacketConnWithControl(c, ipv4.FlagTTL|ipv4.FlagTOS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+	if got, want := p.ControlMessageFlags(), ipv4.FlagTTL|ipv4.FlagTOS; got != want {
+		t.Fatalf("got flags %#x; want %#x", got, want)
+	}
+
+	dst := c.LocalAddr()
+	wb := []byte("HELLO-R-U-THERE")
+	rb := make([]byte, 128)
+	if err := p.SetDeadline(time.Now().Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []ipv4.ControlMessage{
+		{TTL: 7, TOS: 0x28},
+		{TTL: 255, TOS: 0xb8},
+		{TTL: 1},
+	} {
+		if _, err := p.WriteTo(wb, &want, dst); err != nil {
+			t.Fatal(err)
+		}
+		_, cm, _, err := p.ReadFrom(rb)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cm == nil || cm.TTL != want.TTL || cm.TOS != want.TOS {
+			t.Errorf("got %v; want ttl=%d tos=%#x", cm, want.TTL, want.TOS)
+		}
+	}
+}
+
 func TestPacketConnReadWriteUnicastICMP(t *testing.T) {
 	switch runtime.GOOS {
 	case "fuchsia", "hurd", "js", "nacl", "plan9", "windows":
@@ -145,3 +145,136 @@ func testUnicastSocketOptions(t *testing.T, c testIPv4UnicastConn) {
 		t.Fatalf("got %v; want %v", v, ttl)
 	}
 }
+
+func TestConnPMTUD(t *testing.T) {
+	switch runtime.GOOS {
+	case "darwin", "freebsd", "linux":
+	default:
+		t.Skipf("not supported on %s", runtime.GOOS)
+	}
+	if _, err := nettest.RoutedInterface("ip4", net.FlagUp|net.FlagLoopback); err != nil {
+		t.Skipf("not available on %s", runtime.GOOS)
+	}
+
+	ln, err := nettest.NewLocalPacketListener("udp4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	c, err := net.Dial("udp4", ln.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	p := ipv4.NewConn(c)
+
+	modes := []ipv4.PMTUDMode{ipv4.PMTUDDo, ipv4.PMTUDDont}
+	if runtime.GOOS == "linux" {
+		modes = append(modes, ipv4.PMTUDWant, ipv4.PMTUDProbe, ipv4.PMTUDInterface, ipv4.PMTUDOmit)
+	}
+	for _, mode := range modes {
+		if err := p.SetPMTUD(mode); err != nil {
+			t.Fatal(err)
+		}
+		if got, err := p.PMTUD(); err != nil {
+			t.Fatal(err)
+		} else if got != mode {
+			t.Errorf("got %v; want %v", got, mode)
+		}
+	}
+	if err := p.SetPMTUD(ipv4.PMTUDMode(-1)); err == nil {
+		t.Error("SetPMTUD with invalid mode succeeded")
+	}
+	if runtime.GOOS == "linux" {
+		if mtu, err := p.PathMTU(); err != nil {
+			t.Fatal(err)
+		} else if mtu <= 0 {
+			t.Errorf("got path MTU %d; want positive", mtu)
+		}
+	}
+}
+
+func TestPMTUDModeString(t *testing.T) {
+	for mode, want := range map[ipv4.PMTUDMode]string{
+		ipv4.PMTUDDont:      "dont",
+		ipv4.PMTUDWant:      "want",
+		ipv4.PMTUDDo:        "do",
+		ipv4.PMTUDProbe:     "probe",
+		ipv4.PMTUDInterface: "interface",
+		ipv4.PMTUDOmit:      "omit",
+		6:                   "<nil>",
+	} {
+		if got := mode.String(); got != want {
+			t.Errorf("PMTUDMode(%d).String() = %q; want %q", int(mode), got, want)
+		}
+	}
+}
+
+func TestPacketConnReadMTUError(t *testing.T) {
+	switch runtime.GOOS {
+	case "linux":
+	default:
+		t.Skipf("not supported on %s", runtime.GOOS)
+	}
+
+	c, err := nettest.NewLocalPacketListener("udp4")
+	if err != nil {
+		t.Skip(err)
+	}
+	defer c.Close()
+	p := ipv4.NewPacketConn(c)
+	if err := p.SetRecvErrors(true); err != nil {
+		t.Fatal(err)
+	}
+	// Nothing has been sent, so there is no error to read, and
+	// ReadMTUError doesn't wait for one.
+	if e, err := p.ReadMTUError(); e != nil || err != nil {
+		t.Fatalf("got %v, %v; want nil, nil", e, err)
+	}
+
+	// A packet to a closed port queues a port unreachable error,
+	// which isn't an MTU error.
+	ln, err := nettest.NewLocalPacketListener("udp4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dst := ln.LocalAddr()
+	ln.Close()
+	if _, err := p.WriteTo([]byte("HELLO"), nil, dst); err != nil {
+		t.Fatal(err)
+	}
+	if e, err := p.ReadMTUError(); e != nil || err != nil {
+		t.Fatalf("got %v, %v; want nil, nil", e, err)
+	}
+}
+
+func TestPacketConnFreeBindTransparent(t *testing.T) {
+	c, err := nettest.NewLocalPacketListener("udp4")
+	if err != nil {
+		t.Skip(err)
+	}
+	defer c.Close()
+	p := ipv4.NewPacketConn(c)
+
+	if runtime.GOOS != "linux" {
+		if err := p.SetFreeBind(true); err == nil {
+			t.Errorf("SetFreeBind succeeded on %s", runtime.GOOS)
+		}
+		return
+	}
+	for _, on := range []bool{true, false} {
+		if err := p.SetFreeBind(on); err != nil {
+			t.Fatal(err)
+		}
+		if v, err := p.FreeBind(); err != nil {
+			t.Fatal(err)
+		} else if v != on {
+			t.Errorf("got %v; want %v", v, on)
+		}
+	}
+	if err := p.SetTransparent(true); err != nil {
+		t.Logf("SetTransparent: %v", err) // needs CAP_NET_ADMIN
+	} else if v, err := p.Transparent(); err != nil || !v {
+		t.Errorf("Transparent() = %v, %v; want true, nil", v, err)
+	}
+}
@@ -80,6 +80,19 @@ func (c *PacketConn) SetControlMessage(cf ControlFlags, on bool) error {
 	return setControlMessage(c.dgramOpt.Conn, &c.payloadHandler.rawOpt, cf, on)
 }
 
+// ControlMessageFlags returns the per packet IP-level socket options
+// that are enabled, which decide the fields of the control messages
+// returned by ReadFrom. Options that SetControlMessage skipped because
+// the platform doesn't support them are not included.
+func (c *PacketConn) ControlMessageFlags() ControlFlags {
+	if !c.payloadHandler.ok() {
+		return 0
+	}
+	c.payloadHandler.rawOpt.RLock()
+	defer c.payloadHandler.rawOpt.RUnlock()
+	return c.payloadHandler.rawOpt.cflags
+}
+
 // SetDeadline sets the read and write deadlines associated with the
 // endpoint.
 func (c *PacketConn) SetDeadline(t time.Time) error {
@@ -125,3 +138,16 @@ func NewPacketConn(c net.PacketConn) *PacketConn {
 		payloadHandler: payloadHandler{PacketConn: c, Conn: cc},
 	}
 }
+
+// NewPacketConnWithControl is like NewPacketConn, but also enables
+// the per packet IP-level socket options specified by cf, so that
+// ReadFrom returns them in its control message. Options that the
+// platform doesn't support are skipped, and missing from the control
+// messages.
+func NewPacketConnWithControl(c net.PacketConn, cf ControlFlags) (*PacketConn, error) {
+	p := NewPacketConn(c)
+	if err := p.SetControlMessage(cf, true); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
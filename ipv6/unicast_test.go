@@ -76,6 +76,51 @@ func TestPacketConnReadWriteUnicastUDP(t *testing.T) {
 	}
 }
 
+func TestPacketConnWithControl(t *testing.T) {
+	switch runtime.GOOS {
+	case "fuchsia", "hurd", "js", "nacl", "plan9", "windows":
+		t.Skipf("not supported on %s", runtime.GOOS)
+	}
+	if !nettest.SupportsIPv6() {
+		t.Skip("ipv6 is not supported")
+	}
+
+	c, err := nettest.NewLocalPacketListener("udp6")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	cf := ipv6.FlagHopLimit | ipv6.FlagDst | ipv6.FlagInterface
+	p, err := ipv6.NewPacketConnWithControl(c, cf)
+	if err != nil {
+		if protocolNotSupported(err) {
+			t.Skipf("not supported on %s", runtime.GOOS)
+		}
+		t.Fatal(err)
+	}
+	defer p.Close()
+	if got := p.ControlMessageFlags(); got != cf {
+		t.Errorf("got flags %#x; want %#x", got, cf)
+	}
+
+	dst := c.LocalAddr()
+	wb := []byte("HELLO-R-U-THERE")
+	if err := p.SetDeadline(time.Now().Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.WriteTo(wb, nil, dst); err != nil {
+		t.Fatal(err)
+	}
+	rb := make([]byte, 128)
+	_, cm, _, err := p.ReadFrom(rb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cm == nil || cm.HopLimit <= 0 || !cm.Dst.Equal(dst.(*net.UDPAddr).IP) {
+		t.Errorf("got %v; want positive hop limit and destination %v", cm, dst)
+	}
+}
+
 func TestPacketConnReadWriteUnicastICMP(t *testing.T) {
 	switch runtime.GOOS {
 	case "fuchsia", "hurd", "js", "nacl", "plan9", "windows", "zos":
@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/url"
 	"os"
@@ -113,6 +114,92 @@ func TestSOCKS5(t *testing.T) {
 	c.Close()
 }
 
+func TestSOCKS5Auth(t *testing.T) {
+	authFunc := func(rw io.ReadWriter, b []byte) error {
+		req, err := sockstest.ParseAuthRequest(b)
+		if err != nil {
+			return err
+		}
+		offered := false
+		for _, m := range req.Methods {
+			if m == socks.AuthMethodUsernamePassword {
+				offered = true
+			}
+		}
+		if !offered {
+			b, _ := sockstest.MarshalAuthReply(socks.Version5, socks.AuthMethodNoAcceptableMethods)
+			rw.Write(b)
+			return errors.New("username/password authentication not offered")
+		}
+		b, _ = sockstest.MarshalAuthReply(socks.Version5, socks.AuthMethodUsernamePassword)
+		if _, err := rw.Write(b); err != nil {
+			return err
+		}
+		// See RFC 1929.
+		b = make([]byte, 513)
+		n, err := rw.Read(b)
+		if err != nil {
+			return err
+		}
+		b = b[:n]
+		if len(b) < 2 || len(b) < 3+int(b[1]) || len(b) != 3+int(b[1])+int(b[2+b[1]]) {
+			return errors.New("malformed username/password request")
+		}
+		user, password := string(b[2:2+b[1]]), string(b[3+b[1]:])
+		if user != "user" || password != "password" {
+			rw.Write([]byte{0x01, 0x01})
+			return errors.New("authentication failed")
+		}
+		_, err = rw.Write([]byte{0x01, 0x00})
+		return err
+	}
+	targets := make(chan socks.Addr, 1)
+	cmdFunc := func(rw io.ReadWriter, b []byte) error {
+		req, err := sockstest.ParseCmdRequest(b)
+		if err != nil {
+			return err
+		}
+		targets <- req.Addr
+		return sockstest.NoProxyRequired(rw, b)
+	}
+	ss, err := sockstest.NewServer(authFunc, cmdFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ss.Close()
+
+	proxy, err := SOCKS5("tcp", ss.Addr().String(), &Auth{User: "user", Password: "password"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		address string
+		want    socks.Addr
+	}{
+		{"192.0.2.1:5963", socks.Addr{IP: net.IPv4(192, 0, 2, 1).To4(), Port: 5963}},
+		{"[2001:db8::1]:5963", socks.Addr{IP: net.ParseIP("2001:db8::1"), Port: 5963}},
+		{"fqdn.doesnotexist:5963", socks.Addr{Name: "fqdn.doesnotexist", Port: 5963}},
+	} {
+		c, err := proxy.(ContextDialer).DialContext(context.Background(), "tcp", tt.address)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.address, err)
+		}
+		c.Close()
+		if got := <-targets; got.String() != tt.want.String() || !got.IP.Equal(tt.want.IP) {
+			t.Errorf("%s: proxy got target %v; want %v", tt.address, &got, &tt.want)
+		}
+	}
+
+	proxy, err = SOCKS5("tcp", ss.Addr().String(), &Auth{User: "user", Password: "wrong"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c, err := proxy.Dial("tcp", "fqdn.doesnotexist:5963"); err == nil {
+		c.Close()
+		t.Fatal("dial with wrong password succeeded")
+	}
+}
+
 type funcFailDialer func(context.Context) error
 
 func (f funcFailDialer) Dial(net, addr string) (net.Conn, error) {
@@ -14,6 +14,10 @@ import (
 // SOCKS5 returns a Dialer that makes SOCKSv5 connections to the given
 // address with an optional username and password.
 // See RFC 1928 and RFC 1929.
+//
+// The returned Dialer also implements ContextDialer. Target addresses
+// with IPv4 or IPv6 literals are sent as such; host names are sent
+// unresolved, for the proxy server to resolve.
 func SOCKS5(network, address string, auth *Auth, forward Dialer) (Dialer, error) {
 	d := socks.NewDialer(network, address)
 	if forward != nil {
@@ -0,0 +1,68 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package publicsuffix
+
+import "fmt"
+
+// A Builder builds a RuleSet from rules added one at a time, such as to
+// treat an organization's internal domains as public suffixes. To extend
+// the public list, add its rules first:
+//
+//	var b publicsuffix.Builder
+//	b.AddRules(publicsuffix.ChildRules(""))
+//	b.AddRule("corp.example", publicsuffix.Private)
+//	b.AddException("www.corp.example", publicsuffix.Private)
+//	rs := b.Build()
+//
+// The zero value is an empty Builder ready to use.
+type Builder struct {
+	rs *RuleSet
+}
+
+// AddRule adds a rule in the list's syntax, such as "corp.example",
+// "*.corp.example", or "!www.corp.example", in the given section, which
+// must be ICANN or Private. A rule that was added before is replaced.
+// Rules in Unicode are converted to Punycode.
+func (b *Builder) AddRule(pattern string, section Section) error {
+	if section != ICANN && section != Private {
+		return fmt.Errorf("publicsuffix: rule %q added in section %v", pattern, section)
+	}
+	if b.rs == nil {
+		b.rs = new(RuleSet)
+	}
+	if err := b.rs.add(pattern, section); err != nil {
+		return fmt.Errorf("publicsuffix: %v", err)
+	}
+	return nil
+}
+
+// AddException adds an exception rule for domain, so that domain isn't a
+// public suffix even if a wildcard rule matches it. It's equivalent to
+// adding the rule "!" + domain.
+func (b *Builder) AddException(domain string, section Section) error {
+	return b.AddRule("!"+domain, section)
+}
+
+// AddRules adds rules, such as those returned by ChildRules or
+// RuleSet.ChildRules, stopping at the first that can't be added.
+func (b *Builder) AddRules(rules []Rule) error {
+	for _, r := range rules {
+		if err := b.AddRule(r.Pattern, r.Section); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Build returns a RuleSet of the rules added to b, and resets b to be
+// empty.
+func (b *Builder) Build() *RuleSet {
+	rs := b.rs
+	if rs == nil {
+		rs = new(RuleSet)
+	}
+	b.rs = nil
+	return rs
+}
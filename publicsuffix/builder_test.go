@@ -0,0 +1,86 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package publicsuffix
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuilder(t *testing.T) {
+	var b Builder
+	if err := b.AddRules(ChildRules("")); err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range []struct {
+		pattern string
+		section Section
+	}{
+		{"corp.example", Private},
+		{"*.dev.corp.example", Private},
+		{"com", ICANN}, // Already added.
+	} {
+		if err := b.AddRule(r.pattern, r.section); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := b.AddException("www.dev.corp.example", Private); err != nil {
+		t.Fatal(err)
+	}
+	rs := b.Build()
+	if got, want := rs.Len(), len(rules)+3; got != want {
+		t.Errorf("Len = %d; want %d", got, want)
+	}
+
+	for _, tc := range []struct {
+		domain   string
+		wantPS   string
+		wantSect Section
+	}{
+		{"example.com", "com", ICANN},
+		{"foo.kawasaki.jp", "foo.kawasaki.jp", ICANN},
+		{"app.corp.example", "corp.example", Private},
+		{"app.foo.dev.corp.example", "foo.dev.corp.example", Private},
+		{"www.dev.corp.example", "dev.corp.example", Private},
+	} {
+		ps, sect := rs.Lookup(tc.domain)
+		if ps != tc.wantPS || sect != tc.wantSect {
+			t.Errorf("Lookup(%q) = %q, %v; want %q, %v", tc.domain, ps, sect, tc.wantPS, tc.wantSect)
+		}
+	}
+
+	want := []Rule{
+		{"!www.dev.corp.example", Private},
+		{"*.dev.corp.example", Private},
+	}
+	if got := rs.ChildRules("corp.example"); !reflect.DeepEqual(got, want) {
+		t.Errorf("ChildRules = %v; want %v", got, want)
+	}
+
+	// Build resets the Builder.
+	if got := b.Build().Len(); got != 0 {
+		t.Errorf("second Build has %d rules; want 0", got)
+	}
+}
+
+func TestBuilderErrors(t *testing.T) {
+	var b Builder
+	for _, tc := range []struct {
+		pattern string
+		section Section
+	}{
+		{"corp.example", Unlisted},
+		{"", Private},
+		{"foo..example", Private},
+		{"foo.*.example", Private},
+	} {
+		if err := b.AddRule(tc.pattern, tc.section); err == nil {
+			t.Errorf("AddRule(%q, %v) succeeded; want error", tc.pattern, tc.section)
+		}
+	}
+	if err := b.AddRules([]Rule{{"ok.example", Private}, {"bad..example", Private}}); err == nil {
+		t.Errorf("AddRules with a bad rule succeeded; want error")
+	}
+}
@@ -2,6 +2,9 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
+//go:build !publicsuffix_icann
+// +build !publicsuffix_icann
+
 package publicsuffix_test
 
 import (
@@ -91,3 +94,33 @@ func ExamplePublicSuffix_manager() {
 	// >       foo.blogspot.co.uk  blogspot.co.uk  is  Privately Managed
 	// >                cromulent       cromulent  is  Unmanaged
 }
+
+// This example demonstrates grouping host names by their registrable
+// domain, the eTLD+1.
+func ExampleEffectiveTLDPlusOne() {
+	for _, host := range []string{
+		"www.books.amazon.co.uk",
+		"books.amazon.co.uk",
+		"maps.google.com",
+		"foo.blogspot.co.uk",
+		"co.uk",
+		"WWW.Example.COM.",
+		"foo..example.com",
+	} {
+		eTLDPlusOne, err := publicsuffix.EffectiveTLDPlusOne(host)
+		if err != nil {
+			fmt.Printf("%s: %v\n", host, err)
+			continue
+		}
+		fmt.Printf("%s: %s\n", host, eTLDPlusOne)
+	}
+
+	// Output:
+	// www.books.amazon.co.uk: amazon.co.uk
+	// books.amazon.co.uk: amazon.co.uk
+	// maps.google.com: google.com
+	// foo.blogspot.co.uk: foo.blogspot.co.uk
+	// co.uk: publicsuffix: cannot derive eTLD+1 for domain "co.uk"
+	// WWW.Example.COM.: Example.COM.
+	// foo..example.com: publicsuffix: empty label in domain "foo..example.com"
+}
@@ -7,8 +7,19 @@
 
 package main
 
-// This program generates table.go and table_test.go based on the authoritative
-// public suffix list at https://publicsuffix.org/list/effective_tld_names.dat
+// This program generates table.go, table_test.go, and data/table based on
+// the authoritative public suffix list at
+// https://publicsuffix.org/list/effective_tld_names.dat
+//
+// The tree of nodes is written to a binary file, which table.go embeds,
+// rather than as Go source, so that it compiles quickly. The nodes' labels
+// and flags are Huffman coded, as described in nodetable.go, and the package
+// decodes the file the first time it's used.
+//
+// With -private=false, it omits the rules in the list's PRIVATE section and
+// generates table_icann.go, table_icann_test.go, and data/icann/table
+// instead. Those are built in place of the others with
+// the publicsuffix_icann build tag.
 //
 // The version is derived from
 // https://api.github.com/repos/publicsuffix/list/commits?path=public_suffix_list.dat
@@ -22,6 +33,7 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"container/heap"
 	"flag"
 	"fmt"
 	"go/format"
@@ -29,6 +41,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"path/filepath"
 	"regexp"
 	"sort"
 	"strings"
@@ -36,47 +49,16 @@ import (
 	"golang.org/x/net/idna"
 )
 
-const (
-	// These sum of these four values must be no greater than 32.
-	nodesBitsChildren   = 10
-	nodesBitsICANN      = 1
-	nodesBitsTextOffset = 15
-	nodesBitsTextLength = 6
-
-	// These sum of these four values must be no greater than 32.
-	childrenBitsWildcard = 1
-	childrenBitsNodeType = 2
-	childrenBitsHi       = 14
-	childrenBitsLo       = 14
-)
-
-var (
-	maxChildren   int
-	maxTextOffset int
-	maxTextLength int
-	maxHi         uint32
-	maxLo         uint32
-)
-
-func max(a, b int) int {
-	if a < b {
-		return b
-	}
-	return a
-}
-
-func u32max(a, b uint32) uint32 {
-	if a < b {
-		return b
-	}
-	return a
-}
-
 const (
 	nodeTypeNormal     = 0
 	nodeTypeException  = 1
 	nodeTypeParentOnly = 2
-	numNodeType        = 3
+
+	// The flags of a node hold its type in the bits of nodeFlagsTypeMask,
+	// and these bits.
+	nodeFlagsTypeMask = 3
+	nodeFlagICANN     = 4
+	nodeFlagWildcard  = 8
 )
 
 func nodeTypeStr(n int) string {
@@ -97,9 +79,6 @@ const (
 )
 
 var (
-	labelEncoding = map[string]uint32{}
-	labelsList    = []string{}
-	labelsMap     = map[string]bool{}
 	rules         = []string{}
 	numICANNRules = 0
 
@@ -111,7 +90,8 @@ var (
 	shaRE  = regexp.MustCompile(`"sha":"([^"]+)"`)
 	dateRE = regexp.MustCompile(`"committer":{[^{]+"date":"([^"]+)"`)
 
-	comments = flag.Bool("comments", false, "generate table.go comments, for debugging")
+	comments = flag.Bool("comments", false, "print the nodes and children tables to stderr, for debugging")
+	private  = flag.Bool("private", true, "include the PRIVATE section of the list; if false, generate table_icann.go and table_icann_test.go")
 	subset   = flag.Bool("subset", false, "generate only a subset of the full table, for debugging")
 	url      = flag.String("url", defaultURL, "URL of the publicsuffix.org list. If empty, stdin is read instead")
 	v        = flag.Bool("v", false, "verbose output (to stderr)")
@@ -127,12 +107,6 @@ func main() {
 
 func main1() error {
 	flag.Parse()
-	if nodesBitsTextLength+nodesBitsTextOffset+nodesBitsICANN+nodesBitsChildren > 32 {
-		return fmt.Errorf("not enough bits to encode the nodes table")
-	}
-	if childrenBitsLo+childrenBitsHi+childrenBitsNodeType+childrenBitsWildcard > 32 {
-		return fmt.Errorf("not enough bits to encode the children table")
-	}
 	if *version == "" {
 		if *url != defaultURL {
 			return fmt.Errorf("-version was not specified, and the -url is not the default one")
@@ -189,6 +163,9 @@ func main1() error {
 		if !validSuffixRE.MatchString(s) {
 			return fmt.Errorf("bad publicsuffix.org list data: %q", s)
 		}
+		if !icann && !*private {
+			continue
+		}
 
 		if *subset {
 			switch {
@@ -235,24 +212,51 @@ func main1() error {
 				n.icann = n.icann && icann
 				n.wildcard = n.wildcard || wildcard
 			}
-			labelsMap[label] = true
 		}
 	}
-	labelsList = make([]string, 0, len(labelsMap))
-	for label := range labelsMap {
-		labelsList = append(labelsList, label)
-	}
-	sort.Strings(labelsList)
+	assignIndexes(&root)
 
-	if err := generate(printReal, &root, "table.go"); err != nil {
+	tableFile, testFile := "table.go", "table_test.go"
+	if !*private {
+		tableFile, testFile = "table_icann.go", "table_icann_test.go"
+	}
+	if err := os.MkdirAll(dataDir(), 0755); err != nil {
 		return err
 	}
-	if err := generate(printTest, &root, "table_test.go"); err != nil {
+	if err := generate(printReal, &root, tableFile); err != nil {
+		return err
+	}
+	if err := generate(printTest, &root, testFile); err != nil {
 		return err
 	}
 	return nil
 }
 
+// printHeader prints the start of a generated file, up to and including the
+// package clause.
+func printHeader(w io.Writer) {
+	tag := "!publicsuffix_icann"
+	if !*private {
+		tag = "publicsuffix_icann"
+	}
+	fmt.Fprintf(w, "// generated by go run gen.go; DO NOT EDIT\n\n")
+	fmt.Fprintf(w, "//go:build %s\n// +build %s\n\npackage publicsuffix\n", tag, tag)
+}
+
+// dataDir returns the directory of the generated binary file, relative to
+// the package directory.
+func dataDir() string {
+	if !*private {
+		return "data/icann"
+	}
+	return "data"
+}
+
+// writeData writes the generated binary file name to dataDir.
+func writeData(name string, b []byte) error {
+	return ioutil.WriteFile(filepath.Join(dataDir(), name), b, 0644)
+}
+
 func generate(p func(io.Writer, *node) error, root *node, filename string) error {
 	buf := new(bytes.Buffer)
 	if err := p(buf, root); err != nil {
@@ -291,42 +295,34 @@ func gitCommit() (sha, date string, retErr error) {
 }
 
 func printTest(w io.Writer, n *node) error {
-	fmt.Fprintf(w, "// generated by go run gen.go; DO NOT EDIT\n\n")
-	fmt.Fprintf(w, "package publicsuffix\n\nconst numICANNRules = %d\n\nvar rules = [...]string{\n", numICANNRules)
+	printHeader(w)
+	fmt.Fprintf(w, "\nconst numICANNRules = %d\n\nvar rules = [...]string{\n", numICANNRules)
 	for _, rule := range rules {
 		fmt.Fprintf(w, "%q,\n", rule)
 	}
 	fmt.Fprintf(w, "}\n\nvar nodeLabels = [...]string{\n")
-	if err := n.walk(w, printNodeLabel); err != nil {
-		return err
+	for _, c := range nodeList {
+		fmt.Fprintf(w, "%q,\n", c.label)
 	}
 	fmt.Fprintf(w, "}\n")
 	return nil
 }
 
 func printReal(w io.Writer, n *node) error {
-	const header = `// generated by go run gen.go; DO NOT EDIT
-
-package publicsuffix
+	printHeader(w)
+	const header = `
+import _ "embed"
 
 const version = %q
 
-const (
-	nodesBitsChildren   = %d
-	nodesBitsICANN      = %d
-	nodesBitsTextOffset = %d
-	nodesBitsTextLength = %d
-
-	childrenBitsWildcard = %d
-	childrenBitsNodeType = %d
-	childrenBitsHi       = %d
-	childrenBitsLo       = %d
-)
-
 const (
 	nodeTypeNormal     = %d
 	nodeTypeException  = %d
 	nodeTypeParentOnly = %d
+
+	nodeFlagsTypeMask = %d
+	nodeFlagICANN     = %d
+	nodeFlagWildcard  = %d
 )
 
 // numTLD is the number of top level domains.
@@ -334,107 +330,229 @@ const numTLD = %d
 
 `
 	fmt.Fprintf(w, header, *version,
-		nodesBitsChildren, nodesBitsICANN, nodesBitsTextOffset, nodesBitsTextLength,
-		childrenBitsWildcard, childrenBitsNodeType, childrenBitsHi, childrenBitsLo,
-		nodeTypeNormal, nodeTypeException, nodeTypeParentOnly, len(n.children))
-
-	text := combineText(labelsList)
-	if text == "" {
-		return fmt.Errorf("internal error: makeText returned no text")
-	}
-	for _, label := range labelsList {
-		offset, length := strings.Index(text, label), len(label)
-		if offset < 0 {
-			return fmt.Errorf("internal error: could not find %q in text %q", label, text)
-		}
-		maxTextOffset, maxTextLength = max(maxTextOffset, offset), max(maxTextLength, length)
-		if offset >= 1<<nodesBitsTextOffset {
-			return fmt.Errorf("text offset %d is too large, or nodeBitsTextOffset is too small", offset)
+		nodeTypeNormal, nodeTypeException, nodeTypeParentOnly,
+		nodeFlagsTypeMask, nodeFlagICANN, nodeFlagWildcard,
+		len(n.children))
+
+	b, err := encodeNodes()
+	if err != nil {
+		return err
+	}
+	if err := writeData("table", b); err != nil {
+		return err
+	}
+	fmt.Fprintf(w, `// tableData is the tree of nodes, in the form described in nodetable.go.
+//
+// If gen.go is run with the -comments flag, it prints each node to stderr.
+// The node's index is followed by the indexes of its children, formatted as
+// (n0x1234-n0x1256), with * denoting the wildcard bit. The nodeType is
+// printed as + for normal, ! for exception, and o for parent-only nodes that
+// have children but don't match a domain label in their own right. An I
+// denotes an ICANN domain.
+//
+//go:embed %s/table
+var tableData string
+
+// %d nodes in %d bytes
+`, dataDir(), len(nodeList), len(b))
+	return nil
+}
+
+// encodeNodes returns the encoding of nodeList described in nodetable.go.
+func encodeNodes() ([]byte, error) {
+	// Count the symbols to code.
+	var labelFreq, prefixFreq, flagsFreq [256]int
+	prev := ""
+	for _, n := range nodeList {
+		if len(n.children) > 1<<16-1 {
+			return nil, fmt.Errorf("node %q has too many children", n.label)
+		}
+		shared := sharedPrefix(prev, n.label)
+		prefixFreq[shared]++
+		for i := shared; i < len(n.label); i++ {
+			labelFreq[n.label[i]]++
+		}
+		labelFreq[0]++ // the end of the label
+		flagsFreq[n.flags()]++
+		prev = n.label
+	}
+	labelCode, err := newHuffmanCode(labelFreq)
+	if err != nil {
+		return nil, err
+	}
+	prefixCode, err := newHuffmanCode(prefixFreq)
+	if err != nil {
+		return nil, err
+	}
+	flagsCode, err := newHuffmanCode(flagsFreq)
+	if err != nil {
+		return nil, err
+	}
+
+	var bw bitWriter
+	bw.writeBits(uint32(len(nodeList)), 32)
+	for _, c := range []*huffmanCode{labelCode, prefixCode, flagsCode} {
+		c.writeTable(&bw)
+	}
+	prev = ""
+	for _, n := range nodeList {
+		shared := sharedPrefix(prev, n.label)
+		prefixCode.write(&bw, byte(shared))
+		for i := shared; i < len(n.label); i++ {
+			labelCode.write(&bw, n.label[i])
 		}
-		if length >= 1<<nodesBitsTextLength {
-			return fmt.Errorf("text length %d is too large, or nodeBitsTextLength is too small", length)
+		labelCode.write(&bw, 0)
+		flagsCode.write(&bw, n.flags())
+		bw.writeGamma(uint32(len(n.children)) + 1)
+		if *comments {
+			s := "---------------"
+			if len(n.children) != 0 {
+				s = fmt.Sprintf("n0x%04x-n0x%04x", n.firstChild, n.firstChild+len(n.children))
+			}
+			fmt.Fprintf(os.Stderr, "n0x%04x (%s)%s %s %s %s\n",
+				n.nodesIndex, s, wildcardStr(n.wildcard), nodeTypeStr(n.nodeType), icannStr(n.icann), n.label)
 		}
-		labelEncoding[label] = uint32(offset)<<nodesBitsTextLength | uint32(length)
+		prev = n.label
+	}
+	return bw.bytes(), nil
+}
+
+// sharedPrefix returns the length of the longest common prefix of a and b.
+func sharedPrefix(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
 	}
-	fmt.Fprintf(w, "// Text is the combined text of all labels.\nconst text = ")
-	for len(text) > 0 {
-		n, plus := len(text), ""
-		if n > 64 {
-			n, plus = 64, " +"
+	return i
+}
+
+// A bitWriter writes bits, most significant bit first.
+type bitWriter struct {
+	b     []byte
+	nbits int // number of bits written
+}
+
+func (w *bitWriter) writeBits(x uint32, n int) {
+	for i := n - 1; i >= 0; i-- {
+		if w.nbits%8 == 0 {
+			w.b = append(w.b, 0)
+		}
+		if x>>uint(i)&1 != 0 {
+			w.b[len(w.b)-1] |= 0x80 >> uint(w.nbits%8)
 		}
-		fmt.Fprintf(w, "%q%s\n", text[:n], plus)
-		text = text[n:]
+		w.nbits++
 	}
+}
 
-	if err := n.walk(w, assignIndexes); err != nil {
-		return err
+// writeGamma writes x, which must be positive, as an Elias gamma code.
+func (w *bitWriter) writeGamma(x uint32) {
+	n := 0
+	for x>>uint(n+1) != 0 {
+		n++
 	}
+	w.writeBits(0, n)
+	w.writeBits(x, n+1)
+}
 
-	fmt.Fprintf(w, `
+func (w *bitWriter) bytes() []byte { return w.b }
 
-// nodes is the list of nodes. Each node is represented as a uint32, which
-// encodes the node's children, wildcard bit and node type (as an index into
-// the children array), ICANN bit and text.
-//
-// If the table was generated with the -comments flag, there is a //-comment
-// after each node's data. In it is the nodes-array indexes of the children,
-// formatted as (n0x1234-n0x1256), with * denoting the wildcard bit. The
-// nodeType is printed as + for normal, ! for exception, and o for parent-only
-// nodes that have children but don't match a domain label in their own right.
-// An I denotes an ICANN domain.
-//
-// The layout within the uint32, from MSB to LSB, is:
-//	[%2d bits] unused
-//	[%2d bits] children index
-//	[%2d bits] ICANN bit
-//	[%2d bits] text index
-//	[%2d bits] text length
-var nodes = [...]uint32{
-`,
-		32-nodesBitsChildren-nodesBitsICANN-nodesBitsTextOffset-nodesBitsTextLength,
-		nodesBitsChildren, nodesBitsICANN, nodesBitsTextOffset, nodesBitsTextLength)
-	if err := n.walk(w, printNode); err != nil {
-		return err
-	}
-	fmt.Fprintf(w, `}
+// maxCodeLength is the maximum length of a Huffman code that nodetable.go
+// decodes.
+const maxCodeLength = 15
 
-// children is the list of nodes' children, the parent's wildcard bit and the
-// parent's node type. If a node has no children then their children index
-// will be in the range [0, 6), depending on the wildcard bit and node type.
-//
-// The layout within the uint32, from MSB to LSB, is:
-//	[%2d bits] unused
-//	[%2d bits] wildcard bit
-//	[%2d bits] node type
-//	[%2d bits] high nodes index (exclusive) of children
-//	[%2d bits] low nodes index (inclusive) of children
-var children=[...]uint32{
-`,
-		32-childrenBitsWildcard-childrenBitsNodeType-childrenBitsHi-childrenBitsLo,
-		childrenBitsWildcard, childrenBitsNodeType, childrenBitsHi, childrenBitsLo)
-	for i, c := range childrenEncoding {
-		s := "---------------"
-		lo := c & (1<<childrenBitsLo - 1)
-		hi := (c >> childrenBitsLo) & (1<<childrenBitsHi - 1)
-		if lo != hi {
-			s = fmt.Sprintf("n0x%04x-n0x%04x", lo, hi)
+// A huffmanCode is a canonical Huffman code for bytes.
+type huffmanCode struct {
+	symbols []byte // the coded symbols, in increasing order
+	lengths [256]int
+	codes   [256]uint32
+}
+
+// newHuffmanCode returns a Huffman code for the symbols with non-zero
+// frequencies freq.
+func newHuffmanCode(freq [256]int) (*huffmanCode, error) {
+	c := new(huffmanCode)
+	h := &codeHeap{}
+	for s, f := range freq {
+		if f > 0 {
+			c.symbols = append(c.symbols, byte(s))
+			*h = append(*h, codeTree{freq: f, symbols: []byte{byte(s)}})
+		}
+	}
+	if len(c.symbols) == 0 {
+		return nil, fmt.Errorf("no symbols to code")
+	}
+	if len(c.symbols) == 1 {
+		c.lengths[c.symbols[0]] = 1
+	}
+	heap.Init(h)
+	for h.Len() > 1 {
+		a, b := heap.Pop(h).(codeTree), heap.Pop(h).(codeTree)
+		for _, s := range a.symbols {
+			c.lengths[s]++
+		}
+		for _, s := range b.symbols {
+			c.lengths[s]++
+		}
+		heap.Push(h, codeTree{freq: a.freq + b.freq, symbols: append(a.symbols, b.symbols...)})
+	}
+	// Assign codes in order of length, then of symbol, as in DEFLATE.
+	code := uint32(0)
+	for l := 1; l <= maxCodeLength; l++ {
+		for _, s := range c.symbols {
+			if c.lengths[s] == l {
+				c.codes[s] = code
+				code++
+			}
 		}
-		nodeType := int(c>>(childrenBitsLo+childrenBitsHi)) & (1<<childrenBitsNodeType - 1)
-		wildcard := c>>(childrenBitsLo+childrenBitsHi+childrenBitsNodeType) != 0
-		if *comments {
-			fmt.Fprintf(w, "0x%08x, // c0x%04x (%s)%s %s\n",
-				c, i, s, wildcardStr(wildcard), nodeTypeStr(nodeType))
-		} else {
-			fmt.Fprintf(w, "0x%x,\n", c)
+		code <<= 1
+	}
+	for _, s := range c.symbols {
+		if c.lengths[s] > maxCodeLength {
+			return nil, fmt.Errorf("Huffman code for %q is longer than %d bits", s, maxCodeLength)
 		}
 	}
-	fmt.Fprintf(w, "}\n\n")
-	fmt.Fprintf(w, "// max children %d (capacity %d)\n", maxChildren, 1<<nodesBitsChildren-1)
-	fmt.Fprintf(w, "// max text offset %d (capacity %d)\n", maxTextOffset, 1<<nodesBitsTextOffset-1)
-	fmt.Fprintf(w, "// max text length %d (capacity %d)\n", maxTextLength, 1<<nodesBitsTextLength-1)
-	fmt.Fprintf(w, "// max hi %d (capacity %d)\n", maxHi, 1<<childrenBitsHi-1)
-	fmt.Fprintf(w, "// max lo %d (capacity %d)\n", maxLo, 1<<childrenBitsLo-1)
-	return nil
+	return c, nil
+}
+
+// writeTable writes the number of symbols of c, then each symbol and the
+// length of its code.
+func (c *huffmanCode) writeTable(w *bitWriter) {
+	w.writeBits(uint32(len(c.symbols)), 8)
+	for _, s := range c.symbols {
+		w.writeBits(uint32(s), 8)
+		w.writeBits(uint32(c.lengths[s]), 4)
+	}
+}
+
+// write writes the code of s.
+func (c *huffmanCode) write(w *bitWriter, s byte) {
+	w.writeBits(c.codes[s], c.lengths[s])
+}
+
+// A codeTree is a subtree of a Huffman code being built: the symbols at its
+// leaves and the sum of their frequencies.
+type codeTree struct {
+	freq    int
+	symbols []byte
+}
+
+// codeHeap is a min-heap of codeTrees by frequency.
+type codeHeap []codeTree
+
+func (h codeHeap) Len() int { return len(h) }
+func (h codeHeap) Less(i, j int) bool {
+	if h[i].freq != h[j].freq {
+		return h[i].freq < h[j].freq
+	}
+	return h[i].symbols[0] < h[j].symbols[0]
+}
+func (h codeHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *codeHeap) Push(x interface{}) { *h = append(*h, x.(codeTree)) }
+func (h *codeHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
 }
 
 type node struct {
@@ -442,9 +560,8 @@ type node struct {
 	nodeType int
 	icann    bool
 	wildcard bool
-	// nodesIndex and childrenIndex are the index of this node in the nodes
-	// and the index of its children offset/length in the children arrays.
-	nodesIndex, childrenIndex int
+	// nodesIndex is the index of this node in nodeList.
+	nodesIndex int
 	// firstChild is the index of this node's first child, or zero if this
 	// node has no children.
 	firstChild int
@@ -452,18 +569,6 @@ type node struct {
 	children []*node
 }
 
-func (n *node) walk(w io.Writer, f func(w1 io.Writer, n1 *node) error) error {
-	if err := f(w, n); err != nil {
-		return err
-	}
-	for _, c := range n.children {
-		if err := c.walk(w, f); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
 // child returns the child of n with the given label. The child is created if
 // it did not exist beforehand.
 func (n *node) child(label string) *node {
@@ -488,94 +593,35 @@ func (b byLabel) Len() int           { return len(b) }
 func (b byLabel) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
 func (b byLabel) Less(i, j int) bool { return b[i].label < b[j].label }
 
-var nextNodesIndex int
-
-// childrenEncoding are the encoded entries in the generated children array.
-// All these pre-defined entries have no children.
-var childrenEncoding = []uint32{
-	0 << (childrenBitsLo + childrenBitsHi), // Without wildcard bit, nodeTypeNormal.
-	1 << (childrenBitsLo + childrenBitsHi), // Without wildcard bit, nodeTypeException.
-	2 << (childrenBitsLo + childrenBitsHi), // Without wildcard bit, nodeTypeParentOnly.
-	4 << (childrenBitsLo + childrenBitsHi), // With wildcard bit, nodeTypeNormal.
-	5 << (childrenBitsLo + childrenBitsHi), // With wildcard bit, nodeTypeException.
-	6 << (childrenBitsLo + childrenBitsHi), // With wildcard bit, nodeTypeParentOnly.
-}
-
-var firstCallToAssignIndexes = true
-
-func assignIndexes(w io.Writer, n *node) error {
-	if len(n.children) != 0 {
-		// Assign nodesIndex.
-		n.firstChild = nextNodesIndex
-		for _, c := range n.children {
-			c.nodesIndex = nextNodesIndex
-			nextNodesIndex++
-		}
-
-		// The root node's children is implicit.
-		if firstCallToAssignIndexes {
-			firstCallToAssignIndexes = false
-			return nil
-		}
+// nodeList is the list of nodes other than the root, in index order: the
+// top level domains first, followed by the children of each node in turn.
+var nodeList []*node
 
-		// Assign childrenIndex.
-		maxChildren = max(maxChildren, len(childrenEncoding))
-		if len(childrenEncoding) >= 1<<nodesBitsChildren {
-			return fmt.Errorf("children table size %d is too large, or nodeBitsChildren is too small", len(childrenEncoding))
-		}
-		n.childrenIndex = len(childrenEncoding)
-		lo := uint32(n.firstChild)
-		hi := lo + uint32(len(n.children))
-		maxLo, maxHi = u32max(maxLo, lo), u32max(maxHi, hi)
-		if lo >= 1<<childrenBitsLo {
-			return fmt.Errorf("children lo %d is too large, or childrenBitsLo is too small", lo)
-		}
-		if hi >= 1<<childrenBitsHi {
-			return fmt.Errorf("children hi %d is too large, or childrenBitsHi is too small", hi)
-		}
-		enc := hi<<childrenBitsLo | lo
-		enc |= uint32(n.nodeType) << (childrenBitsLo + childrenBitsHi)
-		if n.wildcard {
-			enc |= 1 << (childrenBitsLo + childrenBitsHi + childrenBitsNodeType)
-		}
-		childrenEncoding = append(childrenEncoding, enc)
-	} else {
-		n.childrenIndex = n.nodeType
-		if n.wildcard {
-			n.childrenIndex += numNodeType
+// assignIndexes fills in nodeList and the indexes of each node, visiting
+// the nodes breadth first so that the children of each node follow those of
+// the nodes before it.
+func assignIndexes(root *node) {
+	nodeList = append(nodeList, root.children...)
+	for i := 0; i < len(nodeList); i++ {
+		n := nodeList[i]
+		n.nodesIndex = i
+		if len(n.children) != 0 {
+			n.firstChild = len(nodeList)
+			nodeList = append(nodeList, n.children...)
 		}
 	}
-	return nil
 }
 
-func printNode(w io.Writer, n *node) error {
-	for _, c := range n.children {
-		s := "---------------"
-		if len(c.children) != 0 {
-			s = fmt.Sprintf("n0x%04x-n0x%04x", c.firstChild, c.firstChild+len(c.children))
-		}
-		encoding := labelEncoding[c.label]
-		if c.icann {
-			encoding |= 1 << (nodesBitsTextLength + nodesBitsTextOffset)
-		}
-		encoding |= uint32(c.childrenIndex) << (nodesBitsTextLength + nodesBitsTextOffset + nodesBitsICANN)
-		if *comments {
-			fmt.Fprintf(w, "0x%08x, // n0x%04x c0x%04x (%s)%s %s %s %s\n",
-				encoding, c.nodesIndex, c.childrenIndex, s, wildcardStr(c.wildcard),
-				nodeTypeStr(c.nodeType), icannStr(c.icann), c.label,
-			)
-		} else {
-			fmt.Fprintf(w, "0x%x,\n", encoding)
-		}
+// flags returns the flags byte of n's encoding.
+func (n *node) flags() byte {
+	f := byte(n.nodeType)
+	if n.icann {
+		f |= nodeFlagICANN
 	}
-	return nil
-}
-
-func printNodeLabel(w io.Writer, n *node) error {
-	for _, c := range n.children {
-		fmt.Fprintf(w, "%q,\n", c.label)
+	if n.wildcard {
+		f |= nodeFlagWildcard
 	}
-	return nil
+	return f
 }
 
 func icannStr(icann bool) string {
@@ -591,128 +637,3 @@ func wildcardStr(wildcard bool) string {
 	}
 	return " "
 }
-
-// combineText combines all the strings in labelsList to form one giant string.
-// Overlapping strings will be merged: "arpa" and "parliament" could yield
-// "arparliament".
-func combineText(labelsList []string) string {
-	beforeLength := 0
-	for _, s := range labelsList {
-		beforeLength += len(s)
-	}
-
-	text := crush(removeSubstrings(labelsList))
-	if *v {
-		fmt.Fprintf(os.Stderr, "crushed %d bytes to become %d bytes\n", beforeLength, len(text))
-	}
-	return text
-}
-
-type byLength []string
-
-func (s byLength) Len() int           { return len(s) }
-func (s byLength) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
-func (s byLength) Less(i, j int) bool { return len(s[i]) < len(s[j]) }
-
-// removeSubstrings returns a copy of its input with any strings removed
-// that are substrings of other provided strings.
-func removeSubstrings(input []string) []string {
-	// Make a copy of input.
-	ss := append(make([]string, 0, len(input)), input...)
-	sort.Sort(byLength(ss))
-
-	for i, shortString := range ss {
-		// For each string, only consider strings higher than it in sort order, i.e.
-		// of equal length or greater.
-		for _, longString := range ss[i+1:] {
-			if strings.Contains(longString, shortString) {
-				ss[i] = ""
-				break
-			}
-		}
-	}
-
-	// Remove the empty strings.
-	sort.Strings(ss)
-	for len(ss) > 0 && ss[0] == "" {
-		ss = ss[1:]
-	}
-	return ss
-}
-
-// crush combines a list of strings, taking advantage of overlaps. It returns a
-// single string that contains each input string as a substring.
-func crush(ss []string) string {
-	maxLabelLen := 0
-	for _, s := range ss {
-		if maxLabelLen < len(s) {
-			maxLabelLen = len(s)
-		}
-	}
-
-	for prefixLen := maxLabelLen; prefixLen > 0; prefixLen-- {
-		prefixes := makePrefixMap(ss, prefixLen)
-		for i, s := range ss {
-			if len(s) <= prefixLen {
-				continue
-			}
-			mergeLabel(ss, i, prefixLen, prefixes)
-		}
-	}
-
-	return strings.Join(ss, "")
-}
-
-// mergeLabel merges the label at ss[i] with the first available matching label
-// in prefixMap, where the last "prefixLen" characters in ss[i] match the first
-// "prefixLen" characters in the matching label.
-// It will merge ss[i] repeatedly until no more matches are available.
-// All matching labels merged into ss[i] are replaced by "".
-func mergeLabel(ss []string, i, prefixLen int, prefixes prefixMap) {
-	s := ss[i]
-	suffix := s[len(s)-prefixLen:]
-	for _, j := range prefixes[suffix] {
-		// Empty strings mean "already used." Also avoid merging with self.
-		if ss[j] == "" || i == j {
-			continue
-		}
-		if *v {
-			fmt.Fprintf(os.Stderr, "%d-length overlap at (%4d,%4d): %q and %q share %q\n",
-				prefixLen, i, j, ss[i], ss[j], suffix)
-		}
-		ss[i] += ss[j][prefixLen:]
-		ss[j] = ""
-		// ss[i] has a new suffix, so merge again if possible.
-		// Note: we only have to merge again at the same prefix length. Shorter
-		// prefix lengths will be handled in the next iteration of crush's for loop.
-		// Can there be matches for longer prefix lengths, introduced by the merge?
-		// I believe that any such matches would by necessity have been eliminated
-		// during substring removal or merged at a higher prefix length. For
-		// instance, in crush("abc", "cde", "bcdef"), combining "abc" and "cde"
-		// would yield "abcde", which could be merged with "bcdef." However, in
-		// practice "cde" would already have been elimintated by removeSubstrings.
-		mergeLabel(ss, i, prefixLen, prefixes)
-		return
-	}
-}
-
-// prefixMap maps from a prefix to a list of strings containing that prefix. The
-// list of strings is represented as indexes into a slice of strings stored
-// elsewhere.
-type prefixMap map[string][]int
-
-// makePrefixMap constructs a prefixMap from a slice of strings.
-func makePrefixMap(ss []string, prefixLen int) prefixMap {
-	prefixes := make(prefixMap)
-	for i, s := range ss {
-		// We use < rather than <= because if a label matches on a prefix equal to
-		// its full length, that's actually a substring match handled by
-		// removeSubstrings.
-		if prefixLen < len(s) {
-			prefix := s[:prefixLen]
-			prefixes[prefix] = append(prefixes[prefix], i)
-		}
-	}
-
-	return prefixes
-}
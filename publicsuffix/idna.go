@@ -0,0 +1,120 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package publicsuffix
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+// The list's rules have Unicode labels as Punycode, so PublicSuffix and
+// Lookup don't match domains like "商業.tw". The functions in this file
+// normalize their input to that form first.
+
+// normalizeProfile maps domains as UTS #46 does for lookup, but allows
+// the underscores found in names such as "_dmarc.example.com".
+var normalizeProfile = idna.New(
+	idna.MapForLookup(),
+	idna.Transitional(false),
+	idna.StrictDomainName(false),
+)
+
+// Normalize converts domain to the form of the list's rules, by the UTS #46
+// mapping for lookup: it lower cases domain, maps label separators such as
+// "。" to ".", and converts labels in Unicode to Punycode A-labels. For
+// example, "商業。TW" becomes "xn--czrw28b.tw".
+//
+// An error is returned if domain can't be converted, such as when it
+// contains disallowed runes.
+func Normalize(domain string) (string, error) {
+	s, err := normalizeProfile.ToASCII(domain)
+	if err != nil {
+		return "", fmt.Errorf("publicsuffix: cannot normalize domain %q: %v", domain, err)
+	}
+	return s, nil
+}
+
+// A Form is the form in which LookupIDNA and EffectiveTLDPlusOneIDNA
+// return domain names.
+type Form int
+
+const (
+	// ASCII is the normalized form returned by Normalize.
+	ASCII Form = iota
+
+	// Original is the form of the domain passed by the caller, so that
+	// the result is a suffix of it. For example, the public suffix of
+	// "Example.商業.TW" in this form is "商業.TW".
+	Original
+)
+
+// LookupIDNA is like Lookup, but first normalizes domain with Normalize,
+// and returns publicSuffix in the given form.
+func LookupIDNA(domain string, form Form) (publicSuffix string, section Section, err error) {
+	ascii, err := Normalize(domain)
+	if err != nil {
+		return "", Unlisted, err
+	}
+	publicSuffix, section = Lookup(ascii)
+	return inForm(publicSuffix, domain, ascii, form), section, nil
+}
+
+// EffectiveTLDPlusOneIDNA is like EffectiveTLDPlusOne, but first normalizes
+// domain with Normalize, and returns the eTLD+1 in the given form.
+func EffectiveTLDPlusOneIDNA(domain string, form Form) (string, error) {
+	ascii, err := Normalize(domain)
+	if err != nil {
+		return "", err
+	}
+	etldPlusOne, err := EffectiveTLDPlusOne(ascii)
+	if err != nil {
+		return "", err
+	}
+	return inForm(etldPlusOne, domain, ascii, form), nil
+}
+
+// inForm returns suffix, a suffix of ascii, which is the normalized form of
+// domain, in the given form.
+func inForm(suffix, domain, ascii string, form Form) string {
+	if form != Original {
+		return suffix
+	}
+	n := strings.Count(suffix, ".") + 1
+	labels := splitLabels(domain)
+	if len(labels) != strings.Count(ascii, ".")+1 {
+		// Normalization changed the number of labels, so there's no
+		// corresponding suffix of domain.
+		return suffix
+	}
+	i := len(domain)
+	for _, l := range labels[len(labels)-n:] {
+		i -= len(l.sep) + len(l.label)
+	}
+	return domain[i:][len(labels[len(labels)-n].sep):]
+}
+
+// A splitLabel is a label of a domain and the separator preceding it, if any.
+type splitLabel struct {
+	sep, label string
+}
+
+// splitLabels splits domain at each of the separators that UTS #46 maps
+// to ".".
+func splitLabels(domain string) []splitLabel {
+	var labels []splitLabel
+	sep, start := "", 0
+	for i, r := range domain {
+		switch r {
+		case '.', '。', '．', '｡':
+			labels = append(labels, splitLabel{sep, domain[start:i]})
+			sep = string(r)
+			start = i + utf8.RuneLen(r)
+		}
+	}
+	return append(labels, splitLabel{sep, domain[start:]})
+}
@@ -3,6 +3,7 @@
 // license that can be found in the LICENSE file.
 
 //go:generate go run gen.go
+//go:generate go run gen.go -private=false
 
 // Package publicsuffix provides a public suffix list based on data from
 // https://publicsuffix.org/
@@ -42,14 +43,20 @@
 // Instead, the calculation is data driven. This package provides a
 // pre-compiled snapshot of Mozilla's PSL (Public Suffix List) data at
 // https://publicsuffix.org/
+//
+// By default the snapshot includes both sections of the list: the ICANN
+// section and the PRIVATE section of suffixes delegated by organizations
+// such as hosting providers. Building with the publicsuffix_icann build tag
+// compiles in a smaller table of the ICANN section only, for programs that
+// treat privately managed suffixes as ordinary domains.
 package publicsuffix // import "golang.org/x/net/publicsuffix"
 
-// TODO: specify case sensitivity and leading/trailing dot behavior for
-// func PublicSuffix and func EffectiveTLDPlusOne.
-
 import (
+	"errors"
 	"fmt"
+	"net"
 	"net/http/cookiejar"
+	"sort"
 	"strings"
 )
 
@@ -65,11 +72,15 @@ func (list) PublicSuffix(domain string) string {
 }
 
 func (list) String() string {
+	if rs := initRuleSet(); rs != nil {
+		return rs.String()
+	}
 	return version
 }
 
 // PublicSuffix returns the public suffix of the domain using a copy of the
-// publicsuffix.org database compiled into the library.
+// publicsuffix.org database compiled into the library, or the list loaded
+// by InitList.
 //
 // icann is whether the public suffix is managed by the Internet Corporation
 // for Assigned Names and Numbers. If not, the public suffix is either a
@@ -82,41 +93,125 @@ func (list) String() string {
 // Use cases for distinguishing ICANN domains like "foo.com" from private
 // domains like "foo.appspot.com" can be found at
 // https://wiki.mozilla.org/Public_Suffix_List/Use_Cases
+//
+// To tell privately managed suffixes from unmanaged ones, use Lookup.
+//
+// Callers often pass host names taken from URLs or Host headers, so domain
+// need not be in canonical form, but must not include a port:
+//   - Rules match without regard to ASCII case, and publicSuffix keeps the
+//     case of domain: the public suffix of "Example.COM" is "COM".
+//   - A trailing dot, as in the fully qualified "example.com.", is ignored
+//     when matching and kept in publicSuffix, which is "com.".
+//   - Labels up to and including the last empty one are ignored, so the
+//     public suffix of "foo..co.uk" is "co.uk", and that of "foo.." is "".
+//   - An IP address, such as "192.0.2.1" or "[2001:db8::1]", is its own
+//     public suffix, and icann is false.
+//
+// Unicode domains must first be converted to Punycode, as by Normalize.
 func PublicSuffix(domain string) (publicSuffix string, icann bool) {
+	publicSuffix, icann, _ = lookup(domain)
+	return publicSuffix, icann
+}
+
+// A Section identifies the section of the public suffix list holding
+// the rule that matched a domain.
+type Section int
+
+const (
+	// Unlisted means that no rule matched. The public suffix is the
+	// domain's last label, by the list's implicit "*" rule.
+	Unlisted Section = iota
+
+	// ICANN means that the rule is in the ICANN section of the list,
+	// which holds the suffixes managed by registries.
+	ICANN
+
+	// Private means that the rule is in the PRIVATE section of the
+	// list, which holds the suffixes that organizations such as
+	// hosting providers have delegated to their customers.
+	Private
+)
+
+func (s Section) String() string {
+	switch s {
+	case Unlisted:
+		return "Unlisted"
+	case ICANN:
+		return "ICANN"
+	case Private:
+		return "Private"
+	}
+	return fmt.Sprintf("Section(%d)", int(s))
+}
+
+// Lookup is like PublicSuffix, but reports the section of the list holding
+// the rule that matched domain, distinguishing privately managed public
+// suffixes from unmanaged ones. Callers making security decisions, such as
+// whether two hosts may share cookies, typically treat Private suffixes
+// like ICANN ones, and may want to reject Unlisted domains outright.
+func Lookup(domain string) (publicSuffix string, section Section) {
+	publicSuffix, icann, listed := lookup(domain)
+	switch {
+	case icann:
+		return publicSuffix, ICANN
+	case listed:
+		return publicSuffix, Private
+	}
+	return publicSuffix, Unlisted
+}
+
+// lookup implements PublicSuffix and Lookup. listed reports whether a rule
+// matched domain.
+func lookup(domain string) (publicSuffix string, icann, listed bool) {
+	if rs := initRuleSet(); rs != nil {
+		publicSuffix, section := rs.Lookup(domain)
+		return publicSuffix, section == ICANN, section != Unlisted
+	}
+	if isIPLiteral(domain) {
+		return domain, false, false
+	}
+	name := trimTrailingDot(domain)
+	publicSuffix, icann, _, listed = lookupName(name)
+	if publicSuffix == "" {
+		return "", icann, listed
+	}
+	return domain[len(name)-len(publicSuffix):], icann, listed
+}
+
+// lookupName is like lookup, but name must not be an IP address or have a
+// trailing dot. kind is the kind of the rule that matched.
+func lookupName(domain string) (publicSuffix string, icann bool, kind RuleKind, listed bool) {
 	lo, hi := uint32(0), uint32(numTLD)
 	s, suffix, icannNode, wildcard := domain, len(domain), false, false
 loop:
 	for {
 		dot := strings.LastIndex(s, ".")
+		label := s[1+dot:]
+		if label == "" {
+			// No rule has an empty label, not even a wildcard.
+			break
+		}
 		if wildcard {
 			icann = icannNode
-			suffix = 1 + dot
+			suffix, kind = 1+dot, WildcardRule
 		}
 		if lo == hi {
 			break
 		}
-		f := find(s[1+dot:], lo, hi)
+		f := find(lowerASCII(label), lo, hi)
 		if f == notFound {
 			break
 		}
 
-		u := nodes[f] >> (nodesBitsTextOffset + nodesBitsTextLength)
-		icannNode = u&(1<<nodesBitsICANN-1) != 0
-		u >>= nodesBitsICANN
-		u = children[u&(1<<nodesBitsChildren-1)]
-		lo = u & (1<<childrenBitsLo - 1)
-		u >>= childrenBitsLo
-		hi = u & (1<<childrenBitsHi - 1)
-		u >>= childrenBitsHi
-		switch u & (1<<childrenBitsNodeType - 1) {
+		var nodeType uint32
+		lo, hi, nodeType, wildcard, icannNode = nodeChildren(f)
+		switch nodeType {
 		case nodeTypeNormal:
-			suffix = 1 + dot
+			suffix, kind = 1+dot, NormalRule
 		case nodeTypeException:
-			suffix = 1 + len(s)
+			suffix, kind = 1+len(s), ExceptionRule
 			break loop
 		}
-		u >>= childrenBitsNodeType
-		wildcard = u&(1<<childrenBitsWildcard-1) != 0
 		if !wildcard {
 			icann = icannNode
 		}
@@ -128,9 +223,54 @@ loop:
 	}
 	if suffix == len(domain) {
 		// If no rules match, the prevailing rule is "*".
-		return domain[1+strings.LastIndex(domain, "."):], icann
+		return domain[1+strings.LastIndex(domain, "."):], icann, kind, false
 	}
-	return domain[suffix:], icann
+	return domain[suffix:], icann, kind, true
+}
+
+// trimTrailingDot returns domain without the trailing dot of a fully
+// qualified domain name. The domain "." is left alone, as its dot doesn't
+// follow a label.
+func trimTrailingDot(domain string) string {
+	if len(domain) > 1 && domain[len(domain)-1] == '.' {
+		return domain[:len(domain)-1]
+	}
+	return domain
+}
+
+// isIPLiteral reports whether domain is an IPv4 address or an IPv6
+// address, which may be enclosed in brackets and have a zone.
+func isIPLiteral(domain string) bool {
+	if strings.HasPrefix(domain, "[") && strings.HasSuffix(domain, "]") {
+		domain = domain[1 : len(domain)-1]
+	}
+	if i := strings.LastIndex(domain, "%"); i >= 0 && strings.Contains(domain, ":") {
+		domain = domain[:i]
+	}
+	// No top level domain starts with a digit, so only call net.ParseIP
+	// for names that could be addresses.
+	last := domain[1+strings.LastIndex(domain, "."):]
+	if !strings.Contains(domain, ":") && (last == "" || last[0] < '0' || '9' < last[0]) {
+		return false
+	}
+	return net.ParseIP(domain) != nil
+}
+
+// lowerASCII returns s with ASCII upper case letters mapped to lower case.
+// It doesn't allocate if s has none.
+func lowerASCII(s string) string {
+	for i := 0; i < len(s); i++ {
+		if 'A' <= s[i] && s[i] <= 'Z' {
+			b := []byte(s)
+			for j := i; j < len(b); j++ {
+				if 'A' <= b[j] && b[j] <= 'Z' {
+					b[j] += 'a' - 'A'
+				}
+			}
+			return string(b)
+		}
+	}
+	return s
 }
 
 const notFound uint32 = 1<<32 - 1
@@ -139,9 +279,10 @@ const notFound uint32 = 1<<32 - 1
 // label, or notFound if there is no such node. The range is assumed to be in
 // strictly increasing node label order.
 func find(label string, lo, hi uint32) uint32 {
+	t := nodeTable()
 	for lo < hi {
 		mid := lo + (hi-lo)/2
-		s := nodeLabel(mid)
+		s := t.label(mid)
 		if s < label {
 			lo = mid + 1
 		} else if s == label {
@@ -155,27 +296,257 @@ func find(label string, lo, hi uint32) uint32 {
 
 // nodeLabel returns the label for the i'th node.
 func nodeLabel(i uint32) string {
-	x := nodes[i]
-	length := x & (1<<nodesBitsTextLength - 1)
-	x >>= nodesBitsTextLength
-	offset := x & (1<<nodesBitsTextOffset - 1)
-	return text[offset : offset+length]
+	return nodeTable().label(i)
+}
+
+// A Rule is a rule of the public suffix list.
+type Rule struct {
+	// Pattern is the rule as written in the list, such as "co.uk",
+	// "*.kawasaki.jp", or "!city.kawasaki.jp".
+	Pattern string
+
+	// Section is the section of the list holding the rule.
+	Section Section
+}
+
+// Kind returns the kind of r, given by the prefix of its pattern.
+func (r Rule) Kind() RuleKind {
+	switch {
+	case strings.HasPrefix(r.Pattern, "*."):
+		return WildcardRule
+	case strings.HasPrefix(r.Pattern, "!"):
+		return ExceptionRule
+	}
+	return NormalRule
+}
+
+// A RuleKind is the kind of a rule of the public suffix list.
+type RuleKind int
+
+const (
+	// NormalRule is a rule such as "co.uk", which makes the domain it
+	// names a public suffix.
+	NormalRule RuleKind = iota
+
+	// WildcardRule is a rule such as "*.kawasaki.jp", which makes every
+	// domain one label under the domain it names a public suffix.
+	WildcardRule
+
+	// ExceptionRule is a rule such as "!city.kawasaki.jp", which makes the
+	// domain it names not a public suffix, overriding a wildcard rule.
+	// The public suffix is the domain's parent.
+	ExceptionRule
+)
+
+func (k RuleKind) String() string {
+	switch k {
+	case NormalRule:
+		return "Normal"
+	case WildcardRule:
+		return "Wildcard"
+	case ExceptionRule:
+		return "Exception"
+	}
+	return fmt.Sprintf("RuleKind(%d)", int(k))
+}
+
+// MatchingRule returns the rule that determined the public suffix of domain,
+// such as "*.kawasaki.jp" for "foo.kawasaki.jp", so that callers can explain
+// decisions based on it. Domains are matched as by PublicSuffix. If no rule
+// matched domain, ok is false, and the public suffix is domain's last label
+// by the list's implicit "*" rule.
+func MatchingRule(domain string) (rule Rule, ok bool) {
+	if rs := initRuleSet(); rs != nil {
+		return rs.MatchingRule(domain)
+	}
+	if isIPLiteral(domain) {
+		return Rule{}, false
+	}
+	name := trimTrailingDot(domain)
+	publicSuffix, icann, kind, listed := lookupName(name)
+	if !listed {
+		return Rule{}, false
+	}
+	return Rule{rulePattern(name, publicSuffix, kind), sectionOf(icann)}, true
+}
+
+// rulePattern returns the pattern of the rule of the given kind that made
+// publicSuffix the public suffix of name.
+func rulePattern(name, publicSuffix string, kind RuleKind) string {
+	switch kind {
+	case WildcardRule:
+		return "*." + lowerASCII(publicSuffix[1+strings.Index(publicSuffix, "."):])
+	case ExceptionRule:
+		// The exception rule has one more label than the public suffix.
+		prefix := strings.TrimSuffix(name[:len(name)-len(publicSuffix)], ".")
+		return "!" + lowerASCII(name[1+strings.LastIndex(prefix, "."):])
+	}
+	return lowerASCII(publicSuffix)
+}
+
+// ChildRules returns the rules for domains under suffix, such as the rules
+// for "co.uk" and "*.sch.uk" under "uk", in lexical order of their patterns.
+// The rule for suffix itself isn't included, but all rules under it are,
+// not just those one label longer. If suffix is "", ChildRules returns
+// every rule.
+//
+// ChildRules returns nil if no rule is under suffix.
+func ChildRules(suffix string) []Rule {
+	if rs := initRuleSet(); rs != nil {
+		return rs.ChildRules(suffix)
+	}
+	var rules []Rule
+	lo, hi := uint32(0), uint32(numTLD)
+	if suffix = lowerASCII(trimTrailingDot(suffix)); suffix != "" {
+		var wildcard bool
+		for s := suffix; ; {
+			dot := strings.LastIndex(s, ".")
+			f := find(s[1+dot:], lo, hi)
+			if f == notFound {
+				return nil
+			}
+			var icann bool
+			lo, hi, _, wildcard, icann = nodeChildren(f)
+			if dot == -1 {
+				if wildcard {
+					rules = append(rules, Rule{"*." + suffix, sectionOf(icann)})
+				}
+				break
+			}
+			s = s[:dot]
+		}
+	}
+	rules = appendChildRules(rules, suffix, lo, hi)
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Pattern < rules[j].Pattern })
+	return rules
+}
+
+// appendChildRules appends the rules of the nodes in the range [lo, hi),
+// which are the children of the node for suffix, and of their descendants.
+func appendChildRules(rules []Rule, suffix string, lo, hi uint32) []Rule {
+	for i := lo; i < hi; i++ {
+		name := nodeLabel(i)
+		if suffix != "" {
+			name += "." + suffix
+		}
+		clo, chi, nodeType, wildcard, icann := nodeChildren(i)
+		switch nodeType {
+		case nodeTypeNormal:
+			rules = append(rules, Rule{name, sectionOf(icann)})
+		case nodeTypeException:
+			rules = append(rules, Rule{"!" + name, sectionOf(icann)})
+		}
+		if wildcard {
+			rules = append(rules, Rule{"*." + name, sectionOf(icann)})
+		}
+		rules = appendChildRules(rules, name, clo, chi)
+	}
+	return rules
+}
+
+// nodeChildren decodes the i'th node, returning the range [lo, hi) of its
+// children, its type, whether it has a wildcard rule, and whether its rules
+// are ICANN rules.
+func nodeChildren(i uint32) (lo, hi, nodeType uint32, wildcard, icann bool) {
+	n := &nodeTable().nodes[i]
+	lo = n.firstChild
+	hi = lo + uint32(n.numChildren)
+	nodeType = uint32(n.flags & nodeFlagsTypeMask)
+	wildcard = n.flags&nodeFlagWildcard != 0
+	icann = n.flags&nodeFlagICANN != 0
+	return lo, hi, nodeType, wildcard, icann
+}
+
+func sectionOf(icann bool) Section {
+	if icann {
+		return ICANN
+	}
+	return Private
 }
 
 // EffectiveTLDPlusOne returns the effective top level domain plus one more
 // label. For example, the eTLD+1 for "foo.bar.golang.org" is "golang.org".
+//
+// The eTLD+1 is the registrable domain: the part of a host name that its
+// owner registered, under which they may create any subdomains. It's the
+// usual unit for scoping cookies or for grouping hosts by owner.
+//
+// Domains are matched as by PublicSuffix, so the eTLD+1 of
+// "WWW.Example.COM." is "Example.COM.".
+//
+// The error returned wraps ErrEmptyLabel if domain has an empty label,
+// including a leading dot or two trailing dots, ErrIPAddress if domain is
+// an IP address, and ErrNoETLDPlusOne if domain is itself a public suffix,
+// such as "com" or "co.uk".
 func EffectiveTLDPlusOne(domain string) (string, error) {
-	if strings.HasPrefix(domain, ".") || strings.HasSuffix(domain, ".") || strings.Contains(domain, "..") {
-		return "", fmt.Errorf("publicsuffix: empty label in domain %q", domain)
+	return effectiveTLDPlusOne(domain, list{}.PublicSuffix)
+}
+
+// IsPublicSuffix reports whether domain is itself a public suffix, such as
+// "com", "co.uk", or the unlisted "cromulent", under which hosts in
+// different domains may be registered. Hosts that are public suffixes
+// shouldn't be able to set cookies, or be named by wildcard certificates,
+// for their subdomains.
+//
+// Domains are matched as by PublicSuffix, except that IP addresses and
+// domains with empty labels aren't public suffixes.
+func IsPublicSuffix(domain string) bool {
+	return isPublicSuffix(domain, list{}.PublicSuffix)
+}
+
+// IsRegistrableDomain reports whether domain is an eTLD+1, such as
+// "example.com" or "example.co.uk", which is the domain an owner registers
+// and the broadest that their hosts may share cookies across.
+//
+// Domains are matched as by EffectiveTLDPlusOne.
+func IsRegistrableDomain(domain string) bool {
+	return isRegistrableDomain(domain, list{}.PublicSuffix)
+}
+
+func isPublicSuffix(domain string, publicSuffix func(string) string) bool {
+	if domain == "" || isIPLiteral(domain) {
+		return false
+	}
+	return publicSuffix(domain) == domain
+}
+
+func isRegistrableDomain(domain string, publicSuffix func(string) string) bool {
+	etldPlusOne, err := effectiveTLDPlusOne(domain, publicSuffix)
+	return err == nil && etldPlusOne == domain
+}
+
+var (
+	// ErrEmptyLabel is wrapped by the errors of EffectiveTLDPlusOne for
+	// domains with an empty label, such as "foo..com".
+	ErrEmptyLabel = errors.New("publicsuffix: empty label in domain")
+
+	// ErrIPAddress is wrapped by the errors of EffectiveTLDPlusOne for
+	// IP addresses, which have no eTLD+1.
+	ErrIPAddress = errors.New("publicsuffix: IP address has no eTLD+1")
+
+	// ErrNoETLDPlusOne is wrapped by the errors of EffectiveTLDPlusOne for
+	// domains that are public suffixes, such as "co.uk".
+	ErrNoETLDPlusOne = errors.New("publicsuffix: cannot derive eTLD+1 for domain")
+)
+
+// effectiveTLDPlusOne returns the eTLD+1 of domain, using publicSuffix to
+// find its public suffix.
+func effectiveTLDPlusOne(domain string, publicSuffix func(string) string) (string, error) {
+	if isIPLiteral(domain) {
+		return "", fmt.Errorf("%w: %q", ErrIPAddress, domain)
+	}
+	name := trimTrailingDot(domain)
+	if strings.HasPrefix(name, ".") || strings.HasSuffix(name, ".") || strings.Contains(name, "..") {
+		return "", fmt.Errorf("%w %q", ErrEmptyLabel, domain)
 	}
 
-	suffix, _ := PublicSuffix(domain)
-	if len(domain) <= len(suffix) {
-		return "", fmt.Errorf("publicsuffix: cannot derive eTLD+1 for domain %q", domain)
+	suffix := publicSuffix(name)
+	if len(name) <= len(suffix) {
+		return "", fmt.Errorf("%w %q", ErrNoETLDPlusOne, domain)
 	}
-	i := len(domain) - len(suffix) - 1
-	if domain[i] != '.' {
+	i := len(name) - len(suffix) - 1
+	if name[i] != '.' {
 		return "", fmt.Errorf("publicsuffix: invalid public suffix %q for domain %q", suffix, domain)
 	}
-	return domain[1+strings.LastIndex(domain[:i], "."):], nil
+	return domain[1+strings.LastIndex(name[:i], "."):], nil
 }
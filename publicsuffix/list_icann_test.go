@@ -0,0 +1,47 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build publicsuffix_icann
+// +build publicsuffix_icann
+
+package publicsuffix
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestICANNOnlyRules(t *testing.T) {
+	if numICANNRules != len(rules) {
+		t.Fatalf("numICANNRules = %d; want all %d rules", numICANNRules, len(rules))
+	}
+	for _, rule := range rules {
+		if strings.HasPrefix(rule, "*.") || strings.HasPrefix(rule, "!") {
+			continue
+		}
+		if ps, icann := PublicSuffix("foo." + rule); ps != rule || !icann {
+			t.Errorf("PublicSuffix(%q) = %q, %v; want %q, true", "foo."+rule, ps, icann, rule)
+		}
+	}
+}
+
+func TestICANNOnlyLookup(t *testing.T) {
+	testCases := []struct {
+		domain      string
+		wantSuffix  string
+		wantSection Section
+	}{
+		{"foo.co.uk", "co.uk", ICANN},
+		// Private rules are absent.
+		{"foo.dyndns.org", "org", ICANN},
+		{"foo.blogspot.co.uk", "co.uk", ICANN},
+		{"foo.intranet", "intranet", Unlisted},
+	}
+	for _, tc := range testCases {
+		suffix, section := Lookup(tc.domain)
+		if suffix != tc.wantSuffix || section != tc.wantSection {
+			t.Errorf("Lookup(%q) = %q, %v; want %q, %v", tc.domain, suffix, section, tc.wantSuffix, tc.wantSection)
+		}
+	}
+}
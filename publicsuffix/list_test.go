@@ -2,9 +2,13 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
+//go:build !publicsuffix_icann
+// +build !publicsuffix_icann
+
 package publicsuffix
 
 import (
+	"errors"
 	"sort"
 	"strings"
 	"testing"
@@ -78,6 +82,34 @@ func TestICANN(t *testing.T) {
 	}
 }
 
+func TestLookup(t *testing.T) {
+	testCases := []struct {
+		domain      string
+		wantSuffix  string
+		wantSection Section
+	}{
+		{"foo.org", "org", ICANN},
+		{"org", "org", ICANN},
+		{"foo.co.uk", "co.uk", ICANN},
+		{"foo.dyndns.org", "dyndns.org", Private},
+		{"foo.go.dyndns.org", "go.dyndns.org", Private},
+		{"foo.blogspot.co.uk", "blogspot.co.uk", Private},
+		{"foo.intranet", "intranet", Unlisted},
+		{"cromulent", "cromulent", Unlisted},
+		{"www.ck", "ck", ICANN},         // an exception rule
+		{"foo.bar.ck", "bar.ck", ICANN}, // a wildcard rule
+	}
+	for _, tc := range testCases {
+		suffix, section := Lookup(tc.domain)
+		if suffix != tc.wantSuffix || section != tc.wantSection {
+			t.Errorf("Lookup(%q) = %q, %v; want %q, %v", tc.domain, suffix, section, tc.wantSuffix, tc.wantSection)
+		}
+		if wantSuffix, wantICANN := PublicSuffix(tc.domain); suffix != wantSuffix || (section == ICANN) != wantICANN {
+			t.Errorf("Lookup(%q) = %q, %v; PublicSuffix = %q, %v", tc.domain, suffix, section, wantSuffix, wantICANN)
+		}
+	}
+}
+
 var publicSuffixTestCases = []struct {
 	domain    string
 	wantPS    string
@@ -259,6 +291,22 @@ var publicSuffixTestCases = []struct {
 	{"aaa.uberspace.de", "aaa.uberspace.de", false},
 	{"bbb.ccc.uberspace.de", "ccc.uberspace.de", false},
 
+	// Rules added to the list after 2021. The .run and .com rules include:
+	// run (in the ICANN DOMAIN section)
+	// *.build.run (in the PRIVATE DOMAIN section)
+	// it.com (in the PRIVATE DOMAIN section)
+	{"5g.in", "5g.in", true},
+	{"www.5g.in", "5g.in", true},
+	{"build.run", "run", true},
+	{"aaa.build.run", "aaa.build.run", false},
+	{"www.aaa.build.run", "aaa.build.run", false},
+	{"it.com", "it.com", false},
+	{"www.it.com", "it.com", false},
+
+	// The adac TLD has been removed from the list since 2021.
+	{"adac", "adac", false},
+	{"www.adac", "adac", false},
+
 	// There are no .nosuchtld rules.
 	{"nosuchtld", "nosuchtld", false},
 	{"foo.nosuchtld", "nosuchtld", false},
@@ -499,6 +547,80 @@ var eTLDPlusOneTestCases = []struct {
 	{"com..au", ""},
 }
 
+// hostTestCases are domains in the forms found in URLs and Host headers.
+var hostTestCases = []struct {
+	domain   string
+	wantPS   string
+	wantSect Section
+}{
+	{"Example.COM", "COM", ICANN},
+	{"WWW.Example.Co.UK", "Co.UK", ICANN},
+	{"foo.ck", "foo.ck", ICANN},
+	{"Foo.CK", "Foo.CK", ICANN},
+	{"WWW.CK", "CK", ICANN},
+	{"foo.www.ck", "ck", ICANN},
+	{"example.com.", "com.", ICANN},
+	{"Example.COM.", "COM.", ICANN},
+	{"foo.blogspot.co.uk.", "blogspot.co.uk.", Private},
+	{"foo.cromulent.", "cromulent.", Unlisted},
+	{".", "", Unlisted},
+	{"..", "", Unlisted},
+	{"foo..", "", Unlisted},
+	{"example.com..", "", Unlisted},
+	{".com", "com", ICANN},
+	{"foo..co.uk", "co.uk", ICANN},
+	{"foo..uk", "uk", ICANN},
+	// An empty label isn't matched by a wildcard rule, here "*.ck".
+	{"foo..ck", "ck", Unlisted},
+	{"192.0.2.1", "192.0.2.1", Unlisted},
+	{"::1", "::1", Unlisted},
+	{"2001:db8::1", "2001:db8::1", Unlisted},
+	{"[2001:db8::1]", "[2001:db8::1]", Unlisted},
+	{"fe80::1%eth0", "fe80::1%eth0", Unlisted},
+	{"[fe80::1%25eth0]", "[fe80::1%25eth0]", Unlisted},
+	{"192.0.2.1.example", "example", Unlisted},
+	{"1.2.3.com", "com", ICANN},
+	{"999.0.2.1", "1", Unlisted},
+}
+
+func TestPublicSuffixHosts(t *testing.T) {
+	for _, tc := range hostTestCases {
+		ps, sect := Lookup(tc.domain)
+		if ps != tc.wantPS || sect != tc.wantSect {
+			t.Errorf("Lookup(%q) = %q, %v; want %q, %v", tc.domain, ps, sect, tc.wantPS, tc.wantSect)
+		}
+		if !strings.HasSuffix(tc.domain, ps) {
+			t.Errorf("Lookup(%q) = %q, not a suffix of the domain", tc.domain, ps)
+		}
+	}
+}
+
+func TestEffectiveTLDPlusOneHosts(t *testing.T) {
+	for _, tc := range []struct {
+		domain  string
+		want    string
+		wantErr error
+	}{
+		{"WWW.Example.COM", "Example.COM", nil},
+		{"www.example.com.", "example.com.", nil},
+		{"Example.co.UK.", "Example.co.UK.", nil},
+		{"com.", "", ErrNoETLDPlusOne},
+		{"Co.UK", "", ErrNoETLDPlusOne},
+		{"", "", ErrNoETLDPlusOne},
+		{".", "", ErrEmptyLabel},
+		{".example.com", "", ErrEmptyLabel},
+		{"example.com..", "", ErrEmptyLabel},
+		{"foo..example.com", "", ErrEmptyLabel},
+		{"192.0.2.1", "", ErrIPAddress},
+		{"[2001:db8::1]", "", ErrIPAddress},
+	} {
+		got, err := EffectiveTLDPlusOne(tc.domain)
+		if got != tc.want || !errors.Is(err, tc.wantErr) {
+			t.Errorf("EffectiveTLDPlusOne(%q) = %q, %v; want %q, %v", tc.domain, got, err, tc.want, tc.wantErr)
+		}
+	}
+}
+
 func TestEffectiveTLDPlusOne(t *testing.T) {
 	for _, tc := range eTLDPlusOneTestCases {
 		got, _ := EffectiveTLDPlusOne(tc.domain)
@@ -507,3 +629,89 @@ func TestEffectiveTLDPlusOne(t *testing.T) {
 		}
 	}
 }
+
+func TestLookupIDNA(t *testing.T) {
+	for _, tc := range []struct {
+		domain   string
+		form     Form
+		want     string
+		wantSect Section
+	}{
+		{"商業.tw", ASCII, "xn--czrw28b.tw", ICANN},
+		{"商業.tw", Original, "商業.tw", ICANN},
+		{"foo.商業.TW", Original, "商業.TW", ICANN},
+		{"foo.xn--czrw28b.tw", Original, "xn--czrw28b.tw", ICANN},
+		{"Example.COM", ASCII, "com", ICANN},
+		{"Example.COM", Original, "COM", ICANN},
+		{"栃木。ＪＰ", ASCII, "xn--4pvxs.jp", ICANN},
+		{"栃木。ＪＰ", Original, "栃木。ＪＰ", ICANN},
+		{"foo.Blogspot.co.UK", Original, "Blogspot.co.UK", Private},
+		{"_dmarc.example.com", ASCII, "com", ICANN},
+		{"foo.cromulent", Original, "cromulent", Unlisted},
+	} {
+		got, sect, err := LookupIDNA(tc.domain, tc.form)
+		if err != nil || got != tc.want || sect != tc.wantSect {
+			t.Errorf("LookupIDNA(%q, %v) = %q, %v, %v; want %q, %v, nil", tc.domain, tc.form, got, sect, err, tc.want, tc.wantSect)
+		}
+	}
+
+	if _, _, err := LookupIDNA("⒈com", ASCII); err == nil {
+		t.Errorf("LookupIDNA with a disallowed rune: got nil error")
+	}
+}
+
+func TestEffectiveTLDPlusOneIDNA(t *testing.T) {
+	for _, tc := range []struct {
+		domain string
+		form   Form
+		want   string
+	}{
+		{"www.Example.商業.tw", ASCII, "example.xn--czrw28b.tw"},
+		{"www.Example.商業.tw", Original, "Example.商業.tw"},
+		{"WWW.GOOGLE.CO.UK", Original, "GOOGLE.CO.UK"},
+		{"a.b.栃木。jp", Original, "b.栃木。jp"},
+	} {
+		got, err := EffectiveTLDPlusOneIDNA(tc.domain, tc.form)
+		if err != nil || got != tc.want {
+			t.Errorf("EffectiveTLDPlusOneIDNA(%q, %v) = %q, %v; want %q, nil", tc.domain, tc.form, got, err, tc.want)
+		}
+	}
+
+	if _, err := EffectiveTLDPlusOneIDNA("商業.tw", Original); err == nil {
+		t.Errorf("EffectiveTLDPlusOneIDNA of a public suffix: got nil error")
+	}
+}
+
+func TestIsPublicSuffix(t *testing.T) {
+	for _, tc := range []struct {
+		domain                     string
+		wantSuffix, wantRegistered bool
+	}{
+		{"com", true, false},
+		{"co.uk", true, false},
+		{"CO.UK.", true, false},
+		{"blogspot.co.uk", true, false},
+		{"cromulent", true, false},
+		{"foo.ck", true, false},
+		{"www.ck", false, true},
+		{"example.com", false, true},
+		{"Example.COM.", false, true},
+		{"foo.blogspot.co.uk", false, true},
+		{"foo.cromulent", false, true},
+		{"www.example.com", false, false},
+		{"", false, false},
+		{".", false, false},
+		{".com", false, false},
+		{"foo..com", false, false},
+		{"example..com", false, false},
+		{"192.0.2.1", false, false},
+		{"[2001:db8::1]", false, false},
+	} {
+		if got := IsPublicSuffix(tc.domain); got != tc.wantSuffix {
+			t.Errorf("IsPublicSuffix(%q) = %v; want %v", tc.domain, got, tc.wantSuffix)
+		}
+		if got := IsRegistrableDomain(tc.domain); got != tc.wantRegistered {
+			t.Errorf("IsRegistrableDomain(%q) = %v; want %v", tc.domain, got, tc.wantRegistered)
+		}
+	}
+}
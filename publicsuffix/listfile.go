@@ -0,0 +1,107 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package publicsuffix
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+)
+
+// DefaultListFile is the name of the list file that InitList looks for in
+// the directory of the running executable.
+const DefaultListFile = "public_suffix_list.dat"
+
+// LoadList reads and parses the list file name, in the format of
+// https://publicsuffix.org/list/public_suffix_list.dat, after verifying it
+// against the SHA-256 checksum in the file name + ".sha256". That file is
+// in the format written by sha256sum, as by running
+//
+//	sha256sum public_suffix_list.dat > public_suffix_list.dat.sha256
+//
+// and guards against files that are truncated or otherwise damaged in
+// deployment.
+//
+// Programs that embed a list of their own, such as with go:embed, can
+// parse it with Parse.
+func LoadList(name string) (*RuleSet, error) {
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	sum, err := ioutil.ReadFile(name + ".sha256")
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyChecksum(data, sum); err != nil {
+		return nil, fmt.Errorf("publicsuffix: %s: %v", name, err)
+	}
+	return Parse(bytes.NewReader(data))
+}
+
+// verifyChecksum reports an error unless the first field of sum is the
+// hex-encoded SHA-256 checksum of data.
+func verifyChecksum(data, sum []byte) error {
+	fields := strings.Fields(string(sum))
+	if len(fields) == 0 {
+		return errors.New("empty checksum file")
+	}
+	want, err := hex.DecodeString(fields[0])
+	if err != nil || len(want) != sha256.Size {
+		return fmt.Errorf("malformed checksum %q", fields[0])
+	}
+	if got := sha256.Sum256(data); !bytes.Equal(got[:], want) {
+		return fmt.Errorf("checksum mismatch: got %x, want %x", got, want)
+	}
+	return nil
+}
+
+// initRules holds the *RuleSet loaded by InitList, which the package's
+// functions and List use in place of the compiled-in list if it's not nil.
+var initRules atomic.Value // of *RuleSet
+
+// initRuleSet returns the list loaded by InitList, or nil if the
+// compiled-in list is in use.
+func initRuleSet() *RuleSet {
+	rs, _ := initRules.Load().(*RuleSet)
+	return rs
+}
+
+// InitList loads the list in the file named DefaultListFile in the
+// directory of the running executable, as by LoadList, and puts it in use
+// by List and the package's functions, such as PublicSuffix, in place of
+// the list compiled into the package. This lets operators update the list
+// used by a program without rebuilding it. If there's no such file,
+// InitList leaves the list in use unchanged and returns false and a nil
+// error.
+//
+// InitList is safe to call concurrently with lookups, but is typically
+// called once at the start of main.
+func InitList() (loaded bool, err error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return false, err
+	}
+	return initList(filepath.Join(filepath.Dir(exe), DefaultListFile))
+}
+
+func initList(name string) (loaded bool, err error) {
+	if _, err := os.Stat(name); os.IsNotExist(err) {
+		return false, nil
+	}
+	rs, err := LoadList(name)
+	if err != nil {
+		return false, err
+	}
+	initRules.Store(rs)
+	return true, nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package publicsuffix
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeListFile writes list and its checksum file to dir.
+func writeListFile(t *testing.T, dir, list string) string {
+	name := filepath.Join(dir, DefaultListFile)
+	if err := ioutil.WriteFile(name, []byte(list), 0600); err != nil {
+		t.Fatal(err)
+	}
+	sum := fmt.Sprintf("%x  %s\n", sha256.Sum256([]byte(list)), DefaultListFile)
+	if err := ioutil.WriteFile(name+".sha256", []byte(sum), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return name
+}
+
+func TestLoadList(t *testing.T) {
+	name := writeListFile(t, t.TempDir(), testList)
+	rs, err := LoadList(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := rs.PublicSuffix("foo.blogspot.co.uk"), "blogspot.co.uk"; got != want {
+		t.Errorf("PublicSuffix = %q; want %q", got, want)
+	}
+
+	// A damaged list fails verification.
+	if err := ioutil.WriteFile(name, []byte(testList[:len(testList)/2]), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadList(name); err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Errorf("LoadList of damaged list: %v; want checksum mismatch", err)
+	}
+
+	for _, sum := range []string{"", "xyz  " + DefaultListFile, "abcd\n"} {
+		if err := ioutil.WriteFile(name+".sha256", []byte(sum), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadList(name); err == nil {
+			t.Errorf("LoadList with checksum file %q succeeded", sum)
+		}
+	}
+
+	if _, err := LoadList(filepath.Join(t.TempDir(), DefaultListFile)); err == nil {
+		t.Errorf("LoadList of missing file succeeded")
+	}
+}
+
+func TestInitList(t *testing.T) {
+	defer initRules.Store((*RuleSet)(nil))
+
+	dir := t.TempDir()
+	loaded, err := initList(filepath.Join(dir, DefaultListFile))
+	if loaded || err != nil {
+		t.Fatalf("initList without a file = %v, %v; want false, nil", loaded, err)
+	}
+	if rs := initRuleSet(); rs != nil {
+		t.Fatalf("list changed to %v", rs)
+	}
+
+	name := writeListFile(t, dir, testList)
+	loaded, err = initList(name)
+	if !loaded || err != nil {
+		t.Fatalf("initList = %v, %v; want true, nil", loaded, err)
+	}
+	const domain = "foo.compute.example.com"
+	if got := List.PublicSuffix(domain); got != domain {
+		t.Errorf("List.PublicSuffix = %q; want %q", got, domain)
+	}
+	// The package's functions agree with List.
+	if got, icann := PublicSuffix(domain); got != domain || icann {
+		t.Errorf("PublicSuffix = %q, %v; want %q, false", got, icann, domain)
+	}
+	if got, err := EffectiveTLDPlusOne("www.foo.blogspot.co.uk"); got != "foo.blogspot.co.uk" || err != nil {
+		t.Errorf("EffectiveTLDPlusOne = %q, %v; want foo.blogspot.co.uk", got, err)
+	}
+	if rule, ok := MatchingRule(domain); !ok || rule.Pattern != "*.compute.example.com" {
+		t.Errorf("MatchingRule = %v, %v; want *.compute.example.com", rule, ok)
+	}
+	if got, want := fmt.Sprint(List), initRuleSet().String(); got != want {
+		t.Errorf("List.String = %q; want %q", got, want)
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package publicsuffix
+
+import (
+	"errors"
+	"sync"
+)
+
+// The compiled-in list is a tree of nodes, one per label of its rules,
+// which gen.go writes to tableData in a compact form. The nodes are the top
+// level domains, followed by the children of each node in turn, so that
+// each node's children are consecutive, in strictly increasing label order.
+//
+// tableData is a stream of bits, most significant bit of each byte first:
+//
+//	32 bits  number of nodes
+//	         the code tables for label bytes, prefix lengths and flags,
+//	         each made of:
+//	 8 bits    number of symbols
+//	12 bits    per symbol, in increasing order, its value and the
+//	           length of its code
+//	         per node:
+//	           the code of the length of the prefix its label shares with
+//	           the previous node's label
+//	           the codes of the rest of its label's bytes, then that of 0
+//	           the code of its flags: the node type, nodeFlagICANN and
+//	           nodeFlagWildcard
+//	           its number of children plus one, as an Elias gamma code
+//
+// The codes are canonical Huffman codes, as in DEFLATE (RFC 1951, Section
+// 3.2.2), of up to 15 bits. Compared to fixed-width records pointing into a
+// string of labels, this halves the size that the list adds to binaries.
+// The table is decoded the first time it's used, so programs that never
+// look up a domain in the compiled-in list don't pay for the decoded form.
+
+// A tableNode is a decoded node of tableData.
+type tableNode struct {
+	textOffset  uint32 // the label is text[textOffset:textOffset+textLength]
+	firstChild  uint32 // index of the first child
+	numChildren uint16
+	textLength  uint8
+	flags       uint8 // node type, nodeFlagICANN and nodeFlagWildcard
+}
+
+// A table is the decoded form of tableData.
+type table struct {
+	text  string // the concatenated labels of the nodes
+	nodes []tableNode
+}
+
+var (
+	tableOnce    sync.Once
+	decodedTable table
+)
+
+// nodeTable returns the table of nodes, decoding tableData the first time
+// it's called.
+func nodeTable() *table {
+	tableOnce.Do(func() {
+		if err := decodedTable.decode(tableData, numTLD); err != nil {
+			panic("publicsuffix: invalid table: " + err.Error())
+		}
+	})
+	return &decodedTable
+}
+
+var errTruncatedTable = errors.New("truncated table")
+
+// decode decodes data, a table with numTLD top level domains, into t.
+func (t *table) decode(data string, numTLD uint32) error {
+	r := bitReader{s: data}
+	n := r.bits(32)
+	var codes [3]huffmanCode
+	for i := range codes {
+		if err := codes[i].read(&r); err != nil {
+			return err
+		}
+	}
+	labelCode, prefixCode, flagsCode := &codes[0], &codes[1], &codes[2]
+	if r.err != nil || n < numTLD {
+		return errTruncatedTable
+	}
+
+	nodes := make([]tableNode, n)
+	var text []byte
+	var prev []byte // label of the previous node
+	next := numTLD  // index of the next node's first child
+	for i := range nodes {
+		shared := prefixCode.decode(&r)
+		if int(shared) > len(prev) {
+			return errors.New("bad label prefix length")
+		}
+		offset := len(text)
+		text = append(text, prev[:shared]...)
+		for {
+			c := labelCode.decode(&r)
+			if c == 0 || r.err != nil {
+				break
+			}
+			text = append(text, c)
+		}
+		flags := flagsCode.decode(&r)
+		numChildren := r.gamma() - 1
+		if r.err != nil {
+			return r.err
+		}
+		length := len(text) - offset
+		if length == 0 || length > 255 || numChildren > 1<<16-1 || numChildren > n-next {
+			return errors.New("bad node")
+		}
+		nodes[i] = tableNode{
+			textOffset:  uint32(offset),
+			firstChild:  next,
+			numChildren: uint16(numChildren),
+			textLength:  uint8(length),
+			flags:       flags,
+		}
+		next += numChildren
+		prev = text[offset:]
+	}
+	t.text, t.nodes = string(text), nodes
+	return nil
+}
+
+// label returns the label of the i'th node.
+func (t *table) label(i uint32) string {
+	n := &t.nodes[i]
+	return t.text[n.textOffset : n.textOffset+uint32(n.textLength)]
+}
+
+// A bitReader reads bits from s, most significant bit first. Reading past
+// the end of s sets err and returns zero bits.
+type bitReader struct {
+	s   string
+	pos int // index in s of the next bit
+	err error
+}
+
+func (r *bitReader) bit() uint32 {
+	if r.pos >= 8*len(r.s) {
+		r.err = errTruncatedTable
+		return 0
+	}
+	b := r.s[r.pos/8] >> (7 - r.pos%8) & 1
+	r.pos++
+	return uint32(b)
+}
+
+// bits reads an n-bit big-endian number, for n <= 32.
+func (r *bitReader) bits(n int) uint32 {
+	var x uint32
+	for i := 0; i < n; i++ {
+		x = x<<1 | r.bit()
+	}
+	return x
+}
+
+// gamma reads a positive number as an Elias gamma code: as many zero bits
+// as the number has bits after the leading one, then the number.
+func (r *bitReader) gamma() uint32 {
+	zeros := 0
+	for r.bit() == 0 {
+		if r.err != nil || zeros == 31 {
+			r.err = errTruncatedTable
+			return 0
+		}
+		zeros++
+	}
+	return 1<<zeros | r.bits(zeros)
+}
+
+// maxCodeLength is the maximum length of a huffmanCode.
+const maxCodeLength = 15
+
+// A huffmanCode decodes canonical Huffman codes.
+type huffmanCode struct {
+	count   [maxCodeLength + 1]uint16 // number of codes of each length
+	symbols []byte                    // in order of their codes
+}
+
+// read reads the code table of c from r: the number of symbols, then each
+// symbol, in increasing order, and the length of its code.
+func (c *huffmanCode) read(r *bitReader) error {
+	n := int(r.bits(8))
+	lengths := make([]uint8, n)
+	symbols := make([]byte, n)
+	for i := range symbols {
+		symbols[i] = byte(r.bits(8))
+		lengths[i] = uint8(r.bits(4))
+		if lengths[i] == 0 || (i > 0 && symbols[i] <= symbols[i-1]) {
+			return errors.New("bad code table")
+		}
+		c.count[lengths[i]]++
+	}
+	// Codes are assigned in order of length, then of symbol.
+	for l := uint8(1); l <= maxCodeLength; l++ {
+		for i, s := range symbols {
+			if lengths[i] == l {
+				c.symbols = append(c.symbols, s)
+			}
+		}
+	}
+	return r.err
+}
+
+// decode reads a code from r and returns its symbol. It returns 0 and sets
+// r.err if the code is invalid.
+func (c *huffmanCode) decode(r *bitReader) byte {
+	code, first, index := 0, 0, 0
+	for l := 1; l <= maxCodeLength; l++ {
+		code |= int(r.bit())
+		count := int(c.count[l])
+		if code-first < count {
+			return c.symbols[index+code-first]
+		}
+		index += count
+		first = (first + count) << 1
+		code <<= 1
+	}
+	if r.err == nil {
+		r.err = errors.New("bad code")
+	}
+	return 0
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package publicsuffix
+
+import "testing"
+
+func TestTableDecode(t *testing.T) {
+	var tab table
+	if err := tab.decode(tableData, numTLD); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(tab.nodes), len(nodeLabels); got != want {
+		t.Fatalf("got %d nodes, want %d", got, want)
+	}
+	for i, want := range nodeLabels {
+		if got := tab.label(uint32(i)); got != want {
+			t.Errorf("%d: got label %q, want %q", i, got, want)
+		}
+	}
+	// Every node but the top level domains is the child of exactly one node.
+	next := uint32(numTLD)
+	for i, n := range tab.nodes {
+		if n.numChildren == 0 {
+			continue
+		}
+		if n.firstChild != next {
+			t.Fatalf("node %d: first child is %d, want %d", i, n.firstChild, next)
+		}
+		next += uint32(n.numChildren)
+	}
+	if next != uint32(len(tab.nodes)) {
+		t.Errorf("children end at %d, want %d", next, len(tab.nodes))
+	}
+}
+
+func TestTableDecodeInvalid(t *testing.T) {
+	testCases := []struct {
+		name string
+		data string
+	}{
+		{"empty", ""},
+		{"header only", tableData[:4]},
+		{"truncated", tableData[:len(tableData)/2]},
+		{"last byte missing", tableData[:len(tableData)-1]},
+		{"no symbols", "\x00\x00\x00\x01\x00\x00\x00\x00"},
+	}
+	for _, tc := range testCases {
+		var tab table
+		if err := tab.decode(tc.data, numTLD); err == nil {
+			t.Errorf("%s: got nil error", tc.name)
+		}
+	}
+
+	// Too many top level domains for the number of nodes.
+	var tab table
+	if err := tab.decode(tableData, uint32(len(nodeLabels)+1)); err == nil {
+		t.Errorf("too many TLDs: got nil error")
+	}
+}
+
+func TestNodeTableDecodesOnce(t *testing.T) {
+	a, b := nodeTable(), nodeTable()
+	if a != b || len(a.nodes) != len(nodeLabels) {
+		t.Fatalf("nodeTable: got %p (%d nodes) and %p, want one table of %d nodes", a, len(a.nodes), b, len(nodeLabels))
+	}
+}
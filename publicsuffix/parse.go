@@ -0,0 +1,277 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package publicsuffix
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// A RuleSet is a public suffix list parsed at run time by Parse. Its methods
+// correspond to the package's functions of the same names, which use the
+// list compiled into the package, or loaded by InitList, instead.
+//
+// A RuleSet implements the cookiejar.PublicSuffixList interface. It is safe
+// for concurrent use.
+type RuleSet struct {
+	root ruleNode
+	n    int // number of rules
+}
+
+// A ruleNode holds the rules for one domain, such as "co.uk". A rule's
+// section is Unlisted if there's no such rule.
+type ruleNode struct {
+	children map[string]*ruleNode
+
+	normal    Section // section of the rule "co.uk"
+	wildcard  Section // section of the rule "*.co.uk"
+	exception Section // section of the rule "!co.uk"
+}
+
+func (n *ruleNode) child(label string) *ruleNode {
+	if c, ok := n.children[label]; ok {
+		return c
+	}
+	if n.children == nil {
+		n.children = make(map[string]*ruleNode)
+	}
+	c := new(ruleNode)
+	n.children[label] = c
+	return c
+}
+
+// Parse parses a public suffix list in the format of
+// https://publicsuffix.org/list/public_suffix_list.dat, so that programs can
+// use a more recent list than the one compiled into this package.
+//
+// Rules between the "===BEGIN ICANN DOMAINS===" and "===END ICANN
+// DOMAINS===" comments are ICANN rules; all others are private. Rules in
+// Unicode are converted to Punycode.
+func Parse(r io.Reader) (*RuleSet, error) {
+	rs := new(RuleSet)
+	section := Private
+	sc := bufio.NewScanner(r)
+	for lineNum := 1; sc.Scan(); lineNum++ {
+		line := strings.TrimSpace(sc.Text())
+		if strings.HasPrefix(line, "//") {
+			switch {
+			case strings.Contains(line, "===BEGIN ICANN DOMAINS==="):
+				section = ICANN
+			case strings.Contains(line, "===END ICANN DOMAINS==="):
+				section = Private
+			}
+			continue
+		}
+		// Each line is only read up to the first whitespace.
+		if i := strings.IndexAny(line, " \t"); i >= 0 {
+			line = line[:i]
+		}
+		if line == "" {
+			continue
+		}
+		if err := rs.add(line, section); err != nil {
+			return nil, fmt.Errorf("publicsuffix: line %d: %v", lineNum, err)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+func (rs *RuleSet) add(rule string, section Section) error {
+	var wildcard, exception bool
+	s := rule
+	switch {
+	case strings.HasPrefix(s, "*."):
+		s, wildcard = s[2:], true
+	case strings.HasPrefix(s, "!"):
+		s, exception = s[1:], true
+	}
+	s, err := idna.ToASCII(strings.ToLower(s))
+	if err != nil {
+		return fmt.Errorf("bad rule %q: %v", rule, err)
+	}
+	labels := strings.Split(s, ".")
+	n := &rs.root
+	for i := len(labels) - 1; i >= 0; i-- {
+		if labels[i] == "" || strings.Contains(labels[i], "*") || strings.Contains(labels[i], "!") {
+			return fmt.Errorf("bad rule %q", rule)
+		}
+		n = n.child(labels[i])
+	}
+	p := &n.normal
+	switch {
+	case wildcard:
+		p = &n.wildcard
+	case exception:
+		p = &n.exception
+	}
+	if *p == Unlisted {
+		rs.n++
+	}
+	*p = section
+	return nil
+}
+
+// Len returns the number of rules in rs.
+func (rs *RuleSet) Len() int {
+	return rs.n
+}
+
+// Lookup returns the public suffix of domain, and the section of the list
+// holding the rule that matched it, by the algorithm described at
+// https://publicsuffix.org/list/. domain is matched as by the package's
+// PublicSuffix function.
+func (rs *RuleSet) Lookup(domain string) (publicSuffix string, section Section) {
+	if isIPLiteral(domain) {
+		return domain, Unlisted
+	}
+	name := trimTrailingDot(domain)
+	publicSuffix, section, _ = rs.lookupName(name)
+	if publicSuffix == "" {
+		return "", section
+	}
+	return domain[len(name)-len(publicSuffix):], section
+}
+
+// lookupName is like Lookup, but name must not be an IP address or have a
+// trailing dot. kind is the kind of the rule that matched, if section isn't
+// Unlisted.
+func (rs *RuleSet) lookupName(domain string) (publicSuffix string, section Section, kind RuleKind) {
+	// The prevailing rule is an exception rule if one matches, and
+	// otherwise the matching rule with the most labels. matched is that
+	// rule's number of labels.
+	matched := 0
+	n := &rs.root
+	s := domain
+	for depth := 1; ; depth++ {
+		dot := strings.LastIndex(s, ".")
+		label := s[1+dot:]
+		if label == "" {
+			// No rule has an empty label, not even a wildcard.
+			break
+		}
+		if n.wildcard != Unlisted && depth > matched {
+			matched, section, kind = depth, n.wildcard, WildcardRule
+		}
+		c := n.children[lowerASCII(label)]
+		if c == nil {
+			break
+		}
+		if c.exception != Unlisted {
+			// The public suffix is the exception rule with its
+			// leftmost label removed.
+			return strings.TrimPrefix(domain[len(s):], "."), c.exception, ExceptionRule
+		}
+		if c.normal != Unlisted && depth > matched {
+			matched, section, kind = depth, c.normal, NormalRule
+		}
+		if dot < 0 {
+			break
+		}
+		n, s = c, s[:dot]
+	}
+	if matched == 0 {
+		// If no rules match, the prevailing rule is "*".
+		return domain[1+strings.LastIndex(domain, "."):], Unlisted, kind
+	}
+	i := len(domain)
+	for ; matched > 0; matched-- {
+		i = strings.LastIndex(domain[:i], ".")
+	}
+	return domain[i+1:], section, kind
+}
+
+// MatchingRule is like the package's MatchingRule function, but uses the
+// rules of rs.
+func (rs *RuleSet) MatchingRule(domain string) (rule Rule, ok bool) {
+	if isIPLiteral(domain) {
+		return Rule{}, false
+	}
+	name := trimTrailingDot(domain)
+	publicSuffix, section, kind := rs.lookupName(name)
+	if section == Unlisted {
+		return Rule{}, false
+	}
+	return Rule{rulePattern(name, publicSuffix, kind), section}, true
+}
+
+// ChildRules is like the package's ChildRules function, but returns the
+// rules of rs.
+func (rs *RuleSet) ChildRules(suffix string) []Rule {
+	var rules []Rule
+	n := &rs.root
+	if suffix = lowerASCII(trimTrailingDot(suffix)); suffix != "" {
+		labels := strings.Split(suffix, ".")
+		for i := len(labels) - 1; i >= 0; i-- {
+			if n = n.children[labels[i]]; n == nil {
+				return nil
+			}
+		}
+		if n.wildcard != Unlisted {
+			rules = append(rules, Rule{"*." + suffix, n.wildcard})
+		}
+	}
+	rules = n.appendChildRules(rules, suffix)
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Pattern < rules[j].Pattern })
+	return rules
+}
+
+// appendChildRules appends the rules of the descendants of n, the node for
+// suffix.
+func (n *ruleNode) appendChildRules(rules []Rule, suffix string) []Rule {
+	for label, c := range n.children {
+		name := label
+		if suffix != "" {
+			name += "." + suffix
+		}
+		if c.normal != Unlisted {
+			rules = append(rules, Rule{name, c.normal})
+		}
+		if c.exception != Unlisted {
+			rules = append(rules, Rule{"!" + name, c.exception})
+		}
+		if c.wildcard != Unlisted {
+			rules = append(rules, Rule{"*." + name, c.wildcard})
+		}
+		rules = c.appendChildRules(rules, name)
+	}
+	return rules
+}
+
+// PublicSuffix returns the public suffix of domain.
+func (rs *RuleSet) PublicSuffix(domain string) string {
+	ps, _ := rs.Lookup(domain)
+	return ps
+}
+
+// EffectiveTLDPlusOne returns the effective top level domain of domain plus
+// one more label.
+func (rs *RuleSet) EffectiveTLDPlusOne(domain string) (string, error) {
+	return effectiveTLDPlusOne(domain, rs.PublicSuffix)
+}
+
+// IsPublicSuffix is like the package's IsPublicSuffix function, but uses
+// the rules of rs.
+func (rs *RuleSet) IsPublicSuffix(domain string) bool {
+	return isPublicSuffix(domain, rs.PublicSuffix)
+}
+
+// IsRegistrableDomain is like the package's IsRegistrableDomain function,
+// but uses the rules of rs.
+func (rs *RuleSet) IsRegistrableDomain(domain string) bool {
+	return isRegistrableDomain(domain, rs.PublicSuffix)
+}
+
+// String returns a description of rs.
+func (rs *RuleSet) String() string {
+	return fmt.Sprintf("publicsuffix.RuleSet with %d rules", rs.n)
+}
@@ -0,0 +1,255 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package publicsuffix
+
+import (
+	"net/http/cookiejar"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+var _ cookiejar.PublicSuffixList = (*RuleSet)(nil)
+
+const testList = `// A comment.
+// ===BEGIN ICANN DOMAINS===
+com
+uk
+co.uk
+
+// Wildcards and exceptions.
+*.ck
+!www.ck
+
+// Unicode rules are converted to Punycode.
+рф
+ОРГ.рф
+
+// ===END ICANN DOMAINS===
+// ===BEGIN PRIVATE DOMAINS===
+blogspot.co.uk   only the first field of a rule counts
+*.compute.example.com
+// ===END PRIVATE DOMAINS===
+`
+
+func TestParse(t *testing.T) {
+	rs, err := Parse(strings.NewReader(testList))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := rs.Len(), 9; got != want {
+		t.Errorf("Len = %d; want %d", got, want)
+	}
+	testCases := []struct {
+		domain      string
+		wantSuffix  string
+		wantSection Section
+	}{
+		{"com", "com", ICANN},
+		{"example.com", "com", ICANN},
+		{"foo.co.uk", "co.uk", ICANN},
+		{"foo.blogspot.co.uk", "blogspot.co.uk", Private},
+		{"blogspot.co.uk", "blogspot.co.uk", Private},
+		{"ck", "ck", Unlisted},
+		{"foo.ck", "foo.ck", ICANN},
+		{"bar.foo.ck", "foo.ck", ICANN},
+		{"www.ck", "ck", ICANN},
+		{"a.www.ck", "ck", ICANN},
+		{"xn--p1ai", "xn--p1ai", ICANN},
+		{"foo.xn--c1avg.xn--p1ai", "xn--c1avg.xn--p1ai", ICANN},
+		{"a.b.compute.example.com", "b.compute.example.com", Private},
+		{"compute.example.com", "com", ICANN},
+		{"foo.intranet", "intranet", Unlisted},
+		{"", "", Unlisted},
+	}
+	for _, tc := range testCases {
+		suffix, section := rs.Lookup(tc.domain)
+		if suffix != tc.wantSuffix || section != tc.wantSection {
+			t.Errorf("Lookup(%q) = %q, %v; want %q, %v", tc.domain, suffix, section, tc.wantSuffix, tc.wantSection)
+		}
+	}
+
+	if got, err := rs.EffectiveTLDPlusOne("www.foo.blogspot.co.uk"); got != "foo.blogspot.co.uk" || err != nil {
+		t.Errorf("EffectiveTLDPlusOne = %q, %v; want foo.blogspot.co.uk", got, err)
+	}
+	if got, err := rs.EffectiveTLDPlusOne("co.uk"); err == nil {
+		t.Errorf("EffectiveTLDPlusOne(co.uk) = %q; want error", got)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, list := range []string{
+		"com\n..com\n",
+		"*.*.com\n",
+		"a.!b.com\n",
+	} {
+		if _, err := Parse(strings.NewReader(list)); err == nil {
+			t.Errorf("Parse(%q) succeeded; want error", list)
+		}
+	}
+}
+
+// TestParseMatchesTable checks that a RuleSet parsed from the rules of the
+// compiled-in table agrees with the package's functions.
+func TestParseMatchesTable(t *testing.T) {
+	rs := tableRuleSet(t)
+	if rs.Len() != len(rules) {
+		t.Errorf("Len = %d; want %d", rs.Len(), len(rules))
+	}
+
+	isRule := make(map[string]bool)
+	for _, rule := range rules {
+		isRule[rule] = true
+	}
+	check := func(domain string) {
+		gotSuffix, gotSection := rs.Lookup(domain)
+		wantSuffix, wantSection := Lookup(domain)
+		if gotSuffix != wantSuffix || gotSection != wantSection {
+			t.Errorf("Lookup(%q) = %q, %v; package Lookup = %q, %v", domain, gotSuffix, gotSection, wantSuffix, wantSection)
+		}
+		gotRule, gotOK := rs.MatchingRule(domain)
+		wantRule, wantOK := MatchingRule(domain)
+		if gotRule != wantRule || gotOK != wantOK {
+			t.Errorf("MatchingRule(%q) = %v, %v; package MatchingRule = %v, %v", domain, gotRule, gotOK, wantRule, wantOK)
+		}
+		if wantOK != (wantSection != Unlisted) || wantOK && (!isRule[wantRule.Pattern] || wantRule.Section != wantSection) {
+			t.Errorf("MatchingRule(%q) = %v, %v, inconsistent with Lookup = %q, %v", domain, wantRule, wantOK, wantSuffix, wantSection)
+		}
+	}
+	for _, rule := range rules {
+		rule = strings.TrimPrefix(strings.TrimPrefix(rule, "*."), "!")
+		check(rule)
+		check("foo." + rule)
+		check("bar.foo." + rule)
+	}
+	for _, domain := range []string{"", "cromulent", "foo.cromulent", "www.ck", "a.b.c.kobe.jp"} {
+		check(domain)
+	}
+	for _, domain := range []string{
+		"Example.COM", "Foo.CK", "WWW.CK", "example.com.", "foo.blogspot.co.uk.",
+		".", "foo..", ".com", "foo..co.uk", "foo..ck", "192.0.2.1", "[2001:db8::1]",
+	} {
+		check(domain)
+	}
+}
+
+// tableRuleSet returns a RuleSet parsed from the rules of the compiled-in
+// table.
+func tableRuleSet(t *testing.T) *RuleSet {
+	var b strings.Builder
+	b.WriteString("// ===BEGIN ICANN DOMAINS===\n")
+	for i, rule := range rules {
+		if i == numICANNRules {
+			b.WriteString("// ===END ICANN DOMAINS===\n")
+		}
+		b.WriteString(rule + "\n")
+	}
+	rs, err := Parse(strings.NewReader(b.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rs
+}
+
+func TestChildRules(t *testing.T) {
+	var all []Rule
+	for i, rule := range rules {
+		section := ICANN
+		if i >= numICANNRules {
+			section = Private
+		}
+		all = append(all, Rule{rule, section})
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Pattern < all[j].Pattern })
+
+	rs := tableRuleSet(t)
+	for _, f := range []struct {
+		name       string
+		childRules func(string) []Rule
+	}{
+		{"ChildRules", ChildRules},
+		{"RuleSet.ChildRules", rs.ChildRules},
+	} {
+		if got := f.childRules(""); !reflect.DeepEqual(got, all) {
+			t.Errorf("%s(\"\") returned %d rules; want all %d", f.name, len(got), len(all))
+		}
+		for _, suffix := range []string{"uk", "kawasaki.jp", "Kawasaki.JP.", "jp", "cromulent", "zzz.uk"} {
+			var want []Rule
+			key := lowerASCII(trimTrailingDot(suffix))
+			for _, r := range all {
+				name := strings.TrimPrefix(strings.TrimPrefix(r.Pattern, "*."), "!")
+				if strings.HasSuffix(name, "."+key) || strings.HasPrefix(r.Pattern, "*.") && name == key {
+					want = append(want, r)
+				}
+			}
+			if got := f.childRules(suffix); !reflect.DeepEqual(got, want) {
+				t.Errorf("%s(%q) = %v; want %v", f.name, suffix, got, want)
+			}
+		}
+	}
+
+	rs, err := Parse(strings.NewReader(testList))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Rule{{"!www.ck", ICANN}, {"*.ck", ICANN}}
+	if got := rs.ChildRules("ck"); !reflect.DeepEqual(got, want) {
+		t.Errorf("ChildRules(\"ck\") = %v; want %v", got, want)
+	}
+	want = []Rule{{"blogspot.co.uk", Private}, {"co.uk", ICANN}}
+	if got := rs.ChildRules("uk"); !reflect.DeepEqual(got, want) {
+		t.Errorf("ChildRules(\"uk\") = %v; want %v", got, want)
+	}
+}
+
+func TestRuleSetIsPublicSuffix(t *testing.T) {
+	rs, err := Parse(strings.NewReader(testList))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		domain                     string
+		wantSuffix, wantRegistered bool
+	}{
+		{"co.uk", true, false},
+		{"blogspot.co.uk", true, false},
+		{"foo.blogspot.co.uk", false, true},
+		{"foo.compute.example.com", true, false},
+		{"example.com", false, true},
+		{"www.example.com", false, false},
+	} {
+		if got := rs.IsPublicSuffix(tc.domain); got != tc.wantSuffix {
+			t.Errorf("IsPublicSuffix(%q) = %v; want %v", tc.domain, got, tc.wantSuffix)
+		}
+		if got := rs.IsRegistrableDomain(tc.domain); got != tc.wantRegistered {
+			t.Errorf("IsRegistrableDomain(%q) = %v; want %v", tc.domain, got, tc.wantRegistered)
+		}
+	}
+}
+
+func TestMatchingRule(t *testing.T) {
+	for _, tc := range []struct {
+		domain   string
+		want     Rule
+		wantKind RuleKind
+		wantOK   bool
+	}{
+		{"example.co.uk", Rule{"co.uk", ICANN}, NormalRule, true},
+		{"WWW.Example.CO.UK.", Rule{"co.uk", ICANN}, NormalRule, true},
+		{"foo.kawasaki.jp", Rule{"*.kawasaki.jp", ICANN}, WildcardRule, true},
+		{"a.b.Foo.Kawasaki.JP", Rule{"*.kawasaki.jp", ICANN}, WildcardRule, true},
+		{"city.kawasaki.jp", Rule{"!city.kawasaki.jp", ICANN}, ExceptionRule, true},
+		{"www.City.Kawasaki.JP.", Rule{"!city.kawasaki.jp", ICANN}, ExceptionRule, true},
+		{"foo.cromulent", Rule{}, NormalRule, false},
+		{"192.0.2.1", Rule{}, NormalRule, false},
+		{"", Rule{}, NormalRule, false},
+	} {
+		got, ok := MatchingRule(tc.domain)
+		if got != tc.want || ok != tc.wantOK || got.Kind() != tc.wantKind {
+			t.Errorf("MatchingRule(%q) = %v (%v), %v; want %v (%v), %v", tc.domain, got, got.Kind(), ok, tc.want, tc.wantKind, tc.wantOK)
+		}
+	}
+}
@@ -1,10583 +1,37 @@
 // generated by go run gen.go; DO NOT EDIT
 
-package publicsuffix
+//go:build !publicsuffix_icann
+// +build !publicsuffix_icann
 
-const version = "publicsuffix.org's public_suffix_list.dat, git revision 3c213aab32b3c014f171b1673d4ce9b5cd72bf1c (2021-11-26T23:05:53Z)"
+package publicsuffix
 
-const (
-	nodesBitsChildren   = 10
-	nodesBitsICANN      = 1
-	nodesBitsTextOffset = 15
-	nodesBitsTextLength = 6
+import _ "embed"
 
-	childrenBitsWildcard = 1
-	childrenBitsNodeType = 2
-	childrenBitsHi       = 14
-	childrenBitsLo       = 14
-)
+const version = "publicsuffix.org's public_suffix_list.dat, git revision 9e8325c62adb9f7c6211cb7c4f6970a27fcb67f1 (2023-02-09T23:26:35Z)"
 
 const (
 	nodeTypeNormal     = 0
 	nodeTypeException  = 1
 	nodeTypeParentOnly = 2
+
+	nodeFlagsTypeMask = 3
+	nodeFlagICANN     = 4
+	nodeFlagWildcard  = 8
 )
 
 // numTLD is the number of top level domains.
-const numTLD = 1504
-
-// Text is the combined text of all labels.
-const text = "9guacuiababia-goracleaningroks-theatree164-balsfjordd-dnshome-we" +
-	"bservercellikes-piedmonticellocalzoneastasiaetnaamesjevuemielnod" +
-	"umcpeastcoastaldefenceastus2038birdartcenterprisecloudaccesscamb" +
-	"ridgeiseiroumuenchenishiazaindielddanuorrindigenamsosnowiecherni" +
-	"vtsiciliabirkenesoddtangenovaragusarts3-website-eu-west-1birthpl" +
-	"acebitbucketrzynishigovtatsunocelotenkawabjarkoyoshiokanumazuryu" +
-	"kindowapblogsiteleafamilycompany-2bjerkreimbaltimore-og-romsdalp" +
-	"ha-myqnapcloud66bjugnieznorddalombardynalias3-website-sa-east-1b" +
-	"lackfridayukuhashimoichinosekigaharabloombergbauernishiharabloxc" +
-	"ms3-website-us-east-1bluebitemasekd1bmoattachments3-website-us-w" +
-	"est-1bms3-website-us-west-2bmweeklylotteryurihonjournalistjohnis" +
-	"hiizunazukindustriabnrwegroweibolognagareyamakeupowiathletajimag" +
-	"eandsoundandvision-riopretochigiftsalangenishikatakatsukindustri" +
-	"esteamfamberkeleyusuharabomloabaths-heilbronnoysundivttasvuotnak" +
-	"aniikawatanagurabondigitaloceanspacesalon-1bonnishikatsuragit-re" +
-	"posts-and-telecommunicationsaltdalomzaporizhzhegurinfinitinsureg" +
-	"ruhostingloboavistanbulsan-sudtirolondonetskaratsuginamikatagami" +
-	"hokkaidovre-eikerbookinghostedpictetnedalondrinamsskoganeintelli" +
-	"gencebookonlinewjerseyusuisservegame-serverboomlajollamericanexp" +
-	"ressexyuufcfanishikawazukamisatokaizukameyamatotakadaboschaeffle" +
-	"rdalorenskoglogoweirbostik-serveronagasakikuchikuseihicampobasso" +
-	"ciatest-iservecounterstrikebostonakijinsekikogentappsselfiparach" +
-	"utingloppenzaolbia-tempio-olbiatempioolbialystokkeliwebhostinglu" +
-	"gsjcbnpparibashkiriabotanicalgardeno-stagingmbhartipschlesisches" +
-	"aludiyuzawabotanicgardenishimerabotanychernovtsyncloudrangedalot" +
-	"tokorozawabouncemerckmsdnipropetrovskjervoyageometre-experts-com" +
-	"ptablesalvadordalibabalena-devicesalzburgminakamichiharabounty-f" +
-	"ullensakerrypropertiesamegawaboutiquebecommerce-shopitsitemp-dns" +
-	"watch-and-clockerboutireserve-onlinewmexicodyn-o-saurlandesamnan" +
-	"gerbozen-sudtirolouvreisenishinomiyashironocparaglidingmodelling" +
-	"mxboxfordelmenhorstalbansampaleoddabozen-suedtirolpusercontentat" +
-	"toolforgerockartuzybplaceducatorprojectaxihuanishinoomotegohtawa" +
-	"ramotoineppubtlsamsclubartowellbeingzonebrandywinevalleybrasilia" +
-	"bresciabrindisibenikikugawashtenawdevcdnaccessobetsuitagajobserv" +
-	"ableusercontentcmeloyalistoragebristoloseyouriparisor-fronishino" +
-	"shimatsumotofukebritishcolumbialowiezaganquannefrankfurtcp4broad" +
-	"castlebtimnetzlgretakaharussiabroadwaybroke-itvedestrandray-dnst" +
-	"racebrokerbrothermesaverdealerbrowsersafetymarketsamsungrimstadr" +
-	"ayddns5ybrumunddalublindesnesandnessjoenishiokoppegardraydnsupda" +
-	"terbrunelastxenishitosashimizunaminamibosognebrusselsandoybruxel" +
-	"lesandvikcoromantovalle-daostavangerbryanskodjedugit-pagespeedmo" +
-	"bilizeroticagliaricoharuhrbrynewportgorybuskerudrobaknoluoktachi" +
-	"kawafflecellclstagehirnishiwakinterhostsolutionsanfranciscofreak" +
-	"unekobayashikaoirmembersangomniweatherchannelucaniabuzentsujiieb" +
-	"uzzwesteuropenairbusantiquest-a-la-maisondre-landroidrrbwestfale" +
-	"nissandiegomurabzhitomirbzzcoloradoplateaudiopsysantacruzsantafe" +
-	"djeffersoncolumbusheycommunecommunity-prochowicecomobaranzancomp" +
-	"aremarkerryhotelsantamariakecomsecaaskoyabearalvahkievennodesaba" +
-	"erobaticketsantoandreamhostersanukintuitjxjavaldaostathellevange" +
-	"rcondoshichinohealth-carereformemergencyahabaghdadultkmaxxn--0tr" +
-	"q7p7nnconferenceconstructionconsuladogadollsaobernardoconsultant" +
-	"hropologyconsultingrossetouchihayaakasakawaharacontactksatxn--11" +
-	"b4c3dyndns-blogdnsaogoncarriercontagematsubaraumalatvuopmicrosof" +
-	"tbankasaokamikoaniihamatamakawajimaritimodumemorialcontemporarya" +
-	"rteducationalchikugodonnagatorogersvp4contractorskenconventuresh" +
-	"inodearthruherecipescaracalvinklein-berlindaskvollcookingchannel" +
-	"sdvrdnsdojoetsuwanouchikujogaszkolancashireclaimsaotomeiwamashik" +
-	"okuchuocoolcooperativano-frankivskygearapparochernigovernmentlon" +
-	"-2copenhagencyclopedichitosetoeidsvollucernecoproductionsapporoc" +
-	"orporationcorsicahcesuoloansardegnaroycorvettempurlcosenzakopane" +
-	"lblagrarchaeologyeongbuk0cosidnsfor-better-thanawatchandclockash" +
-	"ibatakasakiwakunigamilanotairestaurantmparsardiniacostumedicalta" +
-	"nissettaipeigersundyndns-freeboxosascoli-picenordlandyndns-homed" +
-	"nsarlcouchpotatofriesarpsborgroundhandlingroznycoukashiharacounc" +
-	"ilcouponsarufutsunomiyawakasaikaitabashijonawatecozoravennaharim" +
-	"alborkashiwaracqcxn--12c1fe0bradescotlandyndns-ipartinuyamashina" +
-	"tsukigatakaokalmykiacranbrookuwanalyticsxn--12cfi8ixb8lcrdyndns-" +
-	"mailcreditcardyndns-office-on-the-webercreditunioncremonashgabad" +
-	"addjaguarqhachinohedmarkashiwazakiwielunnercrewfarsundyndns-pics" +
-	"asayamatta-varjjatoyosatoyokawacricketoyotapartsasebofagemologic" +
-	"allynxn--12co0c3b4evalled-aostakinouecrimeast-kazakhstanangercro" +
-	"tonecrownipartycrsaskatchewancruisesassarinvestmentsaudacuisinel" +
-	"lancasterculturalcentertainmentoyotomiyazakinzais-a-candidatecun" +
-	"eocupcakecuritibackyardsauheradyndns-remotewdyndns-serverdalcurv" +
-	"alledaostakkokonoecymruovatmallorcafederation-webpaashorokanaiec" +
-	"yonabarumemsettlersavannahgacyouthachiojiyaitakahashimamakisosak" +
-	"itagawaferraraferrarivneferrerotikagoshimalopolskanlandyndns-wik" +
-	"irafetsundyndns-workshoparenakanojohanamakinoharafgujoinvilleitu" +
-	"ngsenfhvalerfidoomdnsiskinkyotobetsulikescandyn53fieldyndns1figu" +
-	"eresinstagingulenfilateliafilegear-audnedalnfilegear-dealstahaug" +
-	"esunderseaportsinfolionetworkangerfilegear-gbizfilegear-iefilege" +
-	"ar-jpmorganfilegear-sg-1filminamifuranofinalfinancefineartschule" +
-	"finlandynnsaveincloudyndns-webhareidsbergentingrpasadenarashinof" +
-	"innoyfirebaseappassenger-associationfirenetoyourafirenzefireston" +
-	"efirewebhopocznordreisa-hockeynutazurestaticappspaceusercontento" +
-	"ystre-slidrettozawafirmdalegoldpoint2thisamitsukefishingolffansc" +
-	"hulserverfitjarvodkagaminogiessennanjobojis-a-catererfitnessettl" +
-	"ementozsdeloittenrissagaeroclubmedecincinnativeamericanantiquest" +
-	"-mon-blogueurodirumaceratabitorderimo-siemenscaledekaascolipicen" +
-	"oboribetsuckschwarzgwangjuifminamiiserniafjalerfldrvallee-aoster" +
-	"oyflekkefjordynservebbsaves-the-whalessandria-trani-barletta-and" +
-	"riatranibarlettaandriaflesbergunmaniwakurateflickragerokunohealt" +
-	"hcareerschweizflirfloginlinefloraflorencefloridatsunangojomedici" +
-	"nakaiwamizawatchesciencecentersciencehistoryfloripaderbornfloris" +
-	"tanohataitogliattis-a-celticsfanfloromskoguovdageaidnulvikasukab" +
-	"edzin-addrammenuorochesterflowerscientistordalfltrani-andria-bar" +
-	"letta-trani-andriaflynnhosting-clusterfndynulmetacentrumeteorapp" +
-	"assagensavonarusawafnwkasumigaurayasudafoodnetworkdalfor-ourfor-" +
-	"somedio-campidano-mediocampidanomediofor-theaterforexrothachirog" +
-	"atakahatakaishimogosenforgotdnscjohnsonforli-cesena-forlicesenaf" +
-	"orlillehammerfeste-ipatriaforsaleikangerforsandasuoloftraniandri" +
-	"abarlettatraniandriafortalfortexascrapper-sitefortmissoulanciafo" +
-	"rtworthadanorfolkebibleluxembourgushikamifuranore-og-uvdalfosnes" +
-	"crappingwiddleksvikasuyanaizuerichardlillyfotranoyfoxafozfranami" +
-	"zuhobby-sitextileirfjordynv6francaiseharafranziskanerimaringatla" +
-	"ntaiwanairforcechireadthedocscbgxn--1ctwolominamataobaomoriguchi" +
-	"haraffleentry-snowplowiczeladzfredrikstadtvscrysecuritytacticser" +
-	"vehalflifeinsurancefreeddnsfreebox-oservehttpbin-butterfreedeskt" +
-	"oppdalfreemasonryfreemyiphosteurovisionfreesitefreetlservehumour" +
-	"freiburgfreseniuscultureggio-calabriafribourgfriuli-v-giuliafriu" +
-	"li-ve-giuliafriuli-vegiuliafriuli-venezia-giuliafriuli-veneziagi" +
-	"uliafriuli-vgiuliafriuliv-giuliafriulive-giuliafriulivegiuliafri" +
-	"ulivenezia-giuliafriuliveneziagiuliafriulivgiuliafrlfroganservei" +
-	"rchonanbulsan-suedtirolukowestus2frognfrolandynvpnpluscountryest" +
-	"ateofdelawarecreationfrom-akrehamnfrom-alfrom-arfrom-azimuthatog" +
-	"ayabukihokumakogenglandyroyrvikingruenoharafrom-capetownnews-sta" +
-	"gingfrom-coffeedbackplaneappaviancargodaddyn-vpndnserveminecraft" +
-	"ranslatefrom-ctransportefrom-dchoseikarugamvikariyaltakasagotsuk" +
-	"isofukushimangyshlakasamatsudopaasnesoddenmarkhangelskjakdneprop" +
-	"etrovskiervaapsteiermarkarlsoyfrom-deatnuniversityfrom-flanderse" +
-	"rvemp3from-gaulardalfrom-hichisodegaurafrom-iafrom-idfrom-ilfrom" +
-	"-in-brbar0from-kservep2pfizerfrom-kyowariasahikawafrom-langevagr" +
-	"igentomologyeonggiehtavuoatnabudapest-a-la-masion-rancherkasydne" +
-	"yfrom-malselvendrellfrom-mdfrom-medizinhistorischeservepicserveq" +
-	"uakefrom-midsundfrom-mnfrom-modalenfrom-mservesarcasmatartanddes" +
-	"ignfrom-mtnfrom-nchoshibuyachtsanjotelulubindaluroyfrom-ndfrom-n" +
-	"efrom-nhktransurlfrom-njservicesevastopolefrom-nminamiizukaminok" +
-	"awanishiaizubangefrom-nvallee-d-aosteigenfrom-nynysagamiharafrom" +
-	"-ohdattorelayfrom-oketogonohejis-a-chefastly-terrariuminamiechiz" +
-	"enfrom-orfrom-padoval-daostavalleyfrom-pratogurafrom-ris-a-conse" +
-	"rvativegasevenassisicilyfrom-schoenbrunnfrom-sdscloudfrom-tnfrom" +
-	"-txn--1lqs03nfrom-utsiracusaikirovogradoyfrom-vald-aostarostwodz" +
-	"islawhalingfrom-vtrapaniizafrom-wafrom-wiardwebspacefrom-wvallee" +
-	"aosteinkjerusalembroideryfrom-wyfrosinonefrostaplesewhoswholding" +
-	"small-webredirectmeeresistancefroyahooguyfruskydivingfstcgroupgf" +
-	"oggiafujiiderafujikawaguchikonefujiminokamoenairguardiannakadoma" +
-	"rineat-urlfujinomiyadattowebcampinashikiminohostfoldnavyfujiokay" +
-	"amalvikaszubyfujisatoshonairlinebraskaunicommbankatowicefujisawa" +
-	"fujishiroishidakabiratoridebianfujitsurugashimamurogawafujiyoshi" +
-	"davvenjargap-northeast-3fukayabeatsharis-a-cpadualstackatsushika" +
-	"beebyteapplinzis-a-cubicle-slavellinodeobjectsharpharmacienshawa" +
-	"iijimarburgfukuchiyamadavvesiidappnodebalancertificationfukudomi" +
-	"gawafukuis-a-democratravelchannelfukumitsubishigakiryuohkurafuku" +
-	"okazakisarazure-mobileirvikatsuyamarriottravelersinsurancefukuro" +
-	"ishikarikaturindalfukusakishiwadazaifudaigokaseljordfukuyamagata" +
-	"jimifunefunabashiriuchinadafunagatajiris-a-designerfunahashikami" +
-	"amakusatsumasendaisenergyfundaciofunkfeuerfuoiskujukuriyamandalf" +
-	"uosskoczowienfurnitureggio-emilia-romagnakasatsunairportland-4-s" +
-	"alernogatabusebastopologyeongnamegawafaicloudinedre-eikerfurubir" +
-	"afurudonostiaafurukawairtelebitbridgestoneen-rootaruis-a-doctorf" +
-	"usoftwarezzoologyfussaintlouis-a-anarchistoireggiocalabriafutaba" +
-	"yamaguchinomihachimanagementrdfutboldlygoingnowhere-for-morenaka" +
-	"tombetsumitakagiizefuttsurugimperiafuturecmshellaspeziafuturehos" +
-	"tingfuturemailingfvghangglidinghangoutsystemscloudsitehannanmoku" +
-	"izumodenakayamansionshimojis-a-greenhannorthwesternmutualhanyuze" +
-	"nhapmircloudletshimokawahappounjargaharstadharvestcelebrationhas" +
-	"amanxn--1lqs71dhasaminami-alpshimokitayamattelekommunikationhash" +
-	"banghasudahasura-appharmacyshimonitayanagitapphdfcbankazohasvika" +
-	"zteleportlligatrendhostinghatoyamazakitahiroshimaoris-a-gurunusu" +
-	"alpersonhatsukaichikaiseiyoichippubetsubetsugarunzenhattfjelldal" +
-	"hayashimamotobungotakadagestangeorgeorgiahazuminobusellfylkesbib" +
-	"lackbaudcdn-edgestackhero-networkinggroupliguriahelsinkitakamiiz" +
-	"umisanofidelitysvardontexistmein-iservebeero-stagehembygdsforbun" +
-	"dhemneshimonosekikawahemsedalhepforgeblockshimosuwalkis-a-hard-w" +
-	"orkershimotsukeheroyhgtvalleedaostehidorahigashiagatsumagoianiah" +
-	"igashichichibunkyonanaoshimakanegasakilatironrenderhigashihirosh" +
-	"imanehigashiizumozakitakatakamoriokakudamatsuehigashikagawahigas" +
-	"hikagurasoedahigashikawakitaaikitakyushuaiahigashikurumeetrentin" +
-	"-sud-tirolhigashimatsushimapartmentshimotsumayfirstockholmestran" +
-	"dhigashimatsuyamakitaakitadaitoigawahigashimurayamamotorcycleshi" +
-	"nichinanhigashinarusells-for-lesshinjournalismailillesandefjordh" +
-	"igashinehigashiomitamamurausukitamihamadahigashiosakasayamanakak" +
-	"ogawahigashishirakawamatakanabeautysfjordhigashisumiyoshikawamin" +
-	"amiaikitamotosumy-gatewayhigashitsunortonhigashiurawa-mazowszexn" +
-	"etlifyis-a-hunterhigashiyamatokoriyamanashifteditorxn--1qqw23ahi" +
-	"gashiyodogawahigashiyoshinogaris-a-knightpointtohoboleslawiecono" +
-	"miastalowa-wolawawsmpplanetariuminamimakis-a-landscaperugiahirai" +
-	"zumisatohnoshoooshikamaishimodatehirakatashinagawahiranairtraffi" +
-	"cplexus-1hirarahiratsukaerusrcfastlylbananarepublic66hirayaizuwa" +
-	"kamatsubushikusakadogawahistorichouseshinjukumamotoyamasfjordenh" +
-	"itachiomiyagildeskaliszhitachiotagophiladelphiaareadmyblogsytehi" +
-	"traeumtgeradell-ogliastraderhjartdalhjelmelandholeckochikushinon" +
-	"senasakuchinotsuchiurakawaholidayhomegoodshinkamigototalhomeiphi" +
-	"latelyhomelinkyard-cloudjiffyresdalhomelinuxn--2m4a15ehomeoffice" +
-	"homesecuritymacaparecidahomesecuritypchoyodobashichikashukujitaw" +
-	"araholtalenissayokkaichiropractichirurgiens-dentistes-en-franceh" +
-	"omesenseeringhomesklepphilipsynology-diskstationhomeunixn--2scrj" +
-	"9christiansburgripehondahongotembaixadahonjyoitakanezawahorninda" +
-	"lhorsells-for-ustkanmakitaurahortendofinternet-dnshinshinotsurge" +
-	"onshalloffamelbournehospitalhoteleshinshirohotelwithflightshinto" +
-	"kushimahotmailhoyangerhoylandetroitskazunoticiashintomikasaharah" +
-	"umanitieshinyoshitomiokamishihoronobeauxartsandcraftshiojirishir" +
-	"ifujiedahurdalhurumajis-a-lawyerhyllestadhyogoris-a-liberalhyuga" +
-	"warahyundaiwafuneis-uberleetrentin-suedtirolis-very-badajozis-a-" +
-	"nursells-itrentin-sudtirolis-very-evillageis-very-goodyearis-ver" +
-	"y-niceis-very-sweetpepperis-with-thebandownloadisleofmanaustdalj" +
-	"env-arubajddarchitecturealtorlandjeonnamerikawauejetztrentino-a-" +
-	"adigejevnakershusdecorativeartshitaramajewelryjewishartgalleryjf" +
-	"kharkivanylvenneslaskerrylogisticshizukuishimofusakakinokiajgora" +
-	"jlljls-sto1jls-sto2jls-sto3jmphoenixn--30rr7yjnjaworznoshiroomgj" +
-	"oyentrentino-aadigejoyokaichibalashovhadselburgjpnjprshizuokamit" +
-	"suejurkoshimizumakiyosatokamachintaifun-dnsaliashoujis-a-persona" +
-	"ltrainerkoshunantankhmelnitskiyamarshallstatebankharkovaokosugek" +
-	"otohiradomainstitutekotourakouhokutamakiyosemitekounosupabasells" +
-	"yourhomeftphotographysiokouyamarylandkouzushimarylhurstjordalsha" +
-	"lsenkozagawakozakiyosunndalkozowiiheyakagekpnkppspbar2krasnikaho" +
-	"kutokashikizunokunimilitarykrasnodarkredstonekrelliankristiansan" +
-	"dcatshowakristiansundkrodsheradkrokstadelvalle-aostatic-accessho" +
-	"wtimeldalkryminamioguni5kumanotteroykumatorinovecoregontrailroad" +
-	"kumejimashikekumenantokonamegatakashimashikis-a-photographerokus" +
-	"sldkunisakis-a-playershiftcryptonomichigangwonkunitachiarailwayk" +
-	"unitomigusukukis-a-republicancerresearchaeologicaliforniakunnepp" +
-	"uboliviajessheimpertrixcdn77-secureggioemiliaromagnaklodzkodaira" +
-	"kunstsammlungkunstunddesignkuokgrouphxn--3bst00minamisanrikubets" +
-	"upplykurehabmerkurgankurobeepilepsykkylvenicekurogimimatakasugai" +
-	"s-a-rockstarachowicekuroisogndalkuromatsunais-a-socialistdlibest" +
-	"adkurotakikawasakis-a-soxfankushirogawakustanais-a-studentalkusu" +
-	"pplieshwildlifestylekutchanelkutnow-dnsienarutomobelementoraykuz" +
-	"umakis-a-teacherkassyno-dshirakofuefukihabororoshiranukamisunaga" +
-	"wakvafjordkvalsundkvamlidlugolekafjordvagsoygardendoftheinternet" +
-	"flixilovecollegefantasyleaguernseykvanangenkvinesdalkvinnheradkv" +
-	"iteseidatingkvitsoykwpspdnsigdalkzmisasaguris-an-accountantshira" +
-	"ois-a-linux-usershioyandexcloudmisawamisconfusedmishimassa-carra" +
-	"ra-massacarraramassabusinessebykleclerchromediatechnologymissile" +
-	"zajskhmelnytskyivaporcloudmisugitokuyamassivegridmitakeharamitou" +
-	"rismilemitoyoakemiuramiyazurecontainerdpolicemiyotamanomjondalen" +
-	"mlbfanmontrealestatefarmequipmentrentino-s-tirolmonza-brianzappo" +
-	"siiitesilkhplaystation-cloudyclustermonza-e-della-brianzaptokyot" +
-	"angouvichungnamdalseidfjordurbanamexhibitionissedalutskarmoymonz" +
-	"abrianzaramonzaebrianzamonzaedellabrianzamoonscaleforcemordoviam" +
-	"oriyamasudamoriyoshiminamiashigaramormonstermoroyamatsumaebashik" +
-	"shacknetrentino-stirolmortgagemoscowilliamhillmoseushistorymosjo" +
-	"enmoskenesimple-urlmossirdalmosviklabudhabikinokawabarthaebaruer" +
-	"icssongdalenviknakatsugawamoteginowaniigatakahamangooglecodespot" +
-	"rentino-sud-tirolmoviemovimientolgamozilla-iotrentino-sudtirolmt" +
-	"ranbymuginozawaonsensiositemuikaminoyamaxunispacemukoebenhavnmul" +
-	"houseminemunakatanemuncienciamuosattemupiemontemurmanskmpspawnex" +
-	"tdirectrentino-alto-adigemurotorcraftrentino-sued-tirolmusashino" +
-	"haramuseetrentino-suedtirolmuseumverenigingmusicarbonia-iglesias" +
-	"-carboniaiglesiascarboniamutsuzawamy-vigorlicemy-wanggoupilemyac" +
-	"tivedirectorymyasustor-elvdalmycdmycloudnslupsknx-serversicherun" +
-	"gmydattolocalhistorymyddnsgeekgalaxymydissentrentinoa-adigemydob" +
-	"isshikis-an-actormydroboehringerikemydslzmyeffectrentinoaadigemy" +
-	"fastblogermyfirewallonieruchomoscienceandindustrynmyforuminamita" +
-	"nemyfritzmyftpaccessmolaquilansmushcdn77-sslingmyhome-servermyji" +
-	"nomykolaivarggatrentinoalto-adigemymailermymediapchurchaseljeeps" +
-	"ondriodejaneirodoymyokohamamatsudamypepilotsnoasakataketomisatos" +
-	"himatsuzakis-an-actresshiraokamitondabayashiogamagoriziamypetsok" +
-	"ndalmyphotoshibalatinoopencraftrainingmypicturesolarssonmypsxn--" +
-	"3ds443gmysecuritycamerakermyshopblocksolognemyshopifymyspreadsho" +
-	"ppingmythic-beastsolundbeckomaganemytis-a-bookkeeperspectakarazu" +
-	"kaluganskomakiyokawaramytuleap-partnersomamyvncircustomer-ocimdb" +
-	"amblebesbyeniwaizumiotsukumiyamazonawsglobalacceleratorahimeshim" +
-	"abaridagawakuyachimataijibmdevelopmentashkentatamotorsitestingla" +
-	"dedyn-berlincolnavigationavoizumizakiitatebayashiibahccavuotnaga" +
-	"rag-cloud-charitydalipaywhirlimitedgcanonoichinomiyakebinagisoch" +
-	"ildrensgardenavuotnapleskns3-eu-west-2mywirepaircraftingvollolip" +
-	"opimientakayamatsuuraplatter-appinbarcelonagawalbrzycharternopil" +
-	"awalesundiscountysnes3-eu-west-3utilities-1platterpinkomatsushim" +
-	"arugame-hostyhostingplazaplcube-serverplumbingoplurinacionalpodh" +
-	"alepodlasiellaktyubinskiptveterinairealmpmnpodzonepohlpoivronpok" +
-	"erpokrovskommunalforbundpoliticarrdpolitiendapolkowicepoltavalle" +
-	"-d-aostaticsopotrentinos-tirolpomorzeszowinbarclaycards3-externa" +
-	"l-1ponpesaro-urbino-pesarourbinopesaromasvuotnaritakoelnponypord" +
-	"enonepornporsangerporsangugeporsgrunnanyokoshibahikariwanumataka" +
-	"zakis-an-artistgstagepoznanpraxis-a-bruinsfanprdpreservationpres" +
-	"idioprgmrprimetelemarkommuneprincipeprivatizehealthinsuranceprof" +
-	"esionalprogressivestnesor-odalpromombetsupportrentinostirolprope" +
-	"rtyprotectionprotonetrentinosud-tirolprudentialpruszkowindmillpr" +
-	"vcyberlevagangaviikanonjis-an-engineeringprzeworskogpugliapulawy" +
-	"pupioneerpvhagebostadpvtrentinosudtirolpwcistrondheimmobilieniss" +
-	"hingucciprianidurhamburgriwataraidynathomebuiltwithdarkarpaczest" +
-	"-le-patroniyodogawapythonanywherepbodynamic-dnsor-varangerpzqldq" +
-	"otoyohashimotoolsorfoldqponiatowadaqslgbtrentinosued-tirolqualif" +
-	"ioappippueblockbusterniiminamiawajikis-an-anarchistoricalsociety" +
-	"quickconnectrentinosuedtirolquicksytesorocabalestrandabergamoare" +
-	"keymachineustargardquipelementsorreisahayakawakamiichikawamisato" +
-	"ttoris-an-entertainerswedenswidnicartoonartdecologiaswidnikkokam" +
-	"iminersouthcarolinarvikomonotogawaswiebodzin-dslattuminanoswinou" +
-	"jscienceandhistoryswissmarterthanyoutwentesynology-dsouthwest1-u" +
-	"slivinghistorytularvikongsbergtunesowatunkongsvingerturystykaney" +
-	"amazoetuscanytushuissier-justicetuvalleaostaverntuxfamilytwmailv" +
-	"ibo-valentiavibovalentiavideovillaspectruminamiyamashirokawanabe" +
-	"laudibleasingvinnicasacamdvrcampinagrandebuilderschmidtre-gaulda" +
-	"lvinnytsiavipsinaappittsburghofficialvirginiavirtual-userveexcha" +
-	"ngevirtualcloudvirtualservervirtualuserveftpiwatevirtuelvisakuho" +
-	"kksundviterboknowsitallvivolkenkundenvixn--3hcrj9civilaviationth" +
-	"ewifiatlassian-dev-myqnapcloudcontrolledogawarabikomaezakirunoip" +
-	"irangalsaceomutashinainternationalfirearmsannanvlaanderennesoyvl" +
-	"adikavkazimierz-dolnyvladimirvlogintoyonezawavmincomcastresindev" +
-	"icenzaporizhzhiavologdanskoninjambylvolvolkswagentspeedpartnervo" +
-	"lyngdalvoorlopervossevangenvotevotingvotoyonovps-hostrowiecivili" +
-	"sationwithgoogleapiszwithyoutuberspacekitagatamayufuettertdasnet" +
-	"zwiwatsukiyonosegawawixsitewloclawekonsulatrobeeldengeluidvarese" +
-	"rvdwmcloudwmflabspydebergwoodsideltairavpagexlworse-thandawowind" +
-	"owskrakowinnersphinxn--3e0b707ewpdevcloudwpenginepoweredwphosted" +
-	"mailwpmucdnpixolinodeusercontentrentinoaltoadigewpmudeveloperaun" +
-	"iterois-foundationwritesthisblogwroclawiospjelkavikomorotsukagaw" +
-	"awtcirclerkstagets-itrentoyonakagyokutoyakolobrzegersundwtfastvp" +
-	"s-serverisignwuozuwzmiuwajimaxn--45q11civilwarmiasadoesntexistei" +
-	"ngeekaruizawaxn--4gbriminingxn--4it168dxn--4it797kooris-a-painte" +
-	"ractivestfoldxn--4pvxs4allxn--54b7fta0cclanbibaidarmeniaxn--55qw" +
-	"42gxn--55qx5dxn--5js045dxn--5rtp49cldmailuxuryxn--5rtq34kopervik" +
-	"hersonxn--5su34j936bgsgxn--5tzm5gxn--6btw5axn--6frz82gxn--6orx2r" +
-	"xn--6qq986b3xlxn--7t0a264cleverappstmnxn--80aaa0cvacationsrhtren" +
-	"tinsud-tirolxn--80adxhksrlxn--80ao21axn--80aqecdr1axn--80asehdba" +
-	"refootballooninglassassinationalheritagebinordre-landiscourses3-" +
-	"sa-east-1xn--80aswgxn--80augustowitdkonskowolayangrouphonefossho" +
-	"pwarendalenugxn--8ltr62koryokamikawanehonbetsurutaharaxn--8pvr4u" +
-	"xn--8y0a063axn--90a1affinitylotterybnikeisenbahnxn--90a3academia" +
-	"micable-modemoneyxn--90aeroportalaheadjudaicadaquesrvaroyxn--90a" +
-	"ishobarakawagoexn--90amcdirxn--90azhytomyravendbargainstances3-u" +
-	"s-east-2xn--9dbhblg6dietrevisojamisonxn--9dbq2axn--9et52uxn--9kr" +
-	"t00axn--andy-iraxn--aroport-byaotsurnadalxn--asky-iraxn--aurskog" +
-	"-hland-jnbarreauctioncilla-speziauthgear-stagingjesdalimanowarud" +
-	"aurskog-holandinggfarmerseineatonsbergitpagefrontappalmspringsak" +
-	"erevistarnbergivestbytemark12xn--avery-yuasakuragawaxn--b-5gaxn-" +
-	"-b4w605ferdxn--balsan-sdtirol-nsbstorebaselectrentinsudtirolxn--" +
-	"bck1b9a5dre4clicketcloudcontrolapparmatsushigexn--bdddj-mrabdxn-" +
-	"-bearalvhki-y4axn--berlevg-jxaxn--bhcavuotna-s4axn--bhccavuotna-" +
-	"k7axn--bidr-5nachikatsuuraxn--bievt-0qa2xn--bjarky-fyasakaiminat" +
-	"oyookanazawaxn--bjddar-ptargetmyipizzaxn--blt-elabourxn--bmlo-gr" +
-	"aingerxn--bod-2natalxn--bozen-sdtirol-2obanazawaxn--brnny-wuacad" +
-	"emy-firewall-gatewayxn--brnnysund-m8accident-investigation-aptib" +
-	"leadpagesquare7xn--brum-voagatritonxn--btsfjord-9zaxn--bulsan-sd" +
-	"tirol-nsbarrel-of-knowledgeappleborkaragandauthgearappspacehoste" +
-	"d-by-previderhclouddnslivegarsheiheijibigawaustevoll-o-g-i-n4t3l" +
-	"3p0rtarnobrzegyptianatuurwetenschappenginebetsuikirkenes3-ap-sou" +
-	"th-1xn--c1avgxn--c2br7gxn--c3s14miniserverxn--cck2b3barrell-of-k" +
-	"nowledgecomputerhistoryofscience-fictionfabricafjs3-us-gov-west-" +
-	"1xn--cckwcxetdxn--cesena-forl-mcbremangerxn--cesenaforl-i8axn--c" +
-	"g4bkis-gonexn--ciqpnxn--clchc0ea0b2g2a9gcdxn--comunicaes-v6a2oxn" +
-	"--correios-e-telecomunicaes-ghc29axn--czr694barsycenterprisesaki" +
-	"joburgleezebizenakanotoddenayorovnobirauthordalanddnss3-ap-south" +
-	"east-2xn--czrs0troandinosaureplantationxn--czru2dxn--czrw28barsy" +
-	"onlinewhampshirebungoonord-frontierxn--d1acj3basicserversaillesj" +
-	"abbottatarantours3-us-west-1xn--d1alfaromeoxn--d1atrogstadxn--d5" +
-	"qv7z876clickrisinglesannohelplfinancialuzernxn--davvenjrga-y4axn" +
-	"--djrs72d6uyxn--djty4kosaigawaxn--dnna-grajewolterskluwerxn--drb" +
-	"ak-wuaxn--dyry-iraxn--e1a4clinichitachinakagawassamukawatarikuze" +
-	"ntakatainaioiraseating-organicbcn-north-1xn--eckvdtc9dxn--efvn9s" +
-	"torfjordxn--efvy88haibarakitahatakamatsukawaxn--ehqz56nxn--elqq1" +
-	"6hair-surveillancexn--eveni-0qa01gaxn--f6qx53axn--fct429kosakaer" +
-	"odromegallupaasdaburxn--fhbeiarnxn--finny-yuaxn--fiq228c5hstorjc" +
-	"loud-ver-jpchristmasakinderoyxn--fiq64basilicataniautomotiveland" +
-	"ds3-ca-central-1xn--fiqs8stpetersburgxn--fiqz9streamscompute-1xn" +
-	"--fjord-lraxn--fjq720axn--fl-ziaxn--flor-jraxn--flw351exn--forl-" +
-	"cesena-fcbsstudioxn--forlcesena-c8axn--fpcrj9c3dxn--frde-grandra" +
-	"pidstudynamisches-dnsortlandxn--frna-woaraisaijosoyrovigotpanthe" +
-	"onsitexn--frya-hraxn--fzc2c9e2cliniquedapliernewyorkshirecifedex" +
-	"eterxn--fzys8d69uvgmailxn--g2xx48clintonoshoesanokarumaifarmstea" +
-	"dyndns-at-homedepotenzamamidorittogakushimotoganexn--gckr3f0faus" +
-	"kedsmokorsetagayaseralingenoamishirasatogitsumidatlantichofunato" +
-	"riginstantcloudfrontdoorxn--gecrj9clothingdustdatadetectjmaxxxer" +
-	"oxfinityxn--ggaviika-8ya47hakatanorth-kazakhstanxn--gildeskl-g0a" +
-	"xn--givuotna-8yasugitlaborxn--gjvik-wuaxn--gk3at1exn--gls-elacai" +
-	"xaxn--gmq050is-into-animegurownproviderxn--gmqw5axn--gnstigbeste" +
-	"llen-zvbrplsbxn--3pxu8konyvelohmusashimurayamarumorimachidaxn--g" +
-	"nstigliefern-wobihirosakikamijimatsunowtvestre-totennishiawakura" +
-	"xn--h-2failxn--h1aeghakodatexn--h1ahnxn--h1alizxn--h2breg3evenes" +
-	"tuff-4-salexn--h2brj9c8cn-northwest-1xn--h3cuzk1diherokuappkomfo" +
-	"rbar1xn--hbmer-xqaxn--hcesuolo-7ya35basketballfinanzjampalacehim" +
-	"ejiiyamanouchikuhokuryugasakitanakagusukumodernfshostrodawarauto" +
-	"scanadaeguambulancentralus-2xn--hery-iraxn--hgebostad-g3axn--hkk" +
-	"inen-5waxn--hmmrfeasta-s4accident-prevention-k3stufftoread-books" +
-	"nesoruminamiuonumasoyxn--hnefoss-q1axn--hobl-iraxn--holtlen-hxax" +
-	"n--hpmir-xqaxn--hxt814exn--hyanger-q1axn--hylandet-54axn--i1b6b1" +
-	"a6a2exn--imr513nxn--indery-fyasuokannamiharuxn--io0a7is-into-car" +
-	"shiratakahagithubpreviewsaitamatsukuris-a-llamarcheapigeelvinckd" +
-	"diamondshirahamatonbetsurgeryxn--j1adplantsomnarviikamiokameokam" +
-	"akurazakitashiobaraxn--j1aefbsbxn--1ck2e1banzaicloudappspotagerx" +
-	"n--j1ael8batochiokinoshimaintenancempresashibetsukuin-vpncasadel" +
-	"amonedancemrxn--j1amhakonexn--j6w193gxn--jlq480n2rgxn--jlq61u9w7" +
-	"batsfjordiscoveryokoteu-1xn--jlster-byatominamidaitomanchesterxn" +
-	"--jrpeland-54axn--jvr189minisitexn--k7yn95exn--karmy-yuaxn--kbrq" +
-	"7oxn--kcrx77d1x4axn--kfjord-iuaxn--klbu-woaxn--klt787dxn--kltp7d" +
-	"xn--kltx9axn--klty5xn--41axn--koluokta-7ya57hakubahcavuotnagaivu" +
-	"otnagaokakyotambabydgoszczecinemagnethnologyxn--kprw13dxn--kpry5" +
-	"7dxn--kput3is-into-cartoonshishikuis-a-musicianxn--krager-gyatsu" +
-	"kanoyakumoldellogliastradingxn--kranghke-b0axn--krdsherad-m8axn-" +
-	"-krehamn-dxaxn--krjohka-hwab49jdevcloudfunctionshisohugheshisuif" +
-	"uelveruminamiminowaxn--ksnes-uuaxn--kvfjord-nxaxn--kvitsy-fyatsu" +
-	"shiroxn--kvnangen-k0axn--l-1fairwindstuttgartrentinsued-tirolxn-" +
-	"-l1accentureklamborghinikolaeventsurreyxn--laheadju-7yawaraxn--l" +
-	"angevg-jxaxn--lcvr32dxn--ldingen-q1axn--leagaviika-52bauhauspost" +
-	"man-echocolatelevisionflashdrivefsncfdishakotanhlfanhsbcasertail" +
-	"scalecznagasukeu-2xn--lesund-huaxn--lgbbat1ad8jdfaststacksaxoxn-" +
-	"-lgrd-poacctromsakegawaxn--lhppi-xqaxn--linds-pramericanartromso" +
-	"kamogawaxn--lns-qlavagiskexn--loabt-0qaxn--lrdal-sraxn--lrenskog" +
-	"-54axn--lt-liacngroks-thisayamanobeokakegawaxn--lten-granexn--lu" +
-	"ry-iraxn--m3ch0j3axn--mely-iraxn--merker-kuaxn--mgb2ddesusakis-b" +
-	"ytomaritimekeepingxn--mgb9awbfbx-oslodingenxn--mgba3a3ejtrusteex" +
-	"n--mgba3a4f16axn--mgba3a4fra1-deportevaksdalxn--mgba7c0bbn0axn--" +
-	"mgbaakc7dvfbxostrowwlkpmguidefinimamateramochizukindlegallocus-4" +
-	"xn--mgbaam7a8hakuis-a-financialadvisor-aurdalxn--mgbab2bdxn--mgb" +
-	"ah1a3hjkrdxn--mgbai9a5eva00bellunord-odalvdalaskanittedallasalle" +
-	"angaviikadenagahamaroyerxn--mgbai9azgqp6jejuniperxn--mgbayh7gpal" +
-	"ermomahachijolsterxn--mgbbh1a71exn--mgbc0a9azcgxn--mgbca7dzdoxn-" +
-	"-mgbcpq6gpa1axn--mgberp4a5d4a87gxn--mgberp4a5d4arxn--mgbgu82axn-" +
-	"-mgbi4ecexposedxn--mgbpl2fhskypexn--mgbqly7c0a67fbcnpyatigorskol" +
-	"efrakkestadyndns-at-workisboringrondarxn--mgbqly7cvafr-1xn--mgbt" +
-	"3dhdxn--mgbtf8flapymntrvestre-slidretrosnubarclays3-fips-us-gov-" +
-	"west-1xn--mgbtx2beneventodayokozeu-3xn--mgbx4cd0abbvieeexn--mix0" +
-	"82fedorainfraclouderaxn--mix891fedorapeoplegnicapebretonamicroli" +
-	"ghtinguitarschokokekschokoladenxn--mjndalen-64axn--mk0axin-the-b" +
-	"andais-into-gamessinazawaxn--mk1bu44cnsantabarbaraxn--mkru45is-l" +
-	"eetrentin-sued-tirolxn--mlatvuopmi-s4axn--mli-tlavangenxn--mlsel" +
-	"v-iuaxn--moreke-juaxn--mori-qsakurais-lostre-toteneis-a-nascarfa" +
-	"nxn--mosjen-eyawatahamaxn--mot-tlazioxn--mre-og-romsdal-qqbusera" +
-	"nishiaritakurashikis-not-certifiedxn--msy-ula0hakusanagochijiwad" +
-	"egreexn--mtta-vrjjat-k7aflakstadaokagakicks-assnasaarlandxn--muo" +
-	"st-0qaxn--mxtq1minnesotaketakatoris-a-techietis-a-libertarianxn-" +
-	"-ngbc5azdxn--ngbe9e0axn--ngbrxn--42c2d9axn--nit225koseis-a-patsf" +
-	"anxn--nmesjevuemie-tcbalsan-sudtirollagdenesnaaseinet-freaksuson" +
-	"oxn--nnx388axn--nodessakyotanabellevuelosangelesuzakanagawaxn--n" +
-	"qv7fs00emaxn--nry-yla5gxn--ntso0iqx3axn--ntsq17gxn--nttery-byaes" +
-	"eoullensvanguardxn--nvuotna-hwaxn--nyqy26axn--o1achernihivgubsuz" +
-	"ukananiikappudoxn--o3cw4haldenxn--o3cyx2axn--od0algxn--od0aq3ben" +
-	"tleyolasiteu-4lima-cityeatselinogradimo-i-rana4u2-localhostrolek" +
-	"aniepce12hpalmaserati234xn--ogbpf8flatangerxn--oppegrd-ixaxn--os" +
-	"tery-fyaxn--osyro-wuaxn--otu796dxn--p1acfedoraprojectoyotsukaido" +
-	"xn--p1ais-savedxn--pgbs0dhlx3xn--porsgu-sta26feiraquarelleaseekl" +
-	"ogescholarshipschoolsztynsettsurfashionxn--pssu33lxn--pssy2uxn--" +
-	"q7ce6axn--q9jyb4cntjomelhusgardenxn--qcka1pmckinseyxn--qqqt11min" +
-	"tereitrentino-altoadigexn--qxa6axn--qxamsterdamnserverbaniaxn--r" +
-	"ady-iraxn--rdal-poaxn--rde-ulaxn--rdy-0nabaris-slickfh-muensterx" +
-	"n--rennesy-v1axn--rhkkervju-01afermockasserverrankoshigayamein-v" +
-	"igorgexn--rholt-mragowoltlab-democraciaxn--rhqv96gxn--rht27zxn--" +
-	"rht3dxn--rht61exn--risa-5naturalhistorymuseumcenterxn--risr-irax" +
-	"n--rland-uuaxn--rlingen-mxaxn--rmskog-byaxn--rny31halsaitohmanno" +
-	"rthflankaufentigerxn--rovu88beppublishproxyombolzano-altoadigeol" +
-	"ogyomitanobninskarasjohkamikitayamatsurincheonikonanporobserverx" +
-	"n--rros-granvindafjordxn--rskog-uuaxn--rst-0naturalsciencesnatur" +
-	"ellesuzukis-certifiedxn--rsta-framercanvasvalbardunloppacificita" +
-	"deliveryggeexn--rvc1e0am3exn--ryken-vuaxn--ryrvik-byaxn--s-1fait" +
-	"hammarfeastafricapitalonewspaperxn--s9brj9collectionxn--sandness" +
-	"jen-ogbeskidyn-ip24xn--sandy-yuaxn--sdtirol-n2axn--seral-lraxn--" +
-	"ses554gxn--sgne-graphoxn--45br5cylxn--skierv-utazasvcitichiryuky" +
-	"uragifuchungbukharahkkeravjuegoshikimobetsuldaluccaravantaarparl" +
-	"iamentjeldsundrudupontariobranconavstackareliancexn--skjervy-v1a" +
-	"xn--skjk-soaxn--sknit-yqaxn--sknland-fxaxn--slat-5naturbruksgymn" +
-	"xn--slt-elabcieszynh-serveblogspotaribeiraogakibichuoxn--smla-hr" +
-	"axn--smna-gratangentlentapisa-geekosherbrookegawaxn--snase-nraxn" +
-	"--sndre-land-0cbestbuyshouses3-us-west-2xn--snes-poaxn--snsa-roa" +
-	"xn--sr-aurdal-l8axn--sr-fron-q1axn--sr-odal-q1axn--sr-varanger-g" +
-	"gbetainaboxfusejnyanagawalmartateshinanomachimkentateyamaveroyke" +
-	"nebakkeshibechambagriculturealtychyattorneyagawakepnombrendlynge" +
-	"nflfanpachigasakids3-eu-central-1xn--srfold-byaxn--srreisa-q1axn" +
-	"--srum-gratis-a-bulls-fanxn--stfold-9xaxn--stjrdal-s1axn--stjrda" +
-	"lshalsen-sqbhzcasinordeste-idcateringebuildinglitcheltenham-radi" +
-	"o-opensocialimolisembokuleuvenetokigawavocatanzaroweddingjovikan" +
-	"zakitchenaval-d-aosta-valleyboltarumizusawaustinnaumburgivingjem" +
-	"nes3-ap-southeast-1xn--stre-toten-zcbieidskoguchikuzenvironmenta" +
-	"lconservationionjukudoyamaizurugbyglandroverhallaakesvuemielecce" +
-	"vje-og-hornnes3-website-ap-northeast-1xn--t60b56axn--tckwebthing" +
-	"sveioxn--tiq49xqyjelasticbeanstalkhakassiaxn--tjme-hraxn--tn0agr" +
-	"ocerydxn--tnsberg-q1axn--tor131oxn--trany-yuaxn--trentin-sd-tiro" +
-	"l-rzbielawaltervistaikikonaikawachinaganoharamcoachampionshiphop" +
-	"tobamadridnbloggerxn--trentin-sdtirol-7vbiellahppiacenzachpomors" +
-	"kieninohekinannestadiskussionsbereichattanooganordkappgafaninomi" +
-	"yakonojorpelandisrechtranakamagayahikobeardubaiduckdnsnillfjordi" +
-	"tchyouripanamatsusakahoginankokubunjindianapolis-a-bloggerxn--tr" +
-	"entino-sd-tirol-c3bieszczadygeyachiyodaejeonbukcoalwaysdatabaseb" +
-	"allangenkainanaejrietisalatinabeno-ipifony-1xn--trentino-sdtirol" +
-	"-szbievat-band-campaniavoues3-eu-west-1xn--trentinosd-tirol-rzbi" +
-	"fukagawashingtondclk3xn--trentinosdtirol-7vbigv-infolldalivornow" +
-	"ruzhgorodeoceanographics3-website-ap-southeast-1xn--trentinsd-ti" +
-	"rol-6vbihorologyonagoyaxarnetbankaracoldwarszawaustraliamusement" +
-	"dllpages3-ap-northeast-2ix4432-balsan-suedtirolkuszczytnord-aurd" +
-	"alp16-b-datacentermezproxyzgorabruzzoologicalabamagasakishimabar" +
-	"aogashimadachicagoboats3-ap-northeast-1kappchizip611xn--trentins" +
-	"dtirol-nsbikedaemonmoutheworkpccwedeployonagunicloudivtasvuodnak" +
-	"amurataishinomakinkobierzycextraspace-to-rentalstomakomaibarazur" +
-	"ewebsiteshikagamiishibukawakkanaibetsubamericanfamilydsmynasushi" +
-	"obarackmazeplayokosukanraustrheimatunduhrennebugattiffanyaarbort" +
-	"eaches-yogasawaracingjerdrumcprequalifymeinforumzgorzeleccogjers" +
-	"tadotsuruokakamigaharaukraanghkembuchikumagayagawakayamagentosit" +
-	"ecnologiajudygarlanddnskingdyniamunemurorangecloudplatform0emmaf" +
-	"ann-arboretumbriamallamaceiobbcg120001wwwbq-abogadobeaemcloud-fr" +
-	"1337xn--trgstad-r1axn--trna-woaxn--troms-zuaxn--tysvr-vraxn--uc0" +
-	"atvestvagoyxn--uc0ay4axn--uist22hamurakamigoris-a-geekautokeinot" +
-	"iceablewismillerxn--uisz3gxn--unjrga-rtargithubusercontentryclou" +
-	"dflareportrentinsuedtirolxn--unup4yxn--uuwu58axn--vads-jraxn--va" +
-	"lle-aoste-ebbtrysiljanxn--valle-d-aoste-ehbodoes-itcouldbeworldx" +
-	"n--valleaoste-e7axn--valledaoste-ebbvadsoccertmgrazerbaijan-maye" +
-	"ngerdalcesvelvikomvuxn--32vp30hagakhanamigawaxn--vard-jraxn--veg" +
-	"rshei-c0axn--vermgensberater-ctbitsvizzeraxn--vermgensberatung-p" +
-	"wblogoiplatformshangrilanxessooxn--vestvgy-ixa6oxn--vg-yiabkhazi" +
-	"axn--vgan-qoaxn--vgsy-qoa0jelenia-goraxn--vgu402colognexus-3xn--" +
-	"vhquvevelstadxn--vler-qoaxn--vre-eiker-k8axn--vrggt-xqadxn--vry-" +
-	"yla5gxn--vuq861bilbaokinawashirosatobishimagazineues3-website-ap" +
-	"-southeast-2xn--w4r85el8fhu5dnraxn--w4rs40lxn--wcvs22dxn--wgbh1c" +
-	"olonialwilliamsburgrongausdalvivanovoldaxn--wgbl6axn--xhq521bill" +
-	"ustrationredumbrellair-traffic-controlleyoriikarasjokarasuyamarn" +
-	"ardalombardiadembetsukubankaratexn--xkc2al3hye2axn--xkc2dl3a5ee0" +
-	"handsonyxn--y9a3aquariumisakis-a-therapistoiaxn--yer-znaturhisto" +
-	"rischesvn-reposoundcastronomy-routerxn--yfro4i67oxn--ygarden-p1a" +
-	"xn--ygbi2ammxn--45brj9civilizationxn--ystre-slidre-ujbioceanogra" +
-	"phiquexn--zbx025dxn--zf0ao64axn--zf0avxlxn--zfr164bipanasonicath" +
-	"olicaxiaskimitsubatamibudejjuedischesapeakebayernirasakindianmar" +
-	"ketingliwicexnbayxz"
+const numTLD = 1490
 
-// nodes is the list of nodes. Each node is represented as a uint32, which
-// encodes the node's children, wildcard bit and node type (as an index into
-// the children array), ICANN bit and text.
+// tableData is the tree of nodes, in the form described in nodetable.go.
 //
-// If the table was generated with the -comments flag, there is a //-comment
-// after each node's data. In it is the nodes-array indexes of the children,
-// formatted as (n0x1234-n0x1256), with * denoting the wildcard bit. The
-// nodeType is printed as + for normal, ! for exception, and o for parent-only
-// nodes that have children but don't match a domain label in their own right.
-// An I denotes an ICANN domain.
-//
-// The layout within the uint32, from MSB to LSB, is:
-//	[ 0 bits] unused
-//	[10 bits] children index
-//	[ 1 bits] ICANN bit
-//	[15 bits] text index
-//	[ 6 bits] text length
-var nodes = [...]uint32{
-	0x330b03,
-	0x3b6e44,
-	0x2e8c86,
-	0x350003,
-	0x350006,
-	0x392c06,
-	0x3b9283,
-	0x21a084,
-	0x3deb07,
-	0x2e88c8,
-	0x1a000c2,
-	0x1f42f07,
-	0x37f0c9,
-	0x2ddc4a,
-	0x2ddc4b,
-	0x233b83,
-	0x236ac5,
-	0x2213c82,
-	0x3d6204,
-	0x2c8983,
-	0x231c05,
-	0x2601ac2,
-	0x367443,
-	0x2a2ffc4,
-	0x201ac5,
-	0x2e06482,
-	0x20648e,
-	0x25b543,
-	0x3b32c6,
-	0x3204782,
-	0x3e57c7,
-	0x23a206,
-	0x3603682,
-	0x2909c3,
-	0x22c386,
-	0x2691c8,
-	0x295546,
-	0x276dc4,
-	0x3a00b02,
-	0x350889,
-	0x21a3c7,
-	0x2ff486,
-	0x369ac9,
-	0x2ca948,
-	0x246004,
-	0x320146,
-	0x3d8b46,
-	0x3e01c02,
-	0x2fc746,
-	0x212d4f,
-	0x3d99ce,
-	0x2e4804,
-	0x20d105,
-	0x335fc5,
-	0x3a8989,
-	0x2427c9,
-	0x22cb87,
-	0x2239c6,
-	0x22edc3,
-	0x4216302,
-	0x216303,
-	0x2a868a,
-	0x4615c43,
-	0x3456c5,
-	0x2f45c2,
-	0x3a5c49,
-	0x4e028c2,
-	0x208844,
-	0x3c9a86,
-	0x2968c5,
-	0x376c04,
-	0x570fdc4,
-	0x2028c3,
-	0x235fc4,
-	0x5a01942,
-	0x357344,
-	0x5e01a04,
-	0x214f0a,
-	0x6200882,
-	0x20bd07,
-	0x3be8c8,
-	0x7a08b82,
-	0x33a387,
-	0x22da04,
-	0x31b047,
-	0x22da05,
-	0x380e47,
-	0x34d986,
-	0x358c84,
-	0x36af05,
-	0x274707,
-	0x9205982,
-	0x2b0403,
-	0x961f9c2,
-	0x3d3583,
-	0x9a03602,
-	0x254845,
-	0x9e00202,
-	0x3793c4,
-	0x3ccb05,
-	0x2e4747,
-	0x2b294e,
-	0x2c3904,
-	0x235044,
-	0x207843,
-	0x301889,
-	0x306acb,
-	0x391a88,
-	0x331f88,
-	0x337bc8,
-	0x3ceec8,
-	0xa36990a,
-	0x380d47,
-	0x3f3ac6,
-	0xa65a502,
-	0x3de703,
-	0x3e32c3,
-	0x3e4884,
-	0x3de743,
-	0x354783,
-	0x173ec82,
-	0xaa08a42,
-	0x28b785,
-	0x2ac746,
-	0x2a29c4,
-	0x3a1f47,
-	0x237906,
-	0x2d7f04,
-	0x3bb3c7,
-	0x221bc3,
-	0xb6e2082,
-	0xba69782,
-	0xbe16d82,
-	0x217b46,
-	0xc200282,
-	0x266485,
-	0x3401c3,
-	0x3d7244,
-	0x303a84,
-	0x303a85,
-	0x3f1d43,
-	0xc650b03,
-	0xca05a42,
-	0x207fc5,
-	0x207fcb,
-	0x31228b,
-	0x206204,
-	0x208909,
-	0x209544,
-	0xce09902,
-	0x20a143,
-	0x20a6c3,
-	0xd20b4c2,
-	0x21710a,
-	0xd60b782,
-	0x3d6485,
-	0x2f258a,
-	0x245cc4,
-	0x20d603,
-	0x20e404,
-	0x211443,
-	0x211444,
-	0x211447,
-	0x213d45,
-	0x214506,
-	0x2156c6,
-	0x217503,
-	0x21b748,
-	0x21e083,
-	0xda02fc2,
-	0x241708,
-	0x2957cb,
-	0x224788,
-	0x225106,
-	0x225287,
-	0x227b48,
-	0xf201002,
-	0xf620302,
-	0x27a748,
-	0x3dab47,
-	0x31ba45,
-	0xfb1ba48,
-	0xfedf508,
-	0x27d5c3,
-	0x22bfc4,
-	0x392c82,
-	0x1022cdc2,
-	0x10668142,
-	0x10e2d3c2,
-	0x22d3c3,
-	0x11201782,
-	0x313a43,
-	0x24a844,
-	0x201783,
-	0x245fc4,
-	0x23760b,
-	0x202f03,
-	0x2f9446,
-	0x214d84,
-	0x2d368e,
-	0x2ff905,
-	0x273c08,
-	0x3b33c7,
-	0x3b33ca,
-	0x231543,
-	0x3b6c47,
-	0x306c85,
-	0x231544,
-	0x25c046,
-	0x25c047,
-	0x36ff44,
-	0x1171b484,
-	0x381dc4,
-	0x238904,
-	0x3c1386,
-	0x20f543,
-	0x3c1748,
-	0x3f2f08,
-	0x29dc43,
-	0x2170c3,
-	0x34a7c4,
-	0x35b203,
-	0x11e02dc2,
-	0x12621942,
-	0x202986,
-	0x320243,
-	0x23a9c4,
-	0x12a13282,
-	0x213283,
-	0x3818c3,
-	0x218442,
-	0x12e03402,
-	0x2d95c6,
-	0x22b987,
-	0x2ff287,
-	0x2f5d45,
-	0x3cb8c4,
-	0x370c05,
-	0x2c9747,
-	0x3582c9,
-	0x2df986,
-	0x2f5c46,
-	0x13e04102,
-	0x30f188,
-	0x32a0c6,
-	0x22ad85,
-	0x3b1f07,
-	0x3b5d04,
-	0x3b5d05,
-	0x3a24c4,
-	0x3a24c8,
-	0x14205202,
-	0x14600482,
-	0x238ac6,
-	0x200488,
-	0x33e305,
-	0x353686,
-	0x35d788,
-	0x361888,
-	0x14a02c45,
-	0x176204c4,
-	0x2576c7,
-	0x17a08fc2,
-	0x17f547c2,
-	0x19202202,
-	0x3c9b85,
-	0x19ee9e05,
-	0x274246,
-	0x2dc247,
-	0x3e8c07,
-	0x1a206643,
-	0x321c47,
-	0x289a48,
-	0x2822e709,
-	0x206647,
-	0x22ef07,
-	0x349208,
-	0x22f706,
-	0x231046,
-	0x23240c,
-	0x23324a,
-	0x233bc7,
-	0x23698b,
-	0x237c87,
-	0x237c8e,
-	0x286391c4,
-	0x2392c4,
-	0x23b287,
-	0x271d87,
-	0x240086,
-	0x240087,
-	0x332dc7,
-	0x21dac3,
-	0x28a2dd42,
-	0x243106,
-	0x24310a,
-	0x2439cb,
-	0x2457c7,
-	0x247105,
-	0x2473c3,
-	0x247746,
-	0x247747,
-	0x2696c3,
-	0x28e00102,
-	0x247e0a,
-	0x29330c02,
-	0x297a1542,
-	0x29a41402,
-	0x29e31982,
-	0x24a485,
-	0x24b704,
-	0x2aa54302,
-	0x3573c5,
-	0x231bc3,
-	0x374145,
-	0x361b84,
-	0x226f84,
-	0x2dd186,
-	0x25cb86,
-	0x2081c3,
-	0x3d1404,
-	0x358fc3,
-	0x2ba023c2,
-	0x225604,
-	0x225606,
-	0x24fd45,
-	0x399fc6,
-	0x3b2008,
-	0x21de44,
-	0x257208,
-	0x3267c5,
-	0x28e348,
-	0x2d8d86,
-	0x2b9b07,
-	0x27cf44,
-	0x2d67cf46,
-	0x2da1a6c3,
-	0x3a5603,
-	0x371008,
-	0x338504,
-	0x2de0e4c7,
-	0x2862c6,
-	0x2f0109,
-	0x302208,
-	0x375208,
-	0x381944,
-	0x2180c3,
-	0x228b02,
-	0x2e656442,
-	0x2ea014c2,
-	0x328243,
-	0x2ee060c2,
-	0x269644,
-	0x295e46,
-	0x2328c3,
-	0x2cb1c7,
-	0x3dc083,
-	0x2c39c8,
-	0x3816c5,
-	0x26aa03,
-	0x3cca85,
-	0x3ccbc4,
-	0x3b1c06,
-	0x3b7406,
-	0x2e4686,
-	0x2db944,
-	0x238043,
-	0x2f25f042,
-	0x2f637105,
-	0x200843,
-	0x2fe02c02,
-	0x20f343,
-	0x258c05,
-	0x3021f603,
-	0x30a36089,
-	0x30e00942,
-	0x3160b5c2,
-	0x299245,
-	0x2193c6,
-	0x2924c6,
-	0x30d788,
-	0x30d78b,
-	0x34cc8b,
-	0x2f5f45,
-	0x2e2609,
-	0x1601082,
-	0x2e8f88,
-	0x203f04,
-	0x31e01342,
-	0x3441c3,
-	0x32671f46,
-	0x32a01b02,
-	0x3cf4c8,
-	0x32e04c02,
-	0x26c74a,
-	0x336220c3,
-	0x33f7f706,
-	0x31cec8,
-	0x219d46,
-	0x38f207,
-	0x212f47,
-	0x3d86ca,
-	0x245d44,
-	0x3671c4,
-	0x37e709,
-	0x343b2f05,
-	0x2064c6,
-	0x2132c3,
-	0x255ec4,
-	0x346e2504,
-	0x33b487,
-	0x34ba6807,
-	0x280984,
-	0x35dec5,
-	0x274308,
-	0x24c387,
-	0x24c607,
-	0x34e0fd02,
-	0x31f0c4,
-	0x2a21c8,
-	0x24e304,
-	0x251604,
-	0x2519c5,
-	0x251b07,
-	0x35b51789,
-	0x253144,
-	0x253e09,
-	0x2554c8,
-	0x255c44,
-	0x255c47,
-	0x256243,
-	0x256d47,
-	0x35e00bc2,
-	0x16c5fc2,
-	0x25bb06,
-	0x2bdd07,
-	0x25c384,
-	0x25de87,
-	0x25f687,
-	0x260483,
-	0x362596c2,
-	0x21e142,
-	0x2619c3,
-	0x2619c4,
-	0x2619cb,
-	0x332088,
-	0x21e144,
-	0x262c05,
-	0x264687,
-	0x2f3d05,
-	0x32920a,
-	0x267c83,
-	0x36608102,
-	0x23e644,
-	0x26d209,
-	0x270c43,
-	0x270d07,
-	0x3613c9,
-	0x34f6c8,
-	0x264d43,
-	0x28a7c7,
-	0x291103,
-	0x292644,
-	0x293349,
-	0x297786,
-	0x2ae103,
-	0x208782,
-	0x2c5dc3,
-	0x2c5dc7,
-	0x389d85,
-	0x357186,
-	0x212804,
-	0x395305,
-	0x28b243,
-	0x217746,
-	0x272fc3,
-	0x208b02,
-	0x250ac4,
-	0x36a34382,
-	0x36e34383,
-	0x372030c2,
-	0x20bfc3,
-	0x215b44,
-	0x252a07,
-	0x2a0786,
-	0x26d1c2,
-	0x3766d602,
-	0x3b2204,
-	0x37e115c2,
-	0x3820c782,
-	0x20c784,
-	0x20c785,
-	0x33c345,
-	0x3c3dc6,
-	0x38610202,
-	0x2fdf45,
-	0x3323c5,
-	0x2e9d43,
-	0x2fc986,
-	0x210205,
-	0x217ac2,
-	0x35e485,
-	0x217ac4,
-	0x21dd83,
-	0x21dfc3,
-	0x38a074c2,
-	0x274907,
-	0x2556c4,
-	0x2556c9,
-	0x255dc4,
-	0x2b6943,
-	0x2c2c88,
-	0x38ee9c84,
-	0x2e9c86,
-	0x2b4843,
-	0x263643,
-	0x205503,
-	0x393034c2,
-	0x38c902,
-	0x39600642,
-	0x341f88,
-	0x3d2408,
-	0x3c01c6,
-	0x29a7c5,
-	0x2bb385,
-	0x3c7f87,
-	0x39a86e45,
-	0x2062c2,
-	0x39ea4542,
-	0x3a200042,
-	0x287c08,
-	0x30f0c5,
-	0x308604,
-	0x389605,
-	0x394147,
-	0x29ee04,
-	0x2594c2,
-	0x3a6331c2,
-	0x356044,
-	0x30f447,
-	0x2997c7,
-	0x380e04,
-	0x3e3a43,
-	0x29db84,
-	0x29db88,
-	0x3aa31386,
-	0x25beca,
-	0x351644,
-	0x2a1c08,
-	0x2372c4,
-	0x225386,
-	0x2a4504,
-	0x3c9e86,
-	0x255989,
-	0x2b3fc7,
-	0x3a0dc3,
-	0x3ae17382,
-	0x27e1c3,
-	0x209b02,
-	0x3b20af02,
-	0x254606,
-	0x285e48,
-	0x2b6687,
-	0x35f289,
-	0x2b6849,
-	0x2b8005,
-	0x2b9fc9,
-	0x2bb4c5,
-	0x2bc045,
-	0x2bd508,
-	0x3b610084,
-	0x3ba10087,
-	0x22f2c3,
-	0x2bd707,
-	0x22f2c6,
-	0x2be1c7,
-	0x2b3805,
-	0x22ea83,
-	0x3be29602,
-	0x381d04,
-	0x3c21fec2,
-	0x3c615fc2,
-	0x37cd06,
-	0x3be845,
-	0x2c1107,
-	0x2fd603,
-	0x354704,
-	0x201603,
-	0x3be503,
-	0x3ca03042,
-	0x3d601442,
-	0x392d04,
-	0x259683,
-	0x30d445,
-	0x3da04142,
-	0x3e206a42,
-	0x389806,
-	0x2fbf04,
-	0x30ecc4,
-	0x30ecca,
-	0x3ea005c2,
-	0x252383,
-	0x20ce0a,
-	0x20fc88,
-	0x3ee503c4,
-	0x2005c3,
-	0x237703,
-	0x2cb2c9,
-	0x26b289,
-	0x20fe46,
-	0x3f211e43,
-	0x32054d,
-	0x230886,
-	0x247a4b,
-	0x3f605cc2,
-	0x31ff88,
-	0x4421b842,
-	0x44602802,
-	0x2bfe45,
-	0x44a02b82,
-	0x2aaac7,
-	0x20adc3,
-	0x2103c8,
-	0x44e04b02,
-	0x2bc5c4,
-	0x224b03,
-	0x2440c6,
-	0x230a84,
-	0x217083,
-	0x46201d02,
-	0x2f5ec4,
-	0x2c4c45,
-	0x2c59c7,
-	0x288e83,
-	0x2c7003,
-	0x16c76c2,
-	0x2c76c3,
-	0x2c7b43,
-	0x46600c02,
-	0x221e44,
-	0x34d006,
-	0x27d843,
-	0x2c7fc3,
-	0x46a510c2,
-	0x2510c8,
-	0x2c8c84,
-	0x3b6686,
-	0x38ca87,
-	0x3ae1c6,
-	0x370f84,
-	0x54e01302,
-	0x22f18b,
-	0x2c650e,
-	0x21b1cf,
-	0x3a9cc3,
-	0x556d5782,
-	0x1646c82,
-	0x55a06002,
-	0x242443,
-	0x3bf3c4,
-	0x288983,
-	0x358546,
-	0x389c06,
-	0x3c3087,
-	0x244804,
-	0x55e19502,
-	0x56229d02,
-	0x307cc5,
-	0x302d47,
-	0x3ba846,
-	0x566744c2,
-	0x389544,
-	0x2cda83,
-	0x56a06982,
-	0x56f7bc03,
-	0x2ce904,
-	0x2d56c9,
-	0x572dd4c2,
-	0x57639842,
-	0x24e685,
-	0x57add802,
-	0x58204fc2,
-	0x363ec7,
-	0x37f34b,
-	0x212d05,
-	0x248009,
-	0x265e06,
-	0x5861cd44,
-	0x3c58c9,
-	0x3e7587,
-	0x38be47,
-	0x22d903,
-	0x2f8406,
-	0x325a07,
-	0x2721c3,
-	0x2c0686,
-	0x58e0d9c2,
-	0x5922a2c2,
-	0x3b7203,
-	0x3a5e05,
-	0x2df807,
-	0x38ffc6,
-	0x389d05,
-	0x255644,
-	0x2b2085,
-	0x311944,
-	0x59601282,
-	0x2db584,
-	0x26b184,
-	0x26b18d,
-	0x2d92c9,
-	0x393f88,
-	0x201284,
-	0x267945,
-	0x2ff707,
-	0x3c22c4,
-	0x2fe247,
-	0x226505,
-	0x59ab7284,
-	0x2ba645,
-	0x59e6f904,
-	0x318046,
-	0x2dc045,
-	0x5a2663c2,
-	0x22a283,
-	0x30cf03,
-	0x23b5c4,
-	0x23b5c5,
-	0x21c2c6,
-	0x389e45,
-	0x264cc4,
-	0x5a700ec3,
-	0x5aa10886,
-	0x20a8c5,
-	0x218f45,
-	0x2dc144,
-	0x3516c3,
-	0x3516cc,
-	0x5aec5ac2,
-	0x5b200b42,
-	0x5b606b42,
-	0x20f743,
-	0x20f744,
-	0x5ba09582,
-	0x2fa4c8,
-	0x2665c4,
-	0x32ea06,
-	0x5be1a202,
-	0x5c2065c2,
-	0x5c605e42,
-	0x29d5c5,
-	0x3ca106,
-	0x35ed44,
-	0x22c8c6,
-	0x20bac6,
-	0x228343,
-	0x5ca9748a,
-	0x2e9bc5,
-	0x2a8643,
-	0x225ac6,
-	0x5cff3f49,
-	0x225ac7,
-	0x28f848,
-	0x2ca809,
-	0x3a3348,
-	0x29ca06,
-	0x206a83,
-	0x5d202042,
-	0x3a7ac8,
-	0x5d64e442,
-	0x5da00ec2,
-	0x23ddc3,
-	0x2dfa85,
-	0x2a7d84,
-	0x2bd2c9,
-	0x231784,
-	0x235ac8,
-	0x5e209b43,
-	0x5e65f304,
-	0x219408,
-	0x5eac7f42,
-	0x230582,
-	0x335f45,
-	0x234e09,
-	0x206543,
-	0x32c584,
-	0x3a7f44,
-	0x255a83,
-	0x28e94a,
-	0x5ef94cc2,
-	0x5f20d682,
-	0x2e2003,
-	0x396ec3,
-	0x160f402,
-	0x3b3083,
-	0x5f61cf02,
-	0x5fa01502,
-	0x5fe28f84,
-	0x28f406,
-	0x27c704,
-	0x287a43,
-	0x208483,
-	0x6030b843,
-	0x243d46,
-	0x336305,
-	0x2e6947,
-	0x2e6886,
-	0x2e7588,
-	0x2e7786,
-	0x220084,
-	0x2a9ccb,
-	0x2ea443,
-	0x2ea445,
-	0x606066c2,
-	0x3641c2,
-	0x60a4a502,
-	0x60e03c42,
-	0x206e83,
-	0x6127d202,
-	0x27d203,
-	0x2eaf83,
-	0x61a03302,
-	0x61eee6c6,
-	0x2eeac5,
-	0x29acc6,
-	0x62275a82,
-	0x6260a702,
-	0x62a1e002,
-	0x62e070c2,
-	0x6320f8c2,
-	0x63601b82,
-	0x24b083,
-	0x3d3446,
-	0x63a94744,
-	0x3ac646,
-	0x288d04,
-	0x301843,
-	0x646024c2,
-	0x2018c2,
-	0x22e683,
-	0x64a109c3,
-	0x3d3687,
-	0x2dbf47,
-	0x6aa50587,
-	0x314207,
-	0x212343,
-	0x6ae73e04,
-	0x2ecf44,
-	0x2ecf4a,
-	0x3e8d45,
-	0x6b20fcc2,
-	0x25de43,
-	0x6b600602,
-	0x22b643,
-	0x27e183,
-	0x6be00582,
-	0x2899c4,
-	0x335904,
-	0x3afb45,
-	0x3226c5,
-	0x22d006,
-	0x2b9286,
-	0x6c212282,
-	0x6c601f42,
-	0x2c6d85,
-	0x29a9d2,
-	0x2ad8c6,
-	0x203d43,
-	0x3d1f46,
-	0x366905,
-	0x1617142,
-	0x74a0b502,
-	0x3baec3,
-	0x20b503,
-	0x2afb03,
-	0x74e03902,
-	0x218903,
-	0x75216282,
-	0x228fc3,
-	0x3afdc8,
-	0x243503,
-	0x243506,
-	0x3ea507,
-	0x333ac6,
-	0x333acb,
-	0x288c47,
-	0x300e44,
-	0x75a00e82,
-	0x3570c5,
-	0x75e01883,
-	0x23c483,
-	0x3c52c5,
-	0x212243,
-	0x76612246,
-	0x2b1343,
-	0x22c284,
-	0x2003c6,
-	0x3dd9c6,
-	0x76a1f143,
-	0x3545c7,
-	0x360fc7,
-	0x2abc05,
-	0x329dc6,
-	0x20a903,
-	0x796c88c3,
-	0x79a06702,
-	0x79e28d44,
-	0x3f2d09,
-	0x222b85,
-	0x23d9c4,
-	0x2fb7c8,
-	0x245ac5,
-	0x7a247285,
-	0x260fc9,
-	0x2ff543,
-	0x3d7744,
-	0x7a6020c2,
-	0x219743,
-	0x7aa795c2,
-	0x2795c6,
-	0x1686f42,
-	0x7ae06fc2,
-	0x29d4c8,
-	0x29db43,
-	0x2ba587,
-	0x333d45,
-	0x2cc285,
-	0x2cc28b,
-	0x2f8186,
-	0x2cc486,
-	0x244f04,
-	0x211786,
-	0x7b2f8a08,
-	0x2622c3,
-	0x267103,
-	0x267104,
-	0x302c84,
-	0x30e087,
-	0x341845,
-	0x7b768e82,
-	0x7ba04f82,
-	0x7c204f85,
-	0x2d23c4,
-	0x2e32cb,
-	0x303988,
-	0x271c84,
-	0x7c634dc2,
-	0x7ca71c02,
-	0x373dc3,
-	0x304c84,
-	0x304f45,
-	0x3058c7,
-	0x7cf08144,
-	0x20f004,
-	0x7d202b02,
-	0x383b89,
-	0x3096c5,
-	0x212fc5,
-	0x30a245,
-	0x7d619683,
-	0x23ab84,
-	0x23ab8b,
-	0x30af04,
-	0x30b1cb,
-	0x30b785,
-	0x21b30a,
-	0x30bec8,
-	0x30c0ca,
-	0x30c943,
-	0x30c94a,
-	0x7de15cc2,
-	0x7e21a002,
-	0x7e620283,
-	0x7eb0e9c2,
-	0x30e9c3,
-	0x7ef104c2,
-	0x7f340942,
-	0x3115c4,
-	0x21b886,
-	0x22c605,
-	0x3db3c6,
-	0x3c1f05,
-	0x30f784,
-	0x7f600902,
-	0x269484,
-	0x2e228a,
-	0x2c4587,
-	0x3be686,
-	0x237347,
-	0x243143,
-	0x2ce948,
-	0x3ed24b,
-	0x2d61c5,
-	0x21d505,
-	0x21d506,
-	0x3a8084,
-	0x3b7a48,
-	0x214143,
-	0x2a7e84,
-	0x3d8a47,
-	0x300a86,
-	0x3e2106,
-	0x2d34ca,
-	0x23d704,
-	0x23d70a,
-	0x7fb70486,
-	0x370487,
-	0x262c87,
-	0x267784,
-	0x267789,
-	0x229405,
-	0x3e7503,
-	0x20c4c3,
-	0x7fe22b03,
-	0x80200682,
-	0x239ac6,
-	0x806d7105,
-	0x3d2185,
-	0x236746,
-	0x2c7e84,
-	0x80a12482,
-	0x236844,
-	0x81210002,
-	0x3c5745,
-	0x229584,
-	0x82627103,
-	0x82a0b542,
-	0x20b543,
-	0x3b5ec6,
-	0x82e04842,
-	0x39ac48,
-	0x225944,
-	0x225946,
-	0x33ca86,
-	0x83264744,
-	0x20e905,
-	0x2203c8,
-	0x225c47,
-	0x228087,
-	0x22808f,
-	0x2a20c6,
-	0x23ae03,
-	0x23f044,
-	0x227543,
-	0x2254c4,
-	0x382e44,
-	0x8363f602,
-	0x2a0f03,
-	0x33d7c3,
-	0x83a02ec2,
-	0x202ec3,
-	0x269703,
-	0x213dca,
-	0x31bc07,
-	0x3a60cc,
-	0x3a6386,
-	0x251e86,
-	0x259307,
-	0x83e5d447,
-	0x263789,
-	0x84241844,
-	0x84a06ec2,
-	0x84e01042,
-	0x2d3886,
-	0x3543c4,
-	0x2d4746,
-	0x26abc8,
-	0x3a5ec4,
-	0x33da06,
-	0x292485,
-	0x8567e608,
-	0x247843,
-	0x282245,
-	0x285c83,
-	0x2130c3,
-	0x2130c4,
-	0x26b683,
-	0x85a51502,
-	0x85e00e02,
-	0x3e73c9,
-	0x28cb45,
-	0x28cec4,
-	0x298ac5,
-	0x203544,
-	0x2e6f07,
-	0x35ea45,
-	0x8661bc04,
-	0x2f9f48,
-	0x2c9bc6,
-	0x2cf104,
-	0x2cff48,
-	0x86a01a42,
-	0x2e3184,
-	0x31c344,
-	0x351387,
-	0x86e04ac4,
-	0x201cc2,
-	0x87210a82,
-	0x24e583,
-	0x24e584,
-	0x239803,
-	0x38f6c5,
-	0x87655182,
-	0x2f4a85,
-	0x27ccc2,
-	0x317585,
-	0x2e1085,
-	0x87a03d02,
-	0x381844,
-	0x87e03c82,
-	0x3e49c6,
-	0x2d7c06,
-	0x234f48,
-	0x296048,
-	0x37cc84,
-	0x2f8bc5,
-	0x8822a9c9,
-	0x2e90c4,
-	0x3ef104,
-	0x2776c3,
-	0x20e7c3,
-	0x8860e7c5,
-	0x275485,
-	0x2e9f04,
-	0x2b26c2,
-	0x3315c3,
-	0x88a02e82,
-	0x88e01982,
-	0x39a705,
-	0x285b07,
-	0x283d44,
-	0x2caa09,
-	0x2e23c9,
-	0x202183,
-	0x286d88,
-	0x2a8c49,
-	0x222607,
-	0x8933d845,
-	0x359b86,
-	0x35b2c6,
-	0x35c0c5,
-	0x2d93c5,
-	0x89605682,
-	0x259205,
-	0x2d8f88,
-	0x2d5fc6,
-	0x89b0b9c7,
-	0x3a6744,
-	0x371587,
-	0x3b1106,
-	0x89e0de02,
-	0x21bfc6,
-	0x317485,
-	0x8a2429c2,
-	0x8a618b82,
-	0x27aec6,
-	0x8aa99987,
-	0x8ae38742,
-	0x21a043,
-	0x23e186,
-	0x2d8e44,
-	0x269c46,
-	0x341606,
-	0x2fdb0a,
-	0x350145,
-	0x21ef46,
-	0x21f983,
-	0x21f984,
-	0x8b2021c2,
-	0x32a083,
-	0x8b60f782,
-	0x333883,
-	0x8ba0d084,
-	0x2dfbc4,
-	0x8bedfbca,
-	0x206383,
-	0x2096c7,
-	0x366c46,
-	0x3888c4,
-	0x22cec2,
-	0x2298c2,
-	0x8c2007c2,
-	0x30fc43,
-	0x262a47,
-	0x2007c7,
-	0x295284,
-	0x230147,
-	0x3059c6,
-	0x3dac87,
-	0x217c44,
-	0x21c505,
-	0x210785,
-	0x8c60ae42,
-	0x361dc6,
-	0x2309c3,
-	0x231d02,
-	0x231d06,
-	0x8ca20342,
-	0x8ce3d942,
-	0x24a685,
-	0x8d201b42,
-	0x8d60c642,
-	0x8df925c5,
-	0x2e3e85,
-	0x311305,
-	0x8e26bfc3,
-	0x2d9e05,
-	0x2f8247,
-	0x2b6cc5,
-	0x350305,
-	0x273d04,
-	0x245946,
-	0x254f84,
-	0x8e6008c2,
-	0x8f2b5585,
-	0x37b547,
-	0x2f8788,
-	0x28e506,
-	0x28e50d,
-	0x28fa09,
-	0x28fa12,
-	0x387e05,
-	0x391543,
-	0x8f609a02,
-	0x324704,
-	0x230903,
-	0x318785,
-	0x319345,
-	0x8fa24b42,
-	0x26aa43,
-	0x8fe50602,
-	0x90624302,
-	0x90a00082,
-	0x3ee585,
-	0x3a0ec3,
-	0x90e07482,
-	0x91205fc2,
-	0x289986,
-	0x277a0a,
-	0x2056c3,
-	0x23b543,
-	0x2f0ac3,
-	0x92e02642,
-	0xa1641d82,
-	0xa1e18182,
-	0x2046c2,
-	0x330c49,
-	0x2dc8c4,
-	0x3a0208,
-	0xa2221902,
-	0xa2a01102,
-	0x282145,
-	0x236dc8,
-	0x32b148,
-	0x2f0d4c,
-	0x23ba43,
-	0xa2e6f2c2,
-	0xa320c302,
-	0x2d4146,
-	0x31a605,
-	0x2ef943,
-	0x273706,
-	0x31a746,
-	0x2376c3,
-	0x31c283,
-	0x31c946,
-	0x31de04,
-	0x20c306,
-	0x3ec744,
-	0x31e5c4,
-	0x320bca,
-	0xa364c542,
-	0x2563c5,
-	0x3229ca,
-	0x322905,
-	0x3236c4,
-	0x3237c6,
-	0x323944,
-	0x219a06,
-	0xa3a01d82,
-	0x39e8c6,
-	0x302045,
-	0x3bd5c7,
-	0x3c9246,
-	0x259504,
-	0x2efc47,
-	0x21c005,
-	0x25d2c7,
-	0x22b7c7,
-	0x22b7ce,
-	0x288646,
-	0x243885,
-	0x204a07,
-	0x3c2c87,
-	0x20b6c5,
-	0x214404,
-	0x244b82,
-	0x285d07,
-	0x293244,
-	0x24cf44,
-	0x2e78cb,
-	0xa3e20b83,
-	0x326f07,
-	0x220b84,
-	0x327207,
-	0x21c903,
-	0x352b0d,
-	0x326648,
-	0xa424d404,
-	0x24d405,
-	0x3e3e85,
-	0x326e83,
-	0xa4625842,
-	0x32a043,
-	0x32ae03,
-	0x21e044,
-	0x361f45,
-	0x362047,
-	0x21fa06,
-	0x394dc3,
-	0x233e8b,
-	0x3727cb,
-	0x2aeccb,
-	0x2badcb,
-	0x2c78ca,
-	0x2d594b,
-	0x2f8f0b,
-	0x3274cc,
-	0x31e9cb,
-	0x36534a,
-	0x39c74b,
-	0x3b558c,
-	0x3f130b,
-	0x32b74a,
-	0x32c34a,
-	0x32d68e,
-	0x32de0b,
-	0x32e0ca,
-	0x32f191,
-	0x32f5ca,
-	0x32facb,
-	0x33000e,
-	0x33130c,
-	0x33168b,
-	0x33194e,
-	0x331ccc,
-	0x33324a,
-	0x33500c,
-	0xa4b35c0a,
-	0x336448,
-	0x336e49,
-	0x33894a,
-	0x338bca,
-	0x338e4b,
-	0x33cf4e,
-	0x33df11,
-	0x348109,
-	0x34834a,
-	0x348a8b,
-	0x34a04d,
-	0x34aeca,
-	0x34b516,
-	0x34c88b,
-	0x34e18a,
-	0x34e9ca,
-	0x34f8cb,
-	0x350709,
-	0x353489,
-	0x354a4d,
-	0x35520b,
-	0x356b8b,
-	0x357509,
-	0x357b4e,
-	0x35874a,
-	0x35940a,
-	0x35994a,
-	0x35a2cb,
-	0x35ab0b,
-	0x35b8cd,
-	0x35d48d,
-	0x35e110,
-	0x35e5cb,
-	0x35fc4c,
-	0x36160b,
-	0x3639cb,
-	0x367bce,
-	0x3682cb,
-	0x3682cd,
-	0x36e30b,
-	0x36ed8f,
-	0x36f14b,
-	0x36fb0a,
-	0x3724c9,
-	0x374309,
-	0xa4f7468b,
-	0x37494e,
-	0x374cce,
-	0x37638b,
-	0x37708f,
-	0x379b0b,
-	0x379dcb,
-	0x37a08a,
-	0x37ef49,
-	0x38280f,
-	0x386b0c,
-	0x38748c,
-	0x387ace,
-	0x387fcf,
-	0x38838e,
-	0x388b10,
-	0x388f0f,
-	0x38a00e,
-	0x38ab4c,
-	0x38ae51,
-	0x38b292,
-	0x38c611,
-	0x38cc4e,
-	0x38d48b,
-	0x38d48e,
-	0x38d80f,
-	0x38dbce,
-	0x38df50,
-	0x38e353,
-	0x38e811,
-	0x38ec4c,
-	0x38ef4e,
-	0x38f3cc,
-	0x38f813,
-	0x390990,
-	0x390e0c,
-	0x39110c,
-	0x39218b,
-	0x39290e,
-	0x392e0b,
-	0x39354b,
-	0x39564c,
-	0x39b18a,
-	0x39bf4c,
-	0x39c24c,
-	0x39c549,
-	0x39e04b,
-	0x39e308,
-	0x39eec9,
-	0x39eecf,
-	0x3a07cb,
-	0xa53a13ca,
-	0x3a360c,
-	0x3a454b,
-	0xa57a4809,
-	0x3a5008,
-	0x3a53cb,
-	0x3a6c8a,
-	0x3a6f0a,
-	0x3a718b,
-	0x3a784c,
-	0x3a85c9,
-	0x3a8808,
-	0x3ab9cb,
-	0x3ae48b,
-	0x3b230e,
-	0x3b380b,
-	0x3b4f0b,
-	0x3c698b,
-	0x3c6c49,
-	0x3c714d,
-	0x3e264a,
-	0x3e6257,
-	0x3e6a98,
-	0x3e8f09,
-	0x3ea14b,
-	0x3eb314,
-	0x3eb80b,
-	0x3ebd8a,
-	0x3eca0a,
-	0x3ecc8b,
-	0x3ee810,
-	0x3eec11,
-	0x3ef20a,
-	0x3f090d,
-	0x3f100d,
-	0x3f2a0b,
-	0x361ec3,
-	0xa5bd5603,
-	0x27d646,
-	0x286845,
-	0x2eb907,
-	0x2de506,
-	0xa5e3c402,
-	0x2b8a09,
-	0x3db1c4,
-	0x2f64c8,
-	0x222a43,
-	0x324647,
-	0xa62428c2,
-	0x2c1143,
-	0xa6603642,
-	0x2e2ec6,
-	0x2e5184,
-	0x229104,
-	0x3d6b83,
-	0xa6edd842,
-	0xa7201844,
-	0x2676c7,
-	0xa762c082,
-	0x206643,
-	0x2cd03,
-	0x21f603,
-	0x205503,
-	0x206543,
-	0x2109c3,
-	0x21f143,
-	0x11c748,
-	0x21d783,
-	0x2000c2,
-	0x1b9688,
-	0x202202,
-	0x205503,
-	0x206543,
-	0x2109c3,
-	0x21f143,
-	0x213dc3,
-	0x343916,
-	0x36c653,
-	0x22ffc9,
-	0x2575c8,
-	0x356f49,
-	0x322b46,
-	0x356090,
-	0x3ed4d3,
-	0x300b48,
-	0x289647,
-	0x293c47,
-	0x2b1dca,
-	0x36b289,
-	0x3d3dc9,
-	0x25364b,
-	0x34d986,
-	0x33218a,
-	0x225106,
-	0x22f843,
-	0x274845,
-	0x3c1748,
-	0x28dacd,
-	0x3c9c4c,
-	0x301d07,
-	0x31ec4d,
-	0x2204c4,
-	0x23218a,
-	0x232d8a,
-	0x23324a,
-	0x31f787,
-	0x23fec7,
-	0x244ac4,
-	0x27cf46,
-	0x2ff884,
-	0x21f608,
-	0x2317c9,
-	0x30d786,
-	0x30d788,
-	0x24848d,
-	0x2e2609,
-	0x31cec8,
-	0x212f47,
-	0x24a8ca,
-	0x2bdd06,
-	0x37cfc4,
-	0x21dc07,
-	0x239cca,
-	0x23f70e,
-	0x286e45,
-	0x29950b,
-	0x30f989,
-	0x26b289,
-	0x20ac07,
-	0x20ac0a,
-	0x31b187,
-	0x2c6649,
-	0x3eaa48,
-	0x37360b,
-	0x2dfa85,
-	0x393e4a,
-	0x21ddc9,
-	0x2fe3ca,
-	0x215e8b,
-	0x21db0b,
-	0x2533d5,
-	0x2f6985,
-	0x212fc5,
-	0x23ab8a,
-	0x2722ca,
-	0x3107c7,
-	0x213003,
-	0x2d3808,
-	0x2ed6ca,
-	0x225946,
-	0x25f809,
-	0x27e608,
-	0x2cf104,
-	0x286b09,
-	0x296048,
-	0x2d8cc7,
-	0x2b5586,
-	0x37b547,
-	0x2ca047,
-	0x243b45,
-	0x2a174c,
-	0x24d405,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x202202,
-	0x206643,
-	0x2109c3,
-	0x21d783,
-	0x21f143,
-	0x206643,
-	0x2109c3,
-	0x243503,
-	0x21f143,
-	0x1e2a03,
-	0x1b9688,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x206543,
-	0x2109c3,
-	0x21f143,
-	0x1b9688,
-	0x202202,
-	0x206643,
-	0x22e547,
-	0x2b044,
-	0x2109c3,
-	0x1dadc4,
-	0x21f143,
-	0x1c1f05,
-	0x202202,
-	0x201482,
-	0x300dc2,
-	0x204b02,
-	0x206282,
-	0x2061c2,
-	0x1214a,
-	0x12a185,
-	0x12a18a,
-	0x1528d09,
-	0x14910b,
-	0x54047,
-	0x1b1786,
-	0x9d286,
-	0x5c4c9,
-	0xadfc7,
-	0xf8504,
-	0x15adf8a,
-	0xe44e,
-	0x18150c,
-	0x1ddc89,
-	0x4827103,
-	0x95607,
-	0x1106,
-	0xf83,
-	0xecf05,
-	0xc1,
-	0x221bc3,
-	0x5206643,
-	0x2392c4,
-	0x21f603,
-	0x3d6403,
-	0x205503,
-	0x211e43,
-	0x206543,
-	0x2eeac6,
-	0x29acc6,
-	0x2109c3,
-	0x21f143,
-	0x2b6006,
-	0x236e83,
-	0x1b9688,
-	0x200984,
-	0x25f0c7,
-	0x3d6bc3,
-	0x291904,
-	0x20aa83,
-	0x20ac83,
-	0x205503,
-	0xf08c7,
-	0x1a31c4,
-	0x1d45c3,
-	0x1a2345,
-	0x66000c2,
-	0x50b03,
-	0x6a02202,
-	0x6e92849,
-	0x70988c9,
-	0x98dcd,
-	0x9910d,
-	0x300dc2,
-	0x503c4,
-	0x1a2389,
-	0xf9d4c,
-	0x2003c2,
-	0x76502c8,
-	0x10a904,
-	0x3295c3,
-	0x1b9688,
-	0x93244,
-	0x1412f42,
-	0x14005c2,
-	0x1412f42,
-	0x151e7c6,
-	0x233cc3,
-	0x276803,
-	0x7e06643,
-	0x232184,
-	0x861f603,
-	0x8e05503,
-	0x203042,
-	0x2503c4,
-	0x2109c3,
-	0x21bf83,
-	0x201582,
-	0x21f143,
-	0x219142,
-	0x310f03,
-	0x204842,
-	0x2019c3,
-	0x21a743,
-	0x2059c2,
-	0x1b9688,
-	0x829b1c9,
-	0xf9d4c,
-	0x22403,
-	0x233cc3,
-	0x3f2f08,
-	0x8a1bf83,
-	0x201582,
-	0x310f03,
-	0x204842,
-	0x2019c3,
-	0x21a743,
-	0x2059c2,
-	0x3a6387,
-	0x310f03,
-	0x204842,
-	0x2019c3,
-	0x21a743,
-	0x2059c2,
-	0x206643,
-	0x8a42,
-	0xf543,
-	0x1342,
-	0x4c02,
-	0x6d602,
-	0x2042,
-	0x2642,
-	0x13142,
-	0x250b03,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2503c4,
-	0x211e43,
-	0x206543,
-	0x294744,
-	0x2109c3,
-	0x21f143,
-	0x215c82,
-	0x219683,
-	0x1b9688,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x206543,
-	0x2109c3,
-	0x21f143,
-	0x12482,
-	0xab643,
-	0x16282,
-	0x250b03,
-	0x202202,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2503c4,
-	0x2109c3,
-	0x21f143,
-	0x33d845,
-	0x224b42,
-	0x2000c2,
-	0x1b9688,
-	0xae2a792,
-	0xb3c2588,
-	0xf9d4c,
-	0x147e248,
-	0x16d0a,
-	0x2c45,
-	0x1d54c7,
-	0x205503,
-	0x202701,
-	0x2009c1,
-	0x2026c1,
-	0x202741,
-	0x200a41,
-	0x226181,
-	0x200a01,
-	0x232041,
-	0x202781,
-	0x200001,
-	0x2000c1,
-	0x200201,
-	0x14cb05,
-	0x1b9688,
-	0x200101,
-	0x200cc1,
-	0x200501,
-	0x200bc1,
-	0x200041,
-	0x200801,
-	0x200181,
-	0x200c01,
-	0x200701,
-	0x2004c1,
-	0x200ec1,
-	0x200581,
-	0x2003c1,
-	0x201401,
-	0x207141,
-	0x200401,
-	0x200741,
-	0x2007c1,
-	0x200081,
-	0x201101,
-	0x200f81,
-	0x208f81,
-	0x205381,
-	0x201841,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x202202,
-	0x206643,
-	0x21f603,
-	0x2003c2,
-	0x21f143,
-	0xf08c7,
-	0x82b87,
-	0x34106,
-	0x3c80a,
-	0x97d08,
-	0x61f08,
-	0x62947,
-	0xc1e04,
-	0x1ddf06,
-	0xf4245,
-	0x1cf805,
-	0xaec43,
-	0x15d46,
-	0x54146,
-	0x214f04,
-	0x33a247,
-	0x1b9688,
-	0x2e4084,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x2202,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x335e08,
-	0x3c7f44,
-	0x235fc4,
-	0x206204,
-	0x2d4047,
-	0x2ec587,
-	0x206643,
-	0x2392cb,
-	0x3ab74a,
-	0x388787,
-	0x315b08,
-	0x2afec8,
-	0x21f603,
-	0x373887,
-	0x3d6403,
-	0x203a48,
-	0x20b289,
-	0x2503c4,
-	0x211e43,
-	0x25ce88,
-	0x206543,
-	0x2ea58a,
-	0x2eeac6,
-	0x3ac647,
-	0x2109c3,
-	0x3becc6,
-	0x2bea08,
-	0x21f143,
-	0x264506,
-	0x303bcd,
-	0x305608,
-	0x30af0b,
-	0x3121c6,
-	0x33c047,
-	0x217fc5,
-	0x3dcfca,
-	0x233d45,
-	0x27538a,
-	0x224b42,
-	0x200f83,
-	0x24cf44,
-	0x200006,
-	0x3b9283,
-	0x2b4c83,
-	0x38a7c3,
-	0x23c0c3,
-	0x3dd203,
-	0x201c02,
-	0x3a1085,
-	0x2b83c9,
-	0x215c43,
-	0x2441c3,
-	0x2028c3,
-	0x213743,
-	0x200201,
-	0x2e8e87,
-	0x2d9c45,
-	0x3c12c3,
-	0x266483,
-	0x3f1d43,
-	0x206204,
-	0x2fd643,
-	0x2102c8,
-	0x372703,
-	0x31b70d,
-	0x288708,
-	0x3f30c6,
-	0x2fbec3,
-	0x385383,
-	0x3a7403,
-	0xde06643,
-	0x234788,
-	0x2392c4,
-	0x240c03,
-	0x2457c3,
-	0x200106,
-	0x249248,
-	0x2023c3,
-	0x21ce03,
-	0x2be703,
-	0x21a6c3,
-	0x3dd003,
-	0x20f343,
-	0x21f603,
-	0x216503,
-	0x24e0c3,
-	0x2534c3,
-	0x228e03,
-	0x33e443,
-	0x39bac3,
-	0x244bc3,
-	0x3a5b45,
-	0x25c484,
-	0x25db07,
-	0x2596c2,
-	0x260983,
-	0x265506,
-	0x267a83,
-	0x267d83,
-	0x286d43,
-	0x3de7c3,
-	0x219b03,
-	0x33b403,
-	0x2a4e07,
-	0xea05503,
-	0x20ffc3,
-	0x206a43,
-	0x2036c3,
-	0x20fc83,
-	0x34ffc3,
-	0x369d05,
-	0x382b83,
-	0x24dd09,
-	0x200c03,
-	0x319643,
-	0xee4f703,
-	0x266543,
-	0x206243,
-	0x211a08,
-	0x2b8306,
-	0x3de586,
-	0x2c3d46,
-	0x268d87,
-	0x213ac3,
-	0x23ddc3,
-	0x206543,
-	0x297e06,
-	0x2066c2,
-	0x2eddc3,
-	0x3423c5,
-	0x2109c3,
-	0x328fc7,
-	0x161d783,
-	0x23d5c3,
-	0x236a03,
-	0x2364c3,
-	0x23c483,
-	0x21f143,
-	0x23e806,
-	0x3a3286,
-	0x383183,
-	0x3cbd03,
-	0x219683,
-	0x217cc3,
-	0x31c303,
-	0x30e243,
-	0x3118c3,
-	0x3c1f05,
-	0x237343,
-	0x35ddc6,
-	0x20ef83,
-	0x3b98c8,
-	0x20c4c3,
-	0x3b75c9,
-	0x20c4c8,
-	0x21a188,
-	0x21e605,
-	0x22f40a,
-	0x2302ca,
-	0x232acb,
-	0x234448,
-	0x325383,
-	0x217043,
-	0x311903,
-	0x2f2983,
-	0x313948,
-	0x336c83,
-	0x21f984,
-	0x2021c2,
-	0x240b83,
-	0x260e43,
-	0x2007c3,
-	0x23d943,
-	0x2976c3,
-	0x236e83,
-	0x224b42,
-	0x218083,
-	0x23ba43,
-	0x31e943,
-	0x321744,
-	0x24cf44,
-	0x224343,
-	0x1b9688,
-	0xe31d0cc,
-	0xe658b05,
-	0xde305,
-	0x2000c2,
-	0x200b02,
-	0x201c02,
-	0x206182,
-	0x200202,
-	0x2011c2,
-	0x278d02,
-	0x201342,
-	0x200382,
-	0x205e42,
-	0x2c7f42,
-	0x203c42,
-	0x27d202,
-	0x206702,
-	0x2061c2,
-	0x2020c2,
-	0x201402,
-	0x202b02,
-	0x245342,
-	0x203f42,
-	0x200682,
-	0x2039c2,
-	0x212482,
-	0x202ec2,
-	0x201042,
-	0x20e7c2,
-	0x20c642,
-	0xc2,
-	0xb02,
-	0x1c02,
-	0x6182,
-	0x202,
-	0x11c2,
-	0x78d02,
-	0x1342,
-	0x382,
-	0x5e42,
-	0xc7f42,
-	0x3c42,
-	0x7d202,
-	0x6702,
-	0x61c2,
-	0x20c2,
-	0x1402,
-	0x2b02,
-	0x45342,
-	0x3f42,
-	0x682,
-	0x39c2,
-	0x12482,
-	0x2ec2,
-	0x1042,
-	0xe7c2,
-	0xc642,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0xf82,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x99b49,
-	0x2202,
-	0x202202,
-	0x21f143,
-	0x10a06643,
-	0x205503,
-	0xdfa89,
-	0x206543,
-	0xf0847,
-	0x2232c2,
-	0x1b9688,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x17003,
-	0x2109c3,
-	0x21f143,
-	0x3642,
-	0x2001c2,
-	0x1421805,
-	0x14cb05,
-	0x2886c2,
-	0x1b9688,
-	0x2202,
-	0x237e02,
-	0x202f82,
-	0xf9d4c,
-	0x2104c2,
-	0x20fcc2,
-	0x212282,
-	0x1cf805,
-	0x200dc2,
-	0x201582,
-	0x203902,
-	0x201542,
-	0x2020c2,
-	0x2413c2,
-	0x210a82,
-	0x242402,
-	0x11a7a6c4,
-	0x142,
-	0xf08c7,
-	0x42983,
-	0xdc40d,
-	0xf42c9,
-	0x1280b,
-	0xf8108,
-	0x66fc9,
-	0x122ece45,
-	0x119e46,
-	0x137d09,
-	0x205503,
-	0x1b9688,
-	0x1a31c4,
-	0x1d45c3,
-	0x1a2345,
-	0x1b9688,
-	0x1dd507,
-	0x13053907,
-	0x1365f684,
-	0x63646,
-	0x1a2389,
-	0xb728e,
-	0xf9d4c,
-	0x144207,
-	0x15b5c83,
-	0x13a01ac2,
-	0x147849,
-	0x1d5004,
-	0x2000c2,
-	0x214f04,
-	0x202202,
-	0x206643,
-	0x201482,
-	0x21f603,
-	0xfd03,
-	0x200382,
-	0x2e4084,
-	0x211e43,
-	0x24e442,
-	0x2109c3,
-	0x12282,
-	0x2003c2,
-	0x21f143,
-	0x212fc6,
-	0x33940f,
-	0x7de983,
-	0x1b9688,
-	0x202202,
-	0x3d6403,
-	0x205503,
-	0x206543,
-	0x14fd3587,
-	0x1572a46,
-	0x1ee286,
-	0xd9c89,
-	0x153c7448,
-	0x1e8684,
-	0x156c3fca,
-	0x7d848,
-	0x16016c07,
-	0x1c2588,
-	0xb7288,
-	0x15dcd8b,
-	0x147abca,
-	0x16467cc3,
-	0xfac49,
-	0x1690a248,
-	0x16e38a47,
-	0x14eb44a,
-	0x1506147,
-	0xb1e8b,
-	0x1729e38c,
-	0x164685,
-	0xe0405,
-	0x1231c9,
-	0x1029c4,
-	0x11c283,
-	0x15ac4105,
-	0x12c843,
-	0x15e2c1c3,
-	0x12c843,
-	0x42982,
-	0x1b42,
-	0x5fc2,
-	0x5fc2,
-	0x1782,
-	0x5fc2,
-	0x2642,
-	0x3402,
-	0x23c2,
-	0x14cb05,
-	0xf08c7,
-	0x1e8684,
-	0x107e04,
-	0x202202,
-	0x206643,
-	0x205503,
-	0x2109c3,
-	0x2000c2,
-	0x2087c2,
-	0x205a42,
-	0x18206643,
-	0x241382,
-	0x21f603,
-	0x200bc2,
-	0x234382,
-	0x205503,
-	0x2062c2,
-	0x2697c2,
-	0x2208c2,
-	0x207002,
-	0x29cfc2,
-	0x200802,
-	0x203582,
-	0x217382,
-	0x20bd82,
-	0x20af02,
-	0x1610cc,
-	0x2c7002,
-	0x27e5c2,
-	0x230a02,
-	0x201f02,
-	0x206543,
-	0x201502,
-	0x2109c3,
-	0x239e02,
-	0x246102,
-	0x21f143,
-	0x244242,
-	0x202ec2,
-	0x206ec2,
-	0x200e02,
-	0x203d02,
-	0x2429c2,
-	0x20ae42,
-	0x250602,
-	0x230a42,
-	0x32e0ca,
-	0x36fb0a,
-	0x3a1a4a,
-	0x3f4442,
-	0x205382,
-	0x369cc2,
-	0x186fc749,
-	0x18b547ca,
-	0x1549207,
-	0x18e00fc2,
-	0x143bfc3,
-	0x4942,
-	0x1547ca,
-	0x1685ce,
-	0x204884,
-	0x105785,
-	0x19606643,
-	0x42dc3,
-	0x21f603,
-	0x2554c4,
-	0x205503,
-	0x2503c4,
-	0x211e43,
-	0x144009,
-	0x1d4086,
-	0x206543,
-	0xf8984,
-	0x146ec3,
-	0x2109c3,
-	0x1f45,
-	0x21d783,
-	0x21f143,
-	0x1445a04,
-	0x237343,
-	0x1994e6c4,
-	0xcbd48,
-	0x200f83,
-	0x1b9688,
-	0x3042,
-	0x1533a43,
-	0x1de8c6,
-	0x15dde84,
-	0x1d6985,
-	0x1027ca,
-	0x134f82,
-	0x1a5dec0d,
-	0x1b32c6,
-	0x6f51,
-	0x1aafc749,
-	0x159c8a,
-	0x1d6a08,
-	0x8c1c8,
-	0x145cce,
-	0x54b13,
-	0x21572d07,
-	0x28c2,
-	0x13a810,
-	0x145acc,
-	0xfc8d4,
-	0xb0407,
-	0x1a50e,
-	0x14cb0b,
-	0x14eecb,
-	0x1bd04a,
-	0x342c7,
-	0x1b9688,
-	0xb4d88,
-	0x8ec7,
-	0x2181ae0b,
-	0x1c446,
-	0x1f4c7,
-	0x2fc2,
-	0x10fa8d,
-	0x149b45,
-	0x69347,
-	0x2ad8a,
-	0x13e30c,
-	0x13e4cf,
-	0x11f64f,
-	0x1547c2,
-	0x2202,
-	0xe9e08,
-	0x21cfbc4c,
-	0x1a8b0a,
-	0x22361b8a,
-	0xf10ca,
-	0x800ca,
-	0x88508,
-	0x26085,
-	0x6b5c8,
-	0xf1588,
-	0x1dd4c8,
-	0x146488,
-	0x23c2,
-	0x11f3cf,
-	0x142188d,
-	0x140e4d2,
-	0x1ccf8b,
-	0xc9a08,
-	0x38107,
-	0x4e48a,
-	0x12bccb,
-	0xa24c9,
-	0x4e387,
-	0x76706,
-	0x25f88,
-	0x3048c,
-	0x1d9d47,
-	0x1caca,
-	0x7908,
-	0x15f00e,
-	0x19028e,
-	0x3410b,
-	0x3e48b,
-	0x3ed0b,
-	0x41a09,
-	0x42e4b,
-	0x4334d,
-	0x44d4b,
-	0x4978d,
-	0x49b0d,
-	0x5250a,
-	0x4cd8b,
-	0x4d24b,
-	0x52185,
-	0x225c7490,
-	0x2c68f,
-	0x7a88f,
-	0x10ff4d,
-	0x57f50,
-	0x4c02,
-	0x22a2fd08,
-	0x1d9bc8,
-	0x80990,
-	0x12ae8e,
-	0x22f726c5,
-	0x5314b,
-	0x143110,
-	0x59bc5,
-	0xa380b,
-	0x1b178c,
-	0x6b6ca,
-	0x3e649,
-	0x6c448,
-	0x72547,
-	0x72887,
-	0x72a47,
-	0x73ac7,
-	0x75207,
-	0x75607,
-	0x77787,
-	0x77c87,
-	0x78187,
-	0x78507,
-	0x789c7,
-	0x78b87,
-	0x78d47,
-	0x78f07,
-	0x79287,
-	0x79747,
-	0x7b047,
-	0x7b507,
-	0x7c107,
-	0x7c407,
-	0x7c5c7,
-	0x7c8c7,
-	0x7d0c7,
-	0x7d2c7,
-	0x7dcc7,
-	0x7de87,
-	0x7e047,
-	0x7e447,
-	0x7ea87,
-	0x7f447,
-	0x7ff07,
-	0x80347,
-	0x81087,
-	0x81247,
-	0x81887,
-	0x81c07,
-	0x82607,
-	0x82a07,
-	0x82d47,
-	0x82f07,
-	0x83347,
-	0x83a47,
-	0x842c7,
-	0x846c7,
-	0x84887,
-	0x84d07,
-	0x85647,
-	0xf168a,
-	0x15b48,
-	0x1ba40c,
-	0x1416c7,
-	0x98385,
-	0x1e1a51,
-	0x14d146,
-	0x12428a,
-	0xe9c8a,
-	0x63646,
-	0x15cdcb,
-	0x642,
-	0x31391,
-	0x168c89,
-	0xd1e49,
-	0xa48c6,
-	0x17382,
-	0x6808a,
-	0xb78c9,
-	0xb800f,
-	0xb860e,
-	0xbac08,
-	0x23345ed2,
-	0x11608,
-	0x2366c647,
-	0xbdacf,
-	0x15fc2,
-	0x1de3c9,
-	0x1ca20a,
-	0x23a14609,
-	0xd4389,
-	0xd438c,
-	0x604b,
-	0x9670e,
-	0x1cdb8c,
-	0xfa94f,
-	0x1c02ce,
-	0x56a4c,
-	0x80789,
-	0x81d91,
-	0x8b988,
-	0x8c392,
-	0x8e20d,
-	0x9198d,
-	0x95d0b,
-	0x18a455,
-	0x1e0b49,
-	0x9a68a,
-	0x9ecc9,
-	0xa3d50,
-	0xae18b,
-	0xb0a0f,
-	0xc054b,
-	0xc0bcc,
-	0x19bb50,
-	0x17094a,
-	0x17a88d,
-	0x197cce,
-	0xc1eca,
-	0x12cd4c,
-	0xc9d14,
-	0xd1ad1,
-	0xd228b,
-	0xd338f,
-	0xd6fcd,
-	0xd7ace,
-	0xd8b8c,
-	0xda10c,
-	0x19b84b,
-	0x1ef70e,
-	0xddad0,
-	0xf218b,
-	0xf728d,
-	0x11290f,
-	0x1090cc,
-	0x10d60e,
-	0x115111,
-	0x1b124c,
-	0x14b107,
-	0x16430d,
-	0x16fd4c,
-	0x17a2d0,
-	0x19510d,
-	0x195f07,
-	0x199490,
-	0x1a9b08,
-	0xc144b,
-	0xc364f,
-	0x1ba688,
-	0x5450d,
-	0x117510,
-	0x17c789,
-	0x23fc7448,
-	0x242c7fc6,
-	0xc8bc3,
-	0x1aa949,
-	0xa5909,
-	0xcd6c5,
-	0x6982,
-	0x1289,
-	0x4e90a,
-	0x2468c846,
-	0x148c84d,
-	0x24b283d1,
-	0x24f04984,
-	0x1e7086,
-	0x2294a,
-	0x1ec4d,
-	0x252e098b,
-	0x1da1c8,
-	0x25460dc9,
-	0x1c943,
-	0x14880a,
-	0xeff11,
-	0xf0349,
-	0xf1047,
-	0xf1ec8,
-	0xf2447,
-	0x6c548,
-	0x70cb,
-	0x1379c9,
-	0xf91d0,
-	0xf968c,
-	0xf9b09,
-	0xf9d4c,
-	0x25afa14d,
-	0xfb588,
-	0xfba85,
-	0x88088,
-	0x19dc8a,
-	0x16ab87,
-	0x1f42,
-	0x25e21e95,
-	0x143e0a,
-	0x149989,
-	0xa5ac8,
-	0x11ef09,
-	0x86905,
-	0x128e4a,
-	0xfdcc7,
-	0x998cf,
-	0x16470b,
-	0x13ba0c,
-	0x28d52,
-	0x126a06,
-	0x14ff548,
-	0x86f45,
-	0x1282c8,
-	0x10154b,
-	0xe32d1,
-	0x100507,
-	0x557ca,
-	0x180f0c,
-	0x2630a105,
-	0x1ae7cc,
-	0x265104ce,
-	0x140943,
-	0x198e46,
-	0x413c2,
-	0x111e8b,
-	0x11370a,
-	0x15144cc,
-	0x1da0c8,
-	0x49948,
-	0x26aa5b46,
-	0x125f07,
-	0x1c58e,
-	0x146307,
-	0x10002,
-	0x4842,
-	0x5a590,
-	0x6aac7,
-	0x6abcf,
-	0x15d46,
-	0xaa4ce,
-	0xbc10b,
-	0x5a3c8,
-	0xa2889,
-	0x15252,
-	0x11cd8d,
-	0x11d908,
-	0x126c9,
-	0x6af4d,
-	0x6b909,
-	0x6cd4b,
-	0x70e88,
-	0x77f88,
-	0x79408,
-	0x7bc89,
-	0x7be8a,
-	0x7ca4c,
-	0x1bc0a,
-	0xe3007,
-	0xe824a,
-	0x11c347,
-	0x3980a,
-	0xf4788,
-	0x1d880d,
-	0xa1411,
-	0x26ed7dc6,
-	0x16cbcb,
-	0x1dafcc,
-	0x1be08,
-	0x1d7589,
-	0x16194d,
-	0x73d10,
-	0x6a28c,
-	0x1e1e4d,
-	0xfb60f,
-	0x5fc2,
-	0x9eecd,
-	0x2642,
-	0x41d82,
-	0x11c28a,
-	0x272948ca,
-	0x2a08a,
-	0x276849c8,
-	0x12418a,
-	0x12454b,
-	0x125507,
-	0x1ab54c,
-	0x19050c,
-	0x1277ca,
-	0x27927a4f,
-	0x127e0c,
-	0x128107,
-	0x12948e,
-	0x27df4305,
-	0x1a20c8,
-	0x3642,
-	0x141a6c3,
-	0x1afc660e,
-	0x1b7d428e,
-	0x1bf47e8a,
-	0x1c7c414e,
-	0x1cf4de0e,
-	0x1d75910c,
-	0x1549207,
-	0x1559d49,
-	0x143bfc3,
-	0x1dfbf54c,
-	0x1e604c09,
-	0x1ef00749,
-	0x1f7025c9,
-	0x4942,
-	0x1d6591,
-	0x1d41d1,
-	0x147dcd,
-	0x1c4091,
-	0x14dd51,
-	0x15904f,
-	0x1bf48f,
-	0x1d06cc,
-	0x10068c,
-	0x10250c,
-	0x106d8d,
-	0x191c55,
-	0x132f4c,
-	0x137f0c,
-	0x149c50,
-	0x15040c,
-	0x1bb70c,
-	0x1c6359,
-	0x1d25d9,
-	0x1eacd9,
-	0x4954,
-	0x7ad4,
-	0x9054,
-	0x9c54,
-	0xa1d4,
-	0x1fe07d89,
-	0x20409309,
-	0x20f37fc9,
-	0x1b28bb89,
-	0x4942,
-	0x1ba8bb89,
-	0x4942,
-	0x494a,
-	0x4942,
-	0x1c28bb89,
-	0x4942,
-	0x494a,
-	0x4942,
-	0x1ca8bb89,
-	0x4942,
-	0x1d28bb89,
-	0x4942,
-	0x1da8bb89,
-	0x4942,
-	0x494a,
-	0x4942,
-	0x1e28bb89,
-	0x4942,
-	0x494a,
-	0x4942,
-	0x1ea8bb89,
-	0x4942,
-	0x1f28bb89,
-	0x4942,
-	0x494a,
-	0x4942,
-	0x1fa8bb89,
-	0x4942,
-	0x494a,
-	0x4942,
-	0x2028bb89,
-	0x4942,
-	0x20a8bb89,
-	0x4942,
-	0x2128bb89,
-	0x4942,
-	0x494a,
-	0x4942,
-	0x1400401,
-	0x6f45,
-	0x1bd044,
-	0x1414fc3,
-	0x141da83,
-	0x1469683,
-	0x8e344,
-	0x137d08,
-	0x1c660e,
-	0x1d428e,
-	0x8b28e,
-	0x147e8a,
-	0x1c414e,
-	0x14de0e,
-	0x15910c,
-	0x1bf54c,
-	0x4c09,
-	0x100749,
-	0x1025c9,
-	0x7d89,
-	0x9309,
-	0x137fc9,
-	0x149d0d,
-	0x9f09,
-	0xa489,
-	0x175544,
-	0x182384,
-	0x192804,
-	0x1a2284,
-	0xb2144,
-	0x16b604,
-	0x1e8e04,
-	0x189f04,
-	0x15c44,
-	0x4ac44,
-	0xff009,
-	0xff00c,
-	0x157f86,
-	0x157f8e,
-	0x8e344,
-	0x1595903,
-	0x2b447,
-	0x148d88c,
-	0x15e42,
-	0x15c43,
-	0x4ac44,
-	0x4c02,
-	0x37507,
-	0xfbc48,
-	0x1ae288,
-	0x46084,
-	0x5746,
-	0x13a4c7,
-	0xe2c44,
-	0x127386,
-	0x19882,
-	0x8f81,
-	0x22504,
-	0x54986,
-	0x27303,
-	0x4c02,
-	0x15c43,
-	0x124403,
-	0x28b43,
-	0xe983,
-	0x1c80c3,
-	0x28d45,
-	0x7e5c2,
-	0x14eb82,
-	0x1a2bc8,
-	0xf3c87,
-	0x56603,
-	0x137a47,
-	0x23c2,
-	0xd9c89,
-	0x2000c2,
-	0x202202,
-	0x201482,
-	0x20fd02,
-	0x200382,
-	0x2003c2,
-	0x204842,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x20fc83,
-	0x2109c3,
-	0x21f143,
-	0x1b9688,
-	0x206643,
-	0x21f603,
-	0x211e43,
-	0x262784,
-	0x21f143,
-	0x206643,
-	0x21f603,
-	0x2109c3,
-	0x21f143,
-	0xbd03,
-	0x205503,
-	0x503c4,
-	0x2000c2,
-	0x250b03,
-	0x2a206643,
-	0x3a5f47,
-	0x205503,
-	0x20f743,
-	0x294744,
-	0x2109c3,
-	0x21f143,
-	0x3b6f0a,
-	0x212fc5,
-	0x219683,
-	0x23d942,
-	0x1b9688,
-	0x2a6e5b4a,
-	0xc01,
-	0x1b9688,
-	0x2202,
-	0x13e282,
-	0x2ae60b4b,
-	0x2b20f444,
-	0x1048c5,
-	0x1402c45,
-	0xfbc46,
-	0x2b602c45,
-	0x5fb83,
-	0xb2683,
-	0x1a31c4,
-	0x1d45c3,
-	0x1a2345,
-	0x14cb05,
-	0x1b9688,
-	0x1f4c7,
-	0x6643,
-	0x2e70d,
-	0x2be3c647,
-	0xcc6,
-	0x2c14dc45,
-	0x1cb4d2,
-	0xd87,
-	0x26e4a,
-	0x24dc8,
-	0x26d47,
-	0xfe08a,
-	0x1b42c8,
-	0x74a47,
-	0x15c18f,
-	0x4ed47,
-	0x71b06,
-	0x143110,
-	0x1486a46,
-	0x124c8f,
-	0xee89,
-	0x1e7104,
-	0x2c400e4e,
-	0x2ca0d84c,
-	0x37849,
-	0x79046,
-	0x6bb89,
-	0x116a86,
-	0x173cc6,
-	0xbc98c,
-	0x12beca,
-	0xa2647,
-	0x11400a,
-	0x146cc9,
-	0x10368c,
-	0x2410a,
-	0x4deca,
-	0x1a2389,
-	0x1e7086,
-	0xa270a,
-	0x1aae8a,
-	0xad3ca,
-	0x1f06c9,
-	0xef888,
-	0xefb86,
-	0xf4bcd,
-	0xf9d4c,
-	0x55f8b,
-	0xdd585,
-	0x2d322c8c,
-	0x144207,
-	0x1f0189,
-	0xda4c7,
-	0xba714,
-	0x117a8b,
-	0xc984a,
-	0x150ca,
-	0xb500d,
-	0x1524749,
-	0x11cb4c,
-	0x11d70b,
-	0x164c57,
-	0x165ed5,
-	0x7903,
-	0x7903,
-	0x34106,
-	0x7903,
-	0x2ce04b02,
-	0x28d45,
-	0xfbc48,
-	0x15b243,
-	0x49f04,
-	0x17804,
-	0x1780c,
-	0x60483,
-	0x14ad487,
-	0x1702cd,
-	0x15205,
-	0x142a2c3,
-	0x142a2c8,
-	0x5c4c9,
-	0xdfa89,
-	0x28d45,
-	0x10154b,
-	0xd254b,
-	0x1509343,
-	0x1509348,
-	0x1106,
-	0x14526c7,
-	0xa3fc7,
-	0x2e172bc9,
-	0x10886,
-	0x50b03,
-	0x1b9688,
-	0x2202,
-	0x554c4,
-	0xf9d4c,
-	0xff43,
-	0x13d845,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x2028c3,
-	0x206643,
-	0x21f603,
-	0x3d6403,
-	0x205503,
-	0x206543,
-	0x2109c3,
-	0x21f143,
-	0x2d2003,
-	0x200f83,
-	0x2028c3,
-	0x214f04,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x2330c3,
-	0x214f83,
-	0x23d942,
-	0x2f972cc5,
-	0x142d603,
-	0x206643,
-	0x21f603,
-	0x20fd03,
-	0x3d6403,
-	0x205503,
-	0x2503c4,
-	0x33c683,
-	0x23ddc3,
-	0x206543,
-	0x2109c3,
-	0x21f143,
-	0x219683,
-	0x30623443,
-	0x28c49,
-	0x2202,
-	0x23e1c3,
-	0x31206643,
-	0x21f603,
-	0x24ec43,
-	0x205503,
-	0x21a403,
-	0x23ddc3,
-	0x21f143,
-	0x202b03,
-	0x3eda84,
-	0x1b9688,
-	0x31a06643,
-	0x21f603,
-	0x2bacc3,
-	0x205503,
-	0x206543,
-	0x294744,
-	0x2109c3,
-	0x21f143,
-	0x2210c3,
-	0x1b9688,
-	0x32206643,
-	0x21f603,
-	0x3d6403,
-	0xf9d4c,
-	0x21d783,
-	0x21f143,
-	0x1b9688,
-	0x1549207,
-	0x250b03,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2503c4,
-	0x294744,
-	0x2109c3,
-	0x21f143,
-	0x14cb05,
-	0xf08c7,
-	0xba94b,
-	0x332328c6,
-	0xf0744,
-	0xdd585,
-	0x147e248,
-	0x206cd,
-	0x1c7448,
-	0x33a47285,
-	0x1ecc4,
-	0x2202,
-	0x1c36c3,
-	0x157e85,
-	0x232c2,
-	0x34db45,
-	0x1b9688,
-	0x353ddb4d,
-	0x357d520a,
-	0x7902,
-	0x21483,
-	0xf9d4c,
-	0x16be4f,
-	0xfd02,
-	0x8e344,
-	0x4ac44,
-	0x2202,
-	0x2000c2,
-	0x250b03,
-	0x206643,
-	0x205503,
-	0x2503c4,
-	0x206543,
-	0x294744,
-	0x2109c3,
-	0x21f143,
-	0x219683,
-	0x206643,
-	0x21f603,
-	0x2109c3,
-	0x21f143,
-	0x1c1f05,
-	0x3365c8,
-	0x214f04,
-	0x3c2986,
-	0x3d0586,
-	0x1b9688,
-	0x30f143,
-	0x3be309,
-	0x2b9815,
-	0xb981f,
-	0x206643,
-	0x95a87,
-	0x219d52,
-	0x187746,
-	0x189285,
-	0x6b6ca,
-	0x3e649,
-	0x219b0f,
-	0xefd47,
-	0x2e4084,
-	0x2243c5,
-	0x319410,
-	0x2577c7,
-	0xf9d4c,
-	0x21d783,
-	0x23d5c8,
-	0x4aac6,
-	0x28cc4a,
-	0x229804,
-	0x309b43,
-	0x23d942,
-	0x30468b,
-	0x1b9443,
-	0x21f603,
-	0x205503,
-	0x18fc84,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x206543,
-	0x2109c3,
-	0x21f143,
-	0x30e543,
-	0x202202,
-	0x31983,
-	0x58cc4,
-	0x2109c3,
-	0x21f143,
-	0x3783bf05,
-	0x1d7346,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x206543,
-	0x21f143,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x20f743,
-	0x21ddc3,
-	0x21f143,
-	0x50b03,
-	0x202202,
-	0x206643,
-	0x21f603,
-	0x2109c3,
-	0x21f143,
-	0x31e02,
-	0x2000c2,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x2c45,
-	0x74fc9,
-	0x141eccb,
-	0x15c43,
-	0x214f04,
-	0x206643,
-	0x21f603,
-	0x228f84,
-	0x2109c3,
-	0x21f143,
-	0x1b9688,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x132d09,
-	0x6204,
-	0x206643,
-	0x23c2,
-	0x21f603,
-	0x3d6403,
-	0x2036c3,
-	0x206543,
-	0x2109c3,
-	0x21f143,
-	0xc642,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x36b204,
-	0x2503c4,
-	0x2109c3,
-	0x21f143,
-	0x200f83,
-	0x8a42,
-	0x202202,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x15d3c3,
-	0x1b9688,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x325e03,
-	0x52383,
-	0xf743,
-	0x2109c3,
-	0x21f143,
-	0x2f7c6,
-	0x32e0ca,
-	0x34b2c9,
-	0x36408b,
-	0x3649ca,
-	0x36fb0a,
-	0x38028b,
-	0x394bca,
-	0x39b18a,
-	0x3a1a4a,
-	0x3a1ccb,
-	0x3c7d89,
-	0x3dfe4a,
-	0x3e02cb,
-	0x3ebacb,
-	0x3f274a,
-	0x2dc2,
-	0x206643,
-	0x21f603,
-	0x3d6403,
-	0x206543,
-	0x2109c3,
-	0x21f143,
-	0x2c4b,
-	0x12ae87,
-	0x6c9c8,
-	0x6b084,
-	0x1e8684,
-	0x9b108,
-	0xf2f86,
-	0x7206,
-	0x3bb07,
-	0x128c07,
-	0xf8589,
-	0x1b9688,
-	0x206643,
-	0x3e644,
-	0x272544,
-	0x20b082,
-	0x294744,
-	0x231c05,
-	0x2028c3,
-	0x214f04,
-	0x206643,
-	0x2392c4,
-	0x21f603,
-	0x2554c4,
-	0x2e4084,
-	0x2503c4,
-	0x23ddc3,
-	0x2109c3,
-	0x21f143,
-	0x29f085,
-	0x2330c3,
-	0x219683,
-	0x27b403,
-	0x21bc04,
-	0x3de844,
-	0x23c0c5,
-	0x1b9688,
-	0x3c3704,
-	0x3c1386,
-	0x3a24c4,
-	0x202202,
-	0x229147,
-	0x24c707,
-	0x251604,
-	0x2f3d05,
-	0x395305,
-	0x22f2c5,
-	0x2503c4,
-	0x268e48,
-	0x238646,
-	0x365588,
-	0x276445,
-	0x2dfa85,
-	0x273e04,
-	0x21f143,
-	0x30a904,
-	0x37f286,
-	0x2130c3,
-	0x21bc04,
-	0x275485,
-	0x24e884,
-	0x2adec4,
-	0x23d942,
-	0x257106,
-	0x3b5b46,
-	0x31a605,
-	0x2000c2,
-	0x250b03,
-	0xf3106,
-	0x3ce02202,
-	0x21ce04,
-	0x191384,
-	0x65685,
-	0x200382,
-	0x206543,
-	0x3d2070c2,
-	0x2109c3,
-	0x2003c2,
-	0x3070c6,
-	0x213dc3,
-	0x1e7005,
-	0x200f83,
-	0x1b9688,
-	0x14f8cc3,
-	0x1b9688,
-	0x205503,
-	0xf9d4c,
-	0x2000c2,
-	0x3de02202,
-	0x205503,
-	0x278cc3,
-	0x33c683,
-	0x20f444,
-	0x2109c3,
-	0x21f143,
-	0x1b9688,
-	0x126947,
-	0x5860a,
-	0x2000c2,
-	0x3e602202,
-	0x206643,
-	0x2109c3,
-	0x21f143,
-	0x682,
-	0x209a02,
-	0x224b42,
-	0x20f743,
-	0x303643,
-	0x2000c2,
-	0x14cb05,
-	0x1b9688,
-	0xf08c7,
-	0x202202,
-	0x21f603,
-	0x2554c4,
-	0x203b43,
-	0x205503,
-	0x2036c3,
-	0x206543,
-	0x2109c3,
-	0x20f4c3,
-	0x21f143,
-	0x213003,
-	0xcbd48,
-	0xf83,
-	0x145213,
-	0x148d14,
-	0x14cb05,
-	0xf08c7,
-	0x26e49,
-	0x11b646,
-	0x11090b,
-	0x34106,
-	0x61d47,
-	0x1dbac6,
-	0x649,
-	0x18540a,
-	0x97bcd,
-	0xdc10c,
-	0x11e34a,
-	0xa8ac8,
-	0x1cf805,
-	0x26e88,
-	0x15d46,
-	0x1d1b86,
-	0x54146,
-	0x204c02,
-	0x26c4,
-	0x170cc6,
-	0x14e160e,
-	0x1d5186,
-	0x7440c,
-	0x3fb72a4b,
-	0x14cb05,
-	0x14fb4b,
-	0x3fe8c007,
-	0x4028c00a,
-	0x407d1ac4,
-	0x50c9,
-	0x9548,
-	0x1bd207,
-	0x25791,
-	0x13064a,
-	0x206643,
-	0x40a8d788,
-	0xfe005,
-	0x1896c8,
-	0x1b7344,
-	0x4eb05,
-	0xaeb47,
-	0x1a9d0b,
-	0x40e1f109,
-	0x115c5,
-	0x1702c6,
-	0x163486,
-	0x9d28a,
-	0x10320c,
-	0x1c1303,
-	0x1e8684,
-	0x413ed484,
-	0x5c4c9,
-	0x100ec7,
-	0x588ca,
-	0x14e5a49,
-	0x605,
-	0x10f303,
-	0x41637047,
-	0x1f45,
-	0x156ca86,
-	0x140c406,
-	0x15ce8c,
-	0x10c348,
-	0x41930845,
-	0x41c413c3,
-	0x110fc4,
-	0x6948b,
-	0x121e0b,
-	0x4224f04c,
-	0x1426143,
-	0xcef48,
-	0xd254b,
-	0xaea09,
-	0xd9143,
-	0x124848,
-	0x1422886,
-	0x95607,
-	0x42761949,
-	0x30147,
-	0x43aeba48,
-	0xa19c4,
-	0x1178c7,
-	0xe040a,
-	0x43f65188,
-	0x11d3cd,
-	0x1c6e09,
-	0x1d7808,
-	0x15c43,
-	0x14493c9,
-	0x4ac44,
-	0x97c5,
-	0x3c583,
-	0x34106,
-	0x3042,
-	0x15c44,
-	0x2a385,
-	0x1aa884,
-	0x142db83,
-	0x1a6c7,
-	0x42a1a6c3,
-	0x42fcc386,
-	0x4323ab84,
-	0x436fddc7,
-	0xfbc44,
-	0x125f07,
-	0xfbc44,
-	0x125f07,
-	0xfbc44,
-	0xfbc44,
-	0x125f07,
-	0x1dee09,
-	0x41,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x206543,
-	0x2109c3,
-	0x21f143,
-	0x2000c2,
-	0x202202,
-	0x205503,
-	0x203042,
-	0x2109c3,
-	0x21f143,
-	0x213dc3,
-	0x387fcf,
-	0x38838e,
-	0x1b9688,
-	0x206643,
-	0x49087,
-	0x21f603,
-	0x205503,
-	0x211e43,
-	0x2109c3,
-	0x21f143,
-	0x1d50c4,
-	0x1d4704,
-	0xa04,
-	0x220fc3,
-	0x3d5787,
-	0x204782,
-	0x279f49,
-	0x200b02,
-	0x25a88b,
-	0x2ed3ca,
-	0x3289c9,
-	0x200542,
-	0x3b7706,
-	0x25fd95,
-	0x25a9d5,
-	0x264ed3,
-	0x25af53,
-	0x216302,
-	0x228645,
-	0x3c340c,
-	0x2815cb,
-	0x259e85,
-	0x206182,
-	0x2f45c2,
-	0x2f45c6,
-	0x2028c2,
-	0x296186,
-	0x23e88d,
-	0x258d4c,
-	0x3c7504,
-	0x200882,
-	0x210342,
-	0x28c748,
-	0x200202,
-	0x33d3c6,
-	0x39d54f,
-	0x3d47d0,
-	0x2fd044,
-	0x25ff55,
-	0x265053,
-	0x214ec3,
-	0x35898a,
-	0x38b6c7,
-	0x392409,
-	0x313d87,
-	0x269782,
-	0x200282,
-	0x3caa06,
-	0x206202,
-	0x1b9688,
-	0x20b4c2,
-	0x20b782,
-	0x20b787,
-	0x3aeb47,
-	0x3aeb51,
-	0x21d0c5,
-	0x21d0ce,
-	0x21e8cf,
-	0x202fc2,
-	0x221007,
-	0x2211c8,
-	0x201002,
-	0x220302,
-	0x210506,
-	0x21050f,
-	0x2711d0,
-	0x22d3c2,
-	0x201782,
-	0x229288,
-	0x201783,
-	0x26d748,
-	0x23decd,
-	0x202f03,
-	0x3d03c8,
-	0x26220f,
-	0x2625ce,
-	0x214d8a,
-	0x2eebd1,
-	0x2ef050,
-	0x2deecd,
-	0x2df20c,
-	0x381dc7,
-	0x358b07,
-	0x3c2a49,
-	0x2170c2,
-	0x2011c2,
-	0x263b4c,
-	0x263e4b,
-	0x203402,
-	0x39ba06,
-	0x204102,
-	0x200482,
-	0x3547c2,
-	0x202202,
-	0x22ec04,
-	0x23bcc7,
-	0x22dd42,
-	0x243c87,
-	0x246f47,
-	0x242982,
-	0x211802,
-	0x248f45,
-	0x254302,
-	0x2b52ce,
-	0x37b2cd,
-	0x21f603,
-	0x2936ce,
-	0x2d49cd,
-	0x374243,
-	0x202482,
-	0x240dc4,
-	0x213242,
-	0x202442,
-	0x3aa7c5,
-	0x24c1c7,
-	0x24dc42,
-	0x20fd02,
-	0x253c47,
-	0x25c848,
-	0x2596c2,
-	0x286fc6,
-	0x2639cc,
-	0x263d0b,
-	0x208102,
-	0x26db4f,
-	0x26df10,
-	0x26e30f,
-	0x26e6d5,
-	0x26ec14,
-	0x26f10e,
-	0x26f48e,
-	0x26f80f,
-	0x26fbce,
-	0x26ff54,
-	0x270453,
-	0x27090d,
-	0x285809,
-	0x299443,
-	0x2030c2,
-	0x360605,
-	0x2045c6,
-	0x200382,
-	0x2f7c47,
-	0x205503,
-	0x200642,
-	0x233488,
-	0x2eee11,
-	0x2ef250,
-	0x206a42,
-	0x298707,
-	0x202b82,
-	0x259887,
-	0x206982,
-	0x33a609,
-	0x2f4587,
-	0x298bc8,
-	0x3cf606,
-	0x303543,
-	0x398805,
-	0x22a2c2,
-	0x2004c2,
-	0x3c5ec5,
-	0x3dbd85,
-	0x201282,
-	0x211f83,
-	0x2a1a47,
-	0x3d1e07,
-	0x201702,
-	0x3878c4,
-	0x207783,
-	0x3ee109,
-	0x207788,
-	0x206b42,
-	0x209582,
-	0x22bb07,
-	0x3b6b85,
-	0x258488,
-	0x228347,
-	0x225ac3,
-	0x370b46,
-	0x2ded4d,
-	0x2df0cc,
-	0x3898c6,
-	0x202f82,
-	0x202042,
-	0x200ec2,
-	0x26208f,
-	0x26248e,
-	0x395387,
-	0x201e42,
-	0x23d4c5,
-	0x23d4c6,
-	0x21cf02,
-	0x201502,
-	0x29a0c6,
-	0x245e43,
-	0x3c2346,
-	0x2e2c05,
-	0x2e2c0d,
-	0x2e3915,
-	0x2e4f0c,
-	0x2e528d,
-	0x2e55d2,
-	0x203c42,
-	0x27d202,
-	0xf9d4c,
-	0x201b82,
-	0x300486,
-	0x3cda46,
-	0x45295a04,
-	0x201f42,
-	0x204646,
-	0x203902,
-	0x25f385,
-	0x206282,
-	0x2b5409,
-	0x21634c,
-	0x21668b,
-	0x2003c2,
-	0x25df08,
-	0x205842,
-	0x206702,
-	0x281386,
-	0x28bb05,
-	0x38cf47,
-	0x33e845,
-	0x2746c5,
-	0x207202,
-	0x226f42,
-	0x2020c2,
-	0x2b0d07,
-	0x30718d,
-	0x30750c,
-	0x237447,
-	0x286f42,
-	0x201402,
-	0x3cac08,
-	0x201408,
-	0x2ec9c8,
-	0x3ba644,
-	0x3ef987,
-	0x304a03,
-	0x271c02,
-	0x20bc02,
-	0x307f09,
-	0x35f407,
-	0x202b02,
-	0x2819c5,
-	0x21a002,
-	0x21ec82,
-	0x30dd03,
-	0x30dd06,
-	0x30e242,
-	0x310e82,
-	0x200402,
-	0x204746,
-	0x240d07,
-	0x201182,
-	0x200902,
-	0x26d58f,
-	0x29350d,
-	0x296b0e,
-	0x2d484c,
-	0x208ac2,
-	0x202b42,
-	0x3cf445,
-	0x32c506,
-	0x225402,
-	0x203f42,
-	0x200682,
-	0x2282c4,
-	0x2dfa04,
-	0x35ccc6,
-	0x204842,
-	0x293fc7,
-	0x204843,
-	0x23b6c8,
-	0x23da88,
-	0x2478c7,
-	0x2611c6,
-	0x201a42,
-	0x204083,
-	0x204087,
-	0x282486,
-	0x2d9985,
-	0x283508,
-	0x203c82,
-	0x2f5fc7,
-	0x20e7c2,
-	0x2b26c2,
-	0x202e82,
-	0x21ea49,
-	0x20de02,
-	0x18b88,
-	0x2019c2,
-	0x25b383,
-	0x3501c7,
-	0x202a42,
-	0x2164cc,
-	0x2167cb,
-	0x389946,
-	0x312485,
-	0x45606b83,
-	0x201b42,
-	0x20c642,
-	0x2d0a06,
-	0x2274c3,
-	0x36af87,
-	0x26bac2,
-	0x2008c2,
-	0x25fc15,
-	0x25ab95,
-	0x264d93,
-	0x25b0d3,
-	0x284447,
-	0x2a7e51,
-	0x2c2290,
-	0x396092,
-	0x2c16d1,
-	0x2c4d48,
-	0x2c4d50,
-	0x2c810f,
-	0x2ed193,
-	0x3a8152,
-	0x2e2810,
-	0x2e71cf,
-	0x2e9fd2,
-	0x2eab51,
-	0x2ed913,
-	0x2ee252,
-	0x2f1b0f,
-	0x2f2c0e,
-	0x2f53d2,
-	0x3287d1,
-	0x3063cf,
-	0x30bb4e,
-	0x30c511,
-	0x30e5d0,
-	0x311a12,
-	0x3132d1,
-	0x330f10,
-	0x33db4f,
-	0x37eb11,
-	0x3e2250,
-	0x32a506,
-	0x3385c7,
-	0x20cf47,
-	0x204042,
-	0x290285,
-	0x319187,
-	0x224b42,
-	0x202d02,
-	0x294ac5,
-	0x223683,
-	0x3de2c6,
-	0x30734d,
-	0x30768c,
-	0x2046c2,
-	0x3c328b,
-	0x28148a,
-	0x283b8a,
-	0x230b09,
-	0x2cfa4b,
-	0x305ecd,
-	0x22848c,
-	0x31988a,
-	0x2464cc,
-	0x249fcb,
-	0x259ccc,
-	0x27f5ce,
-	0x284e8b,
-	0x2a45cc,
-	0x2cad83,
-	0x325e86,
-	0x365182,
-	0x221902,
-	0x25eb03,
-	0x201102,
-	0x22fd43,
-	0x3c26c6,
-	0x26e887,
-	0x2d69c6,
-	0x3a8cc8,
-	0x201108,
-	0x214786,
-	0x20c302,
-	0x319fcd,
-	0x31a30c,
-	0x321fc7,
-	0x31e187,
-	0x211202,
-	0x219882,
-	0x204002,
-	0x28b082,
-	0x33d2d6,
-	0x342515,
-	0x344cd6,
-	0x34a393,
-	0x34aa52,
-	0x35add3,
-	0x35b452,
-	0x3b480f,
-	0x3c8b58,
-	0x3ca497,
-	0x3cde99,
-	0x3cfb18,
-	0x3d09d8,
-	0x3d1557,
-	0x3d2c17,
-	0x3d6d16,
-	0x3e2e93,
-	0x3e3595,
-	0x3e3fd2,
-	0x3e4453,
-	0x17082,
-	0x45a2c284,
-	0x45fc7448,
-	0x2c45,
-	0x202202,
-	0x2109c3,
-	0x232c2,
-	0x21f143,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x206543,
-	0x294744,
-	0x2109c3,
-	0x21f143,
-	0x213dc3,
-	0x2000c2,
-	0x213c82,
-	0x46e9f385,
-	0x4724bf85,
-	0x47669d46,
-	0x1b9688,
-	0x47ac8685,
-	0x202202,
-	0x201482,
-	0x47f69f05,
-	0x4828e105,
-	0x4868ef07,
-	0x48a760c9,
-	0x48fb60c4,
-	0x200382,
-	0x200642,
-	0x4925b745,
-	0x4969ea09,
-	0x49a11008,
-	0x49ec0a45,
-	0x4a355507,
-	0x4a613388,
-	0x4ab1db05,
-	0x4aea6d46,
-	0x4b24c849,
-	0x4b79ecc8,
-	0x4bad8288,
-	0x4bea778a,
-	0x4c3cc904,
-	0x4c6b5cc5,
-	0x4cab3ac8,
-	0x4ce4e685,
-	0x217702,
-	0x4d201e43,
-	0x4d6b41c6,
-	0x4da48908,
-	0x4dfc98c6,
-	0x4e214888,
-	0x4e7d3446,
-	0x4ea52d84,
-	0x4ee018c2,
-	0x4f6e9907,
-	0x4fabbb04,
-	0x4fe88f07,
-	0x503ea507,
-	0x2003c2,
-	0x506abc05,
-	0x50a57504,
-	0x50f70607,
-	0x5123ae07,
-	0x51692306,
-	0x51a8eb05,
-	0x51ea5f87,
-	0x522c8d48,
-	0x5260c647,
-	0x52abdf89,
-	0x52ee3e85,
-	0x53314f87,
-	0x5369e706,
-	0x1eccb,
-	0x53bdcc08,
-	0x231e4d,
-	0x269809,
-	0x28308b,
-	0x29c10b,
-	0x2b7acb,
-	0x3e550b,
-	0x32c70b,
-	0x32c9cb,
-	0x32d349,
-	0x32e34b,
-	0x32e60b,
-	0x32eb8b,
-	0x32f84a,
-	0x32fd8a,
-	0x33038c,
-	0x33434b,
-	0x334d8a,
-	0x3485ca,
-	0x350e8e,
-	0x351f8e,
-	0x35230a,
-	0x354d8a,
-	0x355b0b,
-	0x355dcb,
-	0x3568cb,
-	0x37680b,
-	0x376e0a,
-	0x377acb,
-	0x377d8a,
-	0x37800a,
-	0x37828a,
-	0x395c8b,
-	0x39ca0b,
-	0x39f5ce,
-	0x39f94b,
-	0x3a69cb,
-	0x3a7ccb,
-	0x3abc8a,
-	0x3abf09,
-	0x3ac14a,
-	0x3ada4a,
-	0x3c854b,
-	0x3e058b,
-	0x3e140a,
-	0x3e28cb,
-	0x3e898b,
-	0x3f218b,
-	0x53e90e08,
-	0x54297009,
-	0x546ae889,
-	0x54af64c8,
-	0x35ca45,
-	0x20ba83,
-	0x256884,
-	0x382285,
-	0x3b5e06,
-	0x3537c5,
-	0x295fc4,
-	0x2f7b48,
-	0x326d45,
-	0x2a0a44,
-	0x3cb1c7,
-	0x2ada4a,
-	0x2ffa8a,
-	0x395487,
-	0x241d07,
-	0x2f2007,
-	0x25b8c7,
-	0x3a2fc5,
-	0x275b46,
-	0x2d6e47,
-	0x24cfc4,
-	0x2cd406,
-	0x2fb206,
-	0x3afbc5,
-	0x381a84,
-	0x2ab846,
-	0x2acc87,
-	0x2269c6,
-	0x30ef07,
-	0x2a0303,
-	0x3c4ac6,
-	0x2294c5,
-	0x28f007,
-	0x27990a,
-	0x233584,
-	0x20ec08,
-	0x2bd889,
-	0x2c5787,
-	0x334c06,
-	0x2e9a48,
-	0x3ea889,
-	0x23a704,
-	0x280544,
-	0x213785,
-	0x2d1508,
-	0x2e1247,
-	0x311049,
-	0x2455c8,
-	0x3218c6,
-	0x245946,
-	0x2a84c8,
-	0x375946,
-	0x24bf85,
-	0x2923c6,
-	0x289348,
-	0x25cd86,
-	0x262e0b,
-	0x29b606,
-	0x2aa20d,
-	0x20ab45,
-	0x2bb9c6,
-	0x20e0c5,
-	0x235249,
-	0x371cc7,
-	0x210e08,
-	0x2be906,
-	0x2a9449,
-	0x356e06,
-	0x279885,
-	0x213686,
-	0x2f3f06,
-	0x2e62c9,
-	0x2cde86,
-	0x2c7d47,
-	0x2b1705,
-	0x211d03,
-	0x262f85,
-	0x3c2447,
-	0x375406,
-	0x20aa49,
-	0x269d46,
-	0x2805c6,
-	0x244289,
-	0x291dc9,
-	0x2b1c87,
-	0x366448,
-	0x29d849,
-	0x28ff08,
-	0x2de7c6,
-	0x2ef645,
-	0x287e0a,
-	0x280646,
-	0x3542c6,
-	0x2e9845,
-	0x25bd48,
-	0x351507,
-	0x23118a,
-	0x255cc6,
-	0x24a205,
-	0x311746,
-	0x29fa47,
-	0x334ac7,
-	0x31fb85,
-	0x279a45,
-	0x271046,
-	0x275786,
-	0x27d446,
-	0x2390c4,
-	0x291209,
-	0x2984c6,
-	0x3e58ca,
-	0x21ac08,
-	0x314c88,
-	0x2ffa8a,
-	0x248ac5,
-	0x2acbc5,
-	0x3cc688,
-	0x384008,
-	0x241147,
-	0x39b706,
-	0x340488,
-	0x3f3247,
-	0x28f148,
-	0x2cd306,
-	0x292bc8,
-	0x2a53c6,
-	0x2765c7,
-	0x35f646,
-	0x2ab846,
-	0x22780a,
-	0x22ec86,
-	0x2ef649,
-	0x2fef06,
-	0x20b8ca,
-	0x252d89,
-	0x307b46,
-	0x2ce7c4,
-	0x3606cd,
-	0x28df47,
-	0x3c5386,
-	0x2d8145,
-	0x356e85,
-	0x33ca86,
-	0x2c6a49,
-	0x2da647,
-	0x28a106,
-	0x37ce46,
-	0x278789,
-	0x24bec4,
-	0x307c44,
-	0x3bdc48,
-	0x35fac6,
-	0x2b1188,
-	0x2f6dc8,
-	0x261787,
-	0x2fd349,
-	0x3ce787,
-	0x2c854a,
-	0x3089cf,
-	0x3a4d8a,
-	0x3cf245,
-	0x289585,
-	0x20cb45,
-	0x2fcf87,
-	0x28ae83,
-	0x366648,
-	0x273146,
-	0x273249,
-	0x358446,
-	0x3667c7,
-	0x2a9209,
-	0x210d08,
-	0x235407,
-	0x32b3c3,
-	0x35cac5,
-	0x29f585,
-	0x238f0b,
-	0x24e744,
-	0x315904,
-	0x2875c6,
-	0x32b587,
-	0x39834a,
-	0x280ec7,
-	0x3467c7,
-	0x28e105,
-	0x3d7285,
-	0x28f689,
-	0x2ab846,
-	0x280d4d,
-	0x3ca045,
-	0x2cb083,
-	0x21da03,
-	0x22ff05,
-	0x340d85,
-	0x2e9a48,
-	0x28a8c7,
-	0x2405c6,
-	0x2ae506,
-	0x22bcc5,
-	0x236347,
-	0x36a2c7,
-	0x238507,
-	0x2b5d4a,
-	0x3c4b88,
-	0x2390c4,
-	0x2907c7,
-	0x28d2c7,
-	0x362686,
-	0x2a4a47,
-	0x31ad08,
-	0x3855c8,
-	0x27ed46,
-	0x241f48,
-	0x2cdf04,
-	0x2d6e46,
-	0x267486,
-	0x2fb406,
-	0x335986,
-	0x211cc4,
-	0x25b986,
-	0x2d6b46,
-	0x2a7c86,
-	0x227806,
-	0x3cbf86,
-	0x2fc686,
-	0x2404c8,
-	0x2c92c8,
-	0x2ec248,
-	0x3539c8,
-	0x3cc606,
-	0x2034c5,
-	0x294106,
-	0x2c0ac5,
-	0x39a847,
-	0x245685,
-	0x2114c3,
-	0x3202c5,
-	0x3ec504,
-	0x3cc0c5,
-	0x2200c3,
-	0x3525c7,
-	0x2dd908,
-	0x30efc6,
-	0x2bf20d,
-	0x289546,
-	0x2a7205,
-	0x21ea43,
-	0x2d2c89,
-	0x24c046,
-	0x2a6546,
-	0x2afb84,
-	0x3a4d07,
-	0x347b06,
-	0x2da905,
-	0x261643,
-	0x212584,
-	0x28d486,
-	0x3aae04,
-	0x3bd448,
-	0x208249,
-	0x27f209,
-	0x2b0f8a,
-	0x2b2e8d,
-	0x233907,
-	0x394fc6,
-	0x226f84,
-	0x2760c9,
-	0x295048,
-	0x296e86,
-	0x23cb06,
-	0x2a4a47,
-	0x370046,
-	0x21d946,
-	0x2fe906,
-	0x3ea58a,
-	0x213388,
-	0x22a685,
-	0x2fe709,
-	0x3d92ca,
-	0x318ec8,
-	0x2ac448,
-	0x2a64c8,
-	0x3721cc,
-	0x32cc45,
-	0x2ae788,
-	0x2ce3c6,
-	0x36f986,
-	0x2dec07,
-	0x280dc5,
-	0x25ccc5,
-	0x27f0c9,
-	0x2053c7,
-	0x273205,
-	0x21fd07,
-	0x21da03,
-	0x2e1c05,
-	0x21b548,
-	0x263307,
-	0x2ac309,
-	0x2cf105,
-	0x2fb984,
-	0x329e48,
-	0x3d8147,
-	0x2355c8,
-	0x20e208,
-	0x397bc5,
-	0x273046,
-	0x213a46,
-	0x3af7c9,
-	0x267587,
-	0x2c0f06,
-	0x3bf107,
-	0x203b83,
-	0x3b60c4,
-	0x3cd5c5,
-	0x236484,
-	0x24dc84,
-	0x24bc07,
-	0x278647,
-	0x28a2c4,
-	0x2ac150,
-	0x346947,
-	0x3d7285,
-	0x3dc28c,
-	0x20dfc4,
-	0x2bedc8,
-	0x2764c9,
-	0x2c4ac6,
-	0x324988,
-	0x256584,
-	0x2878c8,
-	0x2e0c46,
-	0x227688,
-	0x2ad246,
-	0x2e93cb,
-	0x3b9a85,
-	0x3cd448,
-	0x208684,
-	0x20868a,
-	0x2ac309,
-	0x290f86,
-	0x3011c8,
-	0x295c05,
-	0x3c27c4,
-	0x2becc6,
-	0x2383c8,
-	0x290e08,
-	0x339746,
-	0x34d704,
-	0x287d86,
-	0x3ce807,
-	0x288e07,
-	0x2a4a4f,
-	0x34ce47,
-	0x3991c7,
-	0x36f845,
-	0x3da505,
-	0x2b1949,
-	0x2e8bc6,
-	0x28ec45,
-	0x2920c7,
-	0x2e6dc8,
-	0x238d45,
-	0x35f646,
-	0x21aa48,
-	0x3c98ca,
-	0x24d6c8,
-	0x299e47,
-	0x308e06,
-	0x2fe6c6,
-	0x2003c3,
-	0x213b83,
-	0x3d9489,
-	0x29d6c9,
-	0x2bde86,
-	0x2cf105,
-	0x23d0c8,
-	0x3011c8,
-	0x2ab488,
-	0x2fe98b,
-	0x2bf447,
-	0x325149,
-	0x2a4cc8,
-	0x2738c4,
-	0x205bc8,
-	0x29c409,
-	0x2c1205,
-	0x20be07,
-	0x3b6145,
-	0x290d08,
-	0x29f20b,
-	0x2a5cd0,
-	0x2bb605,
-	0x21590c,
-	0x240785,
-	0x28e183,
-	0x2d0606,
-	0x2d6144,
-	0x27fd86,
-	0x2acc87,
-	0x20df44,
-	0x2d27c8,
-	0x36650d,
-	0x39b545,
-	0x233944,
-	0x360444,
-	0x398f09,
-	0x2a9f48,
-	0x337047,
-	0x2e0cc8,
-	0x2912c8,
-	0x28a405,
-	0x3d97c7,
-	0x28a387,
-	0x3be0c7,
-	0x279a49,
-	0x373a09,
-	0x24acc6,
-	0x2df406,
-	0x292186,
-	0x32dac5,
-	0x3c4904,
-	0x3d0f86,
-	0x3d3186,
-	0x28a448,
-	0x29f70b,
-	0x23a547,
-	0x226f84,
-	0x347a46,
-	0x3ee387,
-	0x2fc285,
-	0x258805,
-	0x2266c4,
-	0x373986,
-	0x3d1008,
-	0x2760c9,
-	0x251846,
-	0x294e48,
-	0x2da9c6,
-	0x367508,
-	0x37130c,
-	0x28a2c6,
-	0x2a6ecd,
-	0x2a734b,
-	0x2c7e05,
-	0x36a407,
-	0x2cdf86,
-	0x334988,
-	0x24ad49,
-	0x2bf748,
-	0x3d7285,
-	0x2a5647,
-	0x290008,
-	0x25d109,
-	0x2690c6,
-	0x26694a,
-	0x334708,
-	0x2bf58b,
-	0x2dad8c,
-	0x2879c8,
-	0x28b686,
-	0x3dc788,
-	0x3c9547,
-	0x3b6889,
-	0x29e90d,
-	0x2ab746,
-	0x2c9448,
-	0x2c9189,
-	0x2d3e48,
-	0x292cc8,
-	0x2d780c,
-	0x2d8707,
-	0x2d9507,
-	0x279885,
-	0x2d1187,
-	0x2e6c88,
-	0x2bed46,
-	0x2516cc,
-	0x30b848,
-	0x2e7b88,
-	0x353c86,
-	0x3dd907,
-	0x24aec4,
-	0x3539c8,
-	0x2939cc,
-	0x297f0c,
-	0x3cf2c5,
-	0x3afc47,
-	0x34d686,
-	0x3dd886,
-	0x3a1148,
-	0x221444,
-	0x2269cb,
-	0x258fcb,
-	0x308e06,
-	0x366387,
-	0x34d885,
-	0x280485,
-	0x226b06,
-	0x295bc5,
-	0x24e705,
-	0x223247,
-	0x2200c9,
-	0x203284,
-	0x240c45,
-	0x312105,
-	0x3aab88,
-	0x34f385,
-	0x2d5e09,
-	0x2bfe87,
-	0x2bfe8b,
-	0x307886,
-	0x240209,
-	0x3819c8,
-	0x28b885,
-	0x3be1c8,
-	0x373a48,
-	0x28bec7,
-	0x22b247,
-	0x24bc89,
-	0x2275c7,
-	0x29b3c9,
-	0x2bcc4c,
-	0x2bde88,
-	0x2dabc9,
-	0x2ddec7,
-	0x291389,
-	0x221b47,
-	0x2dae88,
-	0x3c9485,
-	0x2d6dc6,
-	0x2d8188,
-	0x246748,
-	0x3d9189,
-	0x24e747,
-	0x3ade05,
-	0x3d8c09,
-	0x387046,
-	0x29e704,
-	0x32a946,
-	0x248788,
-	0x253a87,
-	0x29f908,
-	0x242009,
-	0x2b64c7,
-	0x2ab5c6,
-	0x205f04,
-	0x320349,
-	0x3d9648,
-	0x353b47,
-	0x37b106,
-	0x29f646,
-	0x354244,
-	0x2d17c6,
-	0x23be43,
-	0x3d5bc9,
-	0x3b9a46,
-	0x275dc5,
-	0x2ae506,
-	0x235705,
-	0x290488,
-	0x3b6747,
-	0x240ec6,
-	0x369f46,
-	0x314c88,
-	0x2b1ac7,
-	0x2ab785,
-	0x2abf48,
-	0x3e0988,
-	0x334708,
-	0x240645,
-	0x2d6e46,
-	0x27efc9,
-	0x3af644,
-	0x31260b,
-	0x21d64b,
-	0x22a589,
-	0x21da03,
-	0x264ac5,
-	0x320a46,
-	0x244fc8,
-	0x2b9344,
-	0x30efc6,
-	0x2b5e89,
-	0x37cbc5,
-	0x223186,
-	0x3d8146,
-	0x20cc04,
-	0x2fae0a,
-	0x275d08,
-	0x246746,
-	0x379305,
-	0x2055c7,
-	0x340fc7,
-	0x273044,
-	0x21d887,
-	0x245644,
-	0x245646,
-	0x206503,
-	0x279a45,
-	0x2c3905,
-	0x214b08,
-	0x290985,
-	0x28a009,
-	0x2b3fc7,
-	0x35380b,
-	0x2b3fcc,
-	0x2b45ca,
-	0x355507,
-	0x210cc3,
-	0x288808,
-	0x307c05,
-	0x238dc5,
-	0x35cb84,
-	0x2dad86,
-	0x2764c6,
-	0x2d1807,
-	0x26158b,
-	0x211cc4,
-	0x210f84,
-	0x2e0ec4,
-	0x2e5fc6,
-	0x20df44,
-	0x2d1608,
-	0x35c985,
-	0x28ac85,
-	0x2ab3c7,
-	0x36a509,
-	0x340d85,
-	0x33ca8a,
-	0x2b1609,
-	0x2a88ca,
-	0x3ea6c9,
-	0x318884,
-	0x37cf05,
-	0x370148,
-	0x3706cb,
-	0x213785,
-	0x2d9b46,
-	0x247004,
-	0x28a546,
-	0x2b6349,
-	0x3ee487,
-	0x269f08,
-	0x2b3206,
-	0x3ce787,
-	0x290e08,
-	0x37fd46,
-	0x205f84,
-	0x267ec7,
-	0x38a345,
-	0x399b87,
-	0x256484,
-	0x2cdf06,
-	0x341108,
-	0x2a7508,
-	0x302d47,
-	0x34d3c8,
-	0x2a5485,
-	0x21d784,
-	0x2ff988,
-	0x316684,
-	0x20cac5,
-	0x3a0f44,
-	0x3f3347,
-	0x298587,
-	0x2914c8,
-	0x235746,
-	0x290905,
-	0x289e08,
-	0x24d8c8,
-	0x2b0ec9,
-	0x21d946,
-	0x231208,
-	0x20850a,
-	0x2fc308,
-	0x31db05,
-	0x256906,
-	0x2b14c8,
-	0x2a570a,
-	0x35ec47,
-	0x295485,
-	0x2a1d88,
-	0x2b8e44,
-	0x25bdc6,
-	0x2d9f48,
-	0x3cbf86,
-	0x3c38c8,
-	0x2a6747,
-	0x3cb0c6,
-	0x2ce7c4,
-	0x360e47,
-	0x2ca244,
-	0x2b6307,
-	0x353e8d,
-	0x22a605,
-	0x2c684b,
-	0x298186,
-	0x25e008,
-	0x2d2784,
-	0x2319c6,
-	0x28d486,
-	0x3dcac7,
-	0x2a6b8d,
-	0x30d487,
-	0x2cafc8,
-	0x3adec5,
-	0x302fc8,
-	0x2e11c6,
-	0x2a5508,
-	0x230746,
-	0x3dc007,
-	0x3557c9,
-	0x35e947,
-	0x297148,
-	0x2547c5,
-	0x22bd48,
-	0x22af85,
-	0x35f585,
-	0x373385,
-	0x2136c3,
-	0x21d584,
-	0x2a1f85,
-	0x24c849,
-	0x37b006,
-	0x31ae08,
-	0x3d83c5,
-	0x2cc607,
-	0x371fca,
-	0x2230c9,
-	0x2f3e0a,
-	0x2ec2c8,
-	0x21fb4c,
-	0x29214d,
-	0x3e7703,
-	0x3c37c8,
-	0x212545,
-	0x3c9686,
-	0x210b86,
-	0x360385,
-	0x3bf209,
-	0x2ffd45,
-	0x289e08,
-	0x266186,
-	0x374506,
-	0x2b23c9,
-	0x268887,
-	0x29f4c6,
-	0x371f48,
-	0x2fb308,
-	0x2f66c7,
-	0x2e650e,
-	0x2e1405,
-	0x25d005,
-	0x3cbe88,
-	0x36ad87,
-	0x2084c2,
-	0x2d75c4,
-	0x27fc8a,
-	0x353c08,
-	0x373b86,
-	0x2a9348,
-	0x213a46,
-	0x3da388,
-	0x2c0f08,
-	0x35f544,
-	0x2cca05,
-	0x7a24c4,
-	0x7a24c4,
-	0x7a24c4,
-	0x2149c3,
-	0x2030c6,
-	0x28a2c6,
-	0x2ad64c,
-	0x2085c3,
-	0x256486,
-	0x213604,
-	0x24bfc8,
-	0x2b5cc5,
-	0x27fd86,
-	0x2b3bc8,
-	0x2ed646,
-	0x240e46,
-	0x3d7f48,
-	0x3cd647,
-	0x227389,
-	0x31024a,
-	0x20c684,
-	0x245685,
-	0x311005,
-	0x275ec6,
-	0x233946,
-	0x36a946,
-	0x386dc6,
-	0x2274c4,
-	0x2274cb,
-	0x245444,
-	0x205645,
-	0x2c0445,
-	0x261846,
-	0x20d408,
-	0x292007,
-	0x3d5f84,
-	0x26c2c3,
-	0x2b8945,
-	0x32a807,
-	0x291f0b,
-	0x214a07,
-	0x2b3ac8,
-	0x2d1947,
-	0x291086,
-	0x269ac8,
-	0x2d030b,
-	0x3821c6,
-	0x208c89,
-	0x2d0485,
-	0x32b3c3,
-	0x223186,
-	0x2a6648,
-	0x205fc3,
-	0x2ce043,
-	0x290e06,
-	0x213a46,
-	0x37deca,
-	0x28b6c5,
-	0x28d2cb,
-	0x2ae44b,
-	0x285503,
-	0x212303,
-	0x2c84c4,
-	0x371e07,
-	0x2879c4,
-	0x24bfc4,
-	0x2ce244,
-	0x2fc608,
-	0x379248,
-	0x39e5c9,
-	0x2e3f08,
-	0x3c5607,
-	0x227806,
-	0x31aa4f,
-	0x2e1546,
-	0x2ebc44,
-	0x37908a,
-	0x32a707,
-	0x2ca346,
-	0x29e749,
-	0x39e545,
-	0x275845,
-	0x39e686,
-	0x22be83,
-	0x2b8e89,
-	0x213506,
-	0x241dc9,
-	0x398346,
-	0x279a45,
-	0x3cf6c5,
-	0x202b83,
-	0x213948,
-	0x337207,
-	0x273144,
-	0x24be48,
-	0x23d244,
-	0x316586,
-	0x2d0606,
-	0x242706,
-	0x3cd309,
-	0x238d45,
-	0x2ab846,
-	0x259709,
-	0x2e0686,
-	0x2fc686,
-	0x3a99c6,
-	0x223005,
-	0x3a0f46,
-	0x3dc004,
-	0x3c9485,
-	0x246744,
-	0x2cb886,
-	0x3ca004,
-	0x205643,
-	0x295105,
-	0x236ec8,
-	0x30f687,
-	0x2b93c9,
-	0x295388,
-	0x2a8291,
-	0x3d81ca,
-	0x308d47,
-	0x2a6886,
-	0x213604,
-	0x2d8288,
-	0x365b08,
-	0x2a844a,
-	0x2d5bcd,
-	0x213686,
-	0x3d8046,
-	0x360f06,
-	0x31fa07,
-	0x2cb085,
-	0x301a07,
-	0x24bf05,
-	0x2bffc4,
-	0x2ec0c6,
-	0x23cf87,
-	0x2b8b8d,
-	0x2b1407,
-	0x2f7a48,
-	0x28a109,
-	0x221d46,
-	0x269045,
-	0x238cc4,
-	0x248886,
-	0x2fd246,
-	0x353d86,
-	0x2a9bc8,
-	0x2162c3,
-	0x22b183,
-	0x3329c5,
-	0x290a46,
-	0x2c0ec5,
-	0x2b3408,
-	0x2ace4a,
-	0x34cf84,
-	0x24bfc8,
-	0x2a64c8,
-	0x2bc587,
-	0x22b0c9,
-	0x2d2fc8,
-	0x276147,
-	0x2d6cc6,
-	0x3cbf8a,
-	0x248908,
-	0x329d09,
-	0x2aa008,
-	0x2185c9,
-	0x3857c7,
-	0x3add45,
-	0x2feb86,
-	0x2bebc8,
-	0x224488,
-	0x2ac5c8,
-	0x308f08,
-	0x205645,
-	0x229f04,
-	0x234888,
-	0x246d84,
-	0x3ea4c4,
-	0x279a45,
-	0x2a0a87,
-	0x36a2c9,
-	0x3dc8c7,
-	0x208545,
-	0x2877c6,
-	0x378b06,
-	0x208804,
-	0x2b2d46,
-	0x28d704,
-	0x28d006,
-	0x36a086,
-	0x20b046,
-	0x3d7285,
-	0x2b32c7,
-	0x210cc3,
-	0x32c109,
-	0x314a88,
-	0x24be44,
-	0x275fcd,
-	0x2a7608,
-	0x2fa688,
-	0x329c86,
-	0x3558c9,
-	0x2230c9,
-	0x32bb85,
-	0x2acf4a,
-	0x2b07ca,
-	0x37d1cc,
-	0x37d346,
-	0x288406,
-	0x2e1b46,
-	0x389a49,
-	0x3c98c6,
-	0x2b1b06,
-	0x2ffe06,
-	0x3539c8,
-	0x24d6c6,
-	0x2eb24b,
-	0x2a0c05,
-	0x28ac85,
-	0x288f05,
-	0x3bd9c6,
-	0x21d7c3,
-	0x242686,
-	0x2b1387,
-	0x2d8145,
-	0x3b9805,
-	0x356e85,
-	0x2c6c06,
-	0x2b6104,
-	0x31de86,
-	0x2a3a89,
-	0x3bd84c,
-	0x2bfd08,
-	0x238344,
-	0x39ebc6,
-	0x298286,
-	0x2a6648,
-	0x3011c8,
-	0x3bd749,
-	0x2055c7,
-	0x35f809,
-	0x281a86,
-	0x22d4c4,
-	0x35d904,
-	0x290744,
-	0x290e08,
-	0x36a10a,
-	0x340d06,
-	0x36f707,
-	0x399e07,
-	0x240305,
-	0x2b6c44,
-	0x29c3c6,
-	0x2cb0c6,
-	0x221483,
-	0x3148c7,
-	0x20e108,
-	0x2b618a,
-	0x301348,
-	0x214888,
-	0x3ca045,
-	0x22d285,
-	0x23a645,
-	0x2406c6,
-	0x245bc6,
-	0x214c45,
-	0x3d5e09,
-	0x2b6a4c,
-	0x360987,
-	0x2a84c8,
-	0x29fbc5,
-	0x7a24c4,
-	0x227d44,
-	0x263444,
-	0x20fb46,
-	0x2af34e,
-	0x2758c7,
-	0x31fc05,
-	0x3af5cc,
-	0x2bc447,
-	0x23cf07,
-	0x23fc89,
-	0x20ecc9,
-	0x295485,
-	0x314a88,
-	0x27efc9,
-	0x3345c5,
-	0x2d8088,
-	0x2c8f46,
-	0x2ffc06,
-	0x252d84,
-	0x325308,
-	0x24b983,
-	0x202cc4,
-	0x2b89c5,
-	0x397747,
-	0x22b5c5,
-	0x2083c9,
-	0x29c74d,
-	0x2aa806,
-	0x3f2e04,
-	0x39b688,
-	0x21ff0a,
-	0x205887,
-	0x257385,
-	0x202d03,
-	0x2ae60e,
-	0x213a4c,
-	0x318fc7,
-	0x2af507,
-	0x4f39db87,
-	0x2a446,
-	0x1ecc4,
-	0x20b903,
-	0x3c9905,
-	0x263445,
-	0x2a9708,
-	0x2a6309,
-	0x238246,
-	0x2879c4,
-	0x308c86,
-	0x2445cb,
-	0x36d18c,
-	0x255a87,
-	0x2eb6c5,
-	0x3e0888,
-	0x2f6485,
-	0x379087,
-	0x2e9907,
-	0x24b985,
-	0x21d7c3,
-	0x32bc44,
-	0x27d785,
-	0x203185,
-	0x203186,
-	0x2a3348,
-	0x23cf87,
-	0x210e86,
-	0x354146,
-	0x3732c6,
-	0x2c95c9,
-	0x3d98c7,
-	0x25cc06,
-	0x36d306,
-	0x3cc806,
-	0x2bbac5,
-	0x218146,
-	0x3ae705,
-	0x34f408,
-	0x2a034b,
-	0x29bf46,
-	0x399e44,
-	0x2fce09,
-	0x2b3fc4,
-	0x2c8ec8,
-	0x24a2c7,
-	0x292bc4,
-	0x2d20c8,
-	0x2d8f04,
-	0x2bbb04,
-	0x283685,
-	0x39b586,
-	0x2fc547,
-	0x204803,
-	0x2ab685,
-	0x324c04,
-	0x25d046,
-	0x2b60c8,
-	0x34d2c5,
-	0x2a0009,
-	0x355505,
-	0x256488,
-	0x235107,
-	0x3b9b48,
-	0x2d1347,
-	0x399289,
-	0x25b806,
-	0x3ef5c6,
-	0x29d984,
-	0x312545,
-	0x366d0c,
-	0x288f07,
-	0x289447,
-	0x233588,
-	0x2aa806,
-	0x2b12c4,
-	0x338704,
-	0x24bb09,
-	0x2e1c46,
-	0x28f707,
-	0x3beb84,
-	0x2cd906,
-	0x3d7a85,
-	0x2e9787,
-	0x2eb1c6,
-	0x266809,
-	0x2e8dc7,
-	0x2a4a47,
-	0x2b2246,
-	0x2cd845,
-	0x28eac8,
-	0x213388,
-	0x375c46,
-	0x34d305,
-	0x38c1c6,
-	0x206003,
-	0x2a9589,
-	0x36a6ce,
-	0x2d1008,
-	0x23d348,
-	0x375a4b,
-	0x2a0246,
-	0x399d04,
-	0x240e44,
-	0x36a7ca,
-	0x215807,
-	0x256845,
-	0x208c89,
-	0x2d6c05,
-	0x3ea507,
-	0x236544,
-	0x29a507,
-	0x2f6cc8,
-	0x2c5846,
-	0x2ce089,
-	0x2d30ca,
-	0x215786,
-	0x2a7146,
-	0x2c03c5,
-	0x39ff05,
-	0x3af087,
-	0x24b008,
-	0x3d79c8,
-	0x35f546,
-	0x3cf745,
-	0x2336ce,
-	0x2390c4,
-	0x2a9685,
-	0x287149,
-	0x2e89c8,
-	0x299d86,
-	0x2aba4c,
-	0x2aca50,
-	0x2aef8f,
-	0x2b1848,
-	0x355507,
-	0x3d7285,
-	0x2a1f85,
-	0x2fc3c9,
-	0x2a1f89,
-	0x287e86,
-	0x213807,
-	0x3a1045,
-	0x241149,
-	0x362706,
-	0x3c970d,
-	0x290609,
-	0x24bfc4,
-	0x2d0908,
-	0x234949,
-	0x340ec6,
-	0x288a05,
-	0x3ef5c6,
-	0x269dc9,
-	0x3bea08,
-	0x2034c5,
-	0x208604,
-	0x2abc0b,
-	0x340d85,
-	0x245046,
-	0x256786,
-	0x3a6646,
-	0x24094b,
-	0x2a0109,
-	0x221c85,
-	0x39a747,
-	0x3d8146,
-	0x291846,
-	0x2631c8,
-	0x20cc09,
-	0x2f780c,
-	0x32a608,
-	0x3239c6,
-	0x339743,
-	0x22f586,
-	0x307b85,
-	0x28de08,
-	0x3cf146,
-	0x2354c8,
-	0x280f45,
-	0x235805,
-	0x315f48,
-	0x3c2f47,
-	0x210ac7,
-	0x2d1807,
-	0x324988,
-	0x355648,
-	0x2ca706,
-	0x2cb6c7,
-	0x3b5f87,
-	0x39900a,
-	0x245f03,
-	0x3bd9c6,
-	0x233645,
-	0x257504,
-	0x28a109,
-	0x399204,
-	0x2c5844,
-	0x2ad2c4,
-	0x2af50b,
-	0x337147,
-	0x233905,
-	0x2a5188,
-	0x2877c6,
-	0x2877c8,
-	0x28b606,
-	0x29a905,
-	0x29ae45,
-	0x29d106,
-	0x272ec8,
-	0x29e688,
-	0x28a2c6,
-	0x2a4fcf,
-	0x2a9050,
-	0x20ab45,
-	0x210cc3,
-	0x2583c5,
-	0x325088,
-	0x2a1e89,
-	0x334708,
-	0x213608,
-	0x25ee48,
-	0x337207,
-	0x287489,
-	0x2356c8,
-	0x29dd44,
-	0x2ad148,
-	0x3aac49,
-	0x2cbc07,
-	0x2d32c4,
-	0x3dc988,
-	0x2b308a,
-	0x316d06,
-	0x213686,
-	0x21d809,
-	0x2acc87,
-	0x2e6b08,
-	0x2365c8,
-	0x294748,
-	0x284585,
-	0x3cbac5,
-	0x28ac85,
-	0x263405,
-	0x3baa87,
-	0x21d7c5,
-	0x2d8145,
-	0x22ed86,
-	0x334647,
-	0x370607,
-	0x2b3386,
-	0x2ec805,
-	0x245046,
-	0x2888c5,
-	0x2f7588,
-	0x383f84,
-	0x2e0706,
-	0x3925c4,
-	0x3c27c8,
-	0x21900a,
-	0x28a8cc,
-	0x2adc05,
-	0x31fac6,
-	0x2f79c6,
-	0x3926c6,
-	0x323a44,
-	0x3eda05,
-	0x28aec7,
-	0x2acd09,
-	0x2e63c7,
-	0x7a24c4,
-	0x7a24c4,
-	0x336fc5,
-	0x2ea844,
-	0x2ab20a,
-	0x287646,
-	0x315ec4,
-	0x3afbc5,
-	0x39cc85,
-	0x2cafc4,
-	0x2920c7,
-	0x3d8d87,
-	0x2e5fc8,
-	0x38c2c8,
-	0x2034c9,
-	0x316688,
-	0x29728b,
-	0x275ec4,
-	0x35f745,
-	0x28ecc5,
-	0x2d1789,
-	0x20cc09,
-	0x2fcd08,
-	0x245448,
-	0x261844,
-	0x2982c5,
-	0x20ba83,
-	0x275e85,
-	0x2ab8c6,
-	0x2a614c,
-	0x213406,
-	0x288906,
-	0x29a005,
-	0x2c6c88,
-	0x2e6146,
-	0x2a6a06,
-	0x213686,
-	0x222e4c,
-	0x280044,
-	0x37340a,
-	0x299f48,
-	0x2a5f87,
-	0x324b06,
-	0x238307,
-	0x308885,
-	0x37b106,
-	0x363386,
-	0x37aec7,
-	0x2d2dc4,
-	0x3f3445,
-	0x287144,
-	0x2c0047,
-	0x287388,
-	0x28828a,
-	0x28fe87,
-	0x2bb6c7,
-	0x355487,
-	0x2f65c9,
-	0x2a614a,
-	0x227483,
-	0x30f645,
-	0x20b083,
-	0x2ce289,
-	0x385908,
-	0x36f847,
-	0x334809,
-	0x213486,
-	0x2214c8,
-	0x352545,
-	0x24d9ca,
-	0x2f7089,
-	0x27ec09,
-	0x2dec07,
-	0x365c09,
-	0x20af48,
-	0x205dc6,
-	0x31fc88,
-	0x3d6107,
-	0x2275c7,
-	0x2b1607,
-	0x2c8d48,
-	0x39dec6,
-	0x2b2e45,
-	0x28aec7,
-	0x2a6c48,
-	0x373244,
-	0x3e5784,
-	0x29f3c7,
-	0x2c1287,
-	0x27ee4a,
-	0x205d46,
-	0x3edd0a,
-	0x2d7507,
-	0x238e87,
-	0x3f3504,
-	0x29b484,
-	0x2e9686,
-	0x3d9e44,
-	0x3d9e4c,
-	0x315e05,
-	0x20ca49,
-	0x256604,
-	0x2cb085,
-	0x21fe88,
-	0x29e745,
-	0x33ca86,
-	0x2a20c4,
-	0x2a414a,
-	0x2de406,
-	0x2bbb8a,
-	0x20c647,
-	0x29fa45,
-	0x22be85,
-	0x24034a,
-	0x24ba45,
-	0x2b0f86,
-	0x246d84,
-	0x2c8646,
-	0x3af145,
-	0x3cf206,
-	0x302d4c,
-	0x33e90a,
-	0x2b08c4,
-	0x227806,
-	0x2acc87,
-	0x2eb144,
-	0x3539c8,
-	0x2d9a46,
-	0x399c89,
-	0x37a689,
-	0x2bdf89,
-	0x3127c6,
-	0x3d6206,
-	0x31fdc7,
-	0x3d5d48,
-	0x3d6009,
-	0x337147,
-	0x2a5306,
-	0x3ce807,
-	0x360dc5,
-	0x2390c4,
-	0x31f987,
-	0x3b6145,
-	0x296445,
-	0x38d187,
-	0x24b848,
-	0x3e0806,
-	0x2a7acd,
-	0x2a990f,
-	0x2ae44d,
-	0x208584,
-	0x236fc6,
-	0x2edf08,
-	0x2ffdc5,
-	0x240808,
-	0x28bd8a,
-	0x24bfc4,
-	0x2cb986,
-	0x2d4e47,
-	0x211cc7,
-	0x3cd709,
-	0x31fc45,
-	0x2cafc4,
-	0x2cc94a,
-	0x2d2b89,
-	0x365d07,
-	0x3645c6,
-	0x340ec6,
-	0x298206,
-	0x267f86,
-	0x3657cf,
-	0x2eddc9,
-	0x24d6c6,
-	0x268c86,
-	0x3db209,
-	0x2cb7c7,
-	0x200e83,
-	0x222fc6,
-	0x213b83,
-	0x360248,
-	0x27d507,
-	0x2b1a49,
-	0x2b3948,
-	0x210c08,
-	0x360ac6,
-	0x229ec9,
-	0x25e185,
-	0x22f4c4,
-	0x2f6f47,
-	0x389ac5,
-	0x208584,
-	0x2339c8,
-	0x215ac4,
-	0x2cb507,
-	0x2dd886,
-	0x271105,
-	0x2aa008,
-	0x340d8b,
-	0x314f87,
-	0x2405c6,
-	0x2e15c4,
-	0x3d33c6,
-	0x279a45,
-	0x3b6145,
-	0x28e849,
-	0x291cc9,
-	0x227604,
-	0x227645,
-	0x210cc5,
-	0x24d846,
-	0x314b88,
-	0x2d6546,
-	0x20df4b,
-	0x2c494a,
-	0x2d1445,
-	0x29aec6,
-	0x22f985,
-	0x326885,
-	0x2421c7,
-	0x3bdc48,
-	0x272f44,
-	0x393d46,
-	0x29e706,
-	0x20b107,
-	0x32b384,
-	0x28d486,
-	0x2fd085,
-	0x2fd089,
-	0x3d6404,
-	0x311149,
-	0x28a2c6,
-	0x2d87c8,
-	0x210cc5,
-	0x399f05,
-	0x3cf206,
-	0x2f7709,
-	0x20ecc9,
-	0x288986,
-	0x2e8ac8,
-	0x29c888,
-	0x22f944,
-	0x2cd104,
-	0x2cd108,
-	0x3c5488,
-	0x35f909,
-	0x2ab846,
-	0x213686,
-	0x34034d,
-	0x30efc6,
-	0x3711c9,
-	0x2fd505,
-	0x39e686,
-	0x3dcc08,
-	0x33c9c5,
-	0x3b5fc4,
-	0x279a45,
-	0x2916c8,
-	0x2aafc9,
-	0x287204,
-	0x2cdf06,
-	0x2eb78a,
-	0x318ec8,
-	0x27efc9,
-	0x28ab4a,
-	0x334786,
-	0x2a9ac8,
-	0x378e45,
-	0x29a1c8,
-	0x308905,
-	0x213349,
-	0x3429c9,
-	0x226942,
-	0x2d0485,
-	0x28ed86,
-	0x28a207,
-	0x257505,
-	0x2f6bc6,
-	0x3833c8,
-	0x2aa806,
-	0x370009,
-	0x289546,
-	0x263048,
-	0x2bc805,
-	0x255346,
-	0x3dc108,
-	0x290e08,
-	0x3856c8,
-	0x321948,
-	0x218144,
-	0x22bc83,
-	0x370244,
-	0x290086,
-	0x360e04,
-	0x23d287,
-	0x2a6909,
-	0x2e0ec5,
-	0x2365c6,
-	0x222fc6,
-	0x2a318b,
-	0x2ca286,
-	0x294206,
-	0x2e0808,
-	0x245946,
-	0x29f843,
-	0x213183,
-	0x2390c4,
-	0x231105,
-	0x2da807,
-	0x287388,
-	0x28738f,
-	0x28adcb,
-	0x314988,
-	0x2cdf86,
-	0x314c8e,
-	0x2110c3,
-	0x2da784,
-	0x2ca205,
-	0x2cae46,
-	0x29c4cb,
-	0x2a0b46,
-	0x21aac9,
-	0x271105,
-	0x2612c8,
-	0x3f3c08,
-	0x20eb8c,
-	0x2af546,
-	0x275ec6,
-	0x2cf105,
-	0x296f08,
-	0x28a8c5,
-	0x2738c8,
-	0x2abdca,
-	0x2ae889,
-	0x7a24c4,
-	0x2000c2,
-	0x55202202,
-	0x200382,
-	0x2503c4,
-	0x200ec2,
-	0x228f84,
-	0x2018c2,
-	0x2003c2,
-	0x202ec2,
-	0x1b9688,
-	0x6204,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x5cc2,
-	0x510c2,
-	0x206543,
-	0x2109c3,
-	0x21f143,
-	0x24b42,
-	0x5fc2,
-	0x2642,
-	0x250b03,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2503c4,
-	0x2109c3,
-	0x21f143,
-	0x214f03,
-	0x214f04,
-	0x206643,
-	0x2392c4,
-	0x21f603,
-	0x2e4084,
-	0x205503,
-	0x2577c7,
-	0x206543,
-	0x21d783,
-	0x23d5c8,
-	0x21f143,
-	0x28cc4b,
-	0x309b43,
-	0x212fc6,
-	0x23d942,
-	0x30468b,
-	0x21f603,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x21f143,
-	0x20ef83,
-	0x224cc3,
-	0x2000c2,
-	0x1b9688,
-	0x22c445,
-	0x3b61c8,
-	0x2e41c8,
-	0x202202,
-	0x36b145,
-	0x3ce947,
-	0x201342,
-	0x2d29c7,
-	0x200382,
-	0x2594c7,
-	0x23c3c9,
-	0x27a288,
-	0x2945c9,
-	0x20d842,
-	0x3af9c7,
-	0x38ca04,
-	0x3cea07,
-	0x2c4847,
-	0x2d5782,
-	0x206543,
-	0x203c42,
-	0x2018c2,
-	0x2003c2,
-	0x2020c2,
-	0x200902,
-	0x202ec2,
-	0x39ffc5,
-	0x210545,
-	0x2202,
-	0x1f603,
-	0x206643,
-	0x21f603,
-	0x22b483,
-	0x205503,
-	0x2036c3,
-	0x2109c3,
-	0x21f143,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x157f86,
-	0x57e9df8b,
-	0x206543,
-	0x2109c3,
-	0x21f143,
-	0x157285,
-	0xb4c3,
-	0x101,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2503c4,
-	0x211e43,
-	0x2109c3,
-	0x21f143,
-	0x219dc3,
-	0x58854986,
-	0x1a6c3,
-	0xfdd45,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x202202,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x5b82,
-	0x1b9688,
-	0x2f843,
-	0x4afc4,
-	0x1484ac4,
-	0xf6885,
-	0x1a5643,
-	0x2000c2,
-	0x39ab04,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x252b83,
-	0x22f2c5,
-	0x211e43,
-	0x20f743,
-	0x2109c3,
-	0x22b643,
-	0x21f143,
-	0x213dc3,
-	0x214f83,
-	0x200f83,
-	0xc7f03,
-	0x5c2,
-	0x232c2,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x2000c2,
-	0x250b03,
-	0x202202,
-	0x23c2,
-	0x21f603,
-	0x205503,
-	0x2503c4,
-	0x2109c3,
-	0x21f143,
-	0x202ec2,
-	0x1b9688,
-	0x205503,
-	0x1b9688,
-	0x276803,
-	0x206643,
-	0x232184,
-	0x21f603,
-	0x205503,
-	0x203042,
-	0x206543,
-	0x2109c3,
-	0x21f143,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x203042,
-	0x23ddc3,
-	0x2109c3,
-	0x21f143,
-	0x303643,
-	0x213dc3,
-	0x2000c2,
-	0x202202,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x212fc5,
-	0x1f0786,
-	0x72544,
-	0xbdc04,
-	0x214f04,
-	0x23d942,
-	0x882,
-	0x1b9688,
-	0x23c2,
-	0x510c2,
-	0xc642,
-	0x2000c2,
-	0x14cb05,
-	0x20e08,
-	0xb2c83,
-	0x202202,
-	0x3fbc4,
-	0x5dd5d986,
-	0x26084,
-	0xba94b,
-	0x3c746,
-	0x82b87,
-	0xa1309,
-	0x21f603,
-	0x4f688,
-	0x4f68b,
-	0x4fb0b,
-	0x5088b,
-	0x50bcb,
-	0x50e8b,
-	0x512cb,
-	0x1c1b46,
-	0x205503,
-	0x1c9f45,
-	0x1a3504,
-	0x21bd03,
-	0x121787,
-	0x165706,
-	0x137585,
-	0x2044,
-	0xf28c4,
-	0x2109c3,
-	0x88a86,
-	0x11ff04,
-	0x21f143,
-	0x30a904,
-	0x137a47,
-	0x1f0389,
-	0xba708,
-	0x1e6785,
-	0x23dc4,
-	0x1ceb44,
-	0x368c3,
-	0x1dea03,
-	0x54146,
-	0x1d7808,
-	0x1aea85,
-	0x1a2c89,
-	0x1e143,
-	0x100a86,
-	0x14cb05,
-	0x202202,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x206543,
-	0x21d783,
-	0x21f143,
-	0x309b43,
-	0x23d942,
-	0x1b9688,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x20fc83,
-	0x294744,
-	0x2109c3,
-	0x21f143,
-	0x206643,
-	0x21f603,
-	0x2e4084,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x212fc6,
-	0x21f603,
-	0x205503,
-	0x18903,
-	0x21f143,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x14cb05,
-	0x82b87,
-	0xc043,
-	0x1e143,
-	0x7442,
-	0x1b9688,
-	0x205503,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x6d7c3,
-	0x176608,
-	0x2109c3,
-	0x21f143,
-	0x61606643,
-	0x21f603,
-	0x2109c3,
-	0x21f143,
-	0x1b9688,
-	0x2000c2,
-	0x202202,
-	0x206643,
-	0x205503,
-	0x2109c3,
-	0x2003c2,
-	0x21f143,
-	0x342f07,
-	0x3be44b,
-	0x22c383,
-	0x287b48,
-	0x3d5ac7,
-	0x38bac6,
-	0x20d1c5,
-	0x36b289,
-	0x212d48,
-	0x257bc9,
-	0x257bd0,
-	0x383c0b,
-	0x3a8989,
-	0x20c043,
-	0x223ac9,
-	0x232f46,
-	0x232f4c,
-	0x22c508,
-	0x3ef408,
-	0x3de109,
-	0x2d390e,
-	0x23c18b,
-	0x2c430c,
-	0x2028c3,
-	0x27cdcc,
-	0x2028c9,
-	0x315a07,
-	0x235fcc,
-	0x2c5d0a,
-	0x204884,
-	0x2bfa0d,
-	0x27cc88,
-	0x33244d,
-	0x282386,
-	0x25364b,
-	0x3f0509,
-	0x268f07,
-	0x3c3a86,
-	0x3d3bc9,
-	0x358c8a,
-	0x31ed88,
-	0x309744,
-	0x2c1d07,
-	0x231ac7,
-	0x335b04,
-	0x21a504,
-	0x206ac9,
-	0x301889,
-	0x3ceec8,
-	0x2cbe45,
-	0x20d785,
-	0x208b46,
-	0x2bf8c9,
-	0x325b4d,
-	0x39e788,
-	0x208a47,
-	0x20d248,
-	0x237906,
-	0x232b84,
-	0x266485,
-	0x3ea3c6,
-	0x3ecf04,
-	0x2027c7,
-	0x204e4a,
-	0x20eac4,
-	0x2156c6,
-	0x217509,
-	0x21750f,
-	0x2182cd,
-	0x218806,
-	0x220a10,
-	0x220e06,
-	0x2227c7,
-	0x223407,
-	0x22340f,
-	0x223ec9,
-	0x2270c6,
-	0x227b47,
-	0x227b48,
-	0x227e89,
-	0x3c1988,
-	0x31c607,
-	0x229a03,
-	0x22e3c6,
-	0x336ac8,
-	0x2d3bca,
-	0x202f09,
-	0x212e83,
-	0x36b046,
-	0x393b8a,
-	0x2345c7,
-	0x31584a,
-	0x373e4e,
-	0x224006,
-	0x321d07,
-	0x25e586,
-	0x202986,
-	0x3cb8cb,
-	0x3c1c4a,
-	0x3f384d,
-	0x3d62c7,
-	0x2fff88,
-	0x2fff89,
-	0x2fff8f,
-	0x2b954c,
-	0x381149,
-	0x2bb04e,
-	0x2578ca,
-	0x3796c6,
-	0x2fbb86,
-	0x323e8c,
-	0x3f158c,
-	0x32b988,
-	0x35e847,
-	0x21c285,
-	0x3cebc4,
-	0x20220e,
-	0x21ca44,
-	0x3d3907,
-	0x3b3a8a,
-	0x3ebfd4,
-	0x22d6cf,
-	0x2235c8,
-	0x22e288,
-	0x20f38d,
-	0x20f38e,
-	0x22e709,
-	0x349208,
-	0x34920f,
-	0x235ccc,
-	0x235ccf,
-	0x236d07,
-	0x23a08a,
-	0x23afcb,
-	0x23b988,
-	0x23dc87,
-	0x271d8d,
-	0x3022c6,
-	0x2bfbc6,
-	0x242509,
-	0x272348,
-	0x248248,
-	0x24824e,
-	0x26d447,
-	0x30d045,
-	0x24a485,
-	0x21a384,
-	0x38bd86,
-	0x3cedc8,
-	0x25f1c3,
-	0x2c544e,
-	0x272148,
-	0x21e20b,
-	0x2769c7,
-	0x35f385,
-	0x27cf46,
-	0x2be707,
-	0x34e508,
-	0x375209,
-	0x2329c5,
-	0x295148,
-	0x30f386,
-	0x3b31ca,
-	0x202109,
-	0x236089,
-	0x23608b,
-	0x347308,
-	0x3359c9,
-	0x2c8a46,
-	0x27b28a,
-	0x2853ca,
-	0x23a28c,
-	0x273407,
-	0x27a08a,
-	0x3c4d0b,
-	0x3c4d19,
-	0x2d66c8,
-	0x213045,
-	0x271f46,
-	0x3798c9,
-	0x35df86,
-	0x2e488a,
-	0x2064c6,
-	0x2e2504,
-	0x2e250d,
-	0x33b487,
-	0x35ee09,
-	0x24ec45,
-	0x24ef08,
-	0x24f449,
-	0x251604,
-	0x251cc7,
-	0x251cc8,
-	0x252007,
-	0x277dc8,
-	0x25ca47,
-	0x269285,
-	0x265b8c,
-	0x265f89,
-	0x32920a,
-	0x268709,
-	0x223bc9,
-	0x268a4c,
-	0x26c18b,
-	0x26d008,
-	0x26d948,
-	0x270d04,
-	0x292648,
-	0x293349,
-	0x2c5dc7,
-	0x217746,
-	0x2ad487,
-	0x370d89,
-	0x245dcb,
-	0x3aef07,
-	0x2a0887,
-	0x256687,
-	0x3323c4,
-	0x3323c5,
-	0x3ab045,
-	0x35be4b,
-	0x3e4bc4,
-	0x2dc688,
-	0x2bd0ca,
-	0x30f447,
-	0x3ef007,
-	0x29bad2,
-	0x28cf06,
-	0x231386,
-	0x3da74e,
-	0x298b06,
-	0x2a1c08,
-	0x2a2c8f,
-	0x332808,
-	0x296988,
-	0x312bca,
-	0x312bd1,
-	0x2b360e,
-	0x27b9ca,
-	0x27b9cc,
-	0x25d947,
-	0x349410,
-	0x3d3208,
-	0x2b3805,
-	0x2befca,
-	0x3ecf4c,
-	0x20bf0d,
-	0x3cd906,
-	0x3cd907,
-	0x3cd90c,
-	0x3f3dcc,
-	0x211e4c,
-	0x32cf0b,
-	0x3a5bc4,
-	0x21d984,
-	0x2c3bc9,
-	0x338787,
-	0x22e049,
-	0x285209,
-	0x2c59c7,
-	0x2c5b86,
-	0x2c5b89,
-	0x2c5f83,
-	0x2aa90a,
-	0x336987,
-	0x3dd24b,
-	0x3f36ca,
-	0x259604,
-	0x3ee686,
-	0x290109,
-	0x3bf3c4,
-	0x2ebcca,
-	0x307cc5,
-	0x2d5005,
-	0x2d500d,
-	0x2d534e,
-	0x363545,
-	0x341bc6,
-	0x212bc7,
-	0x23884a,
-	0x21cd46,
-	0x2f46c4,
-	0x2f8c47,
-	0x3e114b,
-	0x2fe247,
-	0x28c284,
-	0x318046,
-	0x31804d,
-	0x2f120c,
-	0x210886,
-	0x39e98a,
-	0x21d406,
-	0x222488,
-	0x23a947,
-	0x2665ca,
-	0x351986,
-	0x28d503,
-	0x3ca106,
-	0x24a6c8,
-	0x375d0a,
-	0x29a347,
-	0x29a348,
-	0x29c044,
-	0x28d107,
-	0x3870c8,
-	0x235848,
-	0x2cc748,
-	0x2ccb4a,
-	0x2dfa85,
-	0x23ddc7,
-	0x27b813,
-	0x286446,
-	0x235ac8,
-	0x225449,
-	0x2d2888,
-	0x360b4b,
-	0x2ce4c8,
-	0x30ce84,
-	0x316046,
-	0x32c586,
-	0x39b3c9,
-	0x2dfe47,
-	0x265c88,
-	0x36aa46,
-	0x38d084,
-	0x336305,
-	0x3d7408,
-	0x20150a,
-	0x2e2188,
-	0x2e7786,
-	0x2a9cca,
-	0x203308,
-	0x3a9dc8,
-	0x2ebf48,
-	0x2ec4c6,
-	0x2ee106,
-	0x3ac9cc,
-	0x2ee6d0,
-	0x2eeac5,
-	0x320688,
-	0x320690,
-	0x332610,
-	0x257a4e,
-	0x3ac64e,
-	0x3ac654,
-	0x3b0b0f,
-	0x3b0ec6,
-	0x3efd91,
-	0x3474d3,
-	0x3c3c08,
-	0x3c3205,
-	0x289788,
-	0x3eabc5,
-	0x34f10c,
-	0x212349,
-	0x21c889,
-	0x229747,
-	0x3b35c9,
-	0x35db47,
-	0x3a3046,
-	0x266287,
-	0x28b345,
-	0x20b503,
-	0x218903,
-	0x27fb84,
-	0x3d228d,
-	0x3f1dcf,
-	0x38d0c5,
-	0x212246,
-	0x3b74c7,
-	0x22c287,
-	0x2d0c46,
-	0x2d0c4b,
-	0x2b4785,
-	0x21e0c6,
-	0x3b1d87,
-	0x25dc49,
-	0x369dc6,
-	0x21e6c5,
-	0x33bccb,
-	0x3cd206,
-	0x222b85,
-	0x252c08,
-	0x29d4c8,
-	0x2b48cc,
-	0x2b48d0,
-	0x2b6f49,
-	0x2c7747,
-	0x2cc28b,
-	0x2f6986,
-	0x31c4ca,
-	0x2b054b,
-	0x34e74a,
-	0x371946,
-	0x303505,
-	0x3366c6,
-	0x293d08,
-	0x29e14a,
-	0x20f01c,
-	0x309c0c,
-	0x309f08,
-	0x212fc5,
-	0x21f807,
-	0x2b2b46,
-	0x2d3fc5,
-	0x21b886,
-	0x2d0e08,
-	0x2d2e07,
-	0x2d3808,
-	0x28650a,
-	0x2f60cc,
-	0x25f449,
-	0x21f247,
-	0x2282c4,
-	0x224606,
-	0x29650a,
-	0x285305,
-	0x21a18c,
-	0x21a848,
-	0x22d0c8,
-	0x22abcc,
-	0x39598c,
-	0x22dc09,
-	0x22de47,
-	0x24744c,
-	0x233dc4,
-	0x24b48a,
-	0x217d0c,
-	0x28274b,
-	0x39450b,
-	0x3a6386,
-	0x25c1c7,
-	0x25d447,
-	0x34964f,
-	0x317051,
-	0x2f37d2,
-	0x25d44d,
-	0x25d44e,
-	0x25d78e,
-	0x3b0cc8,
-	0x3b0cd2,
-	0x241848,
-	0x2501c7,
-	0x256eca,
-	0x24b2c8,
-	0x298ac5,
-	0x3ba8ca,
-	0x221347,
-	0x2e3184,
-	0x24e583,
-	0x38ff05,
-	0x312e47,
-	0x2f9947,
-	0x20c10e,
-	0x31618d,
-	0x317d09,
-	0x20e7c5,
-	0x326403,
-	0x344206,
-	0x26a945,
-	0x21e448,
-	0x33c149,
-	0x271f85,
-	0x271f8f,
-	0x2baa47,
-	0x20d045,
-	0x27738a,
-	0x20ae06,
-	0x2a8c49,
-	0x35964c,
-	0x37e909,
-	0x2125c6,
-	0x2bcecc,
-	0x37f846,
-	0x3e6888,
-	0x315546,
-	0x27aec6,
-	0x2ca404,
-	0x222383,
-	0x2dfbca,
-	0x29cad1,
-	0x38130a,
-	0x265745,
-	0x268287,
-	0x262a47,
-	0x2d0044,
-	0x3871cb,
-	0x294448,
-	0x2d0a06,
-	0x233605,
-	0x273d04,
-	0x275389,
-	0x2008c4,
-	0x3ed887,
-	0x387e05,
-	0x387e07,
-	0x3da985,
-	0x260ac3,
-	0x250088,
-	0x277a0a,
-	0x204803,
-	0x22c48a,
-	0x204806,
-	0x271d0f,
-	0x26d3c9,
-	0x2c53d0,
-	0x3a7648,
-	0x2e7c89,
-	0x2a7907,
-	0x317fcf,
-	0x334bc4,
-	0x2e4104,
-	0x220c86,
-	0x3b6d46,
-	0x34fd4a,
-	0x273706,
-	0x2c28c7,
-	0x31c948,
-	0x31cb47,
-	0x31dc47,
-	0x320bca,
-	0x31e64b,
-	0x302045,
-	0x2f3408,
-	0x21ff83,
-	0x3d118c,
-	0x21c00f,
-	0x23cc0d,
-	0x29ab07,
-	0x22ce09,
-	0x284107,
-	0x2d91c8,
-	0x3ec1cc,
-	0x30cd88,
-	0x24d408,
-	0x33820e,
-	0x34ba94,
-	0x34bfa4,
-	0x3672ca,
-	0x38420b,
-	0x35dc04,
-	0x35dc09,
-	0x2cba08,
-	0x24b745,
-	0x3d58ca,
-	0x296287,
-	0x22ffc4,
-	0x250b03,
-	0x206643,
-	0x2392c4,
-	0x21f603,
-	0x205503,
-	0x2503c4,
-	0x211e43,
-	0x206543,
-	0x2ee6c6,
-	0x294744,
-	0x2109c3,
-	0x21f143,
-	0x219683,
-	0x2000c2,
-	0x250b03,
-	0x202202,
-	0x206643,
-	0x2392c4,
-	0x21f603,
-	0x205503,
-	0x211e43,
-	0x2ee6c6,
-	0x2109c3,
-	0x21f143,
-	0x1b9688,
-	0x206643,
-	0x21f603,
-	0x3d6403,
-	0x2109c3,
-	0x21f143,
-	0x250b03,
-	0x1b9688,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x206543,
-	0x294744,
-	0x2109c3,
-	0x21f143,
-	0x2000c2,
-	0x38a7c3,
-	0x202202,
-	0x21f603,
-	0x205503,
-	0x206543,
-	0x2109c3,
-	0x21f143,
-	0x201782,
-	0x202dc2,
-	0x202202,
-	0x206643,
-	0x211ac2,
-	0x2005c2,
-	0x2503c4,
-	0x228f84,
-	0x21e002,
-	0x294744,
-	0x2003c2,
-	0x21f143,
-	0x219683,
-	0x3a6386,
-	0x224b42,
-	0x202642,
-	0x225842,
-	0x63e03ec3,
-	0x64255983,
-	0x63586,
-	0x63586,
-	0x214f04,
-	0x21d783,
-	0x1dec0d,
-	0x1cec4a,
-	0x1a1246,
-	0x1d01cc,
-	0x64f1f14d,
-	0x8f28c,
-	0x6545484f,
-	0x1d8f0d,
-	0x79184,
-	0x169044,
-	0xcdc84,
-	0x14cb05,
-	0x95709,
-	0xa0fcc,
-	0x342c7,
-	0x12ac6,
-	0x19288,
-	0x1f4c7,
-	0x24988,
-	0x1bb4ca,
-	0x11b487,
-	0xa1209,
-	0x65ad45c5,
-	0xf48c9,
-	0x65c37e4b,
-	0x1511cb,
-	0x2c4b,
-	0x172bc8,
-	0x16128a,
-	0x17c88e,
-	0x660b74ca,
-	0xe35cd,
-	0x2e70d,
-	0x14d268b,
-	0xf10ca,
-	0x26084,
-	0x8a646,
-	0x1896c8,
-	0xc9a08,
-	0x38107,
-	0x26e45,
-	0x1e3b07,
-	0xa24c9,
-	0x1d9d47,
-	0x7908,
-	0x10f849,
-	0x60a04,
-	0x685c5,
-	0x15440e,
-	0x1455c7,
-	0x666271c6,
-	0xbc84d,
-	0x1d9bc8,
-	0xf3008,
-	0x66a80986,
-	0x674b2788,
-	0x182c0a,
-	0x64348,
-	0x143110,
-	0x6048c,
-	0x72c07,
-	0x74107,
-	0x79c87,
-	0x7fa47,
-	0x8b02,
-	0x12a387,
-	0x1c1e0c,
-	0x14d05,
-	0xcc107,
-	0xb6e06,
-	0xb78c9,
-	0xbac08,
-	0x15fc2,
-	0x5c2,
-	0x116a86,
-	0x194e0b,
-	0x173cc6,
-	0x1de684,
-	0x1cf8c7,
-	0x80789,
-	0x1e0b49,
-	0x1ba688,
-	0x510c2,
-	0x19a989,
-	0x11508,
-	0xf0b8a,
-	0xceb48,
-	0x67ae098b,
-	0x1db9c9,
-	0x4b206,
-	0xe5a49,
-	0xf1047,
-	0xf1909,
-	0xf2a48,
-	0xf4087,
-	0xf5a49,
-	0xf8e05,
-	0xf91d0,
-	0xf9d4c,
-	0x181b86,
-	0x1cf805,
-	0xd9807,
-	0x4350d,
-	0x1b77c9,
-	0x682c88c3,
-	0x47185,
-	0x1cbd46,
-	0x104ac7,
-	0x10a918,
-	0x1da0c8,
-	0x8624a,
-	0x1c58e,
-	0x10002,
-	0x6865228b,
-	0x68ae5b4a,
-	0x1942ca,
-	0x6584d,
-	0x1042,
-	0xdd0c6,
-	0x15d46,
-	0xc20c8,
-	0xba0ca,
-	0x5a3c8,
-	0x1b9549,
-	0x11d908,
-	0x74c8e,
-	0x6308,
-	0x144207,
-	0x68eb26c4,
-	0xcfc4d,
-	0xcbd48,
-	0x113845,
-	0x146f48,
-	0x69381f09,
-	0x371c8,
-	0x6941f74a,
-	0x4042,
-	0x69ab24c8,
-	0x119e46,
-	0x5fc2,
-	0xd0504,
-	0x74b46,
-	0x69d23b48,
-	0x54146,
-	0x6a4de50b,
-	0x3642,
-	0x6523ab84,
-	0x21943,
-	0x16b449,
-	0x1908,
-	0x2547,
-	0x2c0ca,
-	0x71687,
-	0x401,
-	0x81,
-	0x188647,
-	0x117e48,
-	0xc70c8,
-	0xc72c8,
-	0xc74c8,
-	0x6cbc7,
-	0xa8643,
-	0x66e3ab84,
-	0x672d1fc3,
-	0xc1,
-	0xfc986,
-	0xc1,
-	0x201,
-	0xfc986,
-	0xa8643,
-	0x67e4ac44,
-	0x190d04,
-	0xe985,
-	0x39f45,
-	0x1cfa04,
-	0x6784,
-	0x51504,
-	0x1410087,
-	0x144ab87,
-	0x1c7448,
-	0x1c148c,
-	0xc01,
-	0x14f83,
-	0x1ecc4,
-	0x1bd044,
-	0x28d45,
-	0x1c7448,
-	0x6a3c7448,
-	0x68f03,
-	0x7e583,
-	0x12e03,
-	0x22607,
-	0x4a07,
-	0x15e5145,
-	0x56344,
-	0x72d47,
-	0x2202,
-	0x39f04,
-	0x1e0f4a,
-	0x204884,
-	0x206643,
-	0x2554c4,
-	0x2503c4,
-	0x2109c3,
-	0x225305,
-	0x219dc3,
-	0x237343,
-	0x33d845,
-	0x200f83,
-	0x235c3,
-	0x6ba06643,
-	0x21f603,
-	0x554c4,
-	0x3b43,
-	0x205503,
-	0x200181,
-	0xf743,
-	0x206543,
-	0x228f84,
-	0x294744,
-	0x2109c3,
-	0x2b643,
-	0x21f143,
-	0x213dc3,
-	0x1b9688,
-	0x2000c2,
-	0x250b03,
-	0x202202,
-	0x206643,
-	0x21f603,
-	0x3d6403,
-	0x2005c2,
-	0x2503c4,
-	0x211e43,
-	0x206543,
-	0x2109c3,
-	0x21d783,
-	0x21f143,
-	0x200f83,
-	0x1b9688,
-	0x10ea47,
-	0x2202,
-	0x136285,
-	0x605cf,
-	0xe3246,
-	0xf9d4c,
-	0x147e248,
-	0x6ca01bc2,
-	0x3dae48,
-	0x3cf386,
-	0x2db106,
-	0x39d947,
-	0x6ce087c2,
-	0x6d2c5248,
-	0x229cca,
-	0x272688,
-	0x200b02,
-	0x3367c9,
-	0x302087,
-	0x2176c6,
-	0x24fdc9,
-	0x23df04,
-	0x38b9c6,
-	0x2db504,
-	0x220044,
-	0x264b09,
-	0x31b946,
-	0x227d45,
-	0x2783c5,
-	0x22f007,
-	0x334087,
-	0x3edf44,
-	0x360406,
-	0x2c6485,
-	0x3f31c5,
-	0x22f8c5,
-	0x237ac7,
-	0x276805,
-	0x24f8c9,
-	0x3dc545,
-	0x34e644,
-	0x21cc87,
-	0x33b00e,
-	0x346ac9,
-	0x3da609,
-	0x3bde06,
-	0x243f48,
-	0x378c0b,
-	0x2fec8c,
-	0x32db46,
-	0x2c41c7,
-	0x2f8385,
-	0x313aca,
-	0x3cefc9,
-	0x200ac9,
-	0x2fbfc6,
-	0x3b1b45,
-	0x24b105,
-	0x375009,
-	0x22fa4b,
-	0x3cc986,
-	0x357686,
-	0x208a44,
-	0x252946,
-	0x30d0c8,
-	0x3d00c6,
-	0x27da46,
-	0x204248,
-	0x205a47,
-	0x206889,
-	0x207405,
-	0x1b9688,
-	0x3e3a84,
-	0x31e2c4,
-	0x20d605,
-	0x34a809,
-	0x20da07,
-	0x20da0b,
-	0x22620a,
-	0x229685,
-	0x6d605182,
-	0x3f3587,
-	0x6da29a08,
-	0x3c5807,
-	0x2df745,
-	0x23b3ca,
-	0x2202,
-	0x28b00b,
-	0x28d58a,
-	0x2778c6,
-	0x35f383,
-	0x20374d,
-	0x3d7ccc,
-	0x20dc8d,
-	0x236505,
-	0x211185,
-	0x25f207,
-	0x218d49,
-	0x229bc6,
-	0x273585,
-	0x32ac08,
-	0x23a783,
-	0x2e44c8,
-	0x252848,
-	0x3c4987,
-	0x23a788,
-	0x23e289,
-	0x37d047,
-	0x3bdfc7,
-	0x3e4fc8,
-	0x211884,
-	0x211887,
-	0x282288,
-	0x367e86,
-	0x3c5fcf,
-	0x244bc7,
-	0x35ff06,
-	0x22df85,
-	0x2259c3,
-	0x24d0c7,
-	0x38f643,
-	0x252fc6,
-	0x256246,
-	0x259a46,
-	0x29fe05,
-	0x277dc3,
-	0x39a608,
-	0x3a3889,
-	0x25a08b,
-	0x25b588,
-	0x25c705,
-	0x25e805,
-	0x6de596c2,
-	0x266349,
-	0x3d1c87,
-	0x21e145,
-	0x264a07,
-	0x266e86,
-	0x267e45,
-	0x26a78b,
-	0x26d004,
-	0x271845,
-	0x271987,
-	0x285a46,
-	0x286785,
-	0x292a87,
-	0x292fc7,
-	0x2c6b84,
-	0x2a0dca,
-	0x2b76c8,
-	0x378ec9,
-	0x320105,
-	0x275a06,
-	0x30d28a,
-	0x2782c6,
-	0x3ec587,
-	0x27a40d,
-	0x2b42c9,
-	0x384545,
-	0x3c3f07,
-	0x3db508,
-	0x3dbec8,
-	0x33abc7,
-	0x3c2e06,
-	0x216107,
-	0x2556c3,
-	0x31b8c4,
-	0x385d45,
-	0x3b0207,
-	0x3ba289,
-	0x225dc8,
-	0x3ec485,
-	0x273844,
-	0x24f305,
-	0x25ea4d,
-	0x207002,
-	0x2d6246,
-	0x2c8946,
-	0x30e2ca,
-	0x3a1606,
-	0x3adc85,
-	0x38c3c5,
-	0x38c3c7,
-	0x3b300c,
-	0x2641ca,
-	0x29b046,
-	0x2e0dc5,
-	0x252786,
-	0x29b907,
-	0x29de46,
-	0x29fd0c,
-	0x24ff09,
-	0x6e244447,
-	0x2a3045,
-	0x2a3046,
-	0x2a3548,
-	0x249685,
-	0x2b4f85,
-	0x2b5708,
-	0x2b590a,
-	0x6e60bd82,
-	0x6ea09b02,
-	0x2afc05,
-	0x31c703,
-	0x31df88,
-	0x285e43,
-	0x2b5b84,
-	0x2a8d8b,
-	0x2b90c8,
-	0x333d88,
-	0x6ef4da49,
-	0x2bbe09,
-	0x2bc746,
-	0x2be388,
-	0x2be589,
-	0x2c0206,
-	0x2c0385,
-	0x24e186,
-	0x2c0809,
-	0x2d7ec7,
-	0x255206,
-	0x358587,
-	0x358e47,
-	0x3a0e04,
-	0x6f3e4e09,
-	0x2d4208,
-	0x2c5148,
-	0x38d2c7,
-	0x2e1e06,
-	0x3cc189,
-	0x2db7c7,
-	0x3af3ca,
-	0x3edb48,
-	0x2775c7,
-	0x2e4d86,
-	0x3e0d4a,
-	0x347c08,
-	0x2e8845,
-	0x2ba485,
-	0x30a547,
-	0x318549,
-	0x318a4b,
-	0x32ee08,
-	0x3dc5c9,
-	0x25bc07,
-	0x2ced4c,
-	0x2cf24c,
-	0x2cf54a,
-	0x2cf7cc,
-	0x2db088,
-	0x2db288,
-	0x2db484,
-	0x2dc889,
-	0x2dcac9,
-	0x2dcd0a,
-	0x2dcf89,
-	0x2dd307,
-	0x3c5bcc,
-	0x3cab06,
-	0x279dc8,
-	0x278386,
-	0x318406,
-	0x384447,
-	0x396bc8,
-	0x38bf8b,
-	0x2fc0c7,
-	0x2647c9,
-	0x2683c9,
-	0x28f507,
-	0x2db744,
-	0x267307,
-	0x2e9246,
-	0x213f86,
-	0x34ff05,
-	0x230e48,
-	0x35da44,
-	0x35da46,
-	0x26408b,
-	0x2aac09,
-	0x2379c6,
-	0x226509,
-	0x20d6c6,
-	0x3878c8,
-	0x207783,
-	0x3b1cc5,
-	0x2140c9,
-	0x205805,
-	0x2fa4c4,
-	0x244946,
-	0x27db85,
-	0x260806,
-	0x323007,
-	0x3beec6,
-	0x234bcb,
-	0x27b187,
-	0x289046,
-	0x292e46,
-	0x22f0c6,
-	0x3edf09,
-	0x2b3d4a,
-	0x36d405,
-	0x24514d,
-	0x2b5a06,
-	0x2d00c6,
-	0x3a7546,
-	0x222405,
-	0x2f94c7,
-	0x27c247,
-	0x31bcce,
-	0x206543,
-	0x2e1dc9,
-	0x3a2949,
-	0x313ec7,
-	0x27c707,
-	0x2359c5,
-	0x37b205,
-	0x6f606c0f,
-	0x2e7ec7,
-	0x2e8088,
-	0x2e84c4,
-	0x2e8706,
-	0x6fa4a502,
-	0x2ec746,
-	0x2ee6c6,
-	0xf9d4c,
-	0x201b8e,
-	0x2e430a,
-	0x203bc6,
-	0x211b8a,
-	0x3cb2c9,
-	0x23b845,
-	0x371b48,
-	0x316446,
-	0x2c3dc8,
-	0x300308,
-	0x294b8b,
-	0x39da45,
-	0x276888,
-	0x20438c,
-	0x2df607,
-	0x256e06,
-	0x2e4ac8,
-	0x38bc48,
-	0x6fe12282,
-	0x3d4dcb,
-	0x34f589,
-	0x38b809,
-	0x207607,
-	0x3cbb88,
-	0x7023eb48,
-	0x332a8b,
-	0x254389,
-	0x266b4d,
-	0x34d4c8,
-	0x2d0708,
-	0x70601582,
-	0x21e7c4,
-	0x70a232c2,
-	0x36cf46,
-	0x70e0b7c2,
-	0x30794a,
-	0x26c006,
-	0x226b88,
-	0x250688,
-	0x261bc6,
-	0x2c46c6,
-	0x30db86,
-	0x21e3c5,
-	0x23bd04,
-	0x71387844,
-	0x35cbc6,
-	0x259ec7,
-	0x71688b47,
-	0x3979cb,
-	0x3c5a09,
-	0x2111ca,
-	0x38c504,
-	0x2dba88,
-	0x254fcd,
-	0x308249,
-	0x308488,
-	0x308709,
-	0x30a904,
-	0x241744,
-	0x283905,
-	0x3b644b,
-	0x2b9046,
-	0x35ca05,
-	0x390089,
-	0x3604c8,
-	0x267e84,
-	0x313c49,
-	0x234b05,
-	0x3340c8,
-	0x3daa07,
-	0x320d88,
-	0x290306,
-	0x3c1847,
-	0x2f2689,
-	0x33be49,
-	0x222c05,
-	0x257445,
-	0x71a1cd02,
-	0x34e404,
-	0x2f6345,
-	0x39d846,
-	0x383305,
-	0x25e8c7,
-	0x2daac5,
-	0x283e04,
-	0x3bdec6,
-	0x273607,
-	0x24a546,
-	0x3b2185,
-	0x20c888,
-	0x3cf585,
-	0x20f6c7,
-	0x21ce89,
-	0x2aad4a,
-	0x226707,
-	0x22670c,
-	0x227d06,
-	0x23f509,
-	0x247cc5,
-	0x2495c8,
-	0x215d43,
-	0x2c8a05,
-	0x2f5e05,
-	0x290b47,
-	0x71e00b82,
-	0x304207,
-	0x2dd686,
-	0x3e3406,
-	0x2e8586,
-	0x38bb86,
-	0x24cbc8,
-	0x2898c5,
-	0x35ffc7,
-	0x35ffcd,
-	0x24e583,
-	0x3cafc5,
-	0x277147,
-	0x304548,
-	0x276d05,
-	0x2198c8,
-	0x228a46,
-	0x316b87,
-	0x2f44c5,
-	0x39dac6,
-	0x39ab85,
-	0x3ccd4a,
-	0x2f6ac6,
-	0x2d7647,
-	0x227a05,
-	0x2f7f47,
-	0x2f8bc4,
-	0x2fa446,
-	0x371a85,
-	0x22c98b,
-	0x2e90c9,
-	0x38a8ca,
-	0x222c88,
-	0x30b588,
-	0x310b8c,
-	0x311407,
-	0x314788,
-	0x35c508,
-	0x36d085,
-	0x329a4a,
-	0x326409,
-	0x72201982,
-	0x2a0686,
-	0x230c84,
-	0x230c89,
-	0x2286c9,
-	0x319a47,
-	0x27f887,
-	0x285089,
-	0x2ccd48,
-	0x2ccd4f,
-	0x216b06,
-	0x2f048b,
-	0x25f9c5,
-	0x25f9c7,
-	0x354fc9,
-	0x224c86,
-	0x313bc7,
-	0x2f3b45,
-	0x2327c4,
-	0x3b6a46,
-	0x20dbc4,
-	0x2cd507,
-	0x339848,
-	0x727b1a48,
-	0x3c7005,
-	0x3e5247,
-	0x2d6849,
-	0x20e204,
-	0x246d48,
-	0x72b0a388,
-	0x2d0044,
-	0x300d08,
-	0x3c3b44,
-	0x3b7149,
-	0x3a7485,
-	0x72e3d942,
-	0x216b45,
-	0x2ea785,
-	0x33b788,
-	0x236b47,
-	0x732008c2,
-	0x3cc545,
-	0x2eafc6,
-	0x267b06,
-	0x34e3c8,
-	0x350c88,
-	0x3832c6,
-	0x383e86,
-	0x30eb09,
-	0x3e3346,
-	0x224b4b,
-	0x2ff3c5,
-	0x3a6586,
-	0x2ac848,
-	0x3023c6,
-	0x2a2346,
-	0x21b00a,
-	0x3a008a,
-	0x25ed45,
-	0x29b747,
-	0x283486,
-	0x736046c2,
-	0x277287,
-	0x3e4905,
-	0x30d204,
-	0x30d205,
-	0x2db986,
-	0x388947,
-	0x220c85,
-	0x228784,
-	0x2c6188,
-	0x2a2405,
-	0x2f5247,
-	0x336d05,
-	0x381785,
-	0x212784,
-	0x346609,
-	0x2c62c8,
-	0x249d86,
-	0x3aaac6,
-	0x33c3c6,
-	0x73b2d148,
-	0x30b407,
-	0x3915cd,
-	0x366a0c,
-	0x3e0089,
-	0x3e9109,
-	0x73f7d942,
-	0x3e8443,
-	0x228183,
-	0x2e9305,
-	0x3b030a,
-	0x344606,
-	0x3ec8c5,
-	0x323404,
-	0x32340b,
-	0x33910c,
-	0x339a4c,
-	0x339d55,
-	0x33c74d,
-	0x33eb8f,
-	0x33ef52,
-	0x33f3cf,
-	0x33f792,
-	0x33fc13,
-	0x3400cd,
-	0x34068d,
-	0x340a0e,
-	0x34130e,
-	0x34198c,
-	0x341d4c,
-	0x34218b,
-	0x342c0e,
-	0x343512,
-	0x3443cc,
-	0x3448d0,
-	0x351b12,
-	0x35278c,
-	0x352e4d,
-	0x35318c,
-	0x356491,
-	0x35780d,
-	0x359f8d,
-	0x35a58a,
-	0x35a80c,
-	0x35bc0c,
-	0x35c70c,
-	0x35d18c,
-	0x362213,
-	0x362c10,
-	0x363010,
-	0x36368d,
-	0x363c8c,
-	0x367009,
-	0x36914d,
-	0x369493,
-	0x36ba11,
-	0x36c213,
-	0x36d54f,
-	0x36d90c,
-	0x36dc0f,
-	0x36dfcd,
-	0x36e5cf,
-	0x36e990,
-	0x36f40e,
-	0x37564e,
-	0x375f90,
-	0x376acd,
-	0x37744e,
-	0x3777cc,
-	0x378693,
-	0x37abce,
-	0x37b710,
-	0x37bb11,
-	0x37bf4f,
-	0x37c313,
-	0x37d4cd,
-	0x37d80f,
-	0x37dbce,
-	0x37e150,
-	0x37e549,
-	0x37f9d0,
-	0x37fecf,
-	0x38054f,
-	0x380912,
-	0x38248e,
-	0x382f4d,
-	0x3835cd,
-	0x38390d,
-	0x38468d,
-	0x3849cd,
-	0x384d10,
-	0x38510b,
-	0x385b0c,
-	0x385e8c,
-	0x38648c,
-	0x38678e,
-	0x3947d0,
-	0x396512,
-	0x39698b,
-	0x396dce,
-	0x39714e,
-	0x39804e,
-	0x3985cb,
-	0x74398956,
-	0x39988d,
-	0x39a154,
-	0x39ae4d,
-	0x39d095,
-	0x39f28d,
-	0x39fc0f,
-	0x3a040f,
-	0x3a3acf,
-	0x3a3e8e,
-	0x3a420d,
-	0x3a5751,
-	0x3a8ecc,
-	0x3a91cc,
-	0x3a94cb,
-	0x3a978c,
-	0x3a9fcf,
-	0x3aa392,
-	0x3ab18d,
-	0x3ac3cc,
-	0x3acccc,
-	0x3acfcd,
-	0x3ad30f,
-	0x3ad6ce,
-	0x3affcc,
-	0x3b058d,
-	0x3b08cb,
-	0x3b154c,
-	0x3b268d,
-	0x3b29ce,
-	0x3b2d49,
-	0x3b3d13,
-	0x3b44cd,
-	0x3b4bcd,
-	0x3b51cc,
-	0x3b588e,
-	0x3b7c4f,
-	0x3b800c,
-	0x3b830d,
-	0x3b864f,
-	0x3b8a0c,
-	0x3b900c,
-	0x3b9d4c,
-	0x3ba04c,
-	0x3bac4d,
-	0x3baf92,
-	0x3bba0c,
-	0x3bbd0c,
-	0x3bc011,
-	0x3bc44f,
-	0x3bc80f,
-	0x3bcbd3,
-	0x3bf84e,
-	0x3bfbcf,
-	0x3bff8c,
-	0x747c064e,
-	0x3c09cf,
-	0x3c0d96,
-	0x3c44d2,
-	0x3c7a8c,
-	0x3c818f,
-	0x3c880d,
-	0x3df10f,
-	0x3df4cc,
-	0x3df7cd,
-	0x3dfb0d,
-	0x3e168e,
-	0x3e2b8c,
-	0x3e5b4c,
-	0x3e5e50,
-	0x3e77d1,
-	0x3e7c0b,
-	0x3e804c,
-	0x3e834e,
-	0x3e9651,
-	0x3e9a8e,
-	0x3e9e0d,
-	0x3efb4b,
-	0x3f0c4f,
-	0x3f1894,
-	0x2062c2,
-	0x2062c2,
-	0x204383,
-	0x2062c2,
-	0x204383,
-	0x2062c2,
-	0x203cc2,
-	0x24e1c5,
-	0x3e934c,
-	0x2062c2,
-	0x2062c2,
-	0x203cc2,
-	0x2062c2,
-	0x2a43c5,
-	0x2aad45,
-	0x2062c2,
-	0x2062c2,
-	0x20b782,
-	0x2a43c5,
-	0x33cd09,
-	0x36b70c,
-	0x2062c2,
-	0x2062c2,
-	0x2062c2,
-	0x2062c2,
-	0x24e1c5,
-	0x2062c2,
-	0x2062c2,
-	0x2062c2,
-	0x2062c2,
-	0x20b782,
-	0x33cd09,
-	0x2062c2,
-	0x2062c2,
-	0x2062c2,
-	0x2aad45,
-	0x2062c2,
-	0x2aad45,
-	0x36b70c,
-	0x3e934c,
-	0x250b03,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2503c4,
-	0x2109c3,
-	0x21f143,
-	0x29cf,
-	0x13b548,
-	0x74dc4,
-	0xe7008,
-	0x2000c2,
-	0x75602202,
-	0x2457c3,
-	0x2f1684,
-	0x203b43,
-	0x205504,
-	0x231386,
-	0x244843,
-	0x244804,
-	0x29ebc5,
-	0x206543,
-	0x2109c3,
-	0x21f143,
-	0x3b6f0a,
-	0x3a6386,
-	0x3974cc,
-	0x1b9688,
-	0x202202,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x23ddc3,
-	0x2ee6c6,
-	0x2109c3,
-	0x21f143,
-	0x219683,
-	0x1e143,
-	0xb7188,
-	0x761ed1c5,
-	0x7e607,
-	0x50085,
-	0x17947,
-	0x14cb05,
-	0xa2a04,
-	0xa2a0a,
-	0x2d89,
-	0x1ac2,
-	0x1c928a,
-	0x76fdd7c5,
-	0x14cb05,
-	0x342c7,
-	0x6208,
-	0x990e,
-	0x97852,
-	0x13608b,
-	0x11b586,
-	0x772d45c5,
-	0x776d45cc,
-	0x1e4a87,
-	0xf08c7,
-	0xdc24a,
-	0x3f150,
-	0x14dc45,
-	0xba94b,
-	0xc9a08,
-	0x38107,
-	0x12bccb,
-	0xa24c9,
-	0x4e387,
-	0x1d9d47,
-	0x1ccbc7,
-	0x38046,
-	0x7908,
-	0x77c34106,
-	0x5a307,
-	0x28b86,
-	0xbc84d,
-	0xdbc10,
-	0x78004c02,
-	0x1d9bc8,
-	0x193090,
-	0x1937cc,
-	0x787a4a0d,
-	0x6b4c8,
-	0x6bdcb,
-	0x7b6c7,
-	0x1030c9,
-	0x63646,
-	0xa3748,
-	0x17382,
-	0x6808a,
-	0x3eec7,
-	0xcc107,
-	0xb78c9,
-	0xbac08,
-	0x1c9f45,
-	0x6ba47,
-	0x116a86,
-	0x173cc6,
-	0x1097ce,
-	0x48bce,
-	0x5e44f,
-	0x80789,
-	0x1e0b49,
-	0xaf78b,
-	0xde04f,
-	0x19cd8c,
-	0xd844b,
-	0x129148,
-	0x1978c7,
-	0x1a51c8,
-	0xc1b0b,
-	0xc268c,
-	0xc2a8c,
-	0xc2e8c,
-	0xc318d,
-	0x1ba688,
-	0x7e5c2,
-	0x19a989,
-	0xa8ac8,
-	0xde94b,
-	0xe2006,
-	0xea8cb,
-	0x14304b,
-	0xf328a,
-	0xf4245,
-	0xf91d0,
-	0x100986,
-	0x1bf006,
-	0x1cf805,
-	0xd9807,
-	0x101048,
-	0x104ac7,
-	0x104d87,
-	0x172e07,
-	0x20286,
-	0x16cd8a,
-	0xb4c0a,
-	0x15d46,
-	0xcbecd,
-	0x5a3c8,
-	0x11d908,
-	0x126c9,
-	0x86009,
-	0xdd585,
-	0x167fcc,
-	0xc338b,
-	0x1f009,
-	0x118984,
-	0x119c09,
-	0x119e46,
-	0x13206,
-	0x2642,
-	0x54146,
-	0x8618b,
-	0x1260c7,
-	0x126287,
-	0x3642,
-	0xe3dc5,
-	0x6384,
-	0x101,
-	0x5c183,
-	0x77b60fc6,
-	0xd1fc3,
-	0x382,
-	0xe44,
-	0xb02,
-	0x14f04,
-	0x882,
-	0x8b82,
-	0x8a42,
-	0x69782,
-	0x1782,
-	0x21942,
-	0x3402,
-	0x1547c2,
-	0x31982,
-	0x54302,
-	0x23c2,
-	0x56442,
-	0x1f603,
-	0x942,
-	0x1342,
-	0xfd02,
-	0x8102,
-	0x642,
-	0x29602,
-	0x15fc2,
-	0x1442,
-	0x4142,
-	0x5c2,
-	0x11e43,
-	0x2b82,
-	0x4b02,
-	0x510c2,
-	0x6982,
-	0x6b42,
-	0x9582,
-	0x1a202,
-	0x2042,
-	0xec2,
-	0x194cc2,
-	0x7d202,
-	0x70c2,
-	0x109c3,
-	0x602,
-	0x12282,
-	0x1f42,
-	0x16282,
-	0x22b85,
-	0x4f82,
-	0x1a002,
-	0x1dea83,
-	0x682,
-	0x10002,
-	0x1042,
-	0x1a42,
-	0x10a82,
-	0x8c2,
-	0x5fc2,
-	0x2642,
-	0x2c45,
-	0x78a03cc2,
-	0x78f09343,
-	0x15c43,
-	0x79203cc2,
-	0x15c43,
-	0xe18c7,
-	0x20d5c3,
-	0x2000c2,
-	0x206643,
-	0x21f603,
-	0x3d6403,
-	0x2005c3,
-	0x23ddc3,
-	0x2109c3,
-	0x21d783,
-	0x21f143,
-	0x2d2003,
-	0x1a5643,
-	0x1a5644,
-	0x1797c6,
-	0xdd5c4,
-	0x100505,
-	0x10cf85,
-	0x1c36c3,
-	0x1b9688,
-	0x206643,
-	0x21f603,
-	0x3d6403,
-	0x206543,
-	0x2109c3,
-	0x21d783,
-	0x21f143,
-	0x206643,
-	0x21f603,
-	0x21f143,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x200181,
-	0x206543,
-	0x2109c3,
-	0x22b643,
-	0x21f143,
-	0x1df004,
-	0x250b03,
-	0x206643,
-	0x21f603,
-	0x2064c3,
-	0x3d6403,
-	0x290a43,
-	0x234503,
-	0x2b4843,
-	0x24c8c3,
-	0x205503,
-	0x2503c4,
-	0x2109c3,
-	0x21f143,
-	0x200f83,
-	0x3c7f44,
-	0x211103,
-	0x28c3,
-	0x24a643,
-	0x333648,
-	0x358c84,
-	0x20020a,
-	0x25efc6,
-	0xd6644,
-	0x3b4187,
-	0x22370a,
-	0x2169c9,
-	0x3c9107,
-	0x3ce48a,
-	0x250b03,
-	0x2afc8b,
-	0x379449,
-	0x386fc5,
-	0x3b9307,
-	0x2202,
-	0x206643,
-	0x26a5c7,
-	0x30fec5,
-	0x2db609,
-	0x1b98e,
-	0x21f603,
-	0x23bfc6,
-	0x2d96c3,
-	0xdd703,
-	0x122506,
-	0x1dd686,
-	0x1f4147,
-	0x2142c6,
-	0x21aa05,
-	0x2074c7,
-	0x2ef9c7,
-	0x7be05503,
-	0x3529c7,
-	0x285fc3,
-	0xba349,
-	0x301f85,
-	0x2503c4,
-	0x2c7bc8,
-	0x3e868c,
-	0x2c6ec5,
-	0x2b4446,
-	0x30fd87,
-	0x21f307,
-	0x289187,
-	0x289c08,
-	0x32118f,
-	0x3bec05,
-	0x205247,
-	0x2d4d07,
-	0x3d848a,
-	0x32aa49,
-	0x322645,
-	0x33390a,
-	0x1270c6,
-	0xcdd87,
-	0x2d9745,
-	0x3913c4,
-	0x345786,
-	0x1820c6,
-	0x393a47,
-	0x2dff87,
-	0x33ad48,
-	0x20f845,
-	0x26a4c6,
-	0x20508,
-	0x27d9c5,
-	0x26446,
-	0x271585,
-	0x243004,
-	0x240fc7,
-	0x24ca0a,
-	0x2add88,
-	0x205e46,
-	0x3ddc3,
-	0x2dfa85,
-	0x2b0006,
-	0x3c5e06,
-	0x201e46,
-	0x206543,
-	0x3ab407,
-	0xf9d4c,
-	0x2d4c85,
-	0x2109c3,
-	0x2f354d,
-	0x21d783,
-	0x33ae48,
-	0x27fc04,
-	0x2b0305,
-	0x2b5bc6,
-	0x3d4b46,
-	0x3a6487,
-	0x254247,
-	0x27e945,
-	0x21f143,
-	0x36ac87,
-	0x3a2e09,
-	0x323c89,
-	0x38934a,
-	0x207202,
-	0x301f44,
-	0x324444,
-	0x303f07,
-	0x3040c8,
-	0x305b49,
-	0x3cae89,
-	0x306787,
-	0x111609,
-	0x20bc06,
-	0x109546,
-	0x30a904,
-	0x22e98a,
-	0x30cbc8,
-	0x30da49,
-	0x30de86,
-	0x2cb145,
-	0x2adc48,
-	0x2e228a,
-	0x27b403,
-	0x335846,
-	0x306887,
-	0x35eb45,
-	0x82b48,
-	0x3bd345,
-	0x2130c3,
-	0x21bc04,
-	0x4d509,
-	0x2ba445,
-	0x2930c7,
-	0x2c6405,
-	0x2f0a46,
-	0x1062c5,
-	0x203c83,
-	0x203c89,
-	0x2b00cc,
-	0x2d720c,
-	0x3142c8,
-	0x2a3bc7,
-	0x3156c8,
-	0x115d07,
-	0x31688a,
-	0x316e8b,
-	0x379588,
-	0x3d4c48,
-	0x238746,
-	0x3e1985,
-	0x34710a,
-	0x2298c5,
-	0x23d942,
-	0x2e0f47,
-	0x28e506,
-	0x37f205,
-	0x318d09,
-	0x3be785,
-	0x1d7b08,
-	0x2bc385,
-	0x301b49,
-	0x32bac6,
-	0x3d3a08,
-	0x2b03c3,
-	0x20b586,
-	0x244886,
-	0x3256c5,
-	0x3256c9,
-	0x283f09,
-	0x2699c7,
-	0x129804,
-	0x329807,
-	0x3cad89,
-	0x223905,
-	0x3be08,
-	0x3c2b85,
-	0x3d5685,
-	0x3dbc09,
-	0x206182,
-	0x30f5c4,
-	0x201602,
-	0x202b82,
-	0x2ecd85,
-	0x3258c8,
-	0x2cdb45,
-	0x2dd4c3,
-	0x2dd4c5,
-	0x2ec943,
-	0x20a702,
-	0x22b384,
-	0x203283,
-	0x206702,
-	0x3017c4,
-	0x31dac3,
-	0x20bc02,
-	0x254b83,
-	0x215cc4,
-	0x30e003,
-	0x259444,
-	0x204842,
-	0x219583,
-	0x211c83,
-	0x203c82,
-	0x2b26c2,
-	0x283d49,
-	0x207482,
-	0x298684,
-	0x202002,
-	0x261644,
-	0x20bbc4,
-	0x30ba04,
-	0x202642,
-	0x238382,
-	0x22ddc3,
-	0x31f043,
-	0x2cd7c4,
-	0x306a04,
-	0x329984,
-	0x333804,
-	0x325043,
-	0x3705c3,
-	0x327044,
-	0x32b344,
-	0x32b486,
-	0x3d5502,
-	0x2202,
-	0x47183,
-	0x202202,
-	0x21f603,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x36c5,
-	0x2000c2,
-	0x250b03,
-	0x206643,
-	0x21f603,
-	0x204d03,
-	0x205503,
-	0x2503c4,
-	0x284004,
-	0x294744,
-	0x2109c3,
-	0x21f143,
-	0x219683,
-	0x30af04,
-	0x330b03,
-	0x2375c3,
-	0x383204,
-	0x3c2986,
-	0x207843,
-	0x14cb05,
-	0xf08c7,
-	0x21dac3,
-	0x7da24f08,
-	0x2534c3,
-	0x2c90c3,
-	0x2721c3,
-	0x23ddc3,
-	0x3cc445,
-	0x1b92c3,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x26a483,
-	0x202bc3,
-	0x1b9688,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x211e43,
-	0x2109c3,
-	0x262784,
-	0x21f143,
-	0x2b2b44,
-	0x14cb05,
-	0x31af85,
-	0xf08c7,
-	0x202202,
-	0x201482,
-	0x200382,
-	0x2018c2,
-	0x2003c2,
-	0x1d4644,
-	0x206643,
-	0x2392c4,
-	0x21f603,
-	0x205503,
-	0x206543,
-	0x2109c3,
-	0x21f143,
-	0x1b9688,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x206543,
-	0x294744,
-	0x2109c3,
-	0x21f143,
-	0x213dc3,
-	0x214f04,
-	0x1b9688,
-	0x206643,
-	0x21d783,
-	0x1c36c3,
-	0x12d9c4,
-	0x204884,
-	0x14cb05,
-	0x1b9688,
-	0x2202,
-	0x206643,
-	0x2554c4,
-	0x2503c4,
-	0x21d783,
-	0x201582,
-	0x21f143,
-	0x237343,
-	0x1bc04,
-	0x33d845,
-	0x23d942,
-	0x3de983,
-	0x1a2389,
-	0xf1506,
-	0x84b08,
-	0x2000c2,
-	0x1b9688,
-	0x80fbed87,
-	0x202202,
-	0x21f603,
-	0x205503,
-	0x2005c2,
-	0x21f143,
-	0x8f42,
-	0x82,
-	0x1bc04,
-	0xc2,
-	0x1ce647,
-	0x172c9,
-	0x27c3,
-	0x1b9688,
-	0x69743,
-	0x8172e887,
-	0x6643,
-	0xa0588,
-	0x1f603,
-	0x58287,
-	0x5503,
-	0x3fa46,
-	0x11e43,
-	0x42308,
-	0xd89c8,
-	0x1d1483,
-	0x87f86,
-	0x81937585,
-	0x1db6c5,
-	0x6543,
-	0x9b108,
-	0xe5dc8,
-	0x5e943,
-	0x81cf4f06,
-	0xfa885,
-	0x1a2b04,
-	0x36287,
-	0x109c3,
-	0x4643,
-	0x1f143,
-	0x15c82,
-	0x18fd0a,
-	0x20e43,
-	0x8220ff4c,
-	0xcdc03,
-	0x150c4,
-	0x120f8b,
-	0x121548,
-	0x9cfc2,
-	0x123b43,
-	0x1410087,
-	0x153b287,
-	0x151a888,
-	0x1523b43,
-	0x1c7448,
-	0x14e9e04,
-	0xfd6cb,
-	0xd842,
-	0x137a47,
-	0x14e6c4,
-	0xf0c87,
-	0x2000c2,
-	0x202202,
-	0x2392c4,
-	0x205503,
-	0x206543,
-	0x2109c3,
-	0x21f143,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x23ddc3,
-	0x2109c3,
-	0x21f143,
-	0x220283,
-	0x213dc3,
-	0x1e143,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x1c36c3,
-	0x1f603,
-	0x84405503,
-	0x7e607,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2503c4,
-	0x23ddc3,
-	0x2109c3,
-	0x21f143,
-	0x224b42,
-	0x2000c1,
-	0x2000c2,
-	0x200201,
-	0x33ec82,
-	0x1b9688,
-	0x220a05,
-	0x200101,
-	0x6643,
-	0x32184,
-	0x200cc1,
-	0x200501,
-	0x200bc1,
-	0x24e142,
-	0x38f644,
-	0x24e143,
-	0x200041,
-	0x200801,
-	0x200181,
-	0x2dac6,
-	0x1e3c4c,
-	0x200701,
-	0x368ec7,
-	0x30524f,
-	0x3e53c6,
-	0x2004c1,
-	0x32da06,
-	0x200ec1,
-	0xf9d4c,
-	0x200581,
-	0x3b8c8e,
-	0x2003c1,
-	0x21f143,
-	0x201401,
-	0x852cbdc4,
-	0x241c05,
-	0x215c82,
-	0x212fc5,
-	0x200401,
-	0x200741,
-	0x2007c1,
-	0x23d942,
-	0x200081,
-	0x200f81,
-	0x208f81,
-	0x205381,
-	0x201841,
-	0x5682,
-	0x5c4c9,
-	0x1b9688,
-	0x206643,
-	0x21f603,
-	0x58a88,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x219dc3,
-	0x1f2c83,
-	0x206643,
-	0x205503,
-	0x9cf08,
-	0x206543,
-	0x2109c3,
-	0x3d43,
-	0x21f143,
-	0x863ddc88,
-	0x1f2943,
-	0x1d7808,
-	0x886c2,
-	0x29c3,
-	0x4c02,
-	0x2642,
-	0x14cb05,
-	0x1b9688,
-	0x9da06,
-	0x13b947,
-	0x14cb05,
-	0xfc204,
-	0x1593348,
-	0x4afc4,
-	0x12ae87,
-	0x614c4,
-	0x5044c,
-	0x1e8684,
-	0x66545,
-	0x5c4c9,
-	0x1a7f47,
-	0xd0b08,
-	0x2b6c6,
-	0x1c208a,
-	0x15e71ca,
-	0x130e44,
-	0x1582cc3,
-	0x1b9688,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2109c3,
-	0x21f143,
-	0x2028c3,
-	0x1b9688,
-	0x206643,
-	0x21f603,
-	0x2e4084,
-	0x21f143,
-	0x29f085,
-	0x277a04,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x200ec2,
-	0x2109c3,
-	0x21f143,
-	0x13dc3,
-	0x1568c05,
-	0xf2f86,
-	0xc1504,
-	0x12d506,
-	0x250b03,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x200ec2,
-	0x2109c3,
-	0x21f143,
-	0x213dc3,
-	0x202202,
-	0x206643,
-	0x232709,
-	0x21f603,
-	0x2bb789,
-	0x206543,
-	0x2109c3,
-	0x88a84,
-	0x21f143,
-	0x30a708,
-	0x238c07,
-	0x33d845,
-	0xa0cc6,
-	0x133f48,
-	0x13d849,
-	0x1e7e48,
-	0x1ce647,
-	0x10434a,
-	0x76acb,
-	0x12dc47,
-	0x43e08,
-	0x1e4c4a,
-	0xc8748,
-	0x172c9,
-	0x28907,
-	0x1b6307,
-	0x1bda88,
-	0xa0588,
-	0x46a4f,
-	0xadf45,
-	0xa0887,
-	0x3fa46,
-	0x1ec787,
-	0x122786,
-	0x42308,
-	0xfb006,
-	0x1d3807,
-	0x1458c9,
-	0x1b79c7,
-	0x1c7849,
-	0xce6c9,
-	0xd63c6,
-	0xd89c8,
-	0x134205,
-	0x7628a,
-	0xe5dc8,
-	0x5e943,
-	0xecbc8,
-	0x36287,
-	0xfe605,
-	0x162810,
-	0x4643,
-	0x1af247,
-	0x16205,
-	0x105088,
-	0xfff05,
-	0xcdc03,
-	0xfd948,
-	0x1d53c6,
-	0x5e249,
-	0xbe787,
-	0x1a264b,
-	0x118384,
-	0x119804,
-	0x120f8b,
-	0x121548,
-	0x122407,
-	0x14cb05,
-	0x206643,
-	0x21f603,
-	0x3d6403,
-	0x21f143,
-	0x243143,
-	0x205503,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x206543,
-	0x2109c3,
-	0x21f143,
-	0xaf8cb,
-	0x2000c2,
-	0x202202,
-	0x21f143,
-	0x3402,
-	0xec2,
-	0xf82,
-	0x1b9688,
-	0x132d09,
-	0x1c7448,
-	0x2202,
-	0x2000c2,
-	0x202202,
-	0x200382,
-	0x2005c2,
-	0x201e42,
-	0x2109c3,
-	0x12246,
-	0x2003c2,
-	0x1bc04,
-	0x2000c2,
-	0x250b03,
-	0x202202,
-	0x206643,
-	0x21f603,
-	0x200382,
-	0x205503,
-	0x211e43,
-	0x206543,
-	0x294744,
-	0x2109c3,
-	0x20f4c3,
-	0x21f143,
-	0x2150c4,
-	0x200f83,
-	0x205503,
-	0x202202,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x206543,
-	0x2109c3,
-	0x21d783,
-	0x21f143,
-	0x206643,
-	0x214183,
-	0x20fd03,
-	0x205503,
-	0x2036c3,
-	0x2503c4,
-	0x296584,
-	0x321b86,
-	0x241f83,
-	0x2109c3,
-	0x1dd9cb,
-	0x21f143,
-	0x29f085,
-	0x2e0f47,
-	0x1d6c43,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x206543,
-	0x2109c3,
-	0x1bd404,
-	0x21f143,
-	0x19a43,
-	0x8db1300c,
-	0xdb903,
-	0x71bc7,
-	0x862c6,
-	0x1db74c,
-	0xd9807,
-	0x1d3f85,
-	0x210342,
-	0x25a503,
-	0x2d9c43,
-	0x250b03,
-	0x8ea06643,
-	0x211ac2,
-	0x21f603,
-	0x203b43,
-	0x205503,
-	0x2503c4,
-	0x33c683,
-	0x3bec03,
-	0x206543,
-	0x294744,
-	0x8ee024c2,
-	0x2109c3,
-	0x21f143,
-	0x2330c3,
-	0x206383,
-	0x210a43,
-	0x224b42,
-	0x200f83,
-	0x1b9688,
-	0x205503,
-	0x1c36c3,
-	0x22ffc4,
-	0x250b03,
-	0x202202,
-	0x206643,
-	0x2392c4,
-	0x21f603,
-	0x205503,
-	0x2503c4,
-	0x211e43,
-	0x221e44,
-	0x228f84,
-	0x2ee6c6,
-	0x294744,
-	0x2109c3,
-	0x21f143,
-	0x219683,
-	0x28e506,
-	0x3c90b,
-	0x34106,
-	0x436ca,
-	0x126b8a,
-	0x1b9688,
-	0x2204c4,
-	0x90206643,
-	0x34d284,
-	0x21f603,
-	0x212804,
-	0x205503,
-	0x325e03,
-	0x206543,
-	0x2109c3,
-	0x21f143,
-	0x1ccc3,
-	0x34ec4b,
-	0x3dfe4a,
-	0x3f244c,
-	0xf5848,
-	0x2000c2,
-	0x202202,
-	0x200382,
-	0x22f2c5,
-	0x2503c4,
-	0x200ec2,
-	0x206543,
-	0x228f84,
-	0x2018c2,
-	0x2003c2,
-	0x202ec2,
-	0x224b42,
-	0x50b03,
-	0x2dc2,
-	0x2da309,
-	0x27ad48,
-	0x239b49,
-	0x3a0c49,
-	0x203e8a,
-	0x21898a,
-	0x205202,
-	0x3547c2,
-	0x2202,
-	0x206643,
-	0x22dd42,
-	0x246946,
-	0x330c02,
-	0x41402,
-	0x200d82,
-	0x276e4e,
-	0x2195ce,
-	0x210947,
-	0x211742,
-	0x21f603,
-	0x205503,
-	0x20c7c2,
-	0x2005c2,
-	0xfc83,
-	0x2394cf,
-	0x246c82,
-	0x2c6007,
-	0x2cac07,
-	0x32efc7,
-	0x2ca4cc,
-	0x2e010c,
-	0x22fc84,
-	0x28374a,
-	0x219502,
-	0x206982,
-	0x2d0244,
-	0x200702,
-	0x237b42,
-	0x2e0344,
-	0x217702,
-	0x206b42,
-	0xf743,
-	0x2fb087,
-	0x2e4c85,
-	0x21a202,
-	0x31e584,
-	0x394cc2,
-	0x2f5088,
-	0x2109c3,
-	0x37f588,
-	0x201f82,
-	0x22fe45,
-	0x39e446,
-	0x21f143,
-	0x204f82,
-	0x305d87,
-	0x15c82,
-	0x24c4c5,
-	0x34d785,
-	0x2010c2,
-	0x23f602,
-	0x29430a,
-	0x27e7ca,
-	0x27ccc2,
-	0x2ad344,
-	0x202a42,
-	0x301e08,
-	0x218182,
-	0x3d2048,
-	0x1101,
-	0x31b347,
-	0x31c049,
-	0x24c542,
-	0x322f85,
-	0x275c45,
-	0x20f90b,
-	0x32210c,
-	0x22d408,
-	0x337888,
-	0x3d5502,
-	0x29e002,
-	0x2000c2,
-	0x1b9688,
-	0x202202,
-	0x206643,
-	0x200382,
-	0x2018c2,
-	0x2003c2,
-	0x21f143,
-	0x202ec2,
-	0x2000c2,
-	0x14cb05,
-	0x91602202,
-	0x113144,
-	0x3ab05,
-	0x92605503,
-	0xbd504,
-	0x20f743,
-	0x200ec2,
-	0x2109c3,
-	0x381cc3,
-	0x92a1f143,
-	0x303643,
-	0x2e19c6,
-	0x191445,
-	0x1613dc3,
-	0x1a2885,
-	0x14cb05,
-	0x14eecb,
-	0x1b9688,
-	0x91b3c4c8,
-	0x671c7,
-	0x91ed240a,
-	0xf9d4c,
-	0x1b9487,
-	0x1cf805,
-	0x92391889,
-	0x206cd,
-	0x3f842,
-	0x121b42,
-	0xc01,
-	0xfbc44,
-	0xb7d8a,
-	0x7e607,
-	0x13550f,
-	0x16f44,
-	0x16f83,
-	0x16f84,
-	0x6a08b,
-	0xa74d,
-	0x9320bb02,
-	0x93600b02,
-	0x93a028c2,
-	0x93e01942,
-	0x94203602,
-	0x94601782,
-	0xf08c7,
-	0x94a02202,
-	0x94e11802,
-	0x95223fc2,
-	0x956023c2,
-	0x2195c3,
-	0x1b7344,
-	0x95a58a88,
-	0x22df83,
-	0x95e13f42,
-	0x6b4c8,
-	0x96208782,
-	0x55dc7,
-	0x1b5407,
-	0x96600042,
-	0x96a03582,
-	0x96e00182,
-	0x97203042,
-	0x97604142,
-	0x97a005c2,
-	0x186f05,
-	0x2136c3,
-	0x3bf3c4,
-	0x97e00702,
-	0x98239842,
-	0x98604fc2,
-	0x93e0b,
-	0x98a05e42,
-	0x9924e442,
-	0x99600ec2,
-	0x99a01e42,
-	0x9b108,
-	0x99e1cf02,
-	0x9a201502,
-	0x9a603c42,
-	0x9aa7d202,
-	0x9ae024c2,
-	0x9b203702,
-	0x9b6018c2,
-	0x9ba1e342,
-	0x9be0abc2,
-	0x9c239e02,
-	0x11ff04,
-	0x3ccd03,
-	0x9c636d82,
-	0x9ca06782,
-	0x9ce0aec2,
-	0x9d2006c2,
-	0x9d6003c2,
-	0x9da06702,
-	0x102ac8,
-	0xafa47,
-	0x9de02b02,
-	0x9e202b42,
-	0x9e602ec2,
-	0x9ea06ec2,
-	0x167fcc,
-	0x9ee01b42,
-	0x9f2272c2,
-	0x9f616002,
-	0x9fa046c2,
-	0x9fe0c302,
-	0xa0204002,
-	0xa06059c2,
-	0xa0a03dc2,
-	0xa0e84e42,
-	0xa1285782,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x1a403,
-	0xd58c3,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x98f3c683,
-	0x21a403,
-	0x3cc4c4,
-	0x239a46,
-	0x30e543,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x3de009,
-	0x202dc2,
-	0x3dbe43,
-	0x2ce9c3,
-	0x33b705,
-	0x203b43,
-	0x33c683,
-	0x21a403,
-	0x2eddc3,
-	0x240b83,
-	0x221649,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0x202dc2,
-	0x202dc2,
-	0x33c683,
-	0x21a403,
-	0xa1a06643,
-	0x21f603,
-	0x3a0e83,
-	0x206543,
-	0x2109c3,
-	0x21f143,
-	0x1b9688,
-	0x202202,
-	0x206643,
-	0x2109c3,
-	0x21f143,
-	0x14b742,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0xa2500dc2,
-	0x206543,
-	0x2109c3,
-	0x21f143,
-	0xcc1,
-	0x204884,
-	0x266483,
-	0x202202,
-	0x206643,
-	0x3a3183,
-	0x21f603,
-	0x2554c4,
-	0x3d6403,
-	0x205503,
-	0x2503c4,
-	0x211e43,
-	0x206543,
-	0x2109c3,
-	0x21d783,
-	0x21f143,
-	0x235ec3,
-	0x237343,
-	0x33d845,
-	0x240b83,
-	0x200f83,
-	0x882,
-	0x202202,
-	0x206643,
-	0x33c683,
-	0x2109c3,
-	0x21f143,
-	0x2000c2,
-	0x250b03,
-	0x1b9688,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x231386,
-	0x2503c4,
-	0x211e43,
-	0x294744,
-	0x2109c3,
-	0x21f143,
-	0x219683,
-	0x6204,
-	0x1547c2,
-	0x206643,
-	0x1a6c3,
-	0x21f603,
-	0xec2,
-	0x2109c3,
-	0x21f143,
-	0x72e84,
-	0x72544,
-	0xc642,
-	0x158a7c7,
-	0x7247,
-	0x206643,
-	0x34106,
-	0x21f603,
-	0x205503,
-	0xf7e06,
-	0x2109c3,
-	0x21f143,
-	0x3334c8,
-	0x3376c9,
-	0x348109,
-	0x350ac8,
-	0x3a0a88,
-	0x3a0a89,
-	0x32e0ca,
-	0x3649ca,
-	0x39b18a,
-	0x3a1a4a,
-	0x3dfe4a,
-	0x3ebacb,
-	0x24144d,
-	0x242a0f,
-	0x246150,
-	0x36894d,
-	0x38618c,
-	0x3a178b,
-	0x1784c7,
-	0x13098e,
-	0x13530a,
-	0x1373c9,
-	0x148109,
-	0x167709,
-	0x16794a,
-	0x171749,
-	0x1724c9,
-	0x172fcb,
-	0x6208,
-	0x100c08,
-	0x1709,
-	0x1495607,
-	0xe3dc5,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x206543,
-	0x2109c3,
-	0x21f143,
-	0x1f143,
-	0x2000c2,
-	0x3be445,
-	0x206b03,
-	0xa6a02202,
-	0x21f603,
-	0x205503,
-	0x3907c7,
-	0x2721c3,
-	0x206543,
-	0x2109c3,
-	0x22b643,
-	0x20f4c3,
-	0x2034c3,
-	0x21d783,
-	0x21f143,
-	0x3a6386,
-	0x23d942,
-	0x200f83,
-	0x1b9688,
-	0x2000c2,
-	0x250b03,
-	0x202202,
-	0x206643,
-	0x21f603,
-	0x205503,
-	0x2503c4,
-	0x206543,
-	0x2109c3,
-	0x21f143,
-	0x213dc3,
-	0x7247,
-	0xd842,
-	0x13ad44,
-	0x1544746,
-	0x2000c2,
-	0x202202,
-	0x205503,
-	0x206543,
-	0x21f143,
-}
-
-// children is the list of nodes' children, the parent's wildcard bit and the
-// parent's node type. If a node has no children then their children index
-// will be in the range [0, 6), depending on the wildcard bit and node type.
+// If gen.go is run with the -comments flag, it prints each node to stderr.
+// The node's index is followed by the indexes of its children, formatted as
+// (n0x1234-n0x1256), with * denoting the wildcard bit. The nodeType is
+// printed as + for normal, ! for exception, and o for parent-only nodes that
+// have children but don't match a domain label in their own right. An I
+// denotes an ICANN domain.
 //
-// The layout within the uint32, from MSB to LSB, is:
-//	[ 1 bits] unused
-//	[ 1 bits] wildcard bit
-//	[ 2 bits] node type
-//	[14 bits] high nodes index (exclusive) of children
-//	[14 bits] low nodes index (inclusive) of children
-var children = [...]uint32{
-	0x0,
-	0x10000000,
-	0x20000000,
-	0x40000000,
-	0x50000000,
-	0x60000000,
-	0x179c5e0,
-	0x17a05e7,
-	0x17a45e8,
-	0x17c45e9,
-	0x191c5f1,
-	0x1930647,
-	0x194464c,
-	0x1958651,
-	0x1974656,
-	0x199865d,
-	0x19b0666,
-	0x1a0066c,
-	0x1a04680,
-	0x1a3c681,
-	0x1a4068f,
-	0x1a58690,
-	0x1a5c696,
-	0x1a60697,
-	0x1aa4698,
-	0x1aa86a9,
-	0x1aac6aa,
-	0x21ab06ab,
-	0x61ab86ac,
-	0x21ac06ae,
-	0x1b086b0,
-	0x1b146c2,
-	0x21b186c5,
-	0x1b3c6c6,
-	0x1b406cf,
-	0x1b546d0,
-	0x1b586d5,
-	0x1b786d6,
-	0x1ba86de,
-	0x1bc86ea,
-	0x1bd06f2,
-	0x1bf86f4,
-	0x1c146fe,
-	0x21c18705,
-	0x21c1c706,
-	0x1c20707,
-	0x1cb8708,
-	0x1ccc72e,
-	0x1ce0733,
-	0x1d18738,
-	0x1d28746,
-	0x1d3c74a,
-	0x1d5474f,
-	0x1df8755,
-	0x202c77e,
-	0x203480b,
-	0x2203880d,
-	0x2203c80e,
-	0x20a880f,
-	0x211482a,
-	0x212c845,
-	0x214084b,
-	0x2144850,
-	0x2148851,
-	0x2150852,
-	0x2168854,
-	0x216c85a,
-	0x218885b,
-	0x21dc862,
-	0x21e0877,
-	0x221e4878,
-	0x2208879,
-	0x2220c882,
-	0x2210883,
-	0x2214884,
-	0x2244885,
-	0x62248891,
-	0x22250892,
-	0x22254894,
-	0x2298895,
-	0x6229c8a6,
-	0x22b08a7,
-	0x23108ac,
-	0x223148c4,
-	0x223188c5,
-	0x223208c6,
-	0x223248c8,
-	0x223288c9,
-	0x232c8ca,
-	0x23348cb,
-	0x23388cd,
-	0x223448ce,
-	0x2234c8d1,
-	0x235c8d3,
-	0x236c8d7,
-	0x24208db,
-	0x2424908,
-	0x22434909,
-	0x2243890d,
-	0x2244090e,
-	0x249c910,
-	0x24a0927,
-	0x24a4928,
-	0x24a8929,
-	0x2a9c92a,
-	0x2aa0aa7,
-	0x22b48aa8,
-	0x22b4cad2,
-	0x22b50ad3,
-	0x22b5cad4,
-	0x22b60ad7,
-	0x22b6cad8,
-	0x22b70adb,
-	0x22b74adc,
-	0x22b78add,
-	0x22b7cade,
-	0x22b80adf,
-	0x22b8cae0,
-	0x22b90ae3,
-	0x22b9cae4,
-	0x22ba0ae7,
-	0x22ba4ae8,
-	0x22ba8ae9,
-	0x22bb4aea,
-	0x22bb8aed,
-	0x22bc4aee,
-	0x22bc8af1,
-	0x22bccaf2,
-	0x22bd0af3,
-	0x2bd4af4,
-	0x22bd8af5,
-	0x22be4af6,
-	0x22be8af9,
-	0x2becafa,
-	0x2bf4afb,
-	0x62c00afd,
-	0x22c08b00,
-	0x2c4cb02,
-	0x22c6cb13,
-	0x22c70b1b,
-	0x22c74b1c,
-	0x22c7cb1d,
-	0x22c84b1f,
-	0x22c88b21,
-	0x22c8cb22,
-	0x22c94b23,
-	0x22c98b25,
-	0x22c9cb26,
-	0x22ca0b27,
-	0x2ca4b28,
-	0x22cd0b29,
-	0x22cd4b34,
-	0x22cd8b35,
-	0x2cdcb36,
-	0x22ce0b37,
-	0x22ce4b38,
-	0x22cf0b39,
-	0x22cf4b3c,
-	0x2cf8b3d,
-	0x2d00b3e,
-	0x2d0cb40,
-	0x2d14b43,
-	0x2d30b45,
-	0x2d48b4c,
-	0x2d60b52,
-	0x2d70b58,
-	0x2d7cb5c,
-	0x2db0b5f,
-	0x2db8b6c,
-	0x22dbcb6e,
-	0x2dd4b6f,
-	0x22ddcb75,
-	0x22de0b77,
-	0x22de8b78,
-	0x2ef8b7a,
-	0x22efcbbe,
-	0x2f04bbf,
-	0x2f08bc1,
-	0x22f0cbc2,
-	0x22f10bc3,
-	0x22f14bc4,
-	0x2f18bc5,
-	0x2f64bc6,
-	0x2f68bd9,
-	0x2f6cbda,
-	0x2f88bdb,
-	0x2f9cbe2,
-	0x2fc4be7,
-	0x2fecbf1,
-	0x2ff0bfb,
-	0x62ff4bfc,
-	0x3024bfd,
-	0x3028c09,
-	0x2302cc0a,
-	0x3030c0b,
-	0x3058c0c,
-	0x305cc16,
-	0x3080c17,
-	0x3084c20,
-	0x309cc21,
-	0x30a0c27,
-	0x30a4c28,
-	0x30c4c29,
-	0x30e4c31,
-	0x230e8c39,
-	0x30ecc3a,
-	0x230f0c3b,
-	0x30f4c3c,
-	0x30f8c3d,
-	0x30fcc3e,
-	0x3100c3f,
-	0x3120c40,
-	0x23124c48,
-	0x2312cc49,
-	0x3130c4b,
-	0x3158c4c,
-	0x316cc56,
-	0x31ecc5b,
-	0x31f4c7b,
-	0x31f8c7d,
-	0x3214c7e,
-	0x322cc85,
-	0x3230c8b,
-	0x3244c8c,
-	0x325cc91,
-	0x3278c97,
-	0x3290c9e,
-	0x329cca4,
-	0x32b8ca7,
-	0x32d0cae,
-	0x32d4cb4,
-	0x32fccb5,
-	0x331ccbf,
-	0x3338cc7,
-	0x333ccce,
-	0x33a0ccf,
-	0x33bcce8,
-	0x33e4cef,
-	0x33e8cf9,
-	0x3400cfa,
-	0x3444d00,
-	0x34c4d11,
-	0x3504d31,
-	0x3508d41,
-	0x350cd42,
-	0x3518d43,
-	0x3538d46,
-	0x3544d4e,
-	0x3564d51,
-	0x356cd59,
-	0x35b0d5b,
-	0x3604d6c,
-	0x3608d81,
-	0x371cd82,
-	0x23724dc7,
-	0x23728dc9,
-	0x2372cdca,
-	0x23730dcb,
-	0x23734dcc,
-	0x23738dcd,
-	0x2373cdce,
-	0x23740dcf,
-	0x3744dd0,
-	0x3748dd1,
-	0x2374cdd2,
-	0x2375cdd3,
-	0x23764dd7,
-	0x2376cdd9,
-	0x23770ddb,
-	0x23778ddc,
-	0x2377cdde,
-	0x23780ddf,
-	0x3798de0,
-	0x37bcde6,
-	0x37dcdef,
-	0x3e54df7,
-	0x23e58f95,
-	0x23e5cf96,
-	0x23e60f97,
-	0x23e64f98,
-	0x3e74f99,
-	0x3e94f9d,
-	0x4054fa5,
-	0x4125015,
-	0x4195049,
-	0x41ed065,
-	0x42d507b,
-	0x432d0b5,
-	0x43690cb,
-	0x44650da,
-	0x4531119,
-	0x45c914c,
-	0x4659172,
-	0x46bd196,
-	0x48f51af,
-	0x49ad23d,
-	0x4a7926b,
-	0x4ac529e,
-	0x4b4d2b1,
-	0x4b892d3,
-	0x4bd92e2,
-	0x4c512f6,
-	0x64c55314,
-	0x64c59315,
-	0x64c5d316,
-	0x4cd9317,
-	0x4d35336,
-	0x4db134d,
-	0x4e2936c,
-	0x4ea938a,
-	0x4f153aa,
-	0x50413c5,
-	0x5099410,
-	0x6509d426,
-	0x5135427,
-	0x513d44d,
-	0x2514144f,
-	0x51c9450,
-	0x5215472,
-	0x527d485,
-	0x532549f,
-	0x53ed4c9,
-	0x54554fb,
-	0x5569515,
-	0x6556d55a,
-	0x6557155b,
-	0x55cd55c,
-	0x5629573,
-	0x56b958a,
-	0x57355ae,
-	0x57795cd,
-	0x585d5de,
-	0x5891617,
-	0x58f1624,
-	0x596563c,
-	0x59ed659,
-	0x5a2d67b,
-	0x5a9d68b,
-	0x65aa16a7,
-	0x5ac56a8,
-	0x5ac96b1,
-	0x5af96b2,
-	0x5b156be,
-	0x5b596c5,
-	0x5b696d6,
-	0x5b816da,
-	0x5bf96e0,
-	0x5c016fe,
-	0x5c1d700,
-	0x5c31707,
-	0x5c5170c,
-	0x25c55714,
-	0x5c7d715,
-	0x5c8171f,
-	0x5c89720,
-	0x5c9d722,
-	0x5cb9727,
-	0x5cc172e,
-	0x5ccd730,
-	0x5cd1733,
-	0x5d0d734,
-	0x5d11743,
-	0x5d19744,
-	0x5d2d746,
-	0x5d5574b,
-	0x5d5d755,
-	0x5d61757,
-	0x5d85758,
-	0x5da9761,
-	0x5dc176a,
-	0x5dc5770,
-	0x5dcd771,
-	0x5dd5773,
-	0x5de9775,
-	0x5ea177a,
-	0x5ea57a8,
-	0x5ead7a9,
-	0x5eb17ab,
-	0x5ed57ac,
-	0x5ef57b5,
-	0x5f117bd,
-	0x5f217c4,
-	0x5f357c8,
-	0x5f3d7cd,
-	0x5f457cf,
-	0x5f497d1,
-	0x5f517d2,
-	0x5f6d7d4,
-	0x5f7d7db,
-	0x5f817df,
-	0x5f9d7e0,
-	0x68257e7,
-	0x685da09,
-	0x6889a17,
-	0x68a1a22,
-	0x68c5a28,
-	0x68e5a31,
-	0x6929a39,
-	0x6931a4a,
-	0x26935a4c,
-	0x26939a4d,
-	0x6941a4e,
-	0x6b89a50,
-	0x26b8dae2,
-	0x26b91ae3,
-	0x6ba5ae4,
-	0x26ba9ae9,
-	0x6badaea,
-	0x6bb5aeb,
-	0x26bc1aed,
-	0x26bd1af0,
-	0x26bd9af4,
-	0x26be5af6,
-	0x6be9af9,
-	0x26bedafa,
-	0x26c05afb,
-	0x26c0db01,
-	0x26c15b03,
-	0x26c19b05,
-	0x26c21b06,
-	0x26c25b08,
-	0x6c29b09,
-	0x26c2db0a,
-	0x6c31b0b,
-	0x26c3db0c,
-	0x6c45b0f,
-	0x6c59b11,
-	0x6c5db16,
-	0x6c85b17,
-	0x6cc1b21,
-	0x6cc5b30,
-	0x6cfdb31,
-	0x6d1db3f,
-	0x7879b47,
-	0x787de1e,
-	0x7881e1f,
-	0x27885e20,
-	0x7889e21,
-	0x2788de22,
-	0x7891e23,
-	0x2789de24,
-	0x78a1e27,
-	0x78a5e28,
-	0x278a9e29,
-	0x78ade2a,
-	0x278b5e2b,
-	0x78b9e2d,
-	0x78bde2e,
-	0x278cde2f,
-	0x78d1e33,
-	0x78d5e34,
-	0x78d9e35,
-	0x78dde36,
-	0x278e1e37,
-	0x78e5e38,
-	0x78e9e39,
-	0x78ede3a,
-	0x78f1e3b,
-	0x278f9e3c,
-	0x78fde3e,
-	0x7901e3f,
-	0x7905e40,
-	0x27909e41,
-	0x790de42,
-	0x27915e43,
-	0x27919e45,
-	0x7935e46,
-	0x7945e4d,
-	0x7985e51,
-	0x7989e61,
-	0x79ade62,
-	0x79c1e6b,
-	0x79c5e70,
-	0x79d1e71,
-	0x7b99e74,
-	0x27b9dee6,
-	0x27ba5ee7,
-	0x27ba9ee9,
-	0x27badeea,
-	0x7bb5eeb,
-	0x7c91eed,
-	0x27c9df24,
-	0x27ca1f27,
-	0x27ca5f28,
-	0x27ca9f29,
-	0x7cadf2a,
-	0x7cd9f2b,
-	0x7cf1f36,
-	0x7cf5f3c,
-	0x7d15f3d,
-	0x7d21f45,
-	0x7d41f48,
-	0x7d45f50,
-	0x7d7df51,
-	0x8045f5f,
-	0x8102011,
-	0x8106040,
-	0x810a041,
-	0x811e042,
-	0x8122047,
-	0x8156048,
-	0x818e055,
-	0x28192063,
-	0x81ae064,
-	0x81d206b,
-	0x81d6074,
-	0x81f6075,
-	0x821207d,
-	0x8236084,
-	0x824608d,
-	0x824a091,
-	0x824e092,
-	0x828a093,
-	0x82960a2,
-	0x82be0a5,
-	0x282c20af,
-	0x835e0b0,
-	0x283620d7,
-	0x83660d8,
-	0x83760d9,
-	0x2837a0dd,
-	0x83920de,
-	0x83ae0e4,
-	0x83ce0eb,
-	0x83d20f3,
-	0x83e60f4,
-	0x83fa0f9,
-	0x83fe0fe,
-	0x84060ff,
-	0x840a101,
-	0x842a102,
-	0x84e210a,
-	0x284e6138,
-	0x84ea139,
-	0x850a13a,
-	0x8536142,
-	0x2854614d,
-	0x854a151,
-	0x8556152,
-	0x859a155,
-	0x859e166,
-	0x85b2167,
-	0x85d216c,
-	0x85ee174,
-	0x85f217b,
-	0x85fe17c,
-	0x861e17f,
-	0x864e187,
-	0x865a193,
-	0x872a196,
-	0x872e1ca,
-	0x87421cb,
-	0x87461d0,
-	0x875e1d1,
-	0x87621d7,
-	0x876e1d8,
-	0x877a1db,
-	0x877e1de,
-	0x87861df,
-	0x878a1e1,
-	0x87ae1e2,
-	0x87ea1eb,
-	0x87ee1fa,
-	0x880e1fb,
-	0x8846203,
-	0x8876211,
-	0x2887a21d,
-	0x887e21e,
-	0x888621f,
-	0x88de221,
-	0x88e2237,
-	0x88e6238,
-	0x88ea239,
-	0x892e23a,
-	0x893e24b,
-	0x897a24f,
-	0x897e25e,
-	0x89ae25f,
-	0x8afa26b,
-	0x8b1e2be,
-	0x8b5e2c7,
-	0x8b8e2d7,
-	0x28b962e3,
-	0x28b9a2e5,
-	0x28b9e2e6,
-	0x8ba62e7,
-	0x8bbe2e9,
-	0x8ce22ef,
-	0x8cee338,
-	0x8cfa33b,
-	0x8d0633e,
-	0x8d12341,
-	0x8d1e344,
-	0x8d2a347,
-	0x8d3634a,
-	0x8d4234d,
-	0x8d4e350,
-	0x8d5a353,
-	0x28d5e356,
-	0x8d6a357,
-	0x8d7635a,
-	0x8d8235d,
-	0x8d8a360,
-	0x8d96362,
-	0x8da2365,
-	0x8dae368,
-	0x8dba36b,
-	0x8dc636e,
-	0x8dd2371,
-	0x8dde374,
-	0x8dea377,
-	0x8df637a,
-	0x8e0237d,
-	0x8e0e380,
-	0x8e3a383,
-	0x8e4638e,
-	0x8e52391,
-	0x8e5e394,
-	0x8e6a397,
-	0x8e7639a,
-	0x8e7e39d,
-	0x8e8a39f,
-	0x8e963a2,
-	0x8ea23a5,
-	0x8eae3a8,
-	0x8eba3ab,
-	0x8ec63ae,
-	0x8ed23b1,
-	0x8ede3b4,
-	0x8eea3b7,
-	0x8ef63ba,
-	0x8f023bd,
-	0x8f0a3c0,
-	0x8f163c2,
-	0x8f1e3c5,
-	0x8f2a3c7,
-	0x8f363ca,
-	0x8f423cd,
-	0x8f4e3d0,
-	0x8f5a3d3,
-	0x8f663d6,
-	0x8f723d9,
-	0x8f7e3dc,
-	0x8f823df,
-	0x8f8e3e0,
-	0x8fa63e3,
-	0x8faa3e9,
-	0x8fba3ea,
-	0x8fda3ee,
-	0x8fde3f6,
-	0x902e3f7,
-	0x903240b,
-	0x904640c,
-	0x907a411,
-	0x909a41e,
-	0x909e426,
-	0x90a6427,
-	0x90ca429,
-	0x90e2432,
-	0x90fa438,
-	0x911243e,
-	0x913a444,
-	0x914e44e,
-	0x9166453,
-	0x916a459,
-	0x291b245a,
-	0x91b646c,
-	0x91e246d,
-	0x91f2478,
-	0x920647c,
-}
+//go:embed data/table
+var tableData string
 
-// max children 669 (capacity 1023)
-// max text offset 32017 (capacity 32767)
-// max text length 36 (capacity 63)
-// max hi 9345 (capacity 16383)
-// max lo 9340 (capacity 16383)
+// 9700 nodes in 36475 bytes
@@ -1,8 +1,11 @@
 // generated by go run gen.go; DO NOT EDIT
 
+//go:build !publicsuffix_icann
+// +build !publicsuffix_icann
+
 package publicsuffix
 
-const numICANNRules = 7346
+const numICANNRules = 7380
 
 var rules = [...]string{
 	"ac",
@@ -302,9 +305,26 @@ var rules = [...]string{
 	"org.bi",
 	"biz",
 	"bj",
-	"asso.bj",
-	"barreau.bj",
-	"gouv.bj",
+	"africa.bj",
+	"agro.bj",
+	"architectes.bj",
+	"assur.bj",
+	"avocats.bj",
+	"co.bj",
+	"com.bj",
+	"eco.bj",
+	"econo.bj",
+	"edu.bj",
+	"info.bj",
+	"loisirs.bj",
+	"money.bj",
+	"net.bj",
+	"org.bj",
+	"ote.bj",
+	"resto.bj",
+	"restaurant.bj",
+	"tourism.bj",
+	"univ.bj",
 	"bm",
 	"com.bm",
 	"edu.bm",
@@ -702,10 +722,9 @@ var rules = [...]string{
 	"ekloges.cy",
 	"gov.cy",
 	"ltd.cy",
-	"name.cy",
+	"mil.cy",
 	"net.cy",
 	"org.cy",
-	"parliament.cy",
 	"press.cy",
 	"pro.cy",
 	"tm.cy",
@@ -1030,6 +1049,11 @@ var rules = [...]string{
 	"muni.il",
 	"net.il",
 	"org.il",
+	"xn--4dbrk0ce",
+	"xn--4dbgdty6c.xn--4dbrk0ce",
+	"xn--5dbhl8d.xn--4dbrk0ce",
+	"xn--8dbq2a.xn--4dbrk0ce",
+	"xn--hebda8b.xn--4dbrk0ce",
 	"im",
 	"ac.im",
 	"co.im",
@@ -1041,18 +1065,47 @@ var rules = [...]string{
 	"tt.im",
 	"tv.im",
 	"in",
+	"5g.in",
+	"6g.in",
+	"ac.in",
+	"ai.in",
+	"am.in",
+	"bihar.in",
+	"biz.in",
+	"business.in",
+	"ca.in",
+	"cn.in",
 	"co.in",
+	"com.in",
+	"coop.in",
+	"cs.in",
+	"delhi.in",
+	"dr.in",
+	"edu.in",
+	"er.in",
 	"firm.in",
-	"net.in",
-	"org.in",
 	"gen.in",
+	"gov.in",
+	"gujarat.in",
 	"ind.in",
+	"info.in",
+	"int.in",
+	"internet.in",
+	"io.in",
+	"me.in",
+	"mil.in",
+	"net.in",
 	"nic.in",
-	"ac.in",
-	"edu.in",
+	"org.in",
+	"pg.in",
+	"post.in",
+	"pro.in",
 	"res.in",
-	"gov.in",
-	"mil.in",
+	"travel.in",
+	"tv.in",
+	"uk.in",
+	"up.in",
+	"us.in",
 	"info",
 	"int",
 	"eu.int",
@@ -3390,11 +3443,10 @@ var rules = [...]string{
 	"net.kw",
 	"org.kw",
 	"ky",
-	"edu.ky",
-	"gov.ky",
 	"com.ky",
-	"org.ky",
+	"edu.ky",
 	"net.ky",
+	"org.ky",
 	"kz",
 	"org.kz",
 	"edu.kz",
@@ -6203,12 +6255,10 @@ var rules = [...]string{
 	"accountants",
 	"aco",
 	"actor",
-	"adac",
 	"ads",
 	"adult",
 	"aeg",
 	"aetna",
-	"afamilycompany",
 	"afl",
 	"africa",
 	"agakhan",
@@ -6329,8 +6379,6 @@ var rules = [...]string{
 	"broker",
 	"brother",
 	"brussels",
-	"budapest",
-	"bugatti",
 	"build",
 	"builders",
 	"business",
@@ -6345,7 +6393,6 @@ var rules = [...]string{
 	"cam",
 	"camera",
 	"camp",
-	"cancerresearch",
 	"canon",
 	"capetown",
 	"capital",
@@ -6433,7 +6480,6 @@ var rules = [...]string{
 	"crs",
 	"cruise",
 	"cruises",
-	"csc",
 	"cuisinella",
 	"cymru",
 	"cyou",
@@ -6481,7 +6527,6 @@ var rules = [...]string{
 	"drive",
 	"dtv",
 	"dubai",
-	"duck",
 	"dunlop",
 	"dupont",
 	"durban",
@@ -6591,7 +6636,6 @@ var rules = [...]string{
 	"gifts",
 	"gives",
 	"giving",
-	"glade",
 	"glass",
 	"gle",
 	"global",
@@ -6769,14 +6813,12 @@ var rules = [...]string{
 	"lipsy",
 	"live",
 	"living",
-	"lixil",
 	"llc",
 	"llp",
 	"loan",
 	"loans",
 	"locker",
 	"locus",
-	"loft",
 	"lol",
 	"london",
 	"lotte",
@@ -6881,7 +6923,6 @@ var rules = [...]string{
 	"nyc",
 	"obi",
 	"observer",
-	"off",
 	"office",
 	"okinawa",
 	"olayan",
@@ -6963,7 +7004,6 @@ var rules = [...]string{
 	"quest",
 	"racing",
 	"radio",
-	"raid",
 	"read",
 	"realestate",
 	"realtor",
@@ -7032,7 +7072,6 @@ var rules = [...]string{
 	"schule",
 	"schwarz",
 	"science",
-	"scjohnson",
 	"scot",
 	"search",
 	"seat",
@@ -7042,7 +7081,6 @@ var rules = [...]string{
 	"select",
 	"sener",
 	"services",
-	"ses",
 	"seven",
 	"sew",
 	"sex",
@@ -7295,7 +7333,6 @@ var rules = [...]string{
 	"xn--io0a7i",
 	"xn--j1aef",
 	"xn--jlq480n2rg",
-	"xn--jlq61u9w7b",
 	"xn--jvr189m",
 	"xn--kcrx77d1x4a",
 	"xn--kput3i",
@@ -7357,47 +7394,71 @@ var rules = [...]string{
 	"611.to",
 	"graphox.us",
 	"*.devcdnaccesso.com",
+	"*.on-acorn.io",
+	"activetrail.biz",
 	"adobeaemcloud.com",
 	"*.dev.adobeaemcloud.com",
 	"hlx.live",
 	"adobeaemcloud.net",
 	"hlx.page",
 	"hlx3.page",
+	"adobeio-static.net",
+	"adobeioruntime.net",
 	"beep.pl",
+	"airkitapps.com",
+	"airkitapps-au.com",
+	"airkitapps.eu",
+	"aivencloud.com",
+	"akadns.net",
+	"akamai.net",
+	"akamai-staging.net",
+	"akamaiedge.net",
+	"akamaiedge-staging.net",
+	"akamaihd.net",
+	"akamaihd-staging.net",
+	"akamaiorigin.net",
+	"akamaiorigin-staging.net",
+	"akamaized.net",
+	"akamaized-staging.net",
+	"edgekey.net",
+	"edgekey-staging.net",
+	"edgesuite.net",
+	"edgesuite-staging.net",
 	"barsy.ca",
 	"*.compute.estate",
 	"*.alces.network",
 	"kasserver.com",
 	"altervista.org",
 	"alwaysdata.net",
+	"myamaze.net",
 	"cloudfront.net",
 	"*.compute.amazonaws.com",
 	"*.compute-1.amazonaws.com",
 	"*.compute.amazonaws.com.cn",
 	"us-east-1.amazonaws.com",
-	"cn-north-1.eb.amazonaws.com.cn",
-	"cn-northwest-1.eb.amazonaws.com.cn",
-	"elasticbeanstalk.com",
-	"ap-northeast-1.elasticbeanstalk.com",
-	"ap-northeast-2.elasticbeanstalk.com",
-	"ap-northeast-3.elasticbeanstalk.com",
-	"ap-south-1.elasticbeanstalk.com",
-	"ap-southeast-1.elasticbeanstalk.com",
-	"ap-southeast-2.elasticbeanstalk.com",
-	"ca-central-1.elasticbeanstalk.com",
-	"eu-central-1.elasticbeanstalk.com",
-	"eu-west-1.elasticbeanstalk.com",
-	"eu-west-2.elasticbeanstalk.com",
-	"eu-west-3.elasticbeanstalk.com",
-	"sa-east-1.elasticbeanstalk.com",
-	"us-east-1.elasticbeanstalk.com",
-	"us-east-2.elasticbeanstalk.com",
-	"us-gov-west-1.elasticbeanstalk.com",
-	"us-west-1.elasticbeanstalk.com",
-	"us-west-2.elasticbeanstalk.com",
-	"*.elb.amazonaws.com",
-	"*.elb.amazonaws.com.cn",
-	"awsglobalaccelerator.com",
+	"s3.cn-north-1.amazonaws.com.cn",
+	"s3.dualstack.ap-northeast-1.amazonaws.com",
+	"s3.dualstack.ap-northeast-2.amazonaws.com",
+	"s3.ap-northeast-2.amazonaws.com",
+	"s3-website.ap-northeast-2.amazonaws.com",
+	"s3.dualstack.ap-south-1.amazonaws.com",
+	"s3.ap-south-1.amazonaws.com",
+	"s3-website.ap-south-1.amazonaws.com",
+	"s3.dualstack.ap-southeast-1.amazonaws.com",
+	"s3.dualstack.ap-southeast-2.amazonaws.com",
+	"s3.dualstack.ca-central-1.amazonaws.com",
+	"s3.ca-central-1.amazonaws.com",
+	"s3-website.ca-central-1.amazonaws.com",
+	"s3.dualstack.eu-central-1.amazonaws.com",
+	"s3.eu-central-1.amazonaws.com",
+	"s3-website.eu-central-1.amazonaws.com",
+	"s3.dualstack.eu-west-1.amazonaws.com",
+	"s3.dualstack.eu-west-2.amazonaws.com",
+	"s3.eu-west-2.amazonaws.com",
+	"s3-website.eu-west-2.amazonaws.com",
+	"s3.dualstack.eu-west-3.amazonaws.com",
+	"s3.eu-west-3.amazonaws.com",
+	"s3-website.eu-west-3.amazonaws.com",
 	"s3.amazonaws.com",
 	"s3-ap-northeast-1.amazonaws.com",
 	"s3-ap-northeast-2.amazonaws.com",
@@ -7412,46 +7473,90 @@ var rules = [...]string{
 	"s3-external-1.amazonaws.com",
 	"s3-fips-us-gov-west-1.amazonaws.com",
 	"s3-sa-east-1.amazonaws.com",
-	"s3-us-gov-west-1.amazonaws.com",
 	"s3-us-east-2.amazonaws.com",
+	"s3-us-gov-west-1.amazonaws.com",
 	"s3-us-west-1.amazonaws.com",
 	"s3-us-west-2.amazonaws.com",
-	"s3.ap-northeast-2.amazonaws.com",
-	"s3.ap-south-1.amazonaws.com",
-	"s3.cn-north-1.amazonaws.com.cn",
-	"s3.ca-central-1.amazonaws.com",
-	"s3.eu-central-1.amazonaws.com",
-	"s3.eu-west-2.amazonaws.com",
-	"s3.eu-west-3.amazonaws.com",
-	"s3.us-east-2.amazonaws.com",
-	"s3.dualstack.ap-northeast-1.amazonaws.com",
-	"s3.dualstack.ap-northeast-2.amazonaws.com",
-	"s3.dualstack.ap-south-1.amazonaws.com",
-	"s3.dualstack.ap-southeast-1.amazonaws.com",
-	"s3.dualstack.ap-southeast-2.amazonaws.com",
-	"s3.dualstack.ca-central-1.amazonaws.com",
-	"s3.dualstack.eu-central-1.amazonaws.com",
-	"s3.dualstack.eu-west-1.amazonaws.com",
-	"s3.dualstack.eu-west-2.amazonaws.com",
-	"s3.dualstack.eu-west-3.amazonaws.com",
-	"s3.dualstack.sa-east-1.amazonaws.com",
-	"s3.dualstack.us-east-1.amazonaws.com",
-	"s3.dualstack.us-east-2.amazonaws.com",
-	"s3-website-us-east-1.amazonaws.com",
-	"s3-website-us-west-1.amazonaws.com",
-	"s3-website-us-west-2.amazonaws.com",
 	"s3-website-ap-northeast-1.amazonaws.com",
 	"s3-website-ap-southeast-1.amazonaws.com",
 	"s3-website-ap-southeast-2.amazonaws.com",
 	"s3-website-eu-west-1.amazonaws.com",
 	"s3-website-sa-east-1.amazonaws.com",
-	"s3-website.ap-northeast-2.amazonaws.com",
-	"s3-website.ap-south-1.amazonaws.com",
-	"s3-website.ca-central-1.amazonaws.com",
-	"s3-website.eu-central-1.amazonaws.com",
-	"s3-website.eu-west-2.amazonaws.com",
-	"s3-website.eu-west-3.amazonaws.com",
+	"s3-website-us-east-1.amazonaws.com",
+	"s3-website-us-west-1.amazonaws.com",
+	"s3-website-us-west-2.amazonaws.com",
+	"s3.dualstack.sa-east-1.amazonaws.com",
+	"s3.dualstack.us-east-1.amazonaws.com",
+	"s3.dualstack.us-east-2.amazonaws.com",
+	"s3.us-east-2.amazonaws.com",
 	"s3-website.us-east-2.amazonaws.com",
+	"vfs.cloud9.af-south-1.amazonaws.com",
+	"webview-assets.cloud9.af-south-1.amazonaws.com",
+	"vfs.cloud9.ap-east-1.amazonaws.com",
+	"webview-assets.cloud9.ap-east-1.amazonaws.com",
+	"vfs.cloud9.ap-northeast-1.amazonaws.com",
+	"webview-assets.cloud9.ap-northeast-1.amazonaws.com",
+	"vfs.cloud9.ap-northeast-2.amazonaws.com",
+	"webview-assets.cloud9.ap-northeast-2.amazonaws.com",
+	"vfs.cloud9.ap-northeast-3.amazonaws.com",
+	"webview-assets.cloud9.ap-northeast-3.amazonaws.com",
+	"vfs.cloud9.ap-south-1.amazonaws.com",
+	"webview-assets.cloud9.ap-south-1.amazonaws.com",
+	"vfs.cloud9.ap-southeast-1.amazonaws.com",
+	"webview-assets.cloud9.ap-southeast-1.amazonaws.com",
+	"vfs.cloud9.ap-southeast-2.amazonaws.com",
+	"webview-assets.cloud9.ap-southeast-2.amazonaws.com",
+	"vfs.cloud9.ca-central-1.amazonaws.com",
+	"webview-assets.cloud9.ca-central-1.amazonaws.com",
+	"vfs.cloud9.eu-central-1.amazonaws.com",
+	"webview-assets.cloud9.eu-central-1.amazonaws.com",
+	"vfs.cloud9.eu-north-1.amazonaws.com",
+	"webview-assets.cloud9.eu-north-1.amazonaws.com",
+	"vfs.cloud9.eu-south-1.amazonaws.com",
+	"webview-assets.cloud9.eu-south-1.amazonaws.com",
+	"vfs.cloud9.eu-west-1.amazonaws.com",
+	"webview-assets.cloud9.eu-west-1.amazonaws.com",
+	"vfs.cloud9.eu-west-2.amazonaws.com",
+	"webview-assets.cloud9.eu-west-2.amazonaws.com",
+	"vfs.cloud9.eu-west-3.amazonaws.com",
+	"webview-assets.cloud9.eu-west-3.amazonaws.com",
+	"vfs.cloud9.me-south-1.amazonaws.com",
+	"webview-assets.cloud9.me-south-1.amazonaws.com",
+	"vfs.cloud9.sa-east-1.amazonaws.com",
+	"webview-assets.cloud9.sa-east-1.amazonaws.com",
+	"vfs.cloud9.us-east-1.amazonaws.com",
+	"webview-assets.cloud9.us-east-1.amazonaws.com",
+	"vfs.cloud9.us-east-2.amazonaws.com",
+	"webview-assets.cloud9.us-east-2.amazonaws.com",
+	"vfs.cloud9.us-west-1.amazonaws.com",
+	"webview-assets.cloud9.us-west-1.amazonaws.com",
+	"vfs.cloud9.us-west-2.amazonaws.com",
+	"webview-assets.cloud9.us-west-2.amazonaws.com",
+	"cn-north-1.eb.amazonaws.com.cn",
+	"cn-northwest-1.eb.amazonaws.com.cn",
+	"elasticbeanstalk.com",
+	"ap-northeast-1.elasticbeanstalk.com",
+	"ap-northeast-2.elasticbeanstalk.com",
+	"ap-northeast-3.elasticbeanstalk.com",
+	"ap-south-1.elasticbeanstalk.com",
+	"ap-southeast-1.elasticbeanstalk.com",
+	"ap-southeast-2.elasticbeanstalk.com",
+	"ca-central-1.elasticbeanstalk.com",
+	"eu-central-1.elasticbeanstalk.com",
+	"eu-west-1.elasticbeanstalk.com",
+	"eu-west-2.elasticbeanstalk.com",
+	"eu-west-3.elasticbeanstalk.com",
+	"sa-east-1.elasticbeanstalk.com",
+	"us-east-1.elasticbeanstalk.com",
+	"us-east-2.elasticbeanstalk.com",
+	"us-gov-west-1.elasticbeanstalk.com",
+	"us-west-1.elasticbeanstalk.com",
+	"us-west-2.elasticbeanstalk.com",
+	"*.elb.amazonaws.com.cn",
+	"*.elb.amazonaws.com",
+	"awsglobalaccelerator.com",
+	"eero.online",
+	"eero-stage.online",
 	"t3l3p0rt.net",
 	"tele.amune.org",
 	"apigee.io",
@@ -7470,6 +7575,8 @@ var rules = [...]string{
 	"sweetpepper.org",
 	"myasustor.com",
 	"cdn.prod.atlassian-dev.net",
+	"translated.page",
+	"autocode.dev",
 	"myfritz.net",
 	"onavstack.net",
 	"*.awdev.ca",
@@ -7482,6 +7589,18 @@ var rules = [...]string{
 	"*.banzai.cloud",
 	"app.banzaicloud.io",
 	"*.backyards.banzaicloud.io",
+	"base.ec",
+	"official.ec",
+	"buyshop.jp",
+	"fashionstore.jp",
+	"handcrafted.jp",
+	"kawaiishop.jp",
+	"supersale.jp",
+	"theshop.jp",
+	"shopselect.net",
+	"base.shop",
+	"beagleboard.io",
+	"*.beget.app",
 	"betainabox.com",
 	"bnr.la",
 	"bitbucket.io",
@@ -7504,6 +7623,8 @@ var rules = [...]string{
 	"vm.bytemark.co.uk",
 	"cafjs.com",
 	"mycd.eu",
+	"canva-apps.cn",
+	"canva-apps.com",
 	"drr.ac",
 	"uwu.ai",
 	"carrd.co",
@@ -7569,8 +7690,11 @@ var rules = [...]string{
 	"cloudcontrolled.com",
 	"cloudcontrolapp.com",
 	"*.cloudera.site",
-	"pages.dev",
+	"cf-ipfs.com",
+	"cloudflare-ipfs.com",
 	"trycloudflare.com",
+	"pages.dev",
+	"r2.dev",
 	"workers.dev",
 	"wnext.app",
 	"co.ca",
@@ -7593,6 +7717,7 @@ var rules = [...]string{
 	"cloudns.pw",
 	"cloudns.us",
 	"cnpy.gdn",
+	"codeberg.page",
 	"co.nl",
 	"co.no",
 	"webhosting.be",
@@ -7650,8 +7775,12 @@ var rules = [...]string{
 	"deno.dev",
 	"deno-staging.dev",
 	"dedyn.io",
+	"deta.app",
+	"deta.dev",
 	"*.rss.my.id",
 	"*.diher.solutions",
+	"discordsays.com",
+	"discordsez.com",
 	"jozi.biz",
 	"dnshome.de",
 	"online.th",
@@ -7979,15 +8108,18 @@ var rules = [...]string{
 	"blogsite.xyz",
 	"dynv6.net",
 	"e4.cz",
-	"eero.online",
-	"eero-stage.online",
+	"easypanel.app",
+	"easypanel.host",
 	"elementor.cloud",
 	"elementor.cool",
 	"en-root.fr",
 	"mytuleap.com",
 	"tuleap-partners.com",
+	"encr.app",
+	"encoreapi.com",
 	"onred.one",
 	"staging.onred.one",
+	"eu.encoway.cloud",
 	"eu.org",
 	"al.eu.org",
 	"asso.eu.org",
@@ -8135,6 +8267,7 @@ var rules = [...]string{
 	"channelsdvr.net",
 	"u.channelsdvr.net",
 	"edgecompute.app",
+	"fastly-edge.com",
 	"fastly-terrarium.com",
 	"fastlylb.net",
 	"map.fastlylb.net",
@@ -8145,6 +8278,7 @@ var rules = [...]string{
 	"a.ssl.fastly.net",
 	"b.ssl.fastly.net",
 	"global.ssl.fastly.net",
+	"*.user.fm",
 	"fastvps-server.com",
 	"fastvps.host",
 	"myfast.host",
@@ -8155,8 +8289,6 @@ var rules = [...]string{
 	"cloud.fedoraproject.org",
 	"app.os.fedoraproject.org",
 	"app.os.stg.fedoraproject.org",
-	"couk.me",
-	"ukco.me",
 	"conn.uk",
 	"copro.uk",
 	"hosp.uk",
@@ -8182,6 +8314,10 @@ var rules = [...]string{
 	"id.forgerock.io",
 	"framer.app",
 	"framercanvas.com",
+	"framer.media",
+	"framer.photos",
+	"framer.website",
+	"framer.wiki",
 	"*.frusky.de",
 	"ravpage.co.il",
 	"0e.vc",
@@ -8202,7 +8338,16 @@ var rules = [...]string{
 	"*.ex.ortsinfo.at",
 	"*.kunden.ortsinfo.at",
 	"*.statics.cloud",
+	"independent-commission.uk",
+	"independent-inquest.uk",
+	"independent-inquiry.uk",
+	"independent-panel.uk",
+	"independent-review.uk",
+	"public-inquiry.uk",
+	"royal-commission.uk",
+	"campaign.gov.uk",
 	"service.gov.uk",
+	"api.gov.uk",
 	"gehirn.ne.jp",
 	"usercontent.jp",
 	"gentapps.com",
@@ -8222,6 +8367,112 @@ var rules = [...]string{
 	"co.ro",
 	"shop.ro",
 	"lolipop.io",
+	"angry.jp",
+	"babyblue.jp",
+	"babymilk.jp",
+	"backdrop.jp",
+	"bambina.jp",
+	"bitter.jp",
+	"blush.jp",
+	"boo.jp",
+	"boy.jp",
+	"boyfriend.jp",
+	"but.jp",
+	"candypop.jp",
+	"capoo.jp",
+	"catfood.jp",
+	"cheap.jp",
+	"chicappa.jp",
+	"chillout.jp",
+	"chips.jp",
+	"chowder.jp",
+	"chu.jp",
+	"ciao.jp",
+	"cocotte.jp",
+	"coolblog.jp",
+	"cranky.jp",
+	"cutegirl.jp",
+	"daa.jp",
+	"deca.jp",
+	"deci.jp",
+	"digick.jp",
+	"egoism.jp",
+	"fakefur.jp",
+	"fem.jp",
+	"flier.jp",
+	"floppy.jp",
+	"fool.jp",
+	"frenchkiss.jp",
+	"girlfriend.jp",
+	"girly.jp",
+	"gloomy.jp",
+	"gonna.jp",
+	"greater.jp",
+	"hacca.jp",
+	"heavy.jp",
+	"her.jp",
+	"hiho.jp",
+	"hippy.jp",
+	"holy.jp",
+	"hungry.jp",
+	"icurus.jp",
+	"itigo.jp",
+	"jellybean.jp",
+	"kikirara.jp",
+	"kill.jp",
+	"kilo.jp",
+	"kuron.jp",
+	"littlestar.jp",
+	"lolipopmc.jp",
+	"lolitapunk.jp",
+	"lomo.jp",
+	"lovepop.jp",
+	"lovesick.jp",
+	"main.jp",
+	"mods.jp",
+	"mond.jp",
+	"mongolian.jp",
+	"moo.jp",
+	"namaste.jp",
+	"nikita.jp",
+	"nobushi.jp",
+	"noor.jp",
+	"oops.jp",
+	"parallel.jp",
+	"parasite.jp",
+	"pecori.jp",
+	"peewee.jp",
+	"penne.jp",
+	"pepper.jp",
+	"perma.jp",
+	"pigboat.jp",
+	"pinoko.jp",
+	"punyu.jp",
+	"pupu.jp",
+	"pussycat.jp",
+	"pya.jp",
+	"raindrop.jp",
+	"readymade.jp",
+	"sadist.jp",
+	"schoolbus.jp",
+	"secret.jp",
+	"staba.jp",
+	"stripper.jp",
+	"sub.jp",
+	"sunnyday.jp",
+	"thick.jp",
+	"tonkotsu.jp",
+	"under.jp",
+	"upper.jp",
+	"velvet.jp",
+	"verse.jp",
+	"versus.jp",
+	"vivian.jp",
+	"watson.jp",
+	"weblike.jp",
+	"whitesnow.jp",
+	"zombie.jp",
+	"heteml.net",
 	"cloudapps.digital",
 	"london.cloudapps.digital",
 	"pymnt.uk",
@@ -8321,6 +8572,7 @@ var rules = [...]string{
 	"blogspot.ug",
 	"blogspot.vn",
 	"goupile.fr",
+	"gov.nl",
 	"awsmppl.com",
 	"xn--gnstigbestellen-zvb.de",
 	"xn--gnstigliefern-wob.de",
@@ -8338,13 +8590,14 @@ var rules = [...]string{
 	"hepforge.org",
 	"herokuapp.com",
 	"herokussl.com",
-	"myravendb.com",
+	"ravendb.cloud",
 	"ravendb.community",
 	"ravendb.me",
 	"development.run",
 	"ravendb.run",
 	"homesklep.pl",
 	"secaas.hk",
+	"hoplix.shop",
 	"orx.biz",
 	"biz.gl",
 	"col.ng",
@@ -8354,12 +8607,13 @@ var rules = [...]string{
 	"ngo.ng",
 	"edu.scot",
 	"sch.so",
-	"org.yt",
 	"hostyhosting.io",
 	"xn--hkkinen-5wa.fi",
 	"*.moonscale.io",
 	"moonscale.net",
 	"iki.fi",
+	"ibxos.it",
+	"iliadboxos.it",
 	"impertrixcdn.com",
 	"impertrix.com",
 	"smushcdn.com",
@@ -8411,7 +8665,9 @@ var rules = [...]string{
 	"na4u.ru",
 	"iopsys.se",
 	"ipifony.net",
+	"iservschule.de",
 	"mein-iserv.de",
+	"schulplattform.de",
 	"schulserver.de",
 	"test-iserv.de",
 	"iserv.dev",
@@ -8510,10 +8766,13 @@ var rules = [...]string{
 	"js.org",
 	"kaas.gg",
 	"khplay.nl",
+	"ktistory.com",
+	"kapsi.fi",
 	"keymachine.de",
 	"kinghost.net",
 	"uni5.net",
 	"knightpoint.systems",
+	"koobin.events",
 	"oya.to",
 	"kuleuven.cloud",
 	"ezproxy.kuleuven.be",
@@ -8543,6 +8802,7 @@ var rules = [...]string{
 	"*.linodeobjects.com",
 	"ip.linodeusercontent.com",
 	"we.bs",
+	"*.user.localcert.dev",
 	"localzone.xyz",
 	"loginline.app",
 	"loginline.dev",
@@ -8600,6 +8860,7 @@ var rules = [...]string{
 	"hra.health",
 	"miniserver.com",
 	"memset.net",
+	"messerli.app",
 	"*.cloud.metacentrum.cz",
 	"custom.metacentrum.cz",
 	"flt.cloud.muni.cz",
@@ -8612,6 +8873,8 @@ var rules = [...]string{
 	"azure-mobile.net",
 	"cloudapp.net",
 	"azurestaticapps.net",
+	"1.azurestaticapps.net",
+	"2.azurestaticapps.net",
 	"centralus.azurestaticapps.net",
 	"eastasia.azurestaticapps.net",
 	"eastus2.azurestaticapps.net",
@@ -8639,22 +8902,7 @@ var rules = [...]string{
 	"yali.mythic-beasts.com",
 	"cust.retrosnub.co.uk",
 	"ui.nabu.casa",
-	"pony.club",
-	"of.fashion",
-	"in.london",
-	"of.london",
-	"from.marketing",
-	"with.marketing",
-	"for.men",
-	"repair.men",
-	"and.mom",
-	"for.mom",
-	"for.one",
-	"under.one",
-	"for.sale",
-	"that.win",
-	"from.work",
-	"to.work",
+	"cloud.nospamproxy.com",
 	"netlify.app",
 	"4u.com",
 	"ngrok.io",
@@ -8663,7 +8911,10 @@ var rules = [...]string{
 	"*.developer.app",
 	"noop.app",
 	"*.northflank.app",
+	"*.build.run",
 	"*.code.run",
+	"*.database.run",
+	"*.migration.run",
 	"noticeable.news",
 	"dnsking.ch",
 	"mypi.co",
@@ -8778,8 +9029,6 @@ var rules = [...]string{
 	"webhop.me",
 	"zapto.org",
 	"stage.nodeart.io",
-	"nodum.co",
-	"nodum.io",
 	"pcloud.host",
 	"nyc.mn",
 	"static.observableusercontent.com",
@@ -8787,12 +9036,32 @@ var rules = [...]string{
 	"omg.lol",
 	"cloudycluster.net",
 	"omniwe.site",
+	"123hjemmeside.dk",
+	"123hjemmeside.no",
+	"123homepage.it",
+	"123kotisivu.fi",
+	"123minsida.se",
+	"123miweb.es",
+	"123paginaweb.pt",
+	"123sait.ru",
+	"123siteweb.fr",
+	"123webseite.at",
+	"123webseite.de",
+	"123website.be",
+	"123website.ch",
+	"123website.lu",
+	"123website.nl",
 	"service.one",
+	"simplesite.com",
+	"simplesite.com.br",
+	"simplesite.gr",
+	"simplesite.pl",
 	"nid.io",
 	"opensocial.site",
 	"opencraft.hosting",
 	"orsites.com",
 	"operaunite.com",
+	"tech.orange",
 	"authgear-staging.com",
 	"authgearapps.com",
 	"skygearapp.com",
@@ -8837,6 +9106,7 @@ var rules = [...]string{
 	"plesk.page",
 	"pleskns.com",
 	"dyn53.io",
+	"onporter.run",
 	"co.bn",
 	"postman-echo.com",
 	"pstmn.io",
@@ -8879,6 +9149,8 @@ var rules = [...]string{
 	"rhcloud.com",
 	"app.render.com",
 	"onrender.com",
+	"firewalledreplit.co",
+	"id.firewalledreplit.co",
 	"repl.co",
 	"id.repl.co",
 	"repl.run",
@@ -8890,6 +9162,7 @@ var rules = [...]string{
 	"adimo.co.uk",
 	"itcouldbewor.se",
 	"git-pages.rit.edu",
+	"rocky.page",
 	"xn--90amc.xn--p1acf",
 	"xn--j1aef.xn--p1acf",
 	"xn--j1ael8b.xn--p1acf",
@@ -8900,9 +9173,37 @@ var rules = [...]string{
 	"xn--h1aliz.xn--p1acf",
 	"xn--90a1af.xn--p1acf",
 	"xn--41a.xn--p1acf",
+	"*.builder.code.com",
+	"*.dev-builder.code.com",
+	"*.stg-builder.code.com",
 	"sandcats.io",
 	"logoip.de",
 	"logoip.com",
+	"fr-par-1.baremetal.scw.cloud",
+	"fr-par-2.baremetal.scw.cloud",
+	"nl-ams-1.baremetal.scw.cloud",
+	"fnc.fr-par.scw.cloud",
+	"functions.fnc.fr-par.scw.cloud",
+	"k8s.fr-par.scw.cloud",
+	"nodes.k8s.fr-par.scw.cloud",
+	"s3.fr-par.scw.cloud",
+	"s3-website.fr-par.scw.cloud",
+	"whm.fr-par.scw.cloud",
+	"priv.instances.scw.cloud",
+	"pub.instances.scw.cloud",
+	"k8s.scw.cloud",
+	"k8s.nl-ams.scw.cloud",
+	"nodes.k8s.nl-ams.scw.cloud",
+	"s3.nl-ams.scw.cloud",
+	"s3-website.nl-ams.scw.cloud",
+	"whm.nl-ams.scw.cloud",
+	"k8s.pl-waw.scw.cloud",
+	"nodes.k8s.pl-waw.scw.cloud",
+	"s3.pl-waw.scw.cloud",
+	"s3-website.pl-waw.scw.cloud",
+	"scalebook.scw.cloud",
+	"smartlabeling.scw.cloud",
+	"dedibox.fr",
 	"schokokeks.net",
 	"gov.scot",
 	"service.gov.scot",
@@ -8944,9 +9245,14 @@ var rules = [...]string{
 	"beta.bounty-full.com",
 	"small-web.org",
 	"vp4.me",
+	"snowflake.app",
+	"privatelink.snowflake.app",
+	"streamlit.app",
+	"streamlitapp.com",
 	"try-snowplow.com",
 	"srht.site",
 	"stackhero-network.com",
+	"musician.io",
 	"novecore.site",
 	"static.land",
 	"dev.static.land",
@@ -8993,22 +9299,25 @@ var rules = [...]string{
 	"*.s5y.io",
 	"*.sensiosite.cloud",
 	"syncloud.it",
-	"diskstation.me",
 	"dscloud.biz",
-	"dscloud.me",
-	"dscloud.mobi",
+	"direct.quickconnect.cn",
 	"dsmynas.com",
-	"dsmynas.net",
-	"dsmynas.org",
 	"familyds.com",
-	"familyds.net",
-	"familyds.org",
+	"diskstation.me",
+	"dscloud.me",
 	"i234.me",
 	"myds.me",
 	"synology.me",
+	"dscloud.mobi",
+	"dsmynas.net",
+	"familyds.net",
+	"dsmynas.org",
+	"familyds.org",
 	"vpnplus.to",
 	"direct.quickconnect.to",
 	"tabitorder.co.il",
+	"mytabit.co.il",
+	"mytabit.com",
 	"taifun-dns.de",
 	"beta.tailscale.net",
 	"ts.net",
@@ -9017,11 +9326,12 @@ var rules = [...]string{
 	"gdynia.pl",
 	"med.pl",
 	"sopot.pl",
-	"edugit.org",
+	"site.tb-hosting.com",
+	"edugit.io",
+	"s3.teckids.org",
 	"telebit.app",
 	"telebit.io",
 	"*.telebit.xyz",
-	"gwiddle.co.uk",
 	"*.firenet.ch",
 	"*.svc.firenet.ch",
 	"reservd.com",
@@ -9041,7 +9351,6 @@ var rules = [...]string{
 	"pages.torproject.net",
 	"bloxcms.com",
 	"townnews-staging.com",
-	"tbits.me",
 	"12hp.at",
 	"2ix.at",
 	"4lima.at",
@@ -9064,6 +9373,7 @@ var rules = [...]string{
 	"*.transurl.be",
 	"*.transurl.eu",
 	"*.transurl.nl",
+	"site.transip.me",
 	"tuxfamily.org",
 	"dd-dns.de",
 	"diskstation.eu",
@@ -9078,12 +9388,20 @@ var rules = [...]string{
 	"syno-ds.de",
 	"synology-diskstation.de",
 	"synology-ds.de",
+	"typedream.app",
+	"pro.typeform.com",
 	"uber.space",
 	"*.uberspace.de",
 	"hk.com",
 	"hk.org",
 	"ltd.hk",
 	"inc.hk",
+	"it.com",
+	"name.pm",
+	"sch.tf",
+	"biz.wf",
+	"sch.wf",
+	"org.yt",
 	"virtualuser.de",
 	"virtual-user.de",
 	"upli.io",
@@ -9137,13 +9455,8 @@ var rules = [...]string{
 	"dev.vu",
 	"me.vu",
 	"v.ua",
+	"*.vultrobjects.com",
 	"wafflecell.com",
-	"idnblogger.com",
-	"indowapblog.com",
-	"bloger.id",
-	"wblog.id",
-	"wbq.me",
-	"fastblog.net",
 	"*.webhare.dev",
 	"reserve-online.net",
 	"reserve-online.com",
@@ -9159,6 +9472,7 @@ var rules = [...]string{
 	"wmcloud.org",
 	"panel.gg",
 	"daemon.panel.gg",
+	"messwithdns.com",
 	"woltlab-demo.com",
 	"myforum.community",
 	"community-pro.de",
@@ -9221,7 +9535,6 @@ var nodeLabels = [...]string{
 	"aco",
 	"actor",
 	"ad",
-	"adac",
 	"ads",
 	"adult",
 	"ae",
@@ -9229,7 +9542,6 @@ var nodeLabels = [...]string{
 	"aero",
 	"aetna",
 	"af",
-	"afamilycompany",
 	"afl",
 	"africa",
 	"ag",
@@ -9381,8 +9693,6 @@ var nodeLabels = [...]string{
 	"brussels",
 	"bs",
 	"bt",
-	"budapest",
-	"bugatti",
 	"build",
 	"builders",
 	"business",
@@ -9402,7 +9712,6 @@ var nodeLabels = [...]string{
 	"cam",
 	"camera",
 	"camp",
-	"cancerresearch",
 	"canon",
 	"capetown",
 	"capital",
@@ -9505,7 +9814,6 @@ var nodeLabels = [...]string{
 	"crs",
 	"cruise",
 	"cruises",
-	"csc",
 	"cu",
 	"cuisinella",
 	"cv",
@@ -9564,7 +9872,6 @@ var nodeLabels = [...]string{
 	"drive",
 	"dtv",
 	"dubai",
-	"duck",
 	"dunlop",
 	"dupont",
 	"durban",
@@ -9698,7 +10005,6 @@ var nodeLabels = [...]string{
 	"gives",
 	"giving",
 	"gl",
-	"glade",
 	"glass",
 	"gle",
 	"global",
@@ -9925,7 +10231,6 @@ var nodeLabels = [...]string{
 	"lipsy",
 	"live",
 	"living",
-	"lixil",
 	"lk",
 	"llc",
 	"llp",
@@ -9933,7 +10238,6 @@ var nodeLabels = [...]string{
 	"loans",
 	"locker",
 	"locus",
-	"loft",
 	"lol",
 	"london",
 	"lotte",
@@ -10083,7 +10387,6 @@ var nodeLabels = [...]string{
 	"nz",
 	"obi",
 	"observer",
-	"off",
 	"office",
 	"okinawa",
 	"olayan",
@@ -10185,7 +10488,6 @@ var nodeLabels = [...]string{
 	"quest",
 	"racing",
 	"radio",
-	"raid",
 	"re",
 	"read",
 	"realestate",
@@ -10262,7 +10564,6 @@ var nodeLabels = [...]string{
 	"schule",
 	"schwarz",
 	"science",
-	"scjohnson",
 	"scot",
 	"sd",
 	"se",
@@ -10274,7 +10575,6 @@ var nodeLabels = [...]string{
 	"select",
 	"sener",
 	"services",
-	"ses",
 	"seven",
 	"sew",
 	"sex",
@@ -10537,6 +10837,7 @@ var nodeLabels = [...]string{
 	"xn--45br5cyl",
 	"xn--45brj9c",
 	"xn--45q11c",
+	"xn--4dbrk0ce",
 	"xn--4gbrim",
 	"xn--54b7fta0cc",
 	"xn--55qw42g",
@@ -10599,7 +10900,6 @@ var nodeLabels = [...]string{
 	"xn--j1amh",
 	"xn--j6w193g",
 	"xn--jlq480n2rg",
-	"xn--jlq61u9w7b",
 	"xn--jvr189m",
 	"xn--kcrx77d1x4a",
 	"xn--kprw13d",
@@ -10847,15 +11147,20 @@ var nodeLabels = [...]string{
 	"it",
 	"og",
 	"pb",
+	"beget",
 	"bookonline",
 	"clerk",
 	"clerkstage",
+	"deta",
 	"developer",
+	"easypanel",
 	"edgecompute",
+	"encr",
 	"fireweb",
 	"framer",
 	"hasura",
 	"loginline",
+	"messerli",
 	"netlify",
 	"noop",
 	"northflank",
@@ -10863,11 +11168,13 @@ var nodeLabels = [...]string{
 	"onflashdrive",
 	"platform0",
 	"run",
+	"snowflake",
+	"streamlit",
 	"telebit",
+	"typedream",
 	"vercel",
 	"web",
 	"wnext",
-	"a",
 	"bet",
 	"com",
 	"coop",
@@ -10882,7 +11189,6 @@ var nodeLabels = [...]string{
 	"org",
 	"senasa",
 	"tur",
-	"blogspot",
 	"e164",
 	"in-addr",
 	"ip6",
@@ -10891,6 +11197,7 @@ var nodeLabels = [...]string{
 	"urn",
 	"gov",
 	"cloudns",
+	"123webseite",
 	"12hp",
 	"2ix",
 	"4lima",
@@ -10908,13 +11215,6 @@ var nodeLabels = [...]string{
 	"or",
 	"ortsinfo",
 	"priv",
-	"sth",
-	"blogspot",
-	"wien",
-	"ex",
-	"in",
-	"ex",
-	"kunden",
 	"act",
 	"asn",
 	"com",
@@ -10933,25 +11233,6 @@ var nodeLabels = [...]string{
 	"tas",
 	"vic",
 	"wa",
-	"blogspot",
-	"cloudlets",
-	"myspreadshop",
-	"mel",
-	"act",
-	"catholic",
-	"nsw",
-	"nt",
-	"qld",
-	"sa",
-	"tas",
-	"vic",
-	"wa",
-	"schools",
-	"qld",
-	"sa",
-	"tas",
-	"vic",
-	"wa",
 	"com",
 	"be",
 	"cat",
@@ -10993,6 +11274,7 @@ var nodeLabels = [...]string{
 	"org",
 	"store",
 	"tv",
+	"123website",
 	"ac",
 	"blogspot",
 	"interhostsolutions",
@@ -11000,8 +11282,6 @@ var nodeLabels = [...]string{
 	"myspreadshop",
 	"transurl",
 	"webhosting",
-	"cloud",
-	"ezproxy",
 	"gov",
 	"0",
 	"1",
@@ -11051,6 +11331,7 @@ var nodeLabels = [...]string{
 	"edu",
 	"or",
 	"org",
+	"activetrail",
 	"cloudns",
 	"dscloud",
 	"dyndns",
@@ -11065,10 +11346,27 @@ var nodeLabels = [...]string{
 	"orx",
 	"selfip",
 	"webhop",
-	"asso",
-	"barreau",
+	"africa",
+	"agro",
+	"architectes",
+	"assur",
+	"avocats",
 	"blogspot",
-	"gouv",
+	"co",
+	"com",
+	"eco",
+	"econo",
+	"edu",
+	"info",
+	"loisirs",
+	"money",
+	"net",
+	"org",
+	"ote",
+	"restaurant",
+	"resto",
+	"tourism",
+	"univ",
 	"com",
 	"edu",
 	"gov",
@@ -11262,64 +11560,6 @@ var nodeLabels = [...]string{
 	"vlog",
 	"wiki",
 	"zlg",
-	"blogspot",
-	"virtualcloud",
-	"scale",
-	"users",
-	"ac",
-	"al",
-	"am",
-	"ap",
-	"ba",
-	"ce",
-	"df",
-	"es",
-	"go",
-	"ma",
-	"mg",
-	"ms",
-	"mt",
-	"pa",
-	"pb",
-	"pe",
-	"pi",
-	"pr",
-	"rj",
-	"rn",
-	"ro",
-	"rr",
-	"rs",
-	"sc",
-	"se",
-	"sp",
-	"to",
-	"ac",
-	"al",
-	"am",
-	"ap",
-	"ba",
-	"ce",
-	"df",
-	"es",
-	"go",
-	"ma",
-	"mg",
-	"ms",
-	"mt",
-	"pa",
-	"pb",
-	"pe",
-	"pi",
-	"pr",
-	"rj",
-	"rn",
-	"ro",
-	"rr",
-	"rs",
-	"sc",
-	"se",
-	"sp",
-	"to",
 	"com",
 	"edu",
 	"gov",
@@ -11341,7 +11581,6 @@ var nodeLabels = [...]string{
 	"mil",
 	"mycloud",
 	"of",
-	"blogspot",
 	"com",
 	"edu",
 	"gov",
@@ -11371,7 +11610,6 @@ var nodeLabels = [...]string{
 	"sk",
 	"yk",
 	"nabu",
-	"ui",
 	"cloudns",
 	"csx",
 	"fantasyleague",
@@ -11381,9 +11619,9 @@ var nodeLabels = [...]string{
 	"scrapping",
 	"spawn",
 	"twmail",
-	"instances",
 	"gov",
 	"blogspot",
+	"123website",
 	"12hp",
 	"2ix",
 	"4lima",
@@ -11396,10 +11634,6 @@ var nodeLabels = [...]string{
 	"linkyard-cloud",
 	"myspreadshop",
 	"square7",
-	"svc",
-	"ae",
-	"appengine",
-	"alp1",
 	"ac",
 	"asso",
 	"co",
@@ -11427,6 +11661,7 @@ var nodeLabels = [...]string{
 	"banzai",
 	"diadem",
 	"elementor",
+	"encoway",
 	"jelastic",
 	"jele",
 	"jenv-aruba",
@@ -11439,7 +11674,9 @@ var nodeLabels = [...]string{
 	"oxa",
 	"perspecta",
 	"primetel",
+	"ravendb",
 	"reclaim",
+	"scw",
 	"sensiosite",
 	"statics",
 	"trafficplex",
@@ -11447,25 +11684,9 @@ var nodeLabels = [...]string{
 	"urown",
 	"vapor",
 	"voorloper",
-	"es-1",
-	"vip",
-	"aruba",
-	"it1",
-	"eur",
-	"it1",
-	"cs",
-	"tn",
-	"uk",
-	"uk",
-	"ca",
-	"uk",
-	"us",
-	"ch",
-	"de",
 	"barsy",
 	"cloudns",
 	"jele",
-	"pony",
 	"co",
 	"com",
 	"gov",
@@ -11473,6 +11694,7 @@ var nodeLabels = [...]string{
 	"ac",
 	"ah",
 	"bj",
+	"canva-apps",
 	"com",
 	"cq",
 	"edu",
@@ -11501,6 +11723,7 @@ var nodeLabels = [...]string{
 	"nx",
 	"org",
 	"qh",
+	"quickconnect",
 	"sc",
 	"sd",
 	"sh",
@@ -11515,19 +11738,12 @@ var nodeLabels = [...]string{
 	"xz",
 	"yn",
 	"zj",
-	"amazonaws",
-	"cn-north-1",
-	"compute",
-	"eb",
-	"elb",
-	"s3",
-	"cn-north-1",
-	"cn-northwest-1",
 	"arts",
 	"carrd",
 	"com",
 	"crd",
 	"edu",
+	"firewalledreplit",
 	"firm",
 	"gov",
 	"info",
@@ -11538,7 +11754,6 @@ var nodeLabels = [...]string{
 	"mypi",
 	"n4t",
 	"net",
-	"nodum",
 	"nom",
 	"org",
 	"otap",
@@ -11546,8 +11761,6 @@ var nodeLabels = [...]string{
 	"repl",
 	"supabase",
 	"web",
-	"blogspot",
-	"id",
 	"owo",
 	"001www",
 	"0emm",
@@ -11556,6 +11769,9 @@ var nodeLabels = [...]string{
 	"4u",
 	"adobeaemcloud",
 	"africa",
+	"airkitapps",
+	"airkitapps-au",
+	"aivencloud",
 	"alpha-myqnapcloud",
 	"amazonaws",
 	"amscompute",
@@ -11583,13 +11799,17 @@ var nodeLabels = [...]string{
 	"br",
 	"builtwithdark",
 	"cafjs",
+	"canva-apps",
 	"cechire",
+	"cf-ipfs",
 	"ciscofreak",
 	"clicketcloud",
 	"cloudcontrolapp",
 	"cloudcontrolled",
+	"cloudflare-ipfs",
 	"cn",
 	"co",
+	"code",
 	"codespot",
 	"customer-oci",
 	"damnserver",
@@ -11606,6 +11826,8 @@ var nodeLabels = [...]string{
 	"dev-myqnapcloud",
 	"devcdnaccesso",
 	"digitaloceanspaces",
+	"discordsays",
+	"discordsez",
 	"ditchyourip",
 	"dnsalias",
 	"dnsdojo",
@@ -11635,6 +11857,7 @@ var nodeLabels = [...]string{
 	"dyndns-work",
 	"dynns",
 	"elasticbeanstalk",
+	"encoreapi",
 	"est-a-la-maison",
 	"est-a-la-masion",
 	"est-le-patron",
@@ -11642,6 +11865,7 @@ var nodeLabels = [...]string{
 	"eu",
 	"evennode",
 	"familyds",
+	"fastly-edge",
 	"fastly-terrarium",
 	"fastvps-server",
 	"fbsbx",
@@ -11728,11 +11952,9 @@ var nodeLabels = [...]string{
 	"hotelwithflight",
 	"hu",
 	"iamallama",
-	"idnblogger",
 	"ik-server",
 	"impertrix",
 	"impertrixcdn",
-	"indowapblog",
 	"is-a-anarchist",
 	"is-a-blogger",
 	"is-a-bookkeeper",
@@ -11791,6 +12013,7 @@ var nodeLabels = [...]string{
 	"isa-geek",
 	"isa-hockeynut",
 	"issmarterthanyou",
+	"it",
 	"jdevcloud",
 	"jelastic",
 	"joyent",
@@ -11799,6 +12022,7 @@ var nodeLabels = [...]string{
 	"kilatiron",
 	"kozow",
 	"kr",
+	"ktistory",
 	"likes-pie",
 	"likescandy",
 	"linode",
@@ -11810,6 +12034,7 @@ var nodeLabels = [...]string{
 	"lpusercontent",
 	"massivegrid",
 	"mazeplay",
+	"messwithdns",
 	"meteorapp",
 	"mex",
 	"miniserver",
@@ -11820,11 +12045,11 @@ var nodeLabels = [...]string{
 	"mydrobo",
 	"myiphost",
 	"myqnapcloud",
-	"myravendb",
 	"mysecuritycamera",
 	"myshopblocks",
 	"myshopify",
 	"myspreadshop",
+	"mytabit",
 	"mythic-beasts",
 	"mytuleap",
 	"myvnc",
@@ -11832,6 +12057,7 @@ var nodeLabels = [...]string{
 	"net-freaks",
 	"nfshost",
 	"no",
+	"nospamproxy",
 	"observableusercontent",
 	"on-aptible",
 	"onfabrica",
@@ -11894,13 +12120,16 @@ var nodeLabels = [...]string{
 	"shopitsite",
 	"siiites",
 	"simple-url",
+	"simplesite",
 	"sinaapp",
 	"skygearapp",
 	"smushcdn",
 	"space-to-rent",
 	"stackhero-network",
 	"stdlib",
+	"streamlitapp",
 	"stufftoread",
+	"tb-hosting",
 	"teaches-yoga",
 	"temp-dns",
 	"theworkpc",
@@ -11909,11 +12138,13 @@ var nodeLabels = [...]string{
 	"try-snowplow",
 	"trycloudflare",
 	"tuleap-partners",
+	"typeform",
 	"uk",
 	"unusualperson",
 	"us",
 	"uy",
 	"vipsinaapp",
+	"vultrobjects",
 	"wafaicloud",
 	"wafflecell",
 	"wiardweb",
@@ -11930,159 +12161,6 @@ var nodeLabels = [...]string{
 	"xnbay",
 	"yolasite",
 	"za",
-	"dev",
-	"ap-northeast-1",
-	"ap-northeast-2",
-	"ap-south-1",
-	"ap-southeast-1",
-	"ap-southeast-2",
-	"ca-central-1",
-	"compute",
-	"compute-1",
-	"elb",
-	"eu-central-1",
-	"eu-west-1",
-	"eu-west-2",
-	"eu-west-3",
-	"s3",
-	"s3-ap-northeast-1",
-	"s3-ap-northeast-2",
-	"s3-ap-south-1",
-	"s3-ap-southeast-1",
-	"s3-ap-southeast-2",
-	"s3-ca-central-1",
-	"s3-eu-central-1",
-	"s3-eu-west-1",
-	"s3-eu-west-2",
-	"s3-eu-west-3",
-	"s3-external-1",
-	"s3-fips-us-gov-west-1",
-	"s3-sa-east-1",
-	"s3-us-east-2",
-	"s3-us-gov-west-1",
-	"s3-us-west-1",
-	"s3-us-west-2",
-	"s3-website-ap-northeast-1",
-	"s3-website-ap-southeast-1",
-	"s3-website-ap-southeast-2",
-	"s3-website-eu-west-1",
-	"s3-website-sa-east-1",
-	"s3-website-us-east-1",
-	"s3-website-us-west-1",
-	"s3-website-us-west-2",
-	"sa-east-1",
-	"us-east-1",
-	"us-east-2",
-	"dualstack",
-	"s3",
-	"dualstack",
-	"s3",
-	"s3-website",
-	"s3",
-	"dualstack",
-	"s3",
-	"s3-website",
-	"s3",
-	"dualstack",
-	"s3",
-	"dualstack",
-	"s3",
-	"dualstack",
-	"s3",
-	"s3-website",
-	"s3",
-	"dualstack",
-	"s3",
-	"s3-website",
-	"s3",
-	"dualstack",
-	"s3",
-	"dualstack",
-	"s3",
-	"s3-website",
-	"s3",
-	"dualstack",
-	"s3",
-	"s3-website",
-	"s3",
-	"dualstack",
-	"s3",
-	"dualstack",
-	"s3",
-	"dualstack",
-	"s3",
-	"s3-website",
-	"s3",
-	"r",
-	"alpha",
-	"beta",
-	"oci",
-	"ocp",
-	"ocs",
-	"demo",
-	"instance",
-	"ap-northeast-1",
-	"ap-northeast-2",
-	"ap-northeast-3",
-	"ap-south-1",
-	"ap-southeast-1",
-	"ap-southeast-2",
-	"ca-central-1",
-	"eu-central-1",
-	"eu-west-1",
-	"eu-west-2",
-	"eu-west-3",
-	"sa-east-1",
-	"us-east-1",
-	"us-east-2",
-	"us-gov-west-1",
-	"us-west-1",
-	"us-west-2",
-	"eu-1",
-	"eu-2",
-	"eu-3",
-	"eu-4",
-	"us-1",
-	"us-2",
-	"us-3",
-	"us-4",
-	"apps",
-	"paas",
-	"rag-cloud",
-	"rag-cloud-ch",
-	"jcloud",
-	"jcloud-ver-jpc",
-	"demo",
-	"cns",
-	"members",
-	"nodebalancer",
-	"ip",
-	"app",
-	"paas",
-	"eu",
-	"caracal",
-	"customer",
-	"fentiger",
-	"lynx",
-	"ocelot",
-	"oncilla",
-	"onza",
-	"sphinx",
-	"vs",
-	"x",
-	"yali",
-	"static",
-	"xen",
-	"eu",
-	"app",
-	"api",
-	"jed",
-	"lon",
-	"ryd",
-	"pages",
-	"js",
-	"u2",
-	"u2-local",
 	"myforum",
 	"nog",
 	"ravendb",
@@ -12120,27 +12198,19 @@ var nodeLabels = [...]string{
 	"ekloges",
 	"gov",
 	"ltd",
-	"name",
+	"mil",
 	"net",
 	"org",
-	"parliament",
 	"press",
 	"pro",
 	"tm",
 	"blogspot",
-	"scaleforce",
-	"j",
-	"blogspot",
 	"co",
 	"e4",
 	"metacentrum",
 	"muni",
 	"realm",
-	"cloud",
-	"custom",
-	"cloud",
-	"flt",
-	"usr",
+	"123webseite",
 	"12hp",
 	"2ix",
 	"4lima",
@@ -12176,6 +12246,7 @@ var nodeLabels = [...]string{
 	"in-dsl",
 	"in-vpn",
 	"internet-dns",
+	"iservschule",
 	"isteingeek",
 	"istmein",
 	"keymachine",
@@ -12193,6 +12264,7 @@ var nodeLabels = [...]string{
 	"my-wan",
 	"myhome-server",
 	"myspreadshop",
+	"schulplattform",
 	"schulserver",
 	"spdns",
 	"speedpartner",
@@ -12209,27 +12281,24 @@ var nodeLabels = [...]string{
 	"virtualuser",
 	"xn--gnstigbestellen-zvb",
 	"xn--gnstigliefern-wob",
-	"dyn",
-	"dyn",
-	"dyndns",
-	"dyn",
-	"it",
-	"pages",
-	"customer",
 	"bss",
+	"autocode",
 	"curv",
 	"deno",
 	"deno-staging",
+	"deta",
 	"fly",
 	"gateway",
 	"githubpreview",
 	"iserv",
 	"lcl",
 	"lclstage",
+	"localcert",
 	"loginline",
 	"mediatech",
 	"pages",
 	"platter-app",
+	"r2",
 	"shiftcrypto",
 	"stg",
 	"stgstage",
@@ -12237,7 +12306,7 @@ var nodeLabels = [...]string{
 	"webhare",
 	"workers",
 	"cloudapps",
-	"london",
+	"123hjemmeside",
 	"biz",
 	"blogspot",
 	"co",
@@ -12271,7 +12340,7 @@ var nodeLabels = [...]string{
 	"soc",
 	"tm",
 	"dapps",
-	"bzz",
+	"base",
 	"com",
 	"edu",
 	"fin",
@@ -12282,10 +12351,10 @@ var nodeLabels = [...]string{
 	"med",
 	"mil",
 	"net",
+	"official",
 	"org",
 	"pro",
 	"rit",
-	"git-pages",
 	"co",
 	"aip",
 	"com",
@@ -12297,7 +12366,6 @@ var nodeLabels = [...]string{
 	"org",
 	"pri",
 	"riik",
-	"blogspot",
 	"com",
 	"edu",
 	"eun",
@@ -12307,14 +12375,13 @@ var nodeLabels = [...]string{
 	"net",
 	"org",
 	"sci",
-	"blogspot",
+	"123miweb",
 	"com",
 	"edu",
 	"gob",
 	"myspreadshop",
 	"nom",
 	"org",
-	"blogspot",
 	"compute",
 	"biz",
 	"com",
@@ -12324,6 +12391,7 @@ var nodeLabels = [...]string{
 	"name",
 	"net",
 	"org",
+	"airkitapps",
 	"barsy",
 	"cloudns",
 	"diskstation",
@@ -12332,24 +12400,21 @@ var nodeLabels = [...]string{
 	"spdns",
 	"transurl",
 	"wellbeingzone",
-	"jelastic",
 	"party",
-	"user",
 	"co",
+	"koobin",
 	"ybo",
 	"storj",
-	"of",
+	"123kotisivu",
 	"aland",
 	"blogspot",
 	"cloudplatform",
 	"datacenter",
 	"dy",
 	"iki",
+	"kapsi",
 	"myspreadshop",
 	"xn--hkkinen-5wa",
-	"fi",
-	"demo",
-	"paas",
 	"co",
 	"ac",
 	"biz",
@@ -12366,6 +12431,8 @@ var nodeLabels = [...]string{
 	"net",
 	"org",
 	"radio",
+	"user",
+	"123siteweb",
 	"aeroport",
 	"asso",
 	"avocat",
@@ -12376,6 +12443,7 @@ var nodeLabels = [...]string{
 	"chirurgiens-dentistes",
 	"chirurgiens-dentistes-en-france",
 	"com",
+	"dedibox",
 	"en-root",
 	"experts-comptables",
 	"fbx-os",
@@ -12414,7 +12482,6 @@ var nodeLabels = [...]string{
 	"net",
 	"org",
 	"panel",
-	"daemon",
 	"com",
 	"edu",
 	"gov",
@@ -12455,6 +12522,7 @@ var nodeLabels = [...]string{
 	"gov",
 	"net",
 	"org",
+	"simplesite",
 	"discourse",
 	"blog",
 	"com",
@@ -12515,6 +12583,7 @@ var nodeLabels = [...]string{
 	"net",
 	"org",
 	"cloudaccess",
+	"easypanel",
 	"fastvps",
 	"freesite",
 	"half",
@@ -12582,7 +12651,6 @@ var nodeLabels = [...]string{
 	"video",
 	"ac",
 	"biz",
-	"bloger",
 	"co",
 	"desa",
 	"flap",
@@ -12594,11 +12662,8 @@ var nodeLabels = [...]string{
 	"or",
 	"ponpes",
 	"sch",
-	"wblog",
 	"web",
 	"blogspot",
-	"rss",
-	"blogspot",
 	"gov",
 	"myspreadshop",
 	"ac",
@@ -12609,9 +12674,6 @@ var nodeLabels = [...]string{
 	"muni",
 	"net",
 	"org",
-	"blogspot",
-	"ravpage",
-	"tabitorder",
 	"ac",
 	"co",
 	"com",
@@ -12620,24 +12682,51 @@ var nodeLabels = [...]string{
 	"ro",
 	"tt",
 	"tv",
-	"ltd",
-	"plc",
+	"5g",
+	"6g",
 	"ac",
+	"ai",
+	"am",
 	"barsy",
+	"bihar",
+	"biz",
 	"blogspot",
+	"business",
+	"ca",
 	"cloudns",
+	"cn",
 	"co",
+	"com",
+	"coop",
+	"cs",
+	"delhi",
+	"dr",
 	"edu",
+	"er",
 	"firm",
 	"gen",
 	"gov",
+	"gujarat",
 	"ind",
+	"info",
+	"int",
+	"internet",
+	"io",
+	"me",
 	"mil",
 	"net",
 	"nic",
 	"org",
+	"pg",
+	"post",
+	"pro",
 	"res",
 	"supabase",
+	"travel",
+	"tv",
+	"uk",
+	"up",
+	"us",
 	"web",
 	"barrel-of-knowledge",
 	"barrell-of-knowledge",
@@ -12669,6 +12758,7 @@ var nodeLabels = [...]string{
 	"banzaicloud",
 	"barsy",
 	"basicserver",
+	"beagleboard",
 	"beebyte",
 	"beebyteapp",
 	"bigv",
@@ -12684,6 +12774,7 @@ var nodeLabels = [...]string{
 	"drud",
 	"dyn53",
 	"editorx",
+	"edugit",
 	"fh-muenster",
 	"forgerock",
 	"ghost",
@@ -12698,10 +12789,11 @@ var nodeLabels = [...]string{
 	"lolipop",
 	"mo-siemens",
 	"moonscale",
+	"musician",
 	"ngrok",
 	"nid",
 	"nodeart",
-	"nodum",
+	"on-acorn",
 	"on-k3s",
 	"on-rio",
 	"pantheonsite",
@@ -12730,31 +12822,6 @@ var nodeLabels = [...]string{
 	"virtualserver",
 	"webthings",
 	"wedeploy",
-	"app",
-	"backyards",
-	"paas",
-	"sekd1",
-	"uk0",
-	"dyndns",
-	"id",
-	"apps",
-	"stage",
-	"mock",
-	"sys",
-	"devices",
-	"dev",
-	"disrec",
-	"prod",
-	"testing",
-	"cust",
-	"reservd",
-	"cust",
-	"reservd",
-	"cust",
-	"cust",
-	"reservd",
-	"cloud-fr1",
-	"g",
 	"com",
 	"edu",
 	"gov",
@@ -12778,6 +12845,7 @@ var nodeLabels = [...]string{
 	"int",
 	"net",
 	"org",
+	"123homepage",
 	"16-b",
 	"32-b",
 	"64-b",
@@ -12924,8 +12992,10 @@ var nodeLabels = [...]string{
 	"gov",
 	"gr",
 	"grosseto",
+	"ibxos",
 	"iglesias-carbonia",
 	"iglesiascarbonia",
+	"iliadboxos",
 	"im",
 	"imperia",
 	"is",
@@ -13192,10 +13262,6 @@ var nodeLabels = [...]string{
 	"xn--valle-d-aoste-ehb",
 	"xn--valleaoste-e7a",
 	"xn--valledaoste-ebb",
-	"jc",
-	"open",
-	"jelastic",
-	"cloud",
 	"co",
 	"net",
 	"of",
@@ -13212,63 +13278,173 @@ var nodeLabels = [...]string{
 	"ad",
 	"aichi",
 	"akita",
+	"angry",
 	"aomori",
+	"babyblue",
+	"babymilk",
+	"backdrop",
+	"bambina",
+	"bitter",
 	"blogspot",
+	"blush",
+	"boo",
+	"boy",
+	"boyfriend",
+	"but",
+	"buyshop",
+	"candypop",
+	"capoo",
+	"catfood",
+	"cheap",
 	"chiba",
+	"chicappa",
+	"chillout",
+	"chips",
+	"chowder",
+	"chu",
+	"ciao",
 	"co",
+	"cocotte",
+	"coolblog",
+	"cranky",
+	"cutegirl",
+	"daa",
+	"deca",
+	"deci",
+	"digick",
 	"ed",
+	"egoism",
 	"ehime",
+	"fakefur",
+	"fashionstore",
+	"fem",
+	"flier",
+	"floppy",
+	"fool",
+	"frenchkiss",
 	"fukui",
 	"fukuoka",
 	"fukushima",
 	"gifu",
+	"girlfriend",
+	"girly",
+	"gloomy",
 	"go",
+	"gonna",
 	"gr",
+	"greater",
 	"gunma",
+	"hacca",
+	"handcrafted",
+	"heavy",
+	"her",
+	"hiho",
+	"hippy",
 	"hiroshima",
 	"hokkaido",
+	"holy",
+	"hungry",
 	"hyogo",
 	"ibaraki",
+	"icurus",
 	"ishikawa",
+	"itigo",
 	"iwate",
+	"jellybean",
 	"kagawa",
 	"kagoshima",
 	"kanagawa",
+	"kawaiishop",
 	"kawasaki",
+	"kikirara",
+	"kill",
+	"kilo",
 	"kitakyushu",
 	"kobe",
 	"kochi",
 	"kumamoto",
+	"kuron",
 	"kyoto",
 	"lg",
+	"littlestar",
+	"lolipopmc",
+	"lolitapunk",
+	"lomo",
+	"lovepop",
+	"lovesick",
+	"main",
 	"mie",
 	"miyagi",
 	"miyazaki",
+	"mods",
+	"mond",
+	"mongolian",
+	"moo",
 	"nagano",
 	"nagasaki",
 	"nagoya",
+	"namaste",
 	"nara",
 	"ne",
 	"niigata",
+	"nikita",
+	"nobushi",
+	"noor",
 	"oita",
 	"okayama",
 	"okinawa",
+	"oops",
 	"or",
 	"osaka",
+	"parallel",
+	"parasite",
+	"pecori",
+	"peewee",
+	"penne",
+	"pepper",
+	"perma",
+	"pigboat",
+	"pinoko",
+	"punyu",
+	"pupu",
+	"pussycat",
+	"pya",
+	"raindrop",
+	"readymade",
+	"sadist",
 	"saga",
 	"saitama",
 	"sapporo",
+	"schoolbus",
+	"secret",
 	"sendai",
 	"shiga",
 	"shimane",
 	"shizuoka",
+	"staba",
+	"stripper",
+	"sub",
+	"sunnyday",
+	"supersale",
+	"theshop",
+	"thick",
 	"tochigi",
 	"tokushima",
 	"tokyo",
+	"tonkotsu",
 	"tottori",
 	"toyama",
+	"under",
+	"upper",
 	"usercontent",
+	"velvet",
+	"verse",
+	"versus",
+	"vivian",
 	"wakayama",
+	"watson",
+	"weblike",
+	"whitesnow",
 	"xn--0trq7p7nn",
 	"xn--1ctwo",
 	"xn--1lqs03n",
@@ -13320,1689 +13496,7 @@ var nodeLabels = [...]string{
 	"yamaguchi",
 	"yamanashi",
 	"yokohama",
-	"aisai",
-	"ama",
-	"anjo",
-	"asuke",
-	"chiryu",
-	"chita",
-	"fuso",
-	"gamagori",
-	"handa",
-	"hazu",
-	"hekinan",
-	"higashiura",
-	"ichinomiya",
-	"inazawa",
-	"inuyama",
-	"isshiki",
-	"iwakura",
-	"kanie",
-	"kariya",
-	"kasugai",
-	"kira",
-	"kiyosu",
-	"komaki",
-	"konan",
-	"kota",
-	"mihama",
-	"miyoshi",
-	"nishio",
-	"nisshin",
-	"obu",
-	"oguchi",
-	"oharu",
-	"okazaki",
-	"owariasahi",
-	"seto",
-	"shikatsu",
-	"shinshiro",
-	"shitara",
-	"tahara",
-	"takahama",
-	"tobishima",
-	"toei",
-	"togo",
-	"tokai",
-	"tokoname",
-	"toyoake",
-	"toyohashi",
-	"toyokawa",
-	"toyone",
-	"toyota",
-	"tsushima",
-	"yatomi",
-	"akita",
-	"daisen",
-	"fujisato",
-	"gojome",
-	"hachirogata",
-	"happou",
-	"higashinaruse",
-	"honjo",
-	"honjyo",
-	"ikawa",
-	"kamikoani",
-	"kamioka",
-	"katagami",
-	"kazuno",
-	"kitaakita",
-	"kosaka",
-	"kyowa",
-	"misato",
-	"mitane",
-	"moriyoshi",
-	"nikaho",
-	"noshiro",
-	"odate",
-	"oga",
-	"ogata",
-	"semboku",
-	"yokote",
-	"yurihonjo",
-	"aomori",
-	"gonohe",
-	"hachinohe",
-	"hashikami",
-	"hiranai",
-	"hirosaki",
-	"itayanagi",
-	"kuroishi",
-	"misawa",
-	"mutsu",
-	"nakadomari",
-	"noheji",
-	"oirase",
-	"owani",
-	"rokunohe",
-	"sannohe",
-	"shichinohe",
-	"shingo",
-	"takko",
-	"towada",
-	"tsugaru",
-	"tsuruta",
-	"abiko",
-	"asahi",
-	"chonan",
-	"chosei",
-	"choshi",
-	"chuo",
-	"funabashi",
-	"futtsu",
-	"hanamigawa",
-	"ichihara",
-	"ichikawa",
-	"ichinomiya",
-	"inzai",
-	"isumi",
-	"kamagaya",
-	"kamogawa",
-	"kashiwa",
-	"katori",
-	"katsuura",
-	"kimitsu",
-	"kisarazu",
-	"kozaki",
-	"kujukuri",
-	"kyonan",
-	"matsudo",
-	"midori",
-	"mihama",
-	"minamiboso",
-	"mobara",
-	"mutsuzawa",
-	"nagara",
-	"nagareyama",
-	"narashino",
-	"narita",
-	"noda",
-	"oamishirasato",
-	"omigawa",
-	"onjuku",
-	"otaki",
-	"sakae",
-	"sakura",
-	"shimofusa",
-	"shirako",
-	"shiroi",
-	"shisui",
-	"sodegaura",
-	"sosa",
-	"tako",
-	"tateyama",
-	"togane",
-	"tohnosho",
-	"tomisato",
-	"urayasu",
-	"yachimata",
-	"yachiyo",
-	"yokaichiba",
-	"yokoshibahikari",
-	"yotsukaido",
-	"ainan",
-	"honai",
-	"ikata",
-	"imabari",
-	"iyo",
-	"kamijima",
-	"kihoku",
-	"kumakogen",
-	"masaki",
-	"matsuno",
-	"matsuyama",
-	"namikata",
-	"niihama",
-	"ozu",
-	"saijo",
-	"seiyo",
-	"shikokuchuo",
-	"tobe",
-	"toon",
-	"uchiko",
-	"uwajima",
-	"yawatahama",
-	"echizen",
-	"eiheiji",
-	"fukui",
-	"ikeda",
-	"katsuyama",
-	"mihama",
-	"minamiechizen",
-	"obama",
-	"ohi",
-	"ono",
-	"sabae",
-	"sakai",
-	"takahama",
-	"tsuruga",
-	"wakasa",
-	"ashiya",
-	"buzen",
-	"chikugo",
-	"chikuho",
-	"chikujo",
-	"chikushino",
-	"chikuzen",
-	"chuo",
-	"dazaifu",
-	"fukuchi",
-	"hakata",
-	"higashi",
-	"hirokawa",
-	"hisayama",
-	"iizuka",
-	"inatsuki",
-	"kaho",
-	"kasuga",
-	"kasuya",
-	"kawara",
-	"keisen",
-	"koga",
-	"kurate",
-	"kurogi",
-	"kurume",
-	"minami",
-	"miyako",
-	"miyama",
-	"miyawaka",
-	"mizumaki",
-	"munakata",
-	"nakagawa",
-	"nakama",
-	"nishi",
-	"nogata",
-	"ogori",
-	"okagaki",
-	"okawa",
-	"oki",
-	"omuta",
-	"onga",
-	"onojo",
-	"oto",
-	"saigawa",
-	"sasaguri",
-	"shingu",
-	"shinyoshitomi",
-	"shonai",
-	"soeda",
-	"sue",
-	"tachiarai",
-	"tagawa",
-	"takata",
-	"toho",
-	"toyotsu",
-	"tsuiki",
-	"ukiha",
-	"umi",
-	"usui",
-	"yamada",
-	"yame",
-	"yanagawa",
-	"yukuhashi",
-	"aizubange",
-	"aizumisato",
-	"aizuwakamatsu",
-	"asakawa",
-	"bandai",
-	"date",
-	"fukushima",
-	"furudono",
-	"futaba",
-	"hanawa",
-	"higashi",
-	"hirata",
-	"hirono",
-	"iitate",
-	"inawashiro",
-	"ishikawa",
-	"iwaki",
-	"izumizaki",
-	"kagamiishi",
-	"kaneyama",
-	"kawamata",
-	"kitakata",
-	"kitashiobara",
-	"koori",
-	"koriyama",
-	"kunimi",
-	"miharu",
-	"mishima",
-	"namie",
-	"nango",
-	"nishiaizu",
-	"nishigo",
-	"okuma",
-	"omotego",
-	"ono",
-	"otama",
-	"samegawa",
-	"shimogo",
-	"shirakawa",
-	"showa",
-	"soma",
-	"sukagawa",
-	"taishin",
-	"tamakawa",
-	"tanagura",
-	"tenei",
-	"yabuki",
-	"yamato",
-	"yamatsuri",
-	"yanaizu",
-	"yugawa",
-	"anpachi",
-	"ena",
-	"gifu",
-	"ginan",
-	"godo",
-	"gujo",
-	"hashima",
-	"hichiso",
-	"hida",
-	"higashishirakawa",
-	"ibigawa",
-	"ikeda",
-	"kakamigahara",
-	"kani",
-	"kasahara",
-	"kasamatsu",
-	"kawaue",
-	"kitagata",
-	"mino",
-	"minokamo",
-	"mitake",
-	"mizunami",
-	"motosu",
-	"nakatsugawa",
-	"ogaki",
-	"sakahogi",
-	"seki",
-	"sekigahara",
-	"shirakawa",
-	"tajimi",
-	"takayama",
-	"tarui",
-	"toki",
-	"tomika",
-	"wanouchi",
-	"yamagata",
-	"yaotsu",
-	"yoro",
-	"annaka",
-	"chiyoda",
-	"fujioka",
-	"higashiagatsuma",
-	"isesaki",
-	"itakura",
-	"kanna",
-	"kanra",
-	"katashina",
-	"kawaba",
-	"kiryu",
-	"kusatsu",
-	"maebashi",
-	"meiwa",
-	"midori",
-	"minakami",
-	"naganohara",
-	"nakanojo",
-	"nanmoku",
-	"numata",
-	"oizumi",
-	"ora",
-	"ota",
-	"shibukawa",
-	"shimonita",
-	"shinto",
-	"showa",
-	"takasaki",
-	"takayama",
-	"tamamura",
-	"tatebayashi",
-	"tomioka",
-	"tsukiyono",
-	"tsumagoi",
-	"ueno",
-	"yoshioka",
-	"asaminami",
-	"daiwa",
-	"etajima",
-	"fuchu",
-	"fukuyama",
-	"hatsukaichi",
-	"higashihiroshima",
-	"hongo",
-	"jinsekikogen",
-	"kaita",
-	"kui",
-	"kumano",
-	"kure",
-	"mihara",
-	"miyoshi",
-	"naka",
-	"onomichi",
-	"osakikamijima",
-	"otake",
-	"saka",
-	"sera",
-	"seranishi",
-	"shinichi",
-	"shobara",
-	"takehara",
-	"abashiri",
-	"abira",
-	"aibetsu",
-	"akabira",
-	"akkeshi",
-	"asahikawa",
-	"ashibetsu",
-	"ashoro",
-	"assabu",
-	"atsuma",
-	"bibai",
-	"biei",
-	"bifuka",
-	"bihoro",
-	"biratori",
-	"chippubetsu",
-	"chitose",
-	"date",
-	"ebetsu",
-	"embetsu",
-	"eniwa",
-	"erimo",
-	"esan",
-	"esashi",
-	"fukagawa",
-	"fukushima",
-	"furano",
-	"furubira",
-	"haboro",
-	"hakodate",
-	"hamatonbetsu",
-	"hidaka",
-	"higashikagura",
-	"higashikawa",
-	"hiroo",
-	"hokuryu",
-	"hokuto",
-	"honbetsu",
-	"horokanai",
-	"horonobe",
-	"ikeda",
-	"imakane",
-	"ishikari",
-	"iwamizawa",
-	"iwanai",
-	"kamifurano",
-	"kamikawa",
-	"kamishihoro",
-	"kamisunagawa",
-	"kamoenai",
-	"kayabe",
-	"kembuchi",
-	"kikonai",
-	"kimobetsu",
-	"kitahiroshima",
-	"kitami",
-	"kiyosato",
-	"koshimizu",
-	"kunneppu",
-	"kuriyama",
-	"kuromatsunai",
-	"kushiro",
-	"kutchan",
-	"kyowa",
-	"mashike",
-	"matsumae",
-	"mikasa",
-	"minamifurano",
-	"mombetsu",
-	"moseushi",
-	"mukawa",
-	"muroran",
-	"naie",
-	"nakagawa",
-	"nakasatsunai",
-	"nakatombetsu",
-	"nanae",
-	"nanporo",
-	"nayoro",
-	"nemuro",
-	"niikappu",
-	"niki",
-	"nishiokoppe",
-	"noboribetsu",
-	"numata",
-	"obihiro",
-	"obira",
-	"oketo",
-	"okoppe",
-	"otaru",
-	"otobe",
-	"otofuke",
-	"otoineppu",
-	"oumu",
-	"ozora",
-	"pippu",
-	"rankoshi",
-	"rebun",
-	"rikubetsu",
-	"rishiri",
-	"rishirifuji",
-	"saroma",
-	"sarufutsu",
-	"shakotan",
-	"shari",
-	"shibecha",
-	"shibetsu",
-	"shikabe",
-	"shikaoi",
-	"shimamaki",
-	"shimizu",
-	"shimokawa",
-	"shinshinotsu",
-	"shintoku",
-	"shiranuka",
-	"shiraoi",
-	"shiriuchi",
-	"sobetsu",
-	"sunagawa",
-	"taiki",
-	"takasu",
-	"takikawa",
-	"takinoue",
-	"teshikaga",
-	"tobetsu",
-	"tohma",
-	"tomakomai",
-	"tomari",
-	"toya",
-	"toyako",
-	"toyotomi",
-	"toyoura",
-	"tsubetsu",
-	"tsukigata",
-	"urakawa",
-	"urausu",
-	"uryu",
-	"utashinai",
-	"wakkanai",
-	"wassamu",
-	"yakumo",
-	"yoichi",
-	"aioi",
-	"akashi",
-	"ako",
-	"amagasaki",
-	"aogaki",
-	"asago",
-	"ashiya",
-	"awaji",
-	"fukusaki",
-	"goshiki",
-	"harima",
-	"himeji",
-	"ichikawa",
-	"inagawa",
-	"itami",
-	"kakogawa",
-	"kamigori",
-	"kamikawa",
-	"kasai",
-	"kasuga",
-	"kawanishi",
-	"miki",
-	"minamiawaji",
-	"nishinomiya",
-	"nishiwaki",
-	"ono",
-	"sanda",
-	"sannan",
-	"sasayama",
-	"sayo",
-	"shingu",
-	"shinonsen",
-	"shiso",
-	"sumoto",
-	"taishi",
-	"taka",
-	"takarazuka",
-	"takasago",
-	"takino",
-	"tamba",
-	"tatsuno",
-	"toyooka",
-	"yabu",
-	"yashiro",
-	"yoka",
-	"yokawa",
-	"ami",
-	"asahi",
-	"bando",
-	"chikusei",
-	"daigo",
-	"fujishiro",
-	"hitachi",
-	"hitachinaka",
-	"hitachiomiya",
-	"hitachiota",
-	"ibaraki",
-	"ina",
-	"inashiki",
-	"itako",
-	"iwama",
-	"joso",
-	"kamisu",
-	"kasama",
-	"kashima",
-	"kasumigaura",
-	"koga",
-	"miho",
-	"mito",
-	"moriya",
-	"naka",
-	"namegata",
-	"oarai",
-	"ogawa",
-	"omitama",
-	"ryugasaki",
-	"sakai",
-	"sakuragawa",
-	"shimodate",
-	"shimotsuma",
-	"shirosato",
-	"sowa",
-	"suifu",
-	"takahagi",
-	"tamatsukuri",
-	"tokai",
-	"tomobe",
-	"tone",
-	"toride",
-	"tsuchiura",
-	"tsukuba",
-	"uchihara",
-	"ushiku",
-	"yachiyo",
-	"yamagata",
-	"yawara",
-	"yuki",
-	"anamizu",
-	"hakui",
-	"hakusan",
-	"kaga",
-	"kahoku",
-	"kanazawa",
-	"kawakita",
-	"komatsu",
-	"nakanoto",
-	"nanao",
-	"nomi",
-	"nonoichi",
-	"noto",
-	"shika",
-	"suzu",
-	"tsubata",
-	"tsurugi",
-	"uchinada",
-	"wajima",
-	"fudai",
-	"fujisawa",
-	"hanamaki",
-	"hiraizumi",
-	"hirono",
-	"ichinohe",
-	"ichinoseki",
-	"iwaizumi",
-	"iwate",
-	"joboji",
-	"kamaishi",
-	"kanegasaki",
-	"karumai",
-	"kawai",
-	"kitakami",
-	"kuji",
-	"kunohe",
-	"kuzumaki",
-	"miyako",
-	"mizusawa",
-	"morioka",
-	"ninohe",
-	"noda",
-	"ofunato",
-	"oshu",
-	"otsuchi",
-	"rikuzentakata",
-	"shiwa",
-	"shizukuishi",
-	"sumita",
-	"tanohata",
-	"tono",
-	"yahaba",
-	"yamada",
-	"ayagawa",
-	"higashikagawa",
-	"kanonji",
-	"kotohira",
-	"manno",
-	"marugame",
-	"mitoyo",
-	"naoshima",
-	"sanuki",
-	"tadotsu",
-	"takamatsu",
-	"tonosho",
-	"uchinomi",
-	"utazu",
-	"zentsuji",
-	"akune",
-	"amami",
-	"hioki",
-	"isa",
-	"isen",
-	"izumi",
-	"kagoshima",
-	"kanoya",
-	"kawanabe",
-	"kinko",
-	"kouyama",
-	"makurazaki",
-	"matsumoto",
-	"minamitane",
-	"nakatane",
-	"nishinoomote",
-	"satsumasendai",
-	"soo",
-	"tarumizu",
-	"yusui",
-	"aikawa",
-	"atsugi",
-	"ayase",
-	"chigasaki",
-	"ebina",
-	"fujisawa",
-	"hadano",
-	"hakone",
-	"hiratsuka",
-	"isehara",
-	"kaisei",
-	"kamakura",
-	"kiyokawa",
-	"matsuda",
-	"minamiashigara",
-	"miura",
-	"nakai",
-	"ninomiya",
-	"odawara",
-	"oi",
-	"oiso",
-	"sagamihara",
-	"samukawa",
-	"tsukui",
-	"yamakita",
-	"yamato",
-	"yokosuka",
-	"yugawara",
-	"zama",
-	"zushi",
-	"city",
-	"city",
-	"city",
-	"aki",
-	"geisei",
-	"hidaka",
-	"higashitsuno",
-	"ino",
-	"kagami",
-	"kami",
-	"kitagawa",
-	"kochi",
-	"mihara",
-	"motoyama",
-	"muroto",
-	"nahari",
-	"nakamura",
-	"nankoku",
-	"nishitosa",
-	"niyodogawa",
-	"ochi",
-	"okawa",
-	"otoyo",
-	"otsuki",
-	"sakawa",
-	"sukumo",
-	"susaki",
-	"tosa",
-	"tosashimizu",
-	"toyo",
-	"tsuno",
-	"umaji",
-	"yasuda",
-	"yusuhara",
-	"amakusa",
-	"arao",
-	"aso",
-	"choyo",
-	"gyokuto",
-	"kamiamakusa",
-	"kikuchi",
-	"kumamoto",
-	"mashiki",
-	"mifune",
-	"minamata",
-	"minamioguni",
-	"nagasu",
-	"nishihara",
-	"oguni",
-	"ozu",
-	"sumoto",
-	"takamori",
-	"uki",
-	"uto",
-	"yamaga",
-	"yamato",
-	"yatsushiro",
-	"ayabe",
-	"fukuchiyama",
-	"higashiyama",
-	"ide",
-	"ine",
-	"joyo",
-	"kameoka",
-	"kamo",
-	"kita",
-	"kizu",
-	"kumiyama",
-	"kyotamba",
-	"kyotanabe",
-	"kyotango",
-	"maizuru",
-	"minami",
-	"minamiyamashiro",
-	"miyazu",
-	"muko",
-	"nagaokakyo",
-	"nakagyo",
-	"nantan",
-	"oyamazaki",
-	"sakyo",
-	"seika",
-	"tanabe",
-	"uji",
-	"ujitawara",
-	"wazuka",
-	"yamashina",
-	"yawata",
-	"asahi",
-	"inabe",
-	"ise",
-	"kameyama",
-	"kawagoe",
-	"kiho",
-	"kisosaki",
-	"kiwa",
-	"komono",
-	"kumano",
-	"kuwana",
-	"matsusaka",
-	"meiwa",
-	"mihama",
-	"minamiise",
-	"misugi",
-	"miyama",
-	"nabari",
-	"shima",
-	"suzuka",
-	"tado",
-	"taiki",
-	"taki",
-	"tamaki",
-	"toba",
-	"tsu",
-	"udono",
-	"ureshino",
-	"watarai",
-	"yokkaichi",
-	"furukawa",
-	"higashimatsushima",
-	"ishinomaki",
-	"iwanuma",
-	"kakuda",
-	"kami",
-	"kawasaki",
-	"marumori",
-	"matsushima",
-	"minamisanriku",
-	"misato",
-	"murata",
-	"natori",
-	"ogawara",
-	"ohira",
-	"onagawa",
-	"osaki",
-	"rifu",
-	"semine",
-	"shibata",
-	"shichikashuku",
-	"shikama",
-	"shiogama",
-	"shiroishi",
-	"tagajo",
-	"taiwa",
-	"tome",
-	"tomiya",
-	"wakuya",
-	"watari",
-	"yamamoto",
-	"zao",
-	"aya",
-	"ebino",
-	"gokase",
-	"hyuga",
-	"kadogawa",
-	"kawaminami",
-	"kijo",
-	"kitagawa",
-	"kitakata",
-	"kitaura",
-	"kobayashi",
-	"kunitomi",
-	"kushima",
-	"mimata",
-	"miyakonojo",
-	"miyazaki",
-	"morotsuka",
-	"nichinan",
-	"nishimera",
-	"nobeoka",
-	"saito",
-	"shiiba",
-	"shintomi",
-	"takaharu",
-	"takanabe",
-	"takazaki",
-	"tsuno",
-	"achi",
-	"agematsu",
-	"anan",
-	"aoki",
-	"asahi",
-	"azumino",
-	"chikuhoku",
-	"chikuma",
-	"chino",
-	"fujimi",
-	"hakuba",
-	"hara",
-	"hiraya",
-	"iida",
-	"iijima",
-	"iiyama",
-	"iizuna",
-	"ikeda",
-	"ikusaka",
-	"ina",
-	"karuizawa",
-	"kawakami",
-	"kiso",
-	"kisofukushima",
-	"kitaaiki",
-	"komagane",
-	"komoro",
-	"matsukawa",
-	"matsumoto",
-	"miasa",
-	"minamiaiki",
-	"minamimaki",
-	"minamiminowa",
-	"minowa",
-	"miyada",
-	"miyota",
-	"mochizuki",
-	"nagano",
-	"nagawa",
-	"nagiso",
-	"nakagawa",
-	"nakano",
-	"nozawaonsen",
-	"obuse",
-	"ogawa",
-	"okaya",
-	"omachi",
-	"omi",
-	"ookuwa",
-	"ooshika",
-	"otaki",
-	"otari",
-	"sakae",
-	"sakaki",
-	"saku",
-	"sakuho",
-	"shimosuwa",
-	"shinanomachi",
-	"shiojiri",
-	"suwa",
-	"suzaka",
-	"takagi",
-	"takamori",
-	"takayama",
-	"tateshina",
-	"tatsuno",
-	"togakushi",
-	"togura",
-	"tomi",
-	"ueda",
-	"wada",
-	"yamagata",
-	"yamanouchi",
-	"yasaka",
-	"yasuoka",
-	"chijiwa",
-	"futsu",
-	"goto",
-	"hasami",
-	"hirado",
-	"iki",
-	"isahaya",
-	"kawatana",
-	"kuchinotsu",
-	"matsuura",
-	"nagasaki",
-	"obama",
-	"omura",
-	"oseto",
-	"saikai",
-	"sasebo",
-	"seihi",
-	"shimabara",
-	"shinkamigoto",
-	"togitsu",
-	"tsushima",
-	"unzen",
-	"city",
-	"ando",
-	"gose",
-	"heguri",
-	"higashiyoshino",
-	"ikaruga",
-	"ikoma",
-	"kamikitayama",
-	"kanmaki",
-	"kashiba",
-	"kashihara",
-	"katsuragi",
-	"kawai",
-	"kawakami",
-	"kawanishi",
-	"koryo",
-	"kurotaki",
-	"mitsue",
-	"miyake",
-	"nara",
-	"nosegawa",
-	"oji",
-	"ouda",
-	"oyodo",
-	"sakurai",
-	"sango",
-	"shimoichi",
-	"shimokitayama",
-	"shinjo",
-	"soni",
-	"takatori",
-	"tawaramoto",
-	"tenkawa",
-	"tenri",
-	"uda",
-	"yamatokoriyama",
-	"yamatotakada",
-	"yamazoe",
-	"yoshino",
-	"aseinet",
-	"gehirn",
-	"user",
-	"aga",
-	"agano",
-	"gosen",
-	"itoigawa",
-	"izumozaki",
-	"joetsu",
-	"kamo",
-	"kariwa",
-	"kashiwazaki",
-	"minamiuonuma",
-	"mitsuke",
-	"muika",
-	"murakami",
-	"myoko",
-	"nagaoka",
-	"niigata",
-	"ojiya",
-	"omi",
-	"sado",
-	"sanjo",
-	"seiro",
-	"seirou",
-	"sekikawa",
-	"shibata",
-	"tagami",
-	"tainai",
-	"tochio",
-	"tokamachi",
-	"tsubame",
-	"tsunan",
-	"uonuma",
-	"yahiko",
-	"yoita",
-	"yuzawa",
-	"beppu",
-	"bungoono",
-	"bungotakada",
-	"hasama",
-	"hiji",
-	"himeshima",
-	"hita",
-	"kamitsue",
-	"kokonoe",
-	"kuju",
-	"kunisaki",
-	"kusu",
-	"oita",
-	"saiki",
-	"taketa",
-	"tsukumi",
-	"usa",
-	"usuki",
-	"yufu",
-	"akaiwa",
-	"asakuchi",
-	"bizen",
-	"hayashima",
-	"ibara",
-	"kagamino",
-	"kasaoka",
-	"kibichuo",
-	"kumenan",
-	"kurashiki",
-	"maniwa",
-	"misaki",
-	"nagi",
-	"niimi",
-	"nishiawakura",
-	"okayama",
-	"satosho",
-	"setouchi",
-	"shinjo",
-	"shoo",
-	"soja",
-	"takahashi",
-	"tamano",
-	"tsuyama",
-	"wake",
-	"yakage",
-	"aguni",
-	"ginowan",
-	"ginoza",
-	"gushikami",
-	"haebaru",
-	"higashi",
-	"hirara",
-	"iheya",
-	"ishigaki",
-	"ishikawa",
-	"itoman",
-	"izena",
-	"kadena",
-	"kin",
-	"kitadaito",
-	"kitanakagusuku",
-	"kumejima",
-	"kunigami",
-	"minamidaito",
-	"motobu",
-	"nago",
-	"naha",
-	"nakagusuku",
-	"nakijin",
-	"nanjo",
-	"nishihara",
-	"ogimi",
-	"okinawa",
-	"onna",
-	"shimoji",
-	"taketomi",
-	"tarama",
-	"tokashiki",
-	"tomigusuku",
-	"tonaki",
-	"urasoe",
-	"uruma",
-	"yaese",
-	"yomitan",
-	"yonabaru",
-	"yonaguni",
-	"zamami",
-	"abeno",
-	"chihayaakasaka",
-	"chuo",
-	"daito",
-	"fujiidera",
-	"habikino",
-	"hannan",
-	"higashiosaka",
-	"higashisumiyoshi",
-	"higashiyodogawa",
-	"hirakata",
-	"ibaraki",
-	"ikeda",
-	"izumi",
-	"izumiotsu",
-	"izumisano",
-	"kadoma",
-	"kaizuka",
-	"kanan",
-	"kashiwara",
-	"katano",
-	"kawachinagano",
-	"kishiwada",
-	"kita",
-	"kumatori",
-	"matsubara",
-	"minato",
-	"minoh",
-	"misaki",
-	"moriguchi",
-	"neyagawa",
-	"nishi",
-	"nose",
-	"osakasayama",
-	"sakai",
-	"sayama",
-	"sennan",
-	"settsu",
-	"shijonawate",
-	"shimamoto",
-	"suita",
-	"tadaoka",
-	"taishi",
-	"tajiri",
-	"takaishi",
-	"takatsuki",
-	"tondabayashi",
-	"toyonaka",
-	"toyono",
-	"yao",
-	"ariake",
-	"arita",
-	"fukudomi",
-	"genkai",
-	"hamatama",
-	"hizen",
-	"imari",
-	"kamimine",
-	"kanzaki",
-	"karatsu",
-	"kashima",
-	"kitagata",
-	"kitahata",
-	"kiyama",
-	"kouhoku",
-	"kyuragi",
-	"nishiarita",
-	"ogi",
-	"omachi",
-	"ouchi",
-	"saga",
-	"shiroishi",
-	"taku",
-	"tara",
-	"tosu",
-	"yoshinogari",
-	"arakawa",
-	"asaka",
-	"chichibu",
-	"fujimi",
-	"fujimino",
-	"fukaya",
-	"hanno",
-	"hanyu",
-	"hasuda",
-	"hatogaya",
-	"hatoyama",
-	"hidaka",
-	"higashichichibu",
-	"higashimatsuyama",
-	"honjo",
-	"ina",
-	"iruma",
-	"iwatsuki",
-	"kamiizumi",
-	"kamikawa",
-	"kamisato",
-	"kasukabe",
-	"kawagoe",
-	"kawaguchi",
-	"kawajima",
-	"kazo",
-	"kitamoto",
-	"koshigaya",
-	"kounosu",
-	"kuki",
-	"kumagaya",
-	"matsubushi",
-	"minano",
-	"misato",
-	"miyashiro",
-	"miyoshi",
-	"moroyama",
-	"nagatoro",
-	"namegawa",
-	"niiza",
-	"ogano",
-	"ogawa",
-	"ogose",
-	"okegawa",
-	"omiya",
-	"otaki",
-	"ranzan",
-	"ryokami",
-	"saitama",
-	"sakado",
-	"satte",
-	"sayama",
-	"shiki",
-	"shiraoka",
-	"soka",
-	"sugito",
-	"toda",
-	"tokigawa",
-	"tokorozawa",
-	"tsurugashima",
-	"urawa",
-	"warabi",
-	"yashio",
-	"yokoze",
-	"yono",
-	"yorii",
-	"yoshida",
-	"yoshikawa",
-	"yoshimi",
-	"city",
-	"city",
-	"aisho",
-	"gamo",
-	"higashiomi",
-	"hikone",
-	"koka",
-	"konan",
-	"kosei",
-	"koto",
-	"kusatsu",
-	"maibara",
-	"moriyama",
-	"nagahama",
-	"nishiazai",
-	"notogawa",
-	"omihachiman",
-	"otsu",
-	"ritto",
-	"ryuoh",
-	"takashima",
-	"takatsuki",
-	"torahime",
-	"toyosato",
-	"yasu",
-	"akagi",
-	"ama",
-	"gotsu",
-	"hamada",
-	"higashiizumo",
-	"hikawa",
-	"hikimi",
-	"izumo",
-	"kakinoki",
-	"masuda",
-	"matsue",
-	"misato",
-	"nishinoshima",
-	"ohda",
-	"okinoshima",
-	"okuizumo",
-	"shimane",
-	"tamayu",
-	"tsuwano",
-	"unnan",
-	"yakumo",
-	"yasugi",
-	"yatsuka",
-	"arai",
-	"atami",
-	"fuji",
-	"fujieda",
-	"fujikawa",
-	"fujinomiya",
-	"fukuroi",
-	"gotemba",
-	"haibara",
-	"hamamatsu",
-	"higashiizu",
-	"ito",
-	"iwata",
-	"izu",
-	"izunokuni",
-	"kakegawa",
-	"kannami",
-	"kawanehon",
-	"kawazu",
-	"kikugawa",
-	"kosai",
-	"makinohara",
-	"matsuzaki",
-	"minamiizu",
-	"mishima",
-	"morimachi",
-	"nishiizu",
-	"numazu",
-	"omaezaki",
-	"shimada",
-	"shimizu",
-	"shimoda",
-	"shizuoka",
-	"susono",
-	"yaizu",
-	"yoshida",
-	"ashikaga",
-	"bato",
-	"haga",
-	"ichikai",
-	"iwafune",
-	"kaminokawa",
-	"kanuma",
-	"karasuyama",
-	"kuroiso",
-	"mashiko",
-	"mibu",
-	"moka",
-	"motegi",
-	"nasu",
-	"nasushiobara",
-	"nikko",
-	"nishikata",
-	"nogi",
-	"ohira",
-	"ohtawara",
-	"oyama",
-	"sakura",
-	"sano",
-	"shimotsuke",
-	"shioya",
-	"takanezawa",
-	"tochigi",
-	"tsuga",
-	"ujiie",
-	"utsunomiya",
-	"yaita",
-	"aizumi",
-	"anan",
-	"ichiba",
-	"itano",
-	"kainan",
-	"komatsushima",
-	"matsushige",
-	"mima",
-	"minami",
-	"miyoshi",
-	"mugi",
-	"nakagawa",
-	"naruto",
-	"sanagochi",
-	"shishikui",
-	"tokushima",
-	"wajiki",
-	"adachi",
-	"akiruno",
-	"akishima",
-	"aogashima",
-	"arakawa",
-	"bunkyo",
-	"chiyoda",
-	"chofu",
-	"chuo",
-	"edogawa",
-	"fuchu",
-	"fussa",
-	"hachijo",
-	"hachioji",
-	"hamura",
-	"higashikurume",
-	"higashimurayama",
-	"higashiyamato",
-	"hino",
-	"hinode",
-	"hinohara",
-	"inagi",
-	"itabashi",
-	"katsushika",
-	"kita",
-	"kiyose",
-	"kodaira",
-	"koganei",
-	"kokubunji",
-	"komae",
-	"koto",
-	"kouzushima",
-	"kunitachi",
-	"machida",
-	"meguro",
-	"minato",
-	"mitaka",
-	"mizuho",
-	"musashimurayama",
-	"musashino",
-	"nakano",
-	"nerima",
-	"ogasawara",
-	"okutama",
-	"ome",
-	"oshima",
-	"ota",
-	"setagaya",
-	"shibuya",
-	"shinagawa",
-	"shinjuku",
-	"suginami",
-	"sumida",
-	"tachikawa",
-	"taito",
-	"tama",
-	"toshima",
-	"chizu",
-	"hino",
-	"kawahara",
-	"koge",
-	"kotoura",
-	"misasa",
-	"nanbu",
-	"nichinan",
-	"sakaiminato",
-	"tottori",
-	"wakasa",
-	"yazu",
-	"yonago",
-	"asahi",
-	"fuchu",
-	"fukumitsu",
-	"funahashi",
-	"himi",
-	"imizu",
-	"inami",
-	"johana",
-	"kamiichi",
-	"kurobe",
-	"nakaniikawa",
-	"namerikawa",
-	"nanto",
-	"nyuzen",
-	"oyabe",
-	"taira",
-	"takaoka",
-	"tateyama",
-	"toga",
-	"tonami",
-	"toyama",
-	"unazuki",
-	"uozu",
-	"yamada",
-	"arida",
-	"aridagawa",
-	"gobo",
-	"hashimoto",
-	"hidaka",
-	"hirogawa",
-	"inami",
-	"iwade",
-	"kainan",
-	"kamitonda",
-	"katsuragi",
-	"kimino",
-	"kinokawa",
-	"kitayama",
-	"koya",
-	"koza",
-	"kozagawa",
-	"kudoyama",
-	"kushimoto",
-	"mihama",
-	"misato",
-	"nachikatsuura",
-	"shingu",
-	"shirahama",
-	"taiji",
-	"tanabe",
-	"wakayama",
-	"yuasa",
-	"yura",
-	"asahi",
-	"funagata",
-	"higashine",
-	"iide",
-	"kahoku",
-	"kaminoyama",
-	"kaneyama",
-	"kawanishi",
-	"mamurogawa",
-	"mikawa",
-	"murayama",
-	"nagai",
-	"nakayama",
-	"nanyo",
-	"nishikawa",
-	"obanazawa",
-	"oe",
-	"oguni",
-	"ohkura",
-	"oishida",
-	"sagae",
-	"sakata",
-	"sakegawa",
-	"shinjo",
-	"shirataka",
-	"shonai",
-	"takahata",
-	"tendo",
-	"tozawa",
-	"tsuruoka",
-	"yamagata",
-	"yamanobe",
-	"yonezawa",
-	"yuza",
-	"abu",
-	"hagi",
-	"hikari",
-	"hofu",
-	"iwakuni",
-	"kudamatsu",
-	"mitou",
-	"nagato",
-	"oshima",
-	"shimonoseki",
-	"shunan",
-	"tabuse",
-	"tokuyama",
-	"toyota",
-	"ube",
-	"yuu",
-	"chuo",
-	"doshi",
-	"fuefuki",
-	"fujikawa",
-	"fujikawaguchiko",
-	"fujiyoshida",
-	"hayakawa",
-	"hokuto",
-	"ichikawamisato",
-	"kai",
-	"kofu",
-	"koshu",
-	"kosuge",
-	"minami-alps",
-	"minobu",
-	"nakamichi",
-	"nanbu",
-	"narusawa",
-	"nirasaki",
-	"nishikatsura",
-	"oshino",
-	"otsuki",
-	"showa",
-	"tabayama",
-	"tsuru",
-	"uenohara",
-	"yamanakako",
-	"yamanashi",
-	"city",
+	"zombie",
 	"ac",
 	"co",
 	"go",
@@ -15012,7 +13506,6 @@ var nodeLabels = [...]string{
 	"ne",
 	"or",
 	"sc",
-	"blogspot",
 	"blog",
 	"com",
 	"edu",
@@ -15100,7 +13593,6 @@ var nodeLabels = [...]string{
 	"org",
 	"com",
 	"edu",
-	"gov",
 	"net",
 	"org",
 	"com",
@@ -15111,7 +13603,6 @@ var nodeLabels = [...]string{
 	"mil",
 	"net",
 	"org",
-	"upaas",
 	"bnr",
 	"c",
 	"com",
@@ -15123,8 +13614,6 @@ var nodeLabels = [...]string{
 	"org",
 	"per",
 	"static",
-	"dev",
-	"sites",
 	"com",
 	"edu",
 	"gov",
@@ -15159,8 +13648,6 @@ var nodeLabels = [...]string{
 	"soc",
 	"web",
 	"omg",
-	"in",
-	"of",
 	"com",
 	"edu",
 	"gov",
@@ -15178,6 +13665,7 @@ var nodeLabels = [...]string{
 	"sc",
 	"blogspot",
 	"gov",
+	"123website",
 	"blogspot",
 	"asn",
 	"com",
@@ -15204,8 +13692,6 @@ var nodeLabels = [...]string{
 	"org",
 	"press",
 	"router",
-	"from",
-	"with",
 	"asso",
 	"tm",
 	"at",
@@ -15218,7 +13704,6 @@ var nodeLabels = [...]string{
 	"brasilia",
 	"c66",
 	"co",
-	"couk",
 	"daplie",
 	"ddns",
 	"diskstation",
@@ -15251,17 +13736,13 @@ var nodeLabels = [...]string{
 	"ravendb",
 	"soundcast",
 	"synology",
-	"tbits",
 	"tcp4",
-	"ukco",
+	"transip",
 	"vp4",
-	"wbq",
 	"webhop",
 	"wedeploy",
 	"yombo",
-	"localhost",
-	"for",
-	"repair",
+	"framer",
 	"barsy",
 	"co",
 	"com",
@@ -15298,8 +13779,6 @@ var nodeLabels = [...]string{
 	"org",
 	"barsy",
 	"dscloud",
-	"and",
-	"for",
 	"ju",
 	"blogspot",
 	"gov",
@@ -15314,7 +13793,6 @@ var nodeLabels = [...]string{
 	"edu",
 	"net",
 	"org",
-	"blogspot",
 	"ac",
 	"co",
 	"com",
@@ -15935,11 +14413,22 @@ var nodeLabels = [...]string{
 	"ws",
 	"her",
 	"his",
-	"forgot",
-	"forgot",
 	"asso",
 	"nom",
 	"adobeaemcloud",
+	"adobeio-static",
+	"adobeioruntime",
+	"akadns",
+	"akamai",
+	"akamai-staging",
+	"akamaiedge",
+	"akamaiedge-staging",
+	"akamaihd",
+	"akamaihd-staging",
+	"akamaiorigin",
+	"akamaiorigin-staging",
+	"akamaized",
+	"akamaized-staging",
 	"alwaysdata",
 	"appudo",
 	"at-band-camp",
@@ -15989,10 +14478,13 @@ var nodeLabels = [...]string{
 	"dynv6",
 	"eating-organic",
 	"edgeapp",
+	"edgekey",
+	"edgekey-staging",
+	"edgesuite",
+	"edgesuite-staging",
 	"elastx",
 	"endofinternet",
 	"familyds",
-	"fastblog",
 	"fastly",
 	"fastlylb",
 	"faststacks",
@@ -16006,6 +14498,7 @@ var nodeLabels = [...]string{
 	"gb",
 	"gets-it",
 	"ham-radio-op",
+	"heteml",
 	"hicam",
 	"homeftp",
 	"homeip",
@@ -16030,6 +14523,7 @@ var nodeLabels = [...]string{
 	"meinforum",
 	"memset",
 	"moonscale",
+	"myamaze",
 	"mydatto",
 	"mydissent",
 	"myeffect",
@@ -16065,6 +14559,7 @@ var nodeLabels = [...]string{
 	"serveblog",
 	"serveftp",
 	"serveminecraft",
+	"shopselect",
 	"siteleaf",
 	"square7",
 	"srcf",
@@ -16085,53 +14580,6 @@ var nodeLabels = [...]string{
 	"webhop",
 	"yandexcloud",
 	"za",
-	"prod",
-	"cdn",
-	"centralus",
-	"eastasia",
-	"eastus2",
-	"westeurope",
-	"westus2",
-	"r",
-	"u",
-	"fra1-de",
-	"west1-us",
-	"jls-sto1",
-	"jls-sto2",
-	"jls-sto3",
-	"freetls",
-	"map",
-	"prod",
-	"ssl",
-	"a",
-	"global",
-	"a",
-	"b",
-	"global",
-	"map",
-	"paas",
-	"fr-1",
-	"lon-1",
-	"lon-2",
-	"ny-1",
-	"ny-2",
-	"sg-1",
-	"hosting",
-	"webpaas",
-	"jelastic",
-	"nordeste-idc",
-	"j",
-	"soc",
-	"user",
-	"beta",
-	"pages",
-	"jelastic",
-	"jelastic",
-	"atl",
-	"njs",
-	"ric",
-	"storage",
-	"website",
 	"alces",
 	"arvo",
 	"azimuth",
@@ -16163,7 +14611,6 @@ var nodeLabels = [...]string{
 	"ngo",
 	"org",
 	"sch",
-	"blogspot",
 	"ac",
 	"biz",
 	"co",
@@ -16178,14 +14625,17 @@ var nodeLabels = [...]string{
 	"nom",
 	"org",
 	"web",
+	"123website",
 	"blogspot",
 	"cistron",
 	"co",
 	"demon",
+	"gov",
 	"hosting-cluster",
 	"khplay",
 	"myspreadshop",
 	"transurl",
+	"123hjemmeside",
 	"aa",
 	"aarborte",
 	"aejrie",
@@ -16913,46 +15363,6 @@ var nodeLabels = [...]string{
 	"xn--yer-zna",
 	"xn--ygarden-p1a",
 	"xn--ystre-slidre-ujb",
-	"gs",
-	"gs",
-	"nes",
-	"gs",
-	"nes",
-	"gs",
-	"os",
-	"valer",
-	"xn--vler-qoa",
-	"gs",
-	"gs",
-	"os",
-	"gs",
-	"heroy",
-	"sande",
-	"gs",
-	"gs",
-	"bo",
-	"heroy",
-	"xn--b-5ga",
-	"xn--hery-ira",
-	"gs",
-	"gs",
-	"gs",
-	"gs",
-	"valer",
-	"gs",
-	"gs",
-	"gs",
-	"gs",
-	"bo",
-	"xn--b-5ga",
-	"gs",
-	"gs",
-	"gs",
-	"sande",
-	"gs",
-	"sande",
-	"xn--hery-ira",
-	"xn--vler-qoa",
 	"biz",
 	"com",
 	"edu",
@@ -16960,1184 +15370,3496 @@ var nodeLabels = [...]string{
 	"info",
 	"net",
 	"org",
-	"enterprisecloud",
-	"merseine",
-	"mine",
-	"shacknet",
+	"enterprisecloud",
+	"merseine",
+	"mine",
+	"shacknet",
+	"ac",
+	"co",
+	"cri",
+	"geek",
+	"gen",
+	"govt",
+	"health",
+	"iwi",
+	"kiwi",
+	"maori",
+	"mil",
+	"net",
+	"org",
+	"parliament",
+	"school",
+	"xn--mori-qsa",
+	"co",
+	"com",
+	"edu",
+	"gov",
+	"med",
+	"museum",
+	"net",
+	"org",
+	"pro",
+	"homelink",
+	"onred",
+	"service",
+	"barsy",
+	"eero",
+	"eero-stage",
+	"tech",
+	"accesscam",
+	"ae",
+	"altervista",
+	"amune",
+	"barsy",
+	"blogdns",
+	"blogsite",
+	"bmoattachments",
+	"boldlygoingnowhere",
+	"cable-modem",
+	"camdvr",
+	"cdn77",
+	"cdn77-secure",
+	"certmgr",
+	"cloudns",
+	"collegefan",
+	"couchpotatofries",
+	"ddnss",
+	"diskstation",
+	"dnsalias",
+	"dnsdojo",
+	"doesntexist",
+	"dontexist",
+	"doomdns",
+	"dsmynas",
+	"duckdns",
+	"dvrdns",
+	"dynalias",
+	"dyndns",
+	"dynserv",
+	"endofinternet",
+	"endoftheinternet",
+	"eu",
+	"familyds",
+	"fedorainfracloud",
+	"fedorapeople",
+	"fedoraproject",
+	"freeddns",
+	"freedesktop",
+	"from-me",
+	"game-host",
+	"gotdns",
+	"hepforge",
+	"hk",
+	"hobby-site",
+	"homedns",
+	"homeftp",
+	"homelinux",
+	"homeunix",
+	"hopto",
+	"httpbin",
+	"in-dsl",
+	"in-vpn",
+	"is-a-bruinsfan",
+	"is-a-candidate",
+	"is-a-celticsfan",
+	"is-a-chef",
+	"is-a-geek",
+	"is-a-knight",
+	"is-a-linux-user",
+	"is-a-patsfan",
+	"is-a-soxfan",
+	"is-found",
+	"is-lost",
+	"is-saved",
+	"is-very-bad",
+	"is-very-evil",
+	"is-very-good",
+	"is-very-nice",
+	"is-very-sweet",
+	"isa-geek",
+	"js",
+	"kicks-ass",
+	"mayfirst",
+	"misconfused",
+	"mlbfan",
+	"mozilla-iot",
+	"my-firewall",
+	"myfirewall",
+	"myftp",
+	"mysecuritycamera",
+	"mywire",
+	"nflfan",
+	"no-ip",
+	"now-dns",
+	"pimienta",
+	"podzone",
+	"poivron",
+	"potager",
+	"pubtls",
+	"read-books",
+	"readmyblog",
+	"selfip",
+	"sellsyourhome",
+	"servebbs",
+	"serveftp",
+	"servegame",
+	"small-web",
+	"spdns",
+	"stuff-4-sale",
+	"sweetpepper",
+	"teckids",
+	"toolforge",
+	"tunk",
+	"tuxfamily",
+	"twmail",
+	"ufcfan",
+	"us",
+	"webhop",
+	"webredirect",
+	"wmcloud",
+	"wmflabs",
+	"za",
+	"zapto",
+	"nerdpol",
+	"abo",
+	"ac",
+	"com",
+	"edu",
+	"gob",
+	"ing",
+	"med",
+	"net",
+	"nom",
+	"org",
+	"sld",
+	"codeberg",
+	"hlx",
+	"hlx3",
+	"magnet",
+	"pdns",
+	"plesk",
+	"prvcy",
+	"rocky",
+	"translated",
+	"ybo",
+	"blogspot",
+	"com",
+	"edu",
+	"gob",
+	"mil",
+	"net",
+	"nom",
+	"org",
+	"com",
+	"edu",
+	"org",
+	"com",
+	"edu",
+	"gov",
+	"i",
+	"mil",
+	"net",
+	"ngo",
+	"org",
+	"framer",
+	"1337",
+	"biz",
+	"com",
+	"edu",
+	"fam",
+	"gob",
+	"gok",
+	"gon",
+	"gop",
+	"gos",
+	"gov",
+	"info",
+	"net",
+	"org",
+	"web",
+	"agro",
+	"aid",
+	"art",
+	"atm",
+	"augustow",
+	"auto",
+	"babia-gora",
+	"bedzin",
+	"beep",
+	"beskidy",
+	"bialowieza",
+	"bialystok",
+	"bielawa",
+	"bieszczady",
+	"biz",
+	"boleslawiec",
+	"bydgoszcz",
+	"bytom",
+	"cieszyn",
+	"co",
+	"com",
+	"czeladz",
+	"czest",
+	"dlugoleka",
+	"ecommerce-shop",
+	"edu",
+	"elblag",
+	"elk",
+	"gda",
+	"gdansk",
+	"gdynia",
+	"gliwice",
+	"glogow",
+	"gmina",
+	"gniezno",
+	"gorlice",
+	"gov",
+	"grajewo",
+	"gsm",
+	"homesklep",
+	"ilawa",
+	"info",
+	"jaworzno",
+	"jelenia-gora",
+	"jgora",
+	"kalisz",
+	"karpacz",
+	"kartuzy",
+	"kaszuby",
+	"katowice",
+	"kazimierz-dolny",
+	"kepno",
+	"ketrzyn",
+	"klodzko",
+	"kobierzyce",
+	"kolobrzeg",
+	"konin",
+	"konskowola",
+	"krakow",
+	"krasnik",
+	"kutno",
+	"lapy",
+	"lebork",
+	"leczna",
+	"legnica",
+	"lezajsk",
+	"limanowa",
+	"lomza",
+	"lowicz",
+	"lubartow",
+	"lubin",
+	"lublin",
+	"lukow",
+	"mail",
+	"malbork",
+	"malopolska",
+	"mazowsze",
+	"mazury",
+	"med",
+	"media",
+	"miasta",
+	"mielec",
+	"mielno",
+	"mil",
+	"mragowo",
+	"myspreadshop",
+	"naklo",
+	"net",
+	"nieruchomosci",
+	"nom",
+	"nowaruda",
+	"nysa",
+	"olawa",
+	"olecko",
+	"olkusz",
+	"olsztyn",
+	"opoczno",
+	"opole",
+	"org",
+	"ostroda",
+	"ostroleka",
+	"ostrowiec",
+	"ostrowwlkp",
+	"pc",
+	"pila",
+	"pisz",
+	"podhale",
+	"podlasie",
+	"polkowice",
+	"pomorskie",
+	"pomorze",
+	"poniatowa",
+	"powiat",
+	"poznan",
+	"priv",
+	"prochowice",
+	"pruszkow",
+	"przeworsk",
+	"pulawy",
+	"radom",
+	"rawa-maz",
+	"realestate",
+	"rel",
+	"rybnik",
+	"rzeszow",
+	"sanok",
+	"sdscloud",
+	"sejny",
+	"sex",
+	"shop",
+	"shoparena",
+	"simplesite",
+	"sklep",
+	"skoczow",
+	"slask",
+	"slupsk",
+	"sopot",
+	"sos",
+	"sosnowiec",
+	"stalowa-wola",
+	"starachowice",
+	"stargard",
+	"suwalki",
+	"swidnica",
+	"swidnik",
+	"swiebodzin",
+	"swinoujscie",
+	"szczecin",
+	"szczytno",
+	"szkola",
+	"targi",
+	"tarnobrzeg",
+	"tgory",
+	"tm",
+	"tourism",
+	"travel",
+	"turek",
+	"turystyka",
+	"tychy",
+	"unicloud",
+	"ustka",
+	"walbrzych",
+	"warmia",
+	"warszawa",
+	"waw",
+	"wegrow",
+	"wielun",
+	"wlocl",
+	"wloclawek",
+	"wodzislaw",
+	"wolomin",
+	"wroc",
+	"wroclaw",
+	"zachpomor",
+	"zagan",
+	"zakopane",
+	"zarow",
+	"zgora",
+	"zgorzelec",
+	"co",
+	"name",
+	"own",
+	"co",
+	"edu",
+	"gov",
+	"net",
+	"org",
+	"indie",
+	"ac",
+	"biz",
+	"com",
+	"edu",
+	"est",
+	"gov",
+	"info",
+	"isla",
+	"name",
+	"net",
+	"org",
+	"pro",
+	"prof",
+	"aaa",
+	"aca",
+	"acct",
+	"avocat",
+	"bar",
+	"barsy",
+	"cloudns",
+	"cpa",
+	"dnstrace",
+	"eng",
+	"jur",
+	"law",
+	"med",
+	"recht",
+	"com",
+	"edu",
+	"gov",
+	"net",
+	"org",
+	"plo",
+	"sec",
+	"123paginaweb",
+	"blogspot",
+	"com",
+	"edu",
+	"gov",
+	"int",
+	"net",
+	"nome",
+	"org",
+	"publ",
+	"barsy",
+	"belau",
+	"cloudns",
+	"co",
+	"ed",
+	"go",
+	"ne",
+	"or",
+	"x443",
+	"com",
+	"coop",
+	"edu",
+	"gov",
+	"mil",
+	"net",
+	"org",
+	"blogspot",
+	"com",
+	"edu",
+	"gov",
+	"mil",
+	"name",
+	"net",
+	"org",
+	"sch",
+	"asso",
+	"blogspot",
+	"com",
+	"nom",
+	"ybo",
+	"clan",
+	"arts",
+	"barsy",
+	"blogspot",
+	"co",
+	"com",
+	"firm",
+	"info",
+	"nom",
+	"nt",
+	"org",
+	"rec",
+	"shop",
+	"store",
+	"tm",
+	"www",
+	"lima-city",
+	"myddns",
+	"webspace",
+	"ac",
+	"blogspot",
+	"brendly",
+	"co",
+	"edu",
+	"gov",
+	"in",
+	"org",
+	"ox",
+	"ua",
+	"123sait",
+	"ac",
+	"adygeya",
+	"bashkiria",
+	"bir",
+	"blogspot",
+	"cbg",
+	"cldmail",
+	"com",
+	"dagestan",
+	"edu",
+	"eurodir",
+	"gov",
+	"grozny",
+	"int",
+	"kalmykia",
+	"kustanai",
+	"lk3",
+	"marine",
+	"mcdir",
+	"mcpre",
+	"mil",
+	"mircloud",
+	"mordovia",
+	"msk",
+	"myjino",
+	"mytis",
+	"na4u",
+	"nalchik",
+	"net",
+	"nov",
+	"org",
+	"pp",
+	"pyatigorsk",
+	"ras",
+	"regruhosting",
+	"spb",
+	"test",
+	"vladikavkaz",
+	"vladimir",
+	"build",
+	"code",
+	"database",
+	"development",
+	"hs",
+	"migration",
+	"onporter",
+	"ravendb",
+	"repl",
+	"servers",
+	"ac",
+	"co",
+	"coop",
+	"gov",
+	"mil",
+	"net",
+	"org",
+	"com",
+	"edu",
+	"gov",
+	"med",
+	"net",
+	"org",
+	"pub",
+	"sch",
+	"com",
+	"edu",
+	"gov",
+	"net",
+	"org",
+	"com",
+	"edu",
+	"gov",
+	"net",
+	"org",
+	"ybo",
+	"edu",
+	"gov",
+	"com",
+	"edu",
+	"gov",
+	"info",
+	"med",
+	"net",
+	"org",
+	"tv",
+	"123minsida",
+	"a",
+	"ac",
+	"b",
+	"bd",
+	"blogspot",
+	"brand",
+	"c",
+	"com",
+	"conf",
+	"d",
+	"e",
+	"f",
+	"fh",
+	"fhsk",
+	"fhv",
+	"g",
+	"h",
+	"i",
+	"iopsys",
+	"itcouldbewor",
+	"k",
+	"komforb",
+	"kommunalforbund",
+	"komvux",
+	"l",
+	"lanbib",
+	"m",
+	"myspreadshop",
+	"n",
+	"naturbruksgymn",
+	"o",
+	"org",
+	"p",
+	"paba",
+	"parti",
+	"pp",
+	"press",
+	"r",
+	"s",
+	"t",
+	"tm",
+	"u",
+	"w",
+	"x",
+	"y",
+	"z",
+	"loginline",
+	"blogspot",
+	"com",
+	"edu",
+	"enscaled",
+	"gov",
+	"net",
+	"org",
+	"per",
+	"bip",
+	"com",
+	"gov",
+	"hashbang",
+	"mil",
+	"net",
+	"now",
+	"org",
+	"platform",
+	"vxl",
+	"wedeploy",
+	"barsy",
+	"base",
+	"hoplix",
+	"blogspot",
+	"gitapp",
+	"gitpage",
+	"barsy",
+	"byen",
+	"cloudera",
+	"cyon",
+	"fastvps",
+	"fnwk",
+	"folionetwork",
+	"jele",
+	"lelux",
+	"loginline",
+	"mintere",
+	"novecore",
+	"omniwe",
+	"opensocial",
+	"platformsh",
+	"srht",
+	"tst",
+	"blogspot",
+	"com",
+	"edu",
+	"gov",
+	"net",
+	"org",
+	"art",
+	"blogspot",
+	"com",
+	"edu",
+	"gouv",
+	"org",
+	"perso",
+	"univ",
+	"com",
+	"edu",
+	"gov",
+	"me",
+	"net",
+	"org",
+	"sch",
+	"diher",
+	"myfast",
+	"uber",
+	"xs4all",
+	"biz",
+	"com",
+	"edu",
+	"gov",
+	"me",
+	"net",
+	"org",
+	"sch",
+	"co",
+	"com",
+	"consulado",
+	"edu",
+	"embaixada",
+	"mil",
+	"net",
+	"noho",
+	"org",
+	"principe",
+	"saotome",
+	"store",
+	"sellfy",
+	"shopware",
+	"storebase",
+	"abkhazia",
+	"adygeya",
+	"aktyubinsk",
+	"arkhangelsk",
+	"armenia",
+	"ashgabad",
+	"azerbaijan",
+	"balashov",
+	"bashkiria",
+	"bryansk",
+	"bukhara",
+	"chimkent",
+	"dagestan",
+	"east-kazakhstan",
+	"exnet",
+	"georgia",
+	"grozny",
+	"ivanovo",
+	"jambyl",
+	"kalmykia",
+	"kaluga",
+	"karacol",
+	"karaganda",
+	"karelia",
+	"khakassia",
+	"krasnodar",
+	"kurgan",
+	"kustanai",
+	"lenug",
+	"mangyshlak",
+	"mordovia",
+	"msk",
+	"murmansk",
+	"nalchik",
+	"navoi",
+	"north-kazakhstan",
+	"nov",
+	"obninsk",
+	"penza",
+	"pokrovsk",
+	"sochi",
+	"spb",
+	"tashkent",
+	"termez",
+	"togliatti",
+	"troitsk",
+	"tselinograd",
+	"tula",
+	"tuva",
+	"vladikavkaz",
+	"vladimir",
+	"vologda",
+	"barsy",
+	"com",
+	"edu",
+	"gob",
+	"org",
+	"red",
+	"gov",
+	"com",
+	"edu",
+	"gov",
+	"mil",
+	"net",
+	"org",
+	"knightpoint",
+	"ac",
+	"co",
+	"org",
+	"ch",
+	"me",
+	"we",
+	"blogspot",
+	"discourse",
+	"jelastic",
+	"co",
+	"sch",
+	"ac",
+	"co",
+	"go",
+	"in",
+	"mi",
+	"net",
+	"online",
+	"or",
+	"shop",
+	"ac",
+	"biz",
+	"co",
+	"com",
+	"edu",
+	"go",
+	"gov",
+	"int",
+	"mil",
+	"name",
+	"net",
+	"nic",
+	"org",
+	"test",
+	"web",
+	"gov",
+	"co",
+	"com",
+	"edu",
+	"gov",
+	"mil",
+	"net",
+	"nom",
+	"org",
+	"com",
+	"ens",
+	"fin",
+	"gov",
+	"ind",
+	"info",
+	"intl",
+	"mincom",
+	"nat",
+	"net",
+	"orangecloud",
+	"org",
+	"perso",
+	"tourism",
+	"611",
+	"com",
+	"edu",
+	"gov",
+	"mil",
+	"net",
+	"nyan",
+	"org",
+	"oya",
+	"quickconnect",
+	"rdv",
+	"vpnplus",
+	"prequalifyme",
+	"now-dns",
+	"ntdll",
+	"av",
+	"bbs",
+	"bel",
+	"biz",
+	"com",
+	"dr",
+	"edu",
+	"gen",
+	"gov",
+	"info",
+	"k12",
+	"kep",
+	"mil",
+	"name",
+	"nc",
+	"net",
+	"org",
+	"pol",
+	"tel",
+	"tsk",
+	"tv",
+	"web",
+	"ybo",
+	"aero",
+	"biz",
+	"co",
+	"com",
+	"coop",
+	"edu",
+	"gov",
+	"info",
+	"int",
+	"jobs",
+	"mobi",
+	"museum",
+	"name",
+	"net",
+	"org",
+	"pro",
+	"travel",
+	"better-than",
+	"dyndns",
+	"on-the-web",
+	"worse-than",
+	"blogspot",
+	"club",
+	"com",
+	"ebiz",
+	"edu",
+	"game",
+	"gov",
+	"idv",
+	"mil",
+	"net",
+	"org",
+	"url",
+	"xn--czrw28b",
+	"xn--uc0atv",
+	"xn--zf0ao64a",
 	"ac",
 	"co",
-	"cri",
-	"geek",
-	"gen",
-	"govt",
-	"health",
-	"iwi",
-	"kiwi",
-	"maori",
+	"go",
+	"hotel",
+	"info",
+	"me",
 	"mil",
-	"net",
-	"org",
-	"parliament",
-	"school",
-	"xn--mori-qsa",
-	"blogspot",
+	"mobi",
+	"ne",
+	"or",
+	"sc",
+	"tv",
+	"biz",
+	"cc",
+	"cherkassy",
+	"cherkasy",
+	"chernigov",
+	"chernihiv",
+	"chernivtsi",
+	"chernovtsy",
+	"ck",
+	"cn",
 	"co",
 	"com",
+	"cr",
+	"crimea",
+	"cv",
+	"cx",
+	"dn",
+	"dnepropetrovsk",
+	"dnipropetrovsk",
+	"donetsk",
+	"dp",
 	"edu",
 	"gov",
-	"med",
-	"museum",
+	"if",
+	"in",
+	"inf",
+	"ivano-frankivsk",
+	"kh",
+	"kharkiv",
+	"kharkov",
+	"kherson",
+	"khmelnitskiy",
+	"khmelnytskyi",
+	"kiev",
+	"kirovograd",
+	"km",
+	"kr",
+	"krym",
+	"ks",
+	"kv",
+	"kyiv",
+	"lg",
+	"lt",
+	"ltd",
+	"lugansk",
+	"lutsk",
+	"lv",
+	"lviv",
+	"mk",
+	"mykolaiv",
 	"net",
+	"nikolaev",
+	"od",
+	"odesa",
+	"odessa",
 	"org",
-	"pro",
-	"for",
-	"homelink",
-	"onred",
-	"service",
-	"under",
-	"staging",
-	"barsy",
-	"eero",
-	"eero-stage",
-	"accesscam",
-	"ae",
-	"altervista",
-	"amune",
-	"barsy",
-	"blogdns",
-	"blogsite",
-	"bmoattachments",
-	"boldlygoingnowhere",
-	"cable-modem",
-	"camdvr",
-	"cdn77",
-	"cdn77-secure",
-	"certmgr",
-	"cloudns",
-	"collegefan",
-	"couchpotatofries",
-	"ddnss",
-	"diskstation",
-	"dnsalias",
-	"dnsdojo",
-	"doesntexist",
-	"dontexist",
-	"doomdns",
-	"dsmynas",
-	"duckdns",
-	"dvrdns",
-	"dynalias",
-	"dyndns",
-	"dynserv",
-	"edugit",
-	"endofinternet",
-	"endoftheinternet",
-	"eu",
-	"familyds",
-	"fedorainfracloud",
-	"fedorapeople",
-	"fedoraproject",
-	"freeddns",
-	"freedesktop",
-	"from-me",
-	"game-host",
-	"gotdns",
-	"hepforge",
-	"hk",
-	"hobby-site",
-	"homedns",
-	"homeftp",
-	"homelinux",
-	"homeunix",
-	"hopto",
-	"httpbin",
-	"in-dsl",
-	"in-vpn",
-	"is-a-bruinsfan",
-	"is-a-candidate",
-	"is-a-celticsfan",
-	"is-a-chef",
-	"is-a-geek",
-	"is-a-knight",
-	"is-a-linux-user",
-	"is-a-patsfan",
-	"is-a-soxfan",
-	"is-found",
-	"is-lost",
-	"is-saved",
-	"is-very-bad",
-	"is-very-evil",
-	"is-very-good",
-	"is-very-nice",
-	"is-very-sweet",
-	"isa-geek",
-	"js",
-	"kicks-ass",
-	"mayfirst",
-	"misconfused",
-	"mlbfan",
-	"mozilla-iot",
-	"my-firewall",
-	"myfirewall",
-	"myftp",
-	"mysecuritycamera",
-	"mywire",
-	"nflfan",
-	"no-ip",
-	"now-dns",
-	"pimienta",
-	"podzone",
-	"poivron",
-	"potager",
-	"pubtls",
-	"read-books",
-	"readmyblog",
-	"selfip",
-	"sellsyourhome",
-	"servebbs",
-	"serveftp",
-	"servegame",
-	"small-web",
-	"spdns",
-	"stuff-4-sale",
-	"sweetpepper",
-	"toolforge",
-	"tunk",
-	"tuxfamily",
-	"twmail",
-	"ufcfan",
-	"us",
-	"webhop",
-	"webredirect",
-	"wmcloud",
-	"wmflabs",
-	"za",
-	"zapto",
-	"tele",
-	"c",
-	"rsc",
-	"origin",
-	"ssl",
+	"pl",
+	"poltava",
+	"pp",
+	"rivne",
+	"rovno",
+	"rv",
+	"sb",
+	"sebastopol",
+	"sevastopol",
+	"sm",
+	"sumy",
+	"te",
+	"ternopil",
+	"uz",
+	"uzhgorod",
+	"v",
+	"vinnica",
+	"vinnytsia",
+	"vn",
+	"volyn",
+	"yalta",
+	"zaporizhzhe",
+	"zaporizhzhia",
+	"zhitomir",
+	"zhytomyr",
+	"zp",
+	"zt",
+	"ac",
+	"blogspot",
+	"co",
+	"com",
 	"go",
-	"home",
+	"ne",
+	"or",
+	"org",
+	"sc",
+	"ac",
+	"barsy",
+	"co",
+	"conn",
+	"copro",
+	"gov",
+	"hosp",
+	"independent-commission",
+	"independent-inquest",
+	"independent-inquiry",
+	"independent-panel",
+	"independent-review",
+	"ltd",
+	"me",
+	"net",
+	"nhs",
+	"org",
+	"plc",
+	"police",
+	"public-inquiry",
+	"pymnt",
+	"royal-commission",
+	"sch",
+	"ak",
 	"al",
-	"asso",
-	"at",
-	"au",
-	"be",
-	"bg",
+	"ar",
+	"as",
+	"az",
 	"ca",
-	"cd",
-	"ch",
-	"cn",
-	"cy",
-	"cz",
+	"cloudns",
+	"co",
+	"ct",
+	"dc",
 	"de",
-	"dk",
-	"edu",
-	"ee",
-	"es",
-	"fi",
-	"fr",
-	"gr",
-	"hr",
-	"hu",
-	"ie",
+	"dni",
+	"drud",
+	"enscaled",
+	"fed",
+	"fl",
+	"freeddns",
+	"ga",
+	"golffan",
+	"graphox",
+	"gu",
+	"hi",
+	"ia",
+	"id",
 	"il",
 	"in",
-	"int",
-	"is",
-	"it",
-	"jp",
-	"kr",
-	"lt",
-	"lu",
-	"lv",
-	"mc",
+	"is-by",
+	"isa",
+	"kids",
+	"ks",
+	"ky",
+	"la",
+	"land-4-sale",
+	"ma",
+	"md",
 	"me",
-	"mk",
+	"mi",
+	"mircloud",
+	"mn",
+	"mo",
+	"ms",
 	"mt",
-	"my",
-	"net",
-	"ng",
-	"nl",
-	"no",
-	"nz",
-	"paris",
-	"pl",
-	"pt",
-	"q-a",
-	"ro",
-	"ru",
-	"se",
-	"si",
-	"sk",
-	"tr",
-	"uk",
-	"us",
-	"cloud",
-	"os",
-	"stg",
-	"app",
-	"os",
-	"app",
-	"nerdpol",
-	"abo",
-	"ac",
-	"com",
-	"edu",
-	"gob",
-	"ing",
-	"med",
-	"net",
-	"nom",
-	"org",
-	"sld",
-	"hlx",
-	"hlx3",
-	"magnet",
-	"pdns",
-	"plesk",
-	"prvcy",
-	"ybo",
-	"blogspot",
-	"com",
-	"edu",
-	"gob",
-	"mil",
-	"net",
-	"nom",
-	"org",
-	"com",
-	"edu",
-	"org",
+	"nc",
+	"nd",
+	"ne",
+	"nh",
+	"nj",
+	"nm",
+	"noip",
+	"nsn",
+	"nv",
+	"ny",
+	"oh",
+	"ok",
+	"or",
+	"pa",
+	"platterp",
+	"pointto",
+	"pr",
+	"ri",
+	"sc",
+	"sd",
+	"stuff-4-sale",
+	"tn",
+	"tx",
+	"ut",
+	"va",
+	"vi",
+	"vt",
+	"wa",
+	"wi",
+	"wv",
+	"wy",
 	"com",
 	"edu",
-	"gov",
-	"i",
+	"gub",
 	"mil",
 	"net",
-	"ngo",
 	"org",
-	"1337",
-	"biz",
+	"co",
 	"com",
-	"edu",
-	"fam",
-	"gob",
-	"gok",
-	"gon",
-	"gop",
-	"gos",
-	"gov",
-	"info",
 	"net",
 	"org",
-	"web",
-	"agro",
-	"aid",
-	"art",
-	"atm",
-	"augustow",
-	"auto",
-	"babia-gora",
-	"bedzin",
-	"beep",
-	"beskidy",
-	"bialowieza",
-	"bialystok",
-	"bielawa",
-	"bieszczady",
-	"biz",
-	"boleslawiec",
-	"bydgoszcz",
-	"bytom",
-	"cieszyn",
-	"co",
+	"0e",
 	"com",
-	"czeladz",
-	"czest",
-	"dlugoleka",
-	"ecommerce-shop",
 	"edu",
-	"elblag",
-	"elk",
-	"gda",
-	"gdansk",
-	"gdynia",
-	"gliwice",
-	"glogow",
-	"gmina",
-	"gniezno",
-	"gorlice",
 	"gov",
-	"grajewo",
-	"gsm",
-	"homesklep",
-	"ilawa",
-	"info",
-	"jaworzno",
-	"jelenia-gora",
-	"jgora",
-	"kalisz",
-	"karpacz",
-	"kartuzy",
-	"kaszuby",
-	"katowice",
-	"kazimierz-dolny",
-	"kepno",
-	"ketrzyn",
-	"klodzko",
-	"kobierzyce",
-	"kolobrzeg",
-	"konin",
-	"konskowola",
-	"krakow",
-	"krasnik",
-	"kutno",
-	"lapy",
-	"lebork",
-	"leczna",
-	"legnica",
-	"lezajsk",
-	"limanowa",
-	"lomza",
-	"lowicz",
-	"lubartow",
-	"lubin",
-	"lublin",
-	"lukow",
-	"mail",
-	"malbork",
-	"malopolska",
-	"mazowsze",
-	"mazury",
-	"med",
-	"media",
-	"miasta",
-	"mielec",
-	"mielno",
+	"gv",
 	"mil",
-	"mragowo",
-	"myspreadshop",
-	"naklo",
 	"net",
-	"nieruchomosci",
-	"nom",
-	"nowaruda",
-	"nysa",
-	"olawa",
-	"olecko",
-	"olkusz",
-	"olsztyn",
-	"opoczno",
-	"opole",
 	"org",
-	"ostroda",
-	"ostroleka",
-	"ostrowiec",
-	"ostrowwlkp",
-	"pc",
-	"pila",
-	"pisz",
-	"podhale",
-	"podlasie",
-	"polkowice",
-	"pomorskie",
-	"pomorze",
-	"poniatowa",
-	"powiat",
-	"poznan",
-	"priv",
-	"prochowice",
-	"pruszkow",
-	"przeworsk",
-	"pulawy",
-	"radom",
-	"rawa-maz",
-	"realestate",
-	"rel",
-	"rybnik",
-	"rzeszow",
-	"sanok",
-	"sdscloud",
-	"sejny",
-	"sex",
-	"shop",
-	"shoparena",
-	"sklep",
-	"skoczow",
-	"slask",
-	"slupsk",
-	"sopot",
-	"sos",
-	"sosnowiec",
-	"stalowa-wola",
-	"starachowice",
-	"stargard",
-	"suwalki",
-	"swidnica",
-	"swidnik",
-	"swiebodzin",
-	"swinoujscie",
-	"szczecin",
-	"szczytno",
-	"szkola",
-	"targi",
-	"tarnobrzeg",
-	"tgory",
-	"tm",
-	"tourism",
-	"travel",
-	"turek",
-	"turystyka",
-	"tychy",
-	"unicloud",
-	"ustka",
-	"walbrzych",
-	"warmia",
-	"warszawa",
-	"waw",
-	"wegrow",
-	"wielun",
-	"wlocl",
-	"wloclawek",
-	"wodzislaw",
-	"wolomin",
-	"wroc",
-	"wroclaw",
-	"zachpomor",
-	"zagan",
-	"zakopane",
-	"zarow",
-	"zgora",
-	"zgorzelec",
-	"ap",
-	"griw",
-	"ic",
-	"is",
-	"kmpsp",
-	"konsulat",
-	"kppsp",
-	"kwp",
-	"kwpsp",
-	"mup",
-	"mw",
-	"oirm",
-	"oum",
-	"pa",
-	"pinb",
-	"piw",
-	"po",
-	"psp",
-	"psse",
-	"pup",
-	"rzgw",
-	"sa",
-	"sdn",
-	"sko",
-	"so",
-	"sr",
-	"starostwo",
-	"ug",
-	"ugim",
-	"um",
-	"umig",
-	"upow",
-	"uppo",
-	"us",
-	"uw",
-	"uzs",
-	"wif",
-	"wiih",
-	"winb",
-	"wios",
-	"witd",
-	"wiw",
-	"wsa",
-	"wskr",
-	"wuoz",
-	"wzmiuw",
-	"zp",
-	"co",
-	"own",
+	"arts",
+	"bib",
 	"co",
+	"com",
+	"e12",
 	"edu",
+	"firm",
+	"gob",
 	"gov",
+	"info",
+	"int",
+	"mil",
+	"net",
+	"nom",
+	"org",
+	"rar",
+	"rec",
+	"store",
+	"tec",
+	"web",
+	"at",
+	"co",
+	"com",
+	"k12",
 	"net",
 	"org",
-	"indie",
 	"ac",
 	"biz",
+	"blogspot",
 	"com",
 	"edu",
-	"est",
 	"gov",
+	"health",
 	"info",
-	"isla",
+	"int",
 	"name",
 	"net",
 	"org",
 	"pro",
-	"prof",
-	"aaa",
-	"aca",
-	"acct",
-	"avocat",
-	"bar",
-	"barsy",
-	"cloudns",
-	"cpa",
-	"dnstrace",
-	"eng",
-	"jur",
-	"law",
-	"med",
-	"recht",
-	"bci",
+	"blog",
+	"cn",
 	"com",
+	"dev",
 	"edu",
-	"gov",
+	"me",
 	"net",
 	"org",
-	"plo",
-	"sec",
-	"blogspot",
+	"framer",
+	"biz",
+	"sch",
+	"framer",
+	"advisor",
+	"cloud66",
 	"com",
+	"dyndns",
 	"edu",
 	"gov",
-	"int",
+	"mypets",
 	"net",
-	"nome",
 	"org",
-	"publ",
-	"barsy",
-	"belau",
-	"cloudns",
-	"co",
-	"ed",
-	"go",
-	"ne",
-	"or",
-	"x443",
+	"xn--4dbgdty6c",
+	"xn--5dbhl8d",
+	"xn--8dbq2a",
+	"xn--hebda8b",
+	"xn--80au",
+	"xn--90azh",
+	"xn--c1avg",
+	"xn--d1at",
+	"xn--o1ac",
+	"xn--o1ach",
+	"xn--55qx5d",
+	"xn--gmqw5a",
+	"xn--mxtq1m",
+	"xn--od0alg",
+	"xn--uc0atv",
+	"xn--wcvs22d",
+	"xn--12c1fe0br",
+	"xn--12cfi8ixb8l",
+	"xn--12co0c3b4eva",
+	"xn--h3cuzk1di",
+	"xn--m3ch0j3a",
+	"xn--o3cyx2a",
+	"xn--41a",
+	"xn--80aaa0cvac",
+	"xn--90a1af",
+	"xn--90amc",
+	"xn--c1avg",
+	"xn--h1ahn",
+	"xn--h1aliz",
+	"xn--j1adp",
+	"xn--j1aef",
+	"xn--j1ael8b",
+	"blogsite",
+	"crafting",
+	"localzone",
+	"telebit",
+	"zapto",
 	"com",
-	"coop",
 	"edu",
 	"gov",
 	"mil",
 	"net",
 	"org",
-	"blogspot",
+	"org",
+	"ac",
+	"agric",
+	"alt",
+	"co",
+	"edu",
+	"gov",
+	"grondar",
+	"law",
+	"mil",
+	"net",
+	"ngo",
+	"nic",
+	"nis",
+	"nom",
+	"org",
+	"school",
+	"tm",
+	"web",
+	"ac",
+	"biz",
+	"co",
 	"com",
 	"edu",
 	"gov",
+	"info",
 	"mil",
-	"name",
 	"net",
 	"org",
 	"sch",
-	"asso",
+	"cloud66",
+	"hs",
+	"lima",
+	"triton",
+	"ac",
+	"co",
+	"gov",
+	"mil",
+	"org",
+	"a",
+	"privatelink",
 	"blogspot",
-	"com",
-	"nom",
-	"ybo",
-	"clan",
-	"arts",
-	"barsy",
+	"sth",
 	"blogspot",
-	"co",
-	"com",
-	"firm",
-	"info",
-	"nom",
+	"wien",
+	"ex",
+	"in",
+	"ex",
+	"kunden",
+	"blogspot",
+	"cloudlets",
+	"myspreadshop",
+	"act",
+	"catholic",
+	"nsw",
 	"nt",
-	"org",
-	"rec",
-	"shop",
-	"store",
-	"tm",
-	"www",
-	"lima-city",
-	"myddns",
-	"webspace",
+	"qld",
+	"sa",
+	"tas",
+	"vic",
+	"wa",
+	"qld",
+	"sa",
+	"tas",
+	"vic",
+	"wa",
+	"cloud",
+	"ezproxy",
+	"blogspot",
+	"simplesite",
+	"virtualcloud",
+	"ac",
+	"al",
+	"am",
+	"ap",
+	"ba",
+	"ce",
+	"df",
+	"es",
+	"go",
+	"ma",
+	"mg",
+	"ms",
+	"mt",
+	"pa",
+	"pb",
+	"pe",
+	"pi",
+	"pr",
+	"rj",
+	"rn",
+	"ro",
+	"rr",
+	"rs",
+	"sc",
+	"se",
+	"sp",
+	"to",
 	"ac",
+	"al",
+	"am",
+	"ap",
+	"ba",
+	"ce",
+	"df",
+	"es",
+	"go",
+	"ma",
+	"mg",
+	"ms",
+	"mt",
+	"pa",
+	"pb",
+	"pe",
+	"pi",
+	"pr",
+	"rj",
+	"rn",
+	"ro",
+	"rr",
+	"rs",
+	"sc",
+	"se",
+	"sp",
+	"to",
+	"blogspot",
+	"ui",
+	"instances",
+	"svc",
+	"ae",
+	"appengine",
+	"es-1",
+	"eu",
+	"vip",
+	"aruba",
+	"it1",
+	"cs",
+	"tn",
+	"uk",
+	"uk",
+	"ca",
+	"uk",
+	"us",
+	"baremetal",
+	"fr-par",
+	"instances",
+	"k8s",
+	"nl-ams",
+	"pl-waw",
+	"scalebook",
+	"smartlabeling",
+	"ch",
+	"de",
+	"amazonaws",
+	"direct",
+	"blogspot",
+	"id",
+	"id",
+	"dev",
+	"af-south-1",
+	"ap-east-1",
+	"ap-northeast-1",
+	"ap-northeast-2",
+	"ap-northeast-3",
+	"ap-south-1",
+	"ap-southeast-1",
+	"ap-southeast-2",
+	"ca-central-1",
+	"compute",
+	"compute-1",
+	"elb",
+	"eu-central-1",
+	"eu-north-1",
+	"eu-south-1",
+	"eu-west-1",
+	"eu-west-2",
+	"eu-west-3",
+	"me-south-1",
+	"s3",
+	"s3-ap-northeast-1",
+	"s3-ap-northeast-2",
+	"s3-ap-south-1",
+	"s3-ap-southeast-1",
+	"s3-ap-southeast-2",
+	"s3-ca-central-1",
+	"s3-eu-central-1",
+	"s3-eu-west-1",
+	"s3-eu-west-2",
+	"s3-eu-west-3",
+	"s3-external-1",
+	"s3-fips-us-gov-west-1",
+	"s3-sa-east-1",
+	"s3-us-east-2",
+	"s3-us-gov-west-1",
+	"s3-us-west-1",
+	"s3-us-west-2",
+	"s3-website-ap-northeast-1",
+	"s3-website-ap-southeast-1",
+	"s3-website-ap-southeast-2",
+	"s3-website-eu-west-1",
+	"s3-website-sa-east-1",
+	"s3-website-us-east-1",
+	"s3-website-us-west-1",
+	"s3-website-us-west-2",
+	"sa-east-1",
+	"us-east-1",
+	"us-east-2",
+	"us-west-1",
+	"us-west-2",
+	"r",
+	"alpha",
+	"beta",
+	"builder",
+	"dev-builder",
+	"stg-builder",
+	"oci",
+	"ocp",
+	"ocs",
+	"demo",
+	"instance",
+	"ap-northeast-1",
+	"ap-northeast-2",
+	"ap-northeast-3",
+	"ap-south-1",
+	"ap-southeast-1",
+	"ap-southeast-2",
+	"ca-central-1",
+	"eu-central-1",
+	"eu-west-1",
+	"eu-west-2",
+	"eu-west-3",
+	"sa-east-1",
+	"us-east-1",
+	"us-east-2",
+	"us-gov-west-1",
+	"us-west-1",
+	"us-west-2",
+	"eu-1",
+	"eu-2",
+	"eu-3",
+	"eu-4",
+	"us-1",
+	"us-2",
+	"us-3",
+	"us-4",
+	"apps",
+	"paas",
+	"rag-cloud",
+	"rag-cloud-ch",
+	"jcloud",
+	"jcloud-ver-jpc",
+	"demo",
+	"cns",
+	"members",
+	"nodebalancer",
+	"ip",
+	"app",
+	"paas",
+	"eu",
+	"caracal",
+	"customer",
+	"fentiger",
+	"lynx",
+	"ocelot",
+	"oncilla",
+	"onza",
+	"sphinx",
+	"vs",
+	"x",
+	"yali",
+	"cloud",
+	"static",
+	"xen",
+	"eu",
+	"app",
+	"api",
+	"site",
+	"pro",
+	"jed",
+	"lon",
+	"ryd",
+	"pages",
+	"js",
+	"u2",
+	"u2-local",
 	"blogspot",
-	"brendly",
-	"co",
-	"edu",
-	"gov",
-	"in",
-	"org",
-	"ox",
-	"ua",
-	"shop",
-	"ac",
-	"adygeya",
-	"bashkiria",
-	"bir",
+	"scaleforce",
+	"cloud",
+	"custom",
+	"cloud",
+	"dyn",
+	"dyn",
+	"dyndns",
+	"dyn",
+	"it",
+	"customer",
+	"user",
+	"london",
+	"bzz",
+	"git-pages",
 	"blogspot",
-	"cbg",
-	"cldmail",
-	"com",
-	"dagestan",
-	"edu",
-	"eurodir",
-	"gov",
-	"grozny",
-	"int",
-	"kalmykia",
-	"kustanai",
-	"lk3",
-	"marine",
-	"mcdir",
-	"mcpre",
-	"mil",
-	"mircloud",
-	"mordovia",
-	"msk",
-	"myjino",
-	"mytis",
-	"na4u",
-	"nalchik",
-	"net",
-	"nov",
-	"org",
-	"pp",
-	"pyatigorsk",
-	"ras",
-	"regruhosting",
-	"spb",
-	"test",
-	"vladikavkaz",
-	"vladimir",
-	"hb",
-	"vps",
-	"hosting",
-	"landing",
-	"spectrum",
-	"vps",
-	"jelastic",
-	"code",
-	"development",
-	"hs",
-	"ravendb",
-	"repl",
-	"servers",
-	"ac",
-	"co",
-	"coop",
-	"gov",
-	"mil",
-	"net",
-	"org",
-	"com",
-	"edu",
-	"gov",
-	"med",
-	"net",
-	"org",
-	"pub",
-	"sch",
-	"for",
-	"com",
-	"edu",
-	"gov",
-	"net",
-	"org",
-	"com",
-	"edu",
-	"gov",
-	"net",
-	"org",
-	"ybo",
-	"edu",
-	"gov",
-	"service",
-	"com",
-	"edu",
-	"gov",
-	"info",
-	"med",
-	"net",
-	"org",
-	"tv",
-	"a",
-	"ac",
-	"b",
-	"bd",
 	"blogspot",
-	"brand",
-	"c",
-	"com",
-	"conf",
-	"d",
-	"e",
-	"f",
-	"fh",
-	"fhsk",
-	"fhv",
-	"g",
-	"h",
-	"i",
-	"iopsys",
-	"itcouldbewor",
-	"k",
-	"komforb",
-	"kommunalforbund",
-	"komvux",
-	"l",
-	"lanbib",
-	"m",
-	"myspreadshop",
-	"n",
-	"naturbruksgymn",
-	"o",
-	"org",
-	"p",
-	"paba",
-	"parti",
-	"pp",
-	"press",
-	"r",
-	"s",
-	"t",
-	"tm",
-	"u",
-	"w",
-	"x",
-	"y",
-	"z",
-	"su",
-	"loginline",
 	"blogspot",
-	"com",
-	"edu",
-	"enscaled",
-	"gov",
-	"net",
-	"org",
-	"per",
-	"bip",
-	"com",
-	"gov",
-	"hashbang",
-	"mil",
-	"net",
-	"now",
-	"org",
-	"platform",
-	"vxl",
-	"wedeploy",
-	"bc",
-	"ent",
-	"eu",
-	"us",
-	"barsy",
+	"jelastic",
+	"user",
+	"fi",
+	"demo",
+	"paas",
+	"daemon",
 	"blogspot",
-	"gitapp",
-	"gitpage",
-	"barsy",
-	"byen",
-	"cloudera",
-	"cyon",
-	"fastvps",
-	"fnwk",
-	"folionetwork",
-	"jele",
-	"lelux",
-	"loginline",
-	"mintere",
-	"novecore",
-	"omniwe",
-	"opensocial",
-	"platformsh",
-	"srht",
-	"tst",
+	"rss",
 	"blogspot",
-	"com",
-	"edu",
-	"gov",
-	"net",
-	"org",
-	"art",
+	"mytabit",
+	"ravpage",
+	"tabitorder",
+	"ltd",
+	"plc",
+	"app",
+	"backyards",
+	"paas",
+	"sekd1",
+	"uk0",
+	"dyndns",
+	"id",
+	"apps",
+	"stage",
+	"mock",
+	"sys",
+	"devices",
+	"dev",
+	"disrec",
+	"prod",
+	"testing",
+	"cloud-fr1",
+	"g",
+	"jc",
+	"open",
+	"aisai",
+	"ama",
+	"anjo",
+	"asuke",
+	"chiryu",
+	"chita",
+	"fuso",
+	"gamagori",
+	"handa",
+	"hazu",
+	"hekinan",
+	"higashiura",
+	"ichinomiya",
+	"inazawa",
+	"inuyama",
+	"isshiki",
+	"iwakura",
+	"kanie",
+	"kariya",
+	"kasugai",
+	"kira",
+	"kiyosu",
+	"komaki",
+	"konan",
+	"kota",
+	"mihama",
+	"miyoshi",
+	"nishio",
+	"nisshin",
+	"obu",
+	"oguchi",
+	"oharu",
+	"okazaki",
+	"owariasahi",
+	"seto",
+	"shikatsu",
+	"shinshiro",
+	"shitara",
+	"tahara",
+	"takahama",
+	"tobishima",
+	"toei",
+	"togo",
+	"tokai",
+	"tokoname",
+	"toyoake",
+	"toyohashi",
+	"toyokawa",
+	"toyone",
+	"toyota",
+	"tsushima",
+	"yatomi",
+	"akita",
+	"daisen",
+	"fujisato",
+	"gojome",
+	"hachirogata",
+	"happou",
+	"higashinaruse",
+	"honjo",
+	"honjyo",
+	"ikawa",
+	"kamikoani",
+	"kamioka",
+	"katagami",
+	"kazuno",
+	"kitaakita",
+	"kosaka",
+	"kyowa",
+	"misato",
+	"mitane",
+	"moriyoshi",
+	"nikaho",
+	"noshiro",
+	"odate",
+	"oga",
+	"ogata",
+	"semboku",
+	"yokote",
+	"yurihonjo",
+	"aomori",
+	"gonohe",
+	"hachinohe",
+	"hashikami",
+	"hiranai",
+	"hirosaki",
+	"itayanagi",
+	"kuroishi",
+	"misawa",
+	"mutsu",
+	"nakadomari",
+	"noheji",
+	"oirase",
+	"owani",
+	"rokunohe",
+	"sannohe",
+	"shichinohe",
+	"shingo",
+	"takko",
+	"towada",
+	"tsugaru",
+	"tsuruta",
+	"abiko",
+	"asahi",
+	"chonan",
+	"chosei",
+	"choshi",
+	"chuo",
+	"funabashi",
+	"futtsu",
+	"hanamigawa",
+	"ichihara",
+	"ichikawa",
+	"ichinomiya",
+	"inzai",
+	"isumi",
+	"kamagaya",
+	"kamogawa",
+	"kashiwa",
+	"katori",
+	"katsuura",
+	"kimitsu",
+	"kisarazu",
+	"kozaki",
+	"kujukuri",
+	"kyonan",
+	"matsudo",
+	"midori",
+	"mihama",
+	"minamiboso",
+	"mobara",
+	"mutsuzawa",
+	"nagara",
+	"nagareyama",
+	"narashino",
+	"narita",
+	"noda",
+	"oamishirasato",
+	"omigawa",
+	"onjuku",
+	"otaki",
+	"sakae",
+	"sakura",
+	"shimofusa",
+	"shirako",
+	"shiroi",
+	"shisui",
+	"sodegaura",
+	"sosa",
+	"tako",
+	"tateyama",
+	"togane",
+	"tohnosho",
+	"tomisato",
+	"urayasu",
+	"yachimata",
+	"yachiyo",
+	"yokaichiba",
+	"yokoshibahikari",
+	"yotsukaido",
+	"ainan",
+	"honai",
+	"ikata",
+	"imabari",
+	"iyo",
+	"kamijima",
+	"kihoku",
+	"kumakogen",
+	"masaki",
+	"matsuno",
+	"matsuyama",
+	"namikata",
+	"niihama",
+	"ozu",
+	"saijo",
+	"seiyo",
+	"shikokuchuo",
+	"tobe",
+	"toon",
+	"uchiko",
+	"uwajima",
+	"yawatahama",
+	"echizen",
+	"eiheiji",
+	"fukui",
+	"ikeda",
+	"katsuyama",
+	"mihama",
+	"minamiechizen",
+	"obama",
+	"ohi",
+	"ono",
+	"sabae",
+	"sakai",
+	"takahama",
+	"tsuruga",
+	"wakasa",
+	"ashiya",
+	"buzen",
+	"chikugo",
+	"chikuho",
+	"chikujo",
+	"chikushino",
+	"chikuzen",
+	"chuo",
+	"dazaifu",
+	"fukuchi",
+	"hakata",
+	"higashi",
+	"hirokawa",
+	"hisayama",
+	"iizuka",
+	"inatsuki",
+	"kaho",
+	"kasuga",
+	"kasuya",
+	"kawara",
+	"keisen",
+	"koga",
+	"kurate",
+	"kurogi",
+	"kurume",
+	"minami",
+	"miyako",
+	"miyama",
+	"miyawaka",
+	"mizumaki",
+	"munakata",
+	"nakagawa",
+	"nakama",
+	"nishi",
+	"nogata",
+	"ogori",
+	"okagaki",
+	"okawa",
+	"oki",
+	"omuta",
+	"onga",
+	"onojo",
+	"oto",
+	"saigawa",
+	"sasaguri",
+	"shingu",
+	"shinyoshitomi",
+	"shonai",
+	"soeda",
+	"sue",
+	"tachiarai",
+	"tagawa",
+	"takata",
+	"toho",
+	"toyotsu",
+	"tsuiki",
+	"ukiha",
+	"umi",
+	"usui",
+	"yamada",
+	"yame",
+	"yanagawa",
+	"yukuhashi",
+	"aizubange",
+	"aizumisato",
+	"aizuwakamatsu",
+	"asakawa",
+	"bandai",
+	"date",
+	"fukushima",
+	"furudono",
+	"futaba",
+	"hanawa",
+	"higashi",
+	"hirata",
+	"hirono",
+	"iitate",
+	"inawashiro",
+	"ishikawa",
+	"iwaki",
+	"izumizaki",
+	"kagamiishi",
+	"kaneyama",
+	"kawamata",
+	"kitakata",
+	"kitashiobara",
+	"koori",
+	"koriyama",
+	"kunimi",
+	"miharu",
+	"mishima",
+	"namie",
+	"nango",
+	"nishiaizu",
+	"nishigo",
+	"okuma",
+	"omotego",
+	"ono",
+	"otama",
+	"samegawa",
+	"shimogo",
+	"shirakawa",
+	"showa",
+	"soma",
+	"sukagawa",
+	"taishin",
+	"tamakawa",
+	"tanagura",
+	"tenei",
+	"yabuki",
+	"yamato",
+	"yamatsuri",
+	"yanaizu",
+	"yugawa",
+	"anpachi",
+	"ena",
+	"gifu",
+	"ginan",
+	"godo",
+	"gujo",
+	"hashima",
+	"hichiso",
+	"hida",
+	"higashishirakawa",
+	"ibigawa",
+	"ikeda",
+	"kakamigahara",
+	"kani",
+	"kasahara",
+	"kasamatsu",
+	"kawaue",
+	"kitagata",
+	"mino",
+	"minokamo",
+	"mitake",
+	"mizunami",
+	"motosu",
+	"nakatsugawa",
+	"ogaki",
+	"sakahogi",
+	"seki",
+	"sekigahara",
+	"shirakawa",
+	"tajimi",
+	"takayama",
+	"tarui",
+	"toki",
+	"tomika",
+	"wanouchi",
+	"yamagata",
+	"yaotsu",
+	"yoro",
+	"annaka",
+	"chiyoda",
+	"fujioka",
+	"higashiagatsuma",
+	"isesaki",
+	"itakura",
+	"kanna",
+	"kanra",
+	"katashina",
+	"kawaba",
+	"kiryu",
+	"kusatsu",
+	"maebashi",
+	"meiwa",
+	"midori",
+	"minakami",
+	"naganohara",
+	"nakanojo",
+	"nanmoku",
+	"numata",
+	"oizumi",
+	"ora",
+	"ota",
+	"shibukawa",
+	"shimonita",
+	"shinto",
+	"showa",
+	"takasaki",
+	"takayama",
+	"tamamura",
+	"tatebayashi",
+	"tomioka",
+	"tsukiyono",
+	"tsumagoi",
+	"ueno",
+	"yoshioka",
+	"asaminami",
+	"daiwa",
+	"etajima",
+	"fuchu",
+	"fukuyama",
+	"hatsukaichi",
+	"higashihiroshima",
+	"hongo",
+	"jinsekikogen",
+	"kaita",
+	"kui",
+	"kumano",
+	"kure",
+	"mihara",
+	"miyoshi",
+	"naka",
+	"onomichi",
+	"osakikamijima",
+	"otake",
+	"saka",
+	"sera",
+	"seranishi",
+	"shinichi",
+	"shobara",
+	"takehara",
+	"abashiri",
+	"abira",
+	"aibetsu",
+	"akabira",
+	"akkeshi",
+	"asahikawa",
+	"ashibetsu",
+	"ashoro",
+	"assabu",
+	"atsuma",
+	"bibai",
+	"biei",
+	"bifuka",
+	"bihoro",
+	"biratori",
+	"chippubetsu",
+	"chitose",
+	"date",
+	"ebetsu",
+	"embetsu",
+	"eniwa",
+	"erimo",
+	"esan",
+	"esashi",
+	"fukagawa",
+	"fukushima",
+	"furano",
+	"furubira",
+	"haboro",
+	"hakodate",
+	"hamatonbetsu",
+	"hidaka",
+	"higashikagura",
+	"higashikawa",
+	"hiroo",
+	"hokuryu",
+	"hokuto",
+	"honbetsu",
+	"horokanai",
+	"horonobe",
+	"ikeda",
+	"imakane",
+	"ishikari",
+	"iwamizawa",
+	"iwanai",
+	"kamifurano",
+	"kamikawa",
+	"kamishihoro",
+	"kamisunagawa",
+	"kamoenai",
+	"kayabe",
+	"kembuchi",
+	"kikonai",
+	"kimobetsu",
+	"kitahiroshima",
+	"kitami",
+	"kiyosato",
+	"koshimizu",
+	"kunneppu",
+	"kuriyama",
+	"kuromatsunai",
+	"kushiro",
+	"kutchan",
+	"kyowa",
+	"mashike",
+	"matsumae",
+	"mikasa",
+	"minamifurano",
+	"mombetsu",
+	"moseushi",
+	"mukawa",
+	"muroran",
+	"naie",
+	"nakagawa",
+	"nakasatsunai",
+	"nakatombetsu",
+	"nanae",
+	"nanporo",
+	"nayoro",
+	"nemuro",
+	"niikappu",
+	"niki",
+	"nishiokoppe",
+	"noboribetsu",
+	"numata",
+	"obihiro",
+	"obira",
+	"oketo",
+	"okoppe",
+	"otaru",
+	"otobe",
+	"otofuke",
+	"otoineppu",
+	"oumu",
+	"ozora",
+	"pippu",
+	"rankoshi",
+	"rebun",
+	"rikubetsu",
+	"rishiri",
+	"rishirifuji",
+	"saroma",
+	"sarufutsu",
+	"shakotan",
+	"shari",
+	"shibecha",
+	"shibetsu",
+	"shikabe",
+	"shikaoi",
+	"shimamaki",
+	"shimizu",
+	"shimokawa",
+	"shinshinotsu",
+	"shintoku",
+	"shiranuka",
+	"shiraoi",
+	"shiriuchi",
+	"sobetsu",
+	"sunagawa",
+	"taiki",
+	"takasu",
+	"takikawa",
+	"takinoue",
+	"teshikaga",
+	"tobetsu",
+	"tohma",
+	"tomakomai",
+	"tomari",
+	"toya",
+	"toyako",
+	"toyotomi",
+	"toyoura",
+	"tsubetsu",
+	"tsukigata",
+	"urakawa",
+	"urausu",
+	"uryu",
+	"utashinai",
+	"wakkanai",
+	"wassamu",
+	"yakumo",
+	"yoichi",
+	"aioi",
+	"akashi",
+	"ako",
+	"amagasaki",
+	"aogaki",
+	"asago",
+	"ashiya",
+	"awaji",
+	"fukusaki",
+	"goshiki",
+	"harima",
+	"himeji",
+	"ichikawa",
+	"inagawa",
+	"itami",
+	"kakogawa",
+	"kamigori",
+	"kamikawa",
+	"kasai",
+	"kasuga",
+	"kawanishi",
+	"miki",
+	"minamiawaji",
+	"nishinomiya",
+	"nishiwaki",
+	"ono",
+	"sanda",
+	"sannan",
+	"sasayama",
+	"sayo",
+	"shingu",
+	"shinonsen",
+	"shiso",
+	"sumoto",
+	"taishi",
+	"taka",
+	"takarazuka",
+	"takasago",
+	"takino",
+	"tamba",
+	"tatsuno",
+	"toyooka",
+	"yabu",
+	"yashiro",
+	"yoka",
+	"yokawa",
+	"ami",
+	"asahi",
+	"bando",
+	"chikusei",
+	"daigo",
+	"fujishiro",
+	"hitachi",
+	"hitachinaka",
+	"hitachiomiya",
+	"hitachiota",
+	"ibaraki",
+	"ina",
+	"inashiki",
+	"itako",
+	"iwama",
+	"joso",
+	"kamisu",
+	"kasama",
+	"kashima",
+	"kasumigaura",
+	"koga",
+	"miho",
+	"mito",
+	"moriya",
+	"naka",
+	"namegata",
+	"oarai",
+	"ogawa",
+	"omitama",
+	"ryugasaki",
+	"sakai",
+	"sakuragawa",
+	"shimodate",
+	"shimotsuma",
+	"shirosato",
+	"sowa",
+	"suifu",
+	"takahagi",
+	"tamatsukuri",
+	"tokai",
+	"tomobe",
+	"tone",
+	"toride",
+	"tsuchiura",
+	"tsukuba",
+	"uchihara",
+	"ushiku",
+	"yachiyo",
+	"yamagata",
+	"yawara",
+	"yuki",
+	"anamizu",
+	"hakui",
+	"hakusan",
+	"kaga",
+	"kahoku",
+	"kanazawa",
+	"kawakita",
+	"komatsu",
+	"nakanoto",
+	"nanao",
+	"nomi",
+	"nonoichi",
+	"noto",
+	"shika",
+	"suzu",
+	"tsubata",
+	"tsurugi",
+	"uchinada",
+	"wajima",
+	"fudai",
+	"fujisawa",
+	"hanamaki",
+	"hiraizumi",
+	"hirono",
+	"ichinohe",
+	"ichinoseki",
+	"iwaizumi",
+	"iwate",
+	"joboji",
+	"kamaishi",
+	"kanegasaki",
+	"karumai",
+	"kawai",
+	"kitakami",
+	"kuji",
+	"kunohe",
+	"kuzumaki",
+	"miyako",
+	"mizusawa",
+	"morioka",
+	"ninohe",
+	"noda",
+	"ofunato",
+	"oshu",
+	"otsuchi",
+	"rikuzentakata",
+	"shiwa",
+	"shizukuishi",
+	"sumita",
+	"tanohata",
+	"tono",
+	"yahaba",
+	"yamada",
+	"ayagawa",
+	"higashikagawa",
+	"kanonji",
+	"kotohira",
+	"manno",
+	"marugame",
+	"mitoyo",
+	"naoshima",
+	"sanuki",
+	"tadotsu",
+	"takamatsu",
+	"tonosho",
+	"uchinomi",
+	"utazu",
+	"zentsuji",
+	"akune",
+	"amami",
+	"hioki",
+	"isa",
+	"isen",
+	"izumi",
+	"kagoshima",
+	"kanoya",
+	"kawanabe",
+	"kinko",
+	"kouyama",
+	"makurazaki",
+	"matsumoto",
+	"minamitane",
+	"nakatane",
+	"nishinoomote",
+	"satsumasendai",
+	"soo",
+	"tarumizu",
+	"yusui",
+	"aikawa",
+	"atsugi",
+	"ayase",
+	"chigasaki",
+	"ebina",
+	"fujisawa",
+	"hadano",
+	"hakone",
+	"hiratsuka",
+	"isehara",
+	"kaisei",
+	"kamakura",
+	"kiyokawa",
+	"matsuda",
+	"minamiashigara",
+	"miura",
+	"nakai",
+	"ninomiya",
+	"odawara",
+	"oi",
+	"oiso",
+	"sagamihara",
+	"samukawa",
+	"tsukui",
+	"yamakita",
+	"yamato",
+	"yokosuka",
+	"yugawara",
+	"zama",
+	"zushi",
+	"city",
+	"city",
+	"city",
+	"aki",
+	"geisei",
+	"hidaka",
+	"higashitsuno",
+	"ino",
+	"kagami",
+	"kami",
+	"kitagawa",
+	"kochi",
+	"mihara",
+	"motoyama",
+	"muroto",
+	"nahari",
+	"nakamura",
+	"nankoku",
+	"nishitosa",
+	"niyodogawa",
+	"ochi",
+	"okawa",
+	"otoyo",
+	"otsuki",
+	"sakawa",
+	"sukumo",
+	"susaki",
+	"tosa",
+	"tosashimizu",
+	"toyo",
+	"tsuno",
+	"umaji",
+	"yasuda",
+	"yusuhara",
+	"amakusa",
+	"arao",
+	"aso",
+	"choyo",
+	"gyokuto",
+	"kamiamakusa",
+	"kikuchi",
+	"kumamoto",
+	"mashiki",
+	"mifune",
+	"minamata",
+	"minamioguni",
+	"nagasu",
+	"nishihara",
+	"oguni",
+	"ozu",
+	"sumoto",
+	"takamori",
+	"uki",
+	"uto",
+	"yamaga",
+	"yamato",
+	"yatsushiro",
+	"ayabe",
+	"fukuchiyama",
+	"higashiyama",
+	"ide",
+	"ine",
+	"joyo",
+	"kameoka",
+	"kamo",
+	"kita",
+	"kizu",
+	"kumiyama",
+	"kyotamba",
+	"kyotanabe",
+	"kyotango",
+	"maizuru",
+	"minami",
+	"minamiyamashiro",
+	"miyazu",
+	"muko",
+	"nagaokakyo",
+	"nakagyo",
+	"nantan",
+	"oyamazaki",
+	"sakyo",
+	"seika",
+	"tanabe",
+	"uji",
+	"ujitawara",
+	"wazuka",
+	"yamashina",
+	"yawata",
+	"asahi",
+	"inabe",
+	"ise",
+	"kameyama",
+	"kawagoe",
+	"kiho",
+	"kisosaki",
+	"kiwa",
+	"komono",
+	"kumano",
+	"kuwana",
+	"matsusaka",
+	"meiwa",
+	"mihama",
+	"minamiise",
+	"misugi",
+	"miyama",
+	"nabari",
+	"shima",
+	"suzuka",
+	"tado",
+	"taiki",
+	"taki",
+	"tamaki",
+	"toba",
+	"tsu",
+	"udono",
+	"ureshino",
+	"watarai",
+	"yokkaichi",
+	"furukawa",
+	"higashimatsushima",
+	"ishinomaki",
+	"iwanuma",
+	"kakuda",
+	"kami",
+	"kawasaki",
+	"marumori",
+	"matsushima",
+	"minamisanriku",
+	"misato",
+	"murata",
+	"natori",
+	"ogawara",
+	"ohira",
+	"onagawa",
+	"osaki",
+	"rifu",
+	"semine",
+	"shibata",
+	"shichikashuku",
+	"shikama",
+	"shiogama",
+	"shiroishi",
+	"tagajo",
+	"taiwa",
+	"tome",
+	"tomiya",
+	"wakuya",
+	"watari",
+	"yamamoto",
+	"zao",
+	"aya",
+	"ebino",
+	"gokase",
+	"hyuga",
+	"kadogawa",
+	"kawaminami",
+	"kijo",
+	"kitagawa",
+	"kitakata",
+	"kitaura",
+	"kobayashi",
+	"kunitomi",
+	"kushima",
+	"mimata",
+	"miyakonojo",
+	"miyazaki",
+	"morotsuka",
+	"nichinan",
+	"nishimera",
+	"nobeoka",
+	"saito",
+	"shiiba",
+	"shintomi",
+	"takaharu",
+	"takanabe",
+	"takazaki",
+	"tsuno",
+	"achi",
+	"agematsu",
+	"anan",
+	"aoki",
+	"asahi",
+	"azumino",
+	"chikuhoku",
+	"chikuma",
+	"chino",
+	"fujimi",
+	"hakuba",
+	"hara",
+	"hiraya",
+	"iida",
+	"iijima",
+	"iiyama",
+	"iizuna",
+	"ikeda",
+	"ikusaka",
+	"ina",
+	"karuizawa",
+	"kawakami",
+	"kiso",
+	"kisofukushima",
+	"kitaaiki",
+	"komagane",
+	"komoro",
+	"matsukawa",
+	"matsumoto",
+	"miasa",
+	"minamiaiki",
+	"minamimaki",
+	"minamiminowa",
+	"minowa",
+	"miyada",
+	"miyota",
+	"mochizuki",
+	"nagano",
+	"nagawa",
+	"nagiso",
+	"nakagawa",
+	"nakano",
+	"nozawaonsen",
+	"obuse",
+	"ogawa",
+	"okaya",
+	"omachi",
+	"omi",
+	"ookuwa",
+	"ooshika",
+	"otaki",
+	"otari",
+	"sakae",
+	"sakaki",
+	"saku",
+	"sakuho",
+	"shimosuwa",
+	"shinanomachi",
+	"shiojiri",
+	"suwa",
+	"suzaka",
+	"takagi",
+	"takamori",
+	"takayama",
+	"tateshina",
+	"tatsuno",
+	"togakushi",
+	"togura",
+	"tomi",
+	"ueda",
+	"wada",
+	"yamagata",
+	"yamanouchi",
+	"yasaka",
+	"yasuoka",
+	"chijiwa",
+	"futsu",
+	"goto",
+	"hasami",
+	"hirado",
+	"iki",
+	"isahaya",
+	"kawatana",
+	"kuchinotsu",
+	"matsuura",
+	"nagasaki",
+	"obama",
+	"omura",
+	"oseto",
+	"saikai",
+	"sasebo",
+	"seihi",
+	"shimabara",
+	"shinkamigoto",
+	"togitsu",
+	"tsushima",
+	"unzen",
+	"city",
+	"ando",
+	"gose",
+	"heguri",
+	"higashiyoshino",
+	"ikaruga",
+	"ikoma",
+	"kamikitayama",
+	"kanmaki",
+	"kashiba",
+	"kashihara",
+	"katsuragi",
+	"kawai",
+	"kawakami",
+	"kawanishi",
+	"koryo",
+	"kurotaki",
+	"mitsue",
+	"miyake",
+	"nara",
+	"nosegawa",
+	"oji",
+	"ouda",
+	"oyodo",
+	"sakurai",
+	"sango",
+	"shimoichi",
+	"shimokitayama",
+	"shinjo",
+	"soni",
+	"takatori",
+	"tawaramoto",
+	"tenkawa",
+	"tenri",
+	"uda",
+	"yamatokoriyama",
+	"yamatotakada",
+	"yamazoe",
+	"yoshino",
+	"aseinet",
+	"gehirn",
+	"aga",
+	"agano",
+	"gosen",
+	"itoigawa",
+	"izumozaki",
+	"joetsu",
+	"kamo",
+	"kariwa",
+	"kashiwazaki",
+	"minamiuonuma",
+	"mitsuke",
+	"muika",
+	"murakami",
+	"myoko",
+	"nagaoka",
+	"niigata",
+	"ojiya",
+	"omi",
+	"sado",
+	"sanjo",
+	"seiro",
+	"seirou",
+	"sekikawa",
+	"shibata",
+	"tagami",
+	"tainai",
+	"tochio",
+	"tokamachi",
+	"tsubame",
+	"tsunan",
+	"uonuma",
+	"yahiko",
+	"yoita",
+	"yuzawa",
+	"beppu",
+	"bungoono",
+	"bungotakada",
+	"hasama",
+	"hiji",
+	"himeshima",
+	"hita",
+	"kamitsue",
+	"kokonoe",
+	"kuju",
+	"kunisaki",
+	"kusu",
+	"oita",
+	"saiki",
+	"taketa",
+	"tsukumi",
+	"usa",
+	"usuki",
+	"yufu",
+	"akaiwa",
+	"asakuchi",
+	"bizen",
+	"hayashima",
+	"ibara",
+	"kagamino",
+	"kasaoka",
+	"kibichuo",
+	"kumenan",
+	"kurashiki",
+	"maniwa",
+	"misaki",
+	"nagi",
+	"niimi",
+	"nishiawakura",
+	"okayama",
+	"satosho",
+	"setouchi",
+	"shinjo",
+	"shoo",
+	"soja",
+	"takahashi",
+	"tamano",
+	"tsuyama",
+	"wake",
+	"yakage",
+	"aguni",
+	"ginowan",
+	"ginoza",
+	"gushikami",
+	"haebaru",
+	"higashi",
+	"hirara",
+	"iheya",
+	"ishigaki",
+	"ishikawa",
+	"itoman",
+	"izena",
+	"kadena",
+	"kin",
+	"kitadaito",
+	"kitanakagusuku",
+	"kumejima",
+	"kunigami",
+	"minamidaito",
+	"motobu",
+	"nago",
+	"naha",
+	"nakagusuku",
+	"nakijin",
+	"nanjo",
+	"nishihara",
+	"ogimi",
+	"okinawa",
+	"onna",
+	"shimoji",
+	"taketomi",
+	"tarama",
+	"tokashiki",
+	"tomigusuku",
+	"tonaki",
+	"urasoe",
+	"uruma",
+	"yaese",
+	"yomitan",
+	"yonabaru",
+	"yonaguni",
+	"zamami",
+	"abeno",
+	"chihayaakasaka",
+	"chuo",
+	"daito",
+	"fujiidera",
+	"habikino",
+	"hannan",
+	"higashiosaka",
+	"higashisumiyoshi",
+	"higashiyodogawa",
+	"hirakata",
+	"ibaraki",
+	"ikeda",
+	"izumi",
+	"izumiotsu",
+	"izumisano",
+	"kadoma",
+	"kaizuka",
+	"kanan",
+	"kashiwara",
+	"katano",
+	"kawachinagano",
+	"kishiwada",
+	"kita",
+	"kumatori",
+	"matsubara",
+	"minato",
+	"minoh",
+	"misaki",
+	"moriguchi",
+	"neyagawa",
+	"nishi",
+	"nose",
+	"osakasayama",
+	"sakai",
+	"sayama",
+	"sennan",
+	"settsu",
+	"shijonawate",
+	"shimamoto",
+	"suita",
+	"tadaoka",
+	"taishi",
+	"tajiri",
+	"takaishi",
+	"takatsuki",
+	"tondabayashi",
+	"toyonaka",
+	"toyono",
+	"yao",
+	"ariake",
+	"arita",
+	"fukudomi",
+	"genkai",
+	"hamatama",
+	"hizen",
+	"imari",
+	"kamimine",
+	"kanzaki",
+	"karatsu",
+	"kashima",
+	"kitagata",
+	"kitahata",
+	"kiyama",
+	"kouhoku",
+	"kyuragi",
+	"nishiarita",
+	"ogi",
+	"omachi",
+	"ouchi",
+	"saga",
+	"shiroishi",
+	"taku",
+	"tara",
+	"tosu",
+	"yoshinogari",
+	"arakawa",
+	"asaka",
+	"chichibu",
+	"fujimi",
+	"fujimino",
+	"fukaya",
+	"hanno",
+	"hanyu",
+	"hasuda",
+	"hatogaya",
+	"hatoyama",
+	"hidaka",
+	"higashichichibu",
+	"higashimatsuyama",
+	"honjo",
+	"ina",
+	"iruma",
+	"iwatsuki",
+	"kamiizumi",
+	"kamikawa",
+	"kamisato",
+	"kasukabe",
+	"kawagoe",
+	"kawaguchi",
+	"kawajima",
+	"kazo",
+	"kitamoto",
+	"koshigaya",
+	"kounosu",
+	"kuki",
+	"kumagaya",
+	"matsubushi",
+	"minano",
+	"misato",
+	"miyashiro",
+	"miyoshi",
+	"moroyama",
+	"nagatoro",
+	"namegawa",
+	"niiza",
+	"ogano",
+	"ogawa",
+	"ogose",
+	"okegawa",
+	"omiya",
+	"otaki",
+	"ranzan",
+	"ryokami",
+	"saitama",
+	"sakado",
+	"satte",
+	"sayama",
+	"shiki",
+	"shiraoka",
+	"soka",
+	"sugito",
+	"toda",
+	"tokigawa",
+	"tokorozawa",
+	"tsurugashima",
+	"urawa",
+	"warabi",
+	"yashio",
+	"yokoze",
+	"yono",
+	"yorii",
+	"yoshida",
+	"yoshikawa",
+	"yoshimi",
+	"city",
+	"city",
+	"aisho",
+	"gamo",
+	"higashiomi",
+	"hikone",
+	"koka",
+	"konan",
+	"kosei",
+	"koto",
+	"kusatsu",
+	"maibara",
+	"moriyama",
+	"nagahama",
+	"nishiazai",
+	"notogawa",
+	"omihachiman",
+	"otsu",
+	"ritto",
+	"ryuoh",
+	"takashima",
+	"takatsuki",
+	"torahime",
+	"toyosato",
+	"yasu",
+	"akagi",
+	"ama",
+	"gotsu",
+	"hamada",
+	"higashiizumo",
+	"hikawa",
+	"hikimi",
+	"izumo",
+	"kakinoki",
+	"masuda",
+	"matsue",
+	"misato",
+	"nishinoshima",
+	"ohda",
+	"okinoshima",
+	"okuizumo",
+	"shimane",
+	"tamayu",
+	"tsuwano",
+	"unnan",
+	"yakumo",
+	"yasugi",
+	"yatsuka",
+	"arai",
+	"atami",
+	"fuji",
+	"fujieda",
+	"fujikawa",
+	"fujinomiya",
+	"fukuroi",
+	"gotemba",
+	"haibara",
+	"hamamatsu",
+	"higashiizu",
+	"ito",
+	"iwata",
+	"izu",
+	"izunokuni",
+	"kakegawa",
+	"kannami",
+	"kawanehon",
+	"kawazu",
+	"kikugawa",
+	"kosai",
+	"makinohara",
+	"matsuzaki",
+	"minamiizu",
+	"mishima",
+	"morimachi",
+	"nishiizu",
+	"numazu",
+	"omaezaki",
+	"shimada",
+	"shimizu",
+	"shimoda",
+	"shizuoka",
+	"susono",
+	"yaizu",
+	"yoshida",
+	"ashikaga",
+	"bato",
+	"haga",
+	"ichikai",
+	"iwafune",
+	"kaminokawa",
+	"kanuma",
+	"karasuyama",
+	"kuroiso",
+	"mashiko",
+	"mibu",
+	"moka",
+	"motegi",
+	"nasu",
+	"nasushiobara",
+	"nikko",
+	"nishikata",
+	"nogi",
+	"ohira",
+	"ohtawara",
+	"oyama",
+	"sakura",
+	"sano",
+	"shimotsuke",
+	"shioya",
+	"takanezawa",
+	"tochigi",
+	"tsuga",
+	"ujiie",
+	"utsunomiya",
+	"yaita",
+	"aizumi",
+	"anan",
+	"ichiba",
+	"itano",
+	"kainan",
+	"komatsushima",
+	"matsushige",
+	"mima",
+	"minami",
+	"miyoshi",
+	"mugi",
+	"nakagawa",
+	"naruto",
+	"sanagochi",
+	"shishikui",
+	"tokushima",
+	"wajiki",
+	"adachi",
+	"akiruno",
+	"akishima",
+	"aogashima",
+	"arakawa",
+	"bunkyo",
+	"chiyoda",
+	"chofu",
+	"chuo",
+	"edogawa",
+	"fuchu",
+	"fussa",
+	"hachijo",
+	"hachioji",
+	"hamura",
+	"higashikurume",
+	"higashimurayama",
+	"higashiyamato",
+	"hino",
+	"hinode",
+	"hinohara",
+	"inagi",
+	"itabashi",
+	"katsushika",
+	"kita",
+	"kiyose",
+	"kodaira",
+	"koganei",
+	"kokubunji",
+	"komae",
+	"koto",
+	"kouzushima",
+	"kunitachi",
+	"machida",
+	"meguro",
+	"minato",
+	"mitaka",
+	"mizuho",
+	"musashimurayama",
+	"musashino",
+	"nakano",
+	"nerima",
+	"ogasawara",
+	"okutama",
+	"ome",
+	"oshima",
+	"ota",
+	"setagaya",
+	"shibuya",
+	"shinagawa",
+	"shinjuku",
+	"suginami",
+	"sumida",
+	"tachikawa",
+	"taito",
+	"tama",
+	"toshima",
+	"chizu",
+	"hino",
+	"kawahara",
+	"koge",
+	"kotoura",
+	"misasa",
+	"nanbu",
+	"nichinan",
+	"sakaiminato",
+	"tottori",
+	"wakasa",
+	"yazu",
+	"yonago",
+	"asahi",
+	"fuchu",
+	"fukumitsu",
+	"funahashi",
+	"himi",
+	"imizu",
+	"inami",
+	"johana",
+	"kamiichi",
+	"kurobe",
+	"nakaniikawa",
+	"namerikawa",
+	"nanto",
+	"nyuzen",
+	"oyabe",
+	"taira",
+	"takaoka",
+	"tateyama",
+	"toga",
+	"tonami",
+	"toyama",
+	"unazuki",
+	"uozu",
+	"yamada",
+	"arida",
+	"aridagawa",
+	"gobo",
+	"hashimoto",
+	"hidaka",
+	"hirogawa",
+	"inami",
+	"iwade",
+	"kainan",
+	"kamitonda",
+	"katsuragi",
+	"kimino",
+	"kinokawa",
+	"kitayama",
+	"koya",
+	"koza",
+	"kozagawa",
+	"kudoyama",
+	"kushimoto",
+	"mihama",
+	"misato",
+	"nachikatsuura",
+	"shingu",
+	"shirahama",
+	"taiji",
+	"tanabe",
+	"wakayama",
+	"yuasa",
+	"yura",
+	"asahi",
+	"funagata",
+	"higashine",
+	"iide",
+	"kahoku",
+	"kaminoyama",
+	"kaneyama",
+	"kawanishi",
+	"mamurogawa",
+	"mikawa",
+	"murayama",
+	"nagai",
+	"nakayama",
+	"nanyo",
+	"nishikawa",
+	"obanazawa",
+	"oe",
+	"oguni",
+	"ohkura",
+	"oishida",
+	"sagae",
+	"sakata",
+	"sakegawa",
+	"shinjo",
+	"shirataka",
+	"shonai",
+	"takahata",
+	"tendo",
+	"tozawa",
+	"tsuruoka",
+	"yamagata",
+	"yamanobe",
+	"yonezawa",
+	"yuza",
+	"abu",
+	"hagi",
+	"hikari",
+	"hofu",
+	"iwakuni",
+	"kudamatsu",
+	"mitou",
+	"nagato",
+	"oshima",
+	"shimonoseki",
+	"shunan",
+	"tabuse",
+	"tokuyama",
+	"toyota",
+	"ube",
+	"yuu",
+	"chuo",
+	"doshi",
+	"fuefuki",
+	"fujikawa",
+	"fujikawaguchiko",
+	"fujiyoshida",
+	"hayakawa",
+	"hokuto",
+	"ichikawamisato",
+	"kai",
+	"kofu",
+	"koshu",
+	"kosuge",
+	"minami-alps",
+	"minobu",
+	"nakamichi",
+	"nanbu",
+	"narusawa",
+	"nirasaki",
+	"nishikatsura",
+	"oshino",
+	"otsuki",
+	"showa",
+	"tabayama",
+	"tsuru",
+	"uenohara",
+	"yamanakako",
+	"yamanashi",
+	"city",
 	"blogspot",
-	"com",
-	"edu",
-	"gouv",
-	"org",
-	"perso",
-	"univ",
-	"com",
-	"edu",
-	"gov",
-	"me",
-	"net",
-	"org",
-	"sch",
-	"diher",
-	"myfast",
-	"uber",
-	"xs4all",
-	"biz",
-	"com",
-	"edu",
-	"gov",
-	"me",
-	"net",
-	"org",
-	"sch",
-	"co",
-	"com",
-	"consulado",
-	"edu",
-	"embaixada",
-	"mil",
-	"net",
-	"noho",
-	"org",
-	"principe",
-	"saotome",
-	"store",
-	"sellfy",
-	"shopware",
-	"storebase",
-	"abkhazia",
-	"adygeya",
-	"aktyubinsk",
-	"arkhangelsk",
-	"armenia",
-	"ashgabad",
-	"azerbaijan",
-	"balashov",
-	"bashkiria",
-	"bryansk",
-	"bukhara",
-	"chimkent",
-	"dagestan",
-	"east-kazakhstan",
-	"exnet",
-	"georgia",
-	"grozny",
-	"ivanovo",
-	"jambyl",
-	"kalmykia",
-	"kaluga",
-	"karacol",
-	"karaganda",
-	"karelia",
-	"khakassia",
-	"krasnodar",
-	"kurgan",
-	"kustanai",
-	"lenug",
-	"mangyshlak",
-	"mordovia",
-	"msk",
-	"murmansk",
-	"nalchik",
-	"navoi",
-	"north-kazakhstan",
-	"nov",
-	"obninsk",
-	"penza",
-	"pokrovsk",
-	"sochi",
-	"spb",
-	"tashkent",
-	"termez",
-	"togliatti",
-	"troitsk",
-	"tselinograd",
-	"tula",
-	"tuva",
-	"vladikavkaz",
-	"vladimir",
-	"vologda",
-	"barsy",
-	"com",
-	"edu",
-	"gob",
-	"org",
-	"red",
-	"gov",
-	"com",
-	"edu",
-	"gov",
-	"mil",
-	"net",
-	"org",
-	"knightpoint",
-	"ac",
-	"co",
-	"org",
-	"ch",
-	"me",
-	"we",
+	"upaas",
+	"dev",
+	"sites",
+	"localhost",
+	"site",
 	"blogspot",
-	"discourse",
+	"forgot",
+	"forgot",
+	"prod",
+	"1",
+	"2",
+	"centralus",
+	"eastasia",
+	"eastus2",
+	"westeurope",
+	"westus2",
+	"r",
+	"u",
+	"fra1-de",
+	"west1-us",
+	"jls-sto1",
+	"jls-sto2",
+	"jls-sto3",
+	"freetls",
+	"map",
+	"prod",
+	"ssl",
+	"map",
+	"paas",
+	"hosting",
+	"webpaas",
 	"jelastic",
-	"co",
-	"ac",
-	"co",
-	"go",
-	"in",
-	"mi",
-	"net",
-	"online",
-	"or",
-	"shop",
-	"ac",
-	"biz",
-	"co",
-	"com",
-	"edu",
-	"go",
-	"gov",
-	"int",
-	"mil",
-	"name",
-	"net",
-	"nic",
-	"org",
-	"test",
-	"web",
-	"gov",
-	"co",
-	"com",
-	"edu",
-	"gov",
-	"mil",
-	"net",
-	"nom",
-	"org",
-	"com",
-	"ens",
-	"fin",
-	"gov",
-	"ind",
-	"info",
-	"intl",
-	"mincom",
-	"nat",
-	"net",
-	"orangecloud",
-	"org",
-	"perso",
-	"tourism",
-	"611",
-	"com",
-	"edu",
-	"gov",
-	"mil",
-	"net",
-	"nyan",
-	"org",
-	"oya",
-	"quickconnect",
-	"rdv",
-	"vpnplus",
-	"direct",
-	"prequalifyme",
-	"now-dns",
-	"ntdll",
-	"av",
-	"bbs",
-	"bel",
-	"biz",
-	"com",
-	"dr",
-	"edu",
-	"gen",
-	"gov",
-	"info",
-	"k12",
-	"kep",
-	"mil",
-	"name",
-	"nc",
-	"net",
-	"org",
-	"pol",
-	"tel",
-	"tsk",
-	"tv",
-	"web",
+	"nordeste-idc",
+	"j",
+	"soc",
+	"user",
+	"beta",
+	"pages",
+	"jelastic",
+	"jelastic",
+	"storage",
+	"website",
 	"blogspot",
-	"gov",
-	"ybo",
-	"aero",
-	"biz",
-	"co",
-	"com",
-	"coop",
-	"edu",
-	"gov",
-	"info",
-	"int",
-	"jobs",
-	"mobi",
-	"museum",
-	"name",
-	"net",
-	"org",
-	"pro",
-	"travel",
-	"better-than",
-	"dyndns",
-	"on-the-web",
-	"worse-than",
+	"gs",
+	"gs",
+	"nes",
+	"gs",
+	"nes",
+	"gs",
+	"os",
+	"valer",
+	"xn--vler-qoa",
+	"gs",
+	"gs",
+	"os",
+	"gs",
+	"heroy",
+	"sande",
+	"gs",
+	"gs",
+	"bo",
+	"heroy",
+	"xn--b-5ga",
+	"xn--hery-ira",
+	"gs",
+	"gs",
+	"gs",
+	"gs",
+	"valer",
+	"gs",
+	"gs",
+	"gs",
+	"gs",
+	"bo",
+	"xn--b-5ga",
+	"gs",
+	"gs",
+	"gs",
+	"sande",
+	"gs",
+	"sande",
+	"xn--hery-ira",
+	"xn--vler-qoa",
 	"blogspot",
-	"club",
-	"com",
-	"ebiz",
-	"edu",
-	"game",
-	"gov",
-	"idv",
-	"mil",
-	"net",
-	"org",
-	"url",
-	"xn--czrw28b",
-	"xn--uc0atv",
-	"xn--zf0ao64a",
-	"mymailer",
-	"ac",
-	"co",
+	"staging",
+	"tele",
+	"c",
+	"rsc",
+	"origin",
 	"go",
-	"hotel",
-	"info",
-	"me",
-	"mil",
-	"mobi",
-	"ne",
-	"or",
-	"sc",
-	"tv",
-	"biz",
-	"cc",
-	"cherkassy",
-	"cherkasy",
-	"chernigov",
-	"chernihiv",
-	"chernivtsi",
-	"chernovtsy",
-	"ck",
+	"home",
+	"al",
+	"asso",
+	"at",
+	"au",
+	"be",
+	"bg",
+	"ca",
+	"cd",
+	"ch",
 	"cn",
-	"co",
-	"com",
-	"cr",
-	"crimea",
-	"cv",
-	"cx",
-	"dn",
-	"dnepropetrovsk",
-	"dnipropetrovsk",
-	"donetsk",
-	"dp",
-	"edu",
-	"gov",
-	"if",
+	"cy",
+	"cz",
+	"de",
+	"dk",
+	"edu",
+	"ee",
+	"es",
+	"fi",
+	"fr",
+	"gr",
+	"hr",
+	"hu",
+	"ie",
+	"il",
 	"in",
-	"inf",
-	"ivano-frankivsk",
-	"kh",
-	"kharkiv",
-	"kharkov",
-	"kherson",
-	"khmelnitskiy",
-	"khmelnytskyi",
-	"kiev",
-	"kirovograd",
-	"km",
+	"int",
+	"is",
+	"it",
+	"jp",
 	"kr",
-	"krym",
-	"ks",
-	"kv",
-	"kyiv",
-	"lg",
 	"lt",
-	"ltd",
-	"lugansk",
-	"lutsk",
+	"lu",
 	"lv",
-	"lviv",
+	"mc",
+	"me",
 	"mk",
-	"mykolaiv",
+	"mt",
+	"my",
 	"net",
-	"nikolaev",
-	"od",
-	"odesa",
-	"odessa",
-	"org",
+	"ng",
+	"nl",
+	"no",
+	"nz",
+	"paris",
 	"pl",
-	"poltava",
-	"pp",
-	"rivne",
-	"rovno",
-	"rv",
-	"sb",
-	"sebastopol",
-	"sevastopol",
-	"sm",
-	"sumy",
-	"te",
-	"ternopil",
-	"uz",
-	"uzhgorod",
-	"v",
-	"vinnica",
-	"vinnytsia",
-	"vn",
-	"volyn",
-	"yalta",
-	"zaporizhzhe",
-	"zaporizhzhia",
-	"zhitomir",
-	"zhytomyr",
+	"pt",
+	"q-a",
+	"ro",
+	"ru",
+	"se",
+	"si",
+	"sk",
+	"tr",
+	"uk",
+	"us",
+	"cloud",
+	"os",
+	"stg",
+	"s3",
+	"ap",
+	"griw",
+	"ic",
+	"is",
+	"kmpsp",
+	"konsulat",
+	"kppsp",
+	"kwp",
+	"kwpsp",
+	"mup",
+	"mw",
+	"oirm",
+	"oum",
+	"pa",
+	"pinb",
+	"piw",
+	"po",
+	"psp",
+	"psse",
+	"pup",
+	"rzgw",
+	"sa",
+	"sdn",
+	"sko",
+	"so",
+	"sr",
+	"starostwo",
+	"ug",
+	"ugim",
+	"um",
+	"umig",
+	"upow",
+	"uppo",
+	"us",
+	"uw",
+	"uzs",
+	"wif",
+	"wiih",
+	"winb",
+	"wios",
+	"witd",
+	"wiw",
+	"wsa",
+	"wskr",
+	"wuoz",
+	"wzmiuw",
 	"zp",
-	"zt",
-	"ac",
+	"bci",
+	"shop",
+	"hb",
+	"vps",
+	"hosting",
+	"landing",
+	"spectrum",
+	"vps",
+	"jelastic",
+	"service",
+	"su",
+	"bc",
+	"ent",
+	"eu",
+	"us",
+	"direct",
 	"blogspot",
-	"co",
-	"com",
-	"go",
-	"ne",
-	"or",
-	"org",
-	"sc",
-	"ac",
-	"barsy",
-	"co",
-	"conn",
-	"copro",
 	"gov",
-	"hosp",
-	"ltd",
-	"me",
-	"net",
-	"nhs",
-	"org",
-	"plc",
-	"police",
-	"pymnt",
-	"sch",
+	"mymailer",
 	"adimo",
 	"barsy",
 	"barsyonline",
 	"blogspot",
 	"bytemark",
-	"gwiddle",
 	"layershift",
 	"myspreadshop",
 	"nh-serv",
 	"no-ip",
 	"retrosnub",
 	"wellbeingzone",
-	"dh",
-	"vm",
-	"j",
-	"cust",
+	"api",
+	"campaign",
 	"homeoffice",
 	"service",
 	"affinitylottery",
@@ -18146,79 +18868,6 @@ var nodeLabels = [...]string{
 	"lugs",
 	"raffleentry",
 	"weeklylottery",
-	"ak",
-	"al",
-	"ar",
-	"as",
-	"az",
-	"ca",
-	"cloudns",
-	"co",
-	"ct",
-	"dc",
-	"de",
-	"dni",
-	"drud",
-	"enscaled",
-	"fed",
-	"fl",
-	"freeddns",
-	"ga",
-	"golffan",
-	"graphox",
-	"gu",
-	"hi",
-	"ia",
-	"id",
-	"il",
-	"in",
-	"is-by",
-	"isa",
-	"kids",
-	"ks",
-	"ky",
-	"la",
-	"land-4-sale",
-	"ma",
-	"md",
-	"me",
-	"mi",
-	"mircloud",
-	"mn",
-	"mo",
-	"ms",
-	"mt",
-	"nc",
-	"nd",
-	"ne",
-	"nh",
-	"nj",
-	"nm",
-	"noip",
-	"nsn",
-	"nv",
-	"ny",
-	"oh",
-	"ok",
-	"or",
-	"pa",
-	"platterp",
-	"pointto",
-	"pr",
-	"ri",
-	"sc",
-	"sd",
-	"stuff-4-sale",
-	"tn",
-	"tx",
-	"ut",
-	"va",
-	"vi",
-	"vt",
-	"wa",
-	"wi",
-	"wv",
-	"wy",
 	"cc",
 	"k12",
 	"lib",
@@ -18285,9 +18934,6 @@ var nodeLabels = [...]string{
 	"cc",
 	"k12",
 	"lib",
-	"chtr",
-	"paroch",
-	"pvt",
 	"cc",
 	"k12",
 	"lib",
@@ -18390,162 +19036,185 @@ var nodeLabels = [...]string{
 	"cc",
 	"k12",
 	"lib",
-	"com",
-	"edu",
-	"gub",
-	"mil",
-	"net",
-	"org",
 	"blogspot",
-	"co",
-	"com",
-	"net",
-	"org",
-	"0e",
-	"com",
-	"edu",
-	"gov",
-	"gv",
-	"mil",
-	"net",
-	"org",
 	"d",
-	"arts",
-	"bib",
-	"co",
-	"com",
-	"e12",
-	"edu",
-	"firm",
-	"gob",
-	"gov",
-	"info",
-	"int",
-	"mil",
-	"net",
-	"nom",
-	"org",
-	"rar",
-	"rec",
-	"store",
-	"tec",
-	"web",
-	"at",
-	"co",
-	"com",
-	"k12",
-	"net",
-	"org",
-	"ac",
-	"biz",
-	"blogspot",
-	"com",
-	"edu",
-	"gov",
-	"health",
-	"info",
-	"int",
-	"name",
-	"net",
-	"org",
-	"pro",
-	"blog",
-	"cn",
-	"com",
-	"dev",
-	"edu",
-	"me",
-	"net",
-	"org",
-	"that",
-	"from",
-	"to",
-	"advisor",
-	"cloud66",
-	"com",
-	"dyndns",
-	"edu",
-	"gov",
-	"mypets",
-	"net",
-	"org",
-	"xn--80au",
-	"xn--90azh",
-	"xn--c1avg",
-	"xn--d1at",
-	"xn--o1ac",
-	"xn--o1ach",
-	"xn--55qx5d",
-	"xn--gmqw5a",
-	"xn--mxtq1m",
-	"xn--od0alg",
-	"xn--uc0atv",
-	"xn--wcvs22d",
-	"xn--12c1fe0br",
-	"xn--12cfi8ixb8l",
-	"xn--12co0c3b4eva",
-	"xn--h3cuzk1di",
-	"xn--m3ch0j3a",
-	"xn--o3cyx2a",
-	"xn--41a",
-	"xn--80aaa0cvac",
-	"xn--90a1af",
-	"xn--90amc",
-	"xn--c1avg",
-	"xn--h1ahn",
-	"xn--h1aliz",
-	"xn--j1adp",
-	"xn--j1aef",
-	"xn--j1ael8b",
-	"blogsite",
-	"crafting",
-	"localzone",
-	"telebit",
-	"zapto",
-	"com",
-	"edu",
-	"gov",
-	"mil",
-	"net",
-	"org",
-	"org",
-	"ac",
-	"agric",
-	"alt",
-	"co",
-	"edu",
-	"gov",
-	"grondar",
-	"law",
-	"mil",
-	"net",
-	"ngo",
-	"nic",
-	"nis",
-	"nom",
-	"org",
-	"school",
-	"tm",
-	"web",
 	"blogspot",
-	"ac",
-	"biz",
-	"co",
-	"com",
-	"edu",
-	"gov",
-	"info",
-	"mil",
-	"net",
-	"org",
-	"sch",
-	"cloud66",
-	"hs",
-	"lima",
-	"triton",
-	"ac",
-	"co",
-	"gov",
-	"mil",
-	"org",
+	"mel",
+	"schools",
+	"scale",
+	"alp1",
+	"eur",
+	"fr-par-1",
+	"fr-par-2",
+	"nl-ams-1",
+	"fnc",
+	"k8s",
+	"s3",
+	"s3-website",
+	"whm",
+	"priv",
+	"pub",
+	"k8s",
+	"s3",
+	"s3-website",
+	"whm",
+	"k8s",
+	"s3",
+	"s3-website",
+	"cn-north-1",
+	"compute",
+	"eb",
+	"elb",
+	"cloud9",
+	"cloud9",
+	"cloud9",
+	"dualstack",
+	"cloud9",
+	"dualstack",
+	"s3",
+	"s3-website",
+	"cloud9",
+	"cloud9",
+	"dualstack",
+	"s3",
+	"s3-website",
+	"cloud9",
+	"dualstack",
+	"cloud9",
+	"dualstack",
+	"cloud9",
+	"dualstack",
+	"s3",
+	"s3-website",
+	"cloud9",
+	"dualstack",
+	"s3",
+	"s3-website",
+	"cloud9",
+	"cloud9",
+	"cloud9",
+	"dualstack",
+	"cloud9",
+	"dualstack",
+	"s3",
+	"s3-website",
+	"cloud9",
+	"dualstack",
+	"s3",
+	"s3-website",
+	"cloud9",
+	"cloud9",
+	"dualstack",
+	"cloud9",
+	"dualstack",
+	"cloud9",
+	"dualstack",
+	"s3",
+	"s3-website",
+	"cloud9",
+	"cloud9",
+	"j",
+	"flt",
+	"usr",
+	"pages",
+	"cust",
+	"reservd",
+	"cust",
+	"reservd",
+	"cust",
+	"cust",
+	"reservd",
+	"jelastic",
+	"user",
+	"cdn",
+	"a",
+	"global",
+	"a",
+	"b",
+	"global",
+	"fr-1",
+	"lon-1",
+	"lon-2",
+	"ny-1",
+	"ny-2",
+	"sg-1",
+	"atl",
+	"njs",
+	"ric",
+	"ssl",
+	"app",
+	"os",
+	"dh",
+	"vm",
+	"j",
+	"cust",
+	"chtr",
+	"paroch",
+	"pvt",
+	"users",
+	"it1",
+	"functions",
+	"nodes",
+	"nodes",
+	"nodes",
+	"s3",
+	"cn-north-1",
+	"cn-northwest-1",
+	"vfs",
+	"webview-assets",
+	"vfs",
+	"webview-assets",
+	"vfs",
+	"webview-assets",
+	"s3",
+	"vfs",
+	"webview-assets",
+	"s3",
+	"vfs",
+	"webview-assets",
+	"vfs",
+	"webview-assets",
+	"s3",
+	"vfs",
+	"webview-assets",
+	"s3",
+	"vfs",
+	"webview-assets",
+	"s3",
+	"vfs",
+	"webview-assets",
+	"s3",
+	"vfs",
+	"webview-assets",
+	"s3",
+	"vfs",
+	"webview-assets",
+	"vfs",
+	"webview-assets",
+	"vfs",
+	"webview-assets",
+	"s3",
+	"vfs",
+	"webview-assets",
+	"s3",
+	"vfs",
+	"webview-assets",
+	"s3",
+	"vfs",
+	"webview-assets",
+	"vfs",
+	"webview-assets",
+	"s3",
+	"vfs",
+	"webview-assets",
+	"s3",
+	"vfs",
+	"webview-assets",
+	"s3",
+	"vfs",
+	"webview-assets",
+	"vfs",
+	"webview-assets",
+	"cloud",
+	"app",
 }
@@ -0,0 +1,266 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package publicsuffix
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultSource is the canonical location of the public suffix list.
+const DefaultSource = "https://publicsuffix.org/list/public_suffix_list.dat"
+
+// maxListSize limits the size of a list read by an Updater. The list is a
+// few hundred kilobytes.
+const maxListSize = 16 << 20
+
+// An Updater keeps a public suffix list current for a long-running program,
+// by periodically reading and parsing it, and replacing its list with the
+// result if that's valid. Until it first loads a list, an Updater uses the
+// same list as the package's functions, such as PublicSuffix.
+//
+// An Updater implements the cookiejar.PublicSuffixList interface. Its
+// methods are safe for concurrent use, and lookups never wait for an
+// update. Its fields must not be changed after its first update.
+type Updater struct {
+	// Source is the http or https URL, or the file name, of the list.
+	// If empty, DefaultSource is used.
+	Source string
+
+	// Interval is the time between updates made by Run.
+	// If zero, the list is updated every 24 hours.
+	Interval time.Duration
+
+	// Client is the HTTP client used to fetch the list.
+	// If nil, http.DefaultClient is used.
+	Client *http.Client
+
+	// Validate, if not nil, is called with each newly parsed list, and
+	// the list is discarded if it returns an error. prev is the list in
+	// use, or nil if the package's list is. If Validate is nil, a list
+	// is accepted if "com" is an ICANN rule and, to guard against
+	// truncation, it has at least half as many rules as prev.
+	Validate func(rs, prev *RuleSet) error
+
+	// ErrorLog specifies an optional logger for errors encountered by Run.
+	// If nil, logging is done via the log package's standard logger.
+	ErrorLog *log.Logger
+
+	cur atomic.Value // of *RuleSet
+
+	mu           sync.Mutex // serializes updates
+	etag         string
+	lastModified string
+}
+
+// RuleSet returns the list in use, or nil if that's the package's list.
+func (u *Updater) RuleSet() *RuleSet {
+	rs, _ := u.cur.Load().(*RuleSet)
+	return rs
+}
+
+// Lookup is like the package's Lookup function, but uses u's list.
+func (u *Updater) Lookup(domain string) (publicSuffix string, section Section) {
+	if rs := u.RuleSet(); rs != nil {
+		return rs.Lookup(domain)
+	}
+	return Lookup(domain)
+}
+
+// MatchingRule is like the package's MatchingRule function, but uses u's
+// list.
+func (u *Updater) MatchingRule(domain string) (rule Rule, ok bool) {
+	if rs := u.RuleSet(); rs != nil {
+		return rs.MatchingRule(domain)
+	}
+	return MatchingRule(domain)
+}
+
+// PublicSuffix returns the public suffix of domain using u's list.
+func (u *Updater) PublicSuffix(domain string) string {
+	ps, _ := u.Lookup(domain)
+	return ps
+}
+
+// EffectiveTLDPlusOne is like the package's EffectiveTLDPlusOne function,
+// but uses u's list.
+func (u *Updater) EffectiveTLDPlusOne(domain string) (string, error) {
+	return effectiveTLDPlusOne(domain, u.PublicSuffix)
+}
+
+// IsPublicSuffix is like the package's IsPublicSuffix function, but uses
+// u's list.
+func (u *Updater) IsPublicSuffix(domain string) bool {
+	return isPublicSuffix(domain, u.PublicSuffix)
+}
+
+// IsRegistrableDomain is like the package's IsRegistrableDomain function,
+// but uses u's list.
+func (u *Updater) IsRegistrableDomain(domain string) bool {
+	return isRegistrableDomain(domain, u.PublicSuffix)
+}
+
+// String returns a description of u's list.
+func (u *Updater) String() string {
+	if rs := u.RuleSet(); rs != nil {
+		return fmt.Sprintf("%v from %s", rs, u.source())
+	}
+	return list{}.String()
+}
+
+// Run updates u's list immediately and then every Interval, until ctx is
+// done. Errors are logged, and leave the previous list in use.
+func (u *Updater) Run(ctx context.Context) error {
+	interval := u.Interval
+	if interval <= 0 {
+		interval = 24 * time.Hour
+	}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		if err := u.Update(ctx); err != nil && ctx.Err() == nil {
+			u.logf("%v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// errNotModified is returned by read when the list at an HTTP source hasn't
+// changed since it was last read.
+var errNotModified = errors.New("not modified")
+
+// Update reads, parses, and validates the list once, and if it's valid,
+// puts it in use. The list in use is unchanged if Update returns an error,
+// or if an HTTP server reports that the list hasn't changed.
+func (u *Updater) Update(ctx context.Context) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	data, etag, lastModified, err := u.read(ctx)
+	if err == errNotModified {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("publicsuffix: reading %s: %v", u.source(), err)
+	}
+	rs, err := Parse(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	prev := u.RuleSet()
+	validate := u.Validate
+	if validate == nil {
+		validate = defaultValidate
+	}
+	if err := validate(rs, prev); err != nil {
+		return fmt.Errorf("publicsuffix: invalid list from %s: %v", u.source(), err)
+	}
+	u.cur.Store(rs)
+	// Remember the validators only now, so that a rejected list is
+	// fetched again rather than reported as not modified.
+	u.etag, u.lastModified = etag, lastModified
+	return nil
+}
+
+// read returns the contents of the list at u's source, and for an HTTP
+// source, the ETag and Last-Modified validators of the response.
+// u.mu must be held.
+func (u *Updater) read(ctx context.Context) (data []byte, etag, lastModified string, err error) {
+	src := u.source()
+	if !strings.HasPrefix(src, "http://") && !strings.HasPrefix(src, "https://") {
+		f, err := os.Open(src)
+		if err != nil {
+			return nil, "", "", err
+		}
+		defer f.Close()
+		data, err := readLimited(f)
+		return data, "", "", err
+	}
+
+	req, err := http.NewRequest("GET", src, nil)
+	if err != nil {
+		return nil, "", "", err
+	}
+	req = req.WithContext(ctx)
+	// Only send validators if a list from this source is in use.
+	if u.RuleSet() != nil {
+		if u.etag != "" {
+			req.Header.Set("If-None-Match", u.etag)
+		}
+		if u.lastModified != "" {
+			req.Header.Set("If-Modified-Since", u.lastModified)
+		}
+	}
+	c := u.Client
+	if c == nil {
+		c = http.DefaultClient
+	}
+	res, err := c.Do(req)
+	if err != nil {
+		return nil, "", "", err
+	}
+	defer res.Body.Close()
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotModified:
+		return nil, "", "", errNotModified
+	default:
+		return nil, "", "", fmt.Errorf("unexpected status %s", res.Status)
+	}
+	data, err = readLimited(res.Body)
+	if err != nil {
+		return nil, "", "", err
+	}
+	return data, res.Header.Get("Etag"), res.Header.Get("Last-Modified"), nil
+}
+
+func readLimited(r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxListSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxListSize {
+		return nil, fmt.Errorf("list larger than %d bytes", maxListSize)
+	}
+	return data, nil
+}
+
+func defaultValidate(rs, prev *RuleSet) error {
+	if _, section := rs.Lookup("com"); section != ICANN {
+		return errors.New(`no ICANN rule for "com"`)
+	}
+	if prev != nil && rs.Len() < prev.Len()/2 {
+		return fmt.Errorf("%d rules, down from %d", rs.Len(), prev.Len())
+	}
+	return nil
+}
+
+func (u *Updater) source() string {
+	if u.Source == "" {
+		return DefaultSource
+	}
+	return u.Source
+}
+
+func (u *Updater) logf(format string, args ...interface{}) {
+	if u.ErrorLog != nil {
+		u.ErrorLog.Printf(format, args...)
+	} else {
+		log.Printf(format, args...)
+	}
+}
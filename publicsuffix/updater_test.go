@@ -0,0 +1,209 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package publicsuffix
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+var _ cookiejar.PublicSuffixList = (*Updater)(nil)
+
+// A listServer serves a public suffix list, with an ETag.
+type listServer struct {
+	mu       sync.Mutex
+	list     string
+	etag     string
+	requests int
+	notMod   int  // number of 304 responses
+	missing  bool // respond 404 Not Found
+}
+
+func (s *listServer) set(list, etag string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list, s.etag = list, etag
+}
+
+func (s *listServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+	if s.missing {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Header.Get("If-None-Match") == s.etag {
+		s.notMod++
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Etag", s.etag)
+	io.WriteString(w, s.list)
+}
+
+func TestUpdater(t *testing.T) {
+	ls := &listServer{list: testList, etag: `"1"`}
+	ts := httptest.NewServer(ls)
+	defer ts.Close()
+	u := &Updater{Source: ts.URL, Client: ts.Client()}
+
+	// The compiled-in list is used until the first update.
+	if rs := u.RuleSet(); rs != nil {
+		t.Fatalf("RuleSet before update = %v; want nil", rs)
+	}
+	if got, want := u.String(), version; got != want {
+		t.Errorf("String before update = %q; want %q", got, want)
+	}
+	if ps, section := u.Lookup("example.org"); ps != "org" || section != ICANN {
+		t.Errorf(`Lookup("example.org") before update = %q, %v; want "org", ICANN`, ps, section)
+	}
+
+	ctx := context.Background()
+	if err := u.Update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if ps, section := u.Lookup("example.org"); ps != "org" || section != Unlisted {
+		t.Errorf(`Lookup("example.org") = %q, %v; want "org", Unlisted`, ps, section)
+	}
+	if got, err := u.EffectiveTLDPlusOne("www.foo.blogspot.co.uk"); got != "foo.blogspot.co.uk" || err != nil {
+		t.Errorf("EffectiveTLDPlusOne = %q, %v; want foo.blogspot.co.uk", got, err)
+	}
+	if got := u.String(); !strings.Contains(got, ts.URL) {
+		t.Errorf("String = %q; want it to name the source %q", got, ts.URL)
+	}
+
+	// An unchanged list isn't fetched again.
+	rs := u.RuleSet()
+	if err := u.Update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if ls.notMod != 1 || u.RuleSet() != rs {
+		t.Errorf("after update of unchanged list: %d 304 responses, RuleSet changed %v; want 1, false", ls.notMod, u.RuleSet() != rs)
+	}
+
+	// A changed list replaces the old one.
+	ls.set(testList+"org\n", `"2"`)
+	if err := u.Update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if ps, section := u.Lookup("example.org"); ps != "org" || section != Private {
+		t.Errorf(`Lookup("example.org") after change = %q, %v; want "org", Private`, ps, section)
+	}
+}
+
+func TestUpdaterRejects(t *testing.T) {
+	ls := &listServer{list: testList, etag: `"1"`}
+	ts := httptest.NewServer(ls)
+	defer ts.Close()
+	u := &Updater{Source: ts.URL, Client: ts.Client()}
+	ctx := context.Background()
+	if err := u.Update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	rs := u.RuleSet()
+
+	for _, tc := range []struct {
+		name string
+		list string
+	}{
+		{"unparsable", "foo.*.com\n"},
+		{"missing com", "// ===BEGIN ICANN DOMAINS===\nuk\n"},
+		{"truncated", "// ===BEGIN ICANN DOMAINS===\ncom\n"},
+	} {
+		ls.set(tc.list, tc.name)
+		if err := u.Update(ctx); err == nil {
+			t.Errorf("%s: Update succeeded; want error", tc.name)
+		}
+		if u.RuleSet() != rs {
+			t.Errorf("%s: RuleSet changed after failed update", tc.name)
+		}
+		// The rejected list is fetched and rejected again, not
+		// reported as unchanged.
+		if err := u.Update(ctx); err == nil {
+			t.Errorf("%s: second Update succeeded; want error", tc.name)
+		}
+	}
+
+	ls.mu.Lock()
+	ls.missing = true
+	ls.mu.Unlock()
+	if err := u.Update(ctx); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("Update with 404 response = %v; want error naming the status", err)
+	}
+	if u.RuleSet() != rs {
+		t.Errorf("RuleSet changed after 404 response")
+	}
+
+	u2 := &Updater{
+		Source: ts.URL,
+		Client: ts.Client(),
+		Validate: func(rs, prev *RuleSet) error {
+			return nil
+		},
+	}
+	ls.mu.Lock()
+	ls.missing = false
+	ls.mu.Unlock()
+	ls.set("uk\n", "custom")
+	if err := u2.Update(ctx); err != nil {
+		t.Errorf("Update with custom Validate: %v", err)
+	}
+}
+
+func TestUpdaterFile(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "public_suffix_list.dat")
+	if err := ioutil.WriteFile(name, []byte(testList), 0600); err != nil {
+		t.Fatal(err)
+	}
+	u := &Updater{Source: name}
+	if err := u.Update(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := u.PublicSuffix("foo.compute.example.com"), "foo.compute.example.com"; got != want {
+		t.Errorf("PublicSuffix = %q; want %q", got, want)
+	}
+
+	u = &Updater{Source: filepath.Join(t.TempDir(), "missing")}
+	if err := u.Update(context.Background()); err == nil {
+		t.Errorf("Update of missing file succeeded")
+	}
+}
+
+func TestUpdaterRun(t *testing.T) {
+	ls := &listServer{list: testList, etag: `"1"`}
+	ts := httptest.NewServer(ls)
+	defer ts.Close()
+	u := &Updater{Source: ts.URL, Client: ts.Client(), Interval: time.Millisecond}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- u.Run(ctx) }()
+	for {
+		ls.mu.Lock()
+		n := ls.requests
+		ls.mu.Unlock()
+		if n >= 3 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("Run = %v; want %v", err, context.Canceled)
+	}
+	if u.RuleSet() == nil {
+		t.Errorf("no list in use after Run")
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package publicsuffix
+
+import (
+	"regexp"
+	"time"
+)
+
+// A ListInfo describes the list compiled into the package.
+type ListInfo struct {
+	// Version describes the list's source, as List.String returns.
+	Version string
+
+	// Revision is the git revision of the list's repository,
+	// github.com/publicsuffix/list, or "" if it's unknown.
+	Revision string
+
+	// Date is the time of the list's revision, or the zero Time if
+	// it's unknown.
+	Date time.Time
+}
+
+// versionRE matches the version gen.go derives from publicsuffix.org's git
+// repository. The version is free-form if it was given with gen.go's
+// -version flag.
+var versionRE = regexp.MustCompile(`git revision ([0-9a-f]+) \(([^)]+)\)`)
+
+// CompiledListInfo returns a description of the list compiled into the
+// package.
+func CompiledListInfo() ListInfo {
+	return parseVersion(version)
+}
+
+func parseVersion(v string) ListInfo {
+	info := ListInfo{Version: v}
+	m := versionRE.FindStringSubmatch(v)
+	if m == nil {
+		return info
+	}
+	info.Revision = m[1]
+	if t, err := time.Parse(time.RFC3339, m[2]); err == nil {
+		info.Date = t
+	}
+	return info
+}
+
+// IsStale reports whether the list compiled into the package is more than
+// maxAge old, so that deployments can alert on outdated data. A list whose
+// date is unknown is stale.
+func IsStale(maxAge time.Duration) bool {
+	return CompiledListInfo().isStale(time.Now(), maxAge)
+}
+
+func (info ListInfo) isStale(now time.Time, maxAge time.Duration) bool {
+	return info.Date.IsZero() || now.Sub(info.Date) > maxAge
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package publicsuffix
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCompiledListInfo(t *testing.T) {
+	info := CompiledListInfo()
+	if info.Version != version || info.Version != List.String() {
+		t.Errorf("Version = %q; want %q", info.Version, version)
+	}
+	if info.Revision == "" || info.Date.IsZero() {
+		t.Errorf("CompiledListInfo() = %+v; want a revision and date", info)
+	}
+	if IsStale(time.Since(info.Date) + time.Hour) {
+		t.Errorf("IsStale with a max age longer than the list's = true")
+	}
+	if !IsStale(time.Since(info.Date) - time.Hour) {
+		t.Errorf("IsStale with a max age shorter than the list's = false")
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	date := time.Date(2021, 11, 26, 23, 5, 53, 0, time.UTC)
+	for _, tc := range []struct {
+		version      string
+		wantRevision string
+		wantDate     time.Time
+	}{
+		{
+			"publicsuffix.org's public_suffix_list.dat, git revision 3c213aab32b3c014f171b1673d4ce9b5cd72bf1c (2021-11-26T23:05:53Z)",
+			"3c213aab32b3c014f171b1673d4ce9b5cd72bf1c",
+			date,
+		},
+		{"git revision 5c70ccd250 (not a date)", "5c70ccd250", time.Time{}},
+		{"an explicit version string", "", time.Time{}},
+	} {
+		info := parseVersion(tc.version)
+		if info.Version != tc.version || info.Revision != tc.wantRevision || !info.Date.Equal(tc.wantDate) {
+			t.Errorf("parseVersion(%q) = %+v; want revision %q, date %v", tc.version, info, tc.wantRevision, tc.wantDate)
+		}
+	}
+
+	now := date.Add(48 * time.Hour)
+	if info := parseVersion("an explicit version string"); !info.isStale(now, 1000*time.Hour) {
+		t.Errorf("list of unknown date isn't stale")
+	}
+	info := ListInfo{Date: date}
+	if info.isStale(now, 72*time.Hour) || !info.isStale(now, 24*time.Hour) {
+		t.Errorf("isStale of 48 hour old list: got %v for 72h, %v for 24h; want false, true",
+			info.isStale(now, 72*time.Hour), info.isStale(now, 24*time.Hour))
+	}
+}
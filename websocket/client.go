@@ -6,6 +6,7 @@ package websocket
 
 import (
 	"bufio"
+	"context"
 	"io"
 	"net"
 	"net/http"
@@ -79,7 +80,14 @@ func parseAuthority(location *url.URL) string {
 
 // DialConfig opens a new client connection to a WebSocket with a config.
 func DialConfig(config *Config) (ws *Conn, err error) {
-	var client net.Conn
+	return config.DialContext(context.Background())
+}
+
+// DialContext opens a new client connection to a WebSocket, with a
+// context that bounds the connection attempt, including any proxy
+// and the opening handshake. Once the connection is established,
+// the context has no effect on it.
+func (config *Config) DialContext(ctx context.Context) (*Conn, error) {
 	if config.Location == nil {
 		return nil, &DialError{config, ErrBadWebSocketLocation}
 	}
@@ -90,17 +98,19 @@ func DialConfig(config *Config) (ws *Conn, err error) {
 	if dialer == nil {
 		dialer = &net.Dialer{}
 	}
-	client, err = dialWithDialer(dialer, config)
+	client, err := dialWithDialer(ctx, dialer, config)
 	if err != nil {
-		goto Error
+		return nil, &DialError{config, err}
 	}
-	ws, err = NewClient(config, client)
+	var ws *Conn
+	err = runWithContext(ctx, client, func() error {
+		var err error
+		ws, err = NewClient(config, client)
+		return err
+	})
 	if err != nil {
 		client.Close()
-		goto Error
+		return nil, &DialError{config, err}
 	}
-	return
-
-Error:
-	return nil, &DialError{config, err}
+	return ws, nil
 }
@@ -0,0 +1,380 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package websocket
+
+// This file implements the permessage-deflate extension.
+// https://tools.ietf.org/html/rfc7692
+
+import (
+	"bytes"
+	"compress/flate"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	permessageDeflate = "permessage-deflate"
+
+	maxWindowBits = 15
+	maxWindowSize = 1 << maxWindowBits
+)
+
+// deflateTail is appended to the payload of a compressed message
+// before decompressing it. It is the empty stored block removed by
+// the sender, followed by an empty final stored block that ends the
+// stream.
+var deflateTail = []byte{0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff}
+
+// CompressionOptions represents the parameters of the
+// permessage-deflate extension, as specified in RFC 7692.
+//
+// Compression cannot be limited to a sliding window smaller than
+// 32KB, so offers that require it are declined.
+type CompressionOptions struct {
+	// Level is the compression level, as defined by the
+	// compress/flate package. If zero, flate.DefaultCompression
+	// is used.
+	Level int
+
+	// ClientNoContextTakeover and ServerNoContextTakeover make
+	// the client and the server, respectively, compress each
+	// message on its own. The peer then doesn't keep a sliding
+	// window of up to 32KB between messages to decompress them,
+	// at the cost of a lower compression ratio.
+	ClientNoContextTakeover bool
+	ServerNoContextTakeover bool
+
+	// serverMaxWindowBits is the server_max_window_bits parameter
+	// of the offer accepted by a server, which it must echo in its
+	// response, or zero if the offer had none.
+	serverMaxWindowBits int
+}
+
+func (opts *CompressionOptions) level() int {
+	if opts.Level == 0 {
+		return flate.DefaultCompression
+	}
+	return opts.Level
+}
+
+// An extensionParam represents a parameter of an extension in the
+// Sec-WebSocket-Extensions header field.
+type extensionParam struct {
+	name, value string
+	hasValue    bool
+}
+
+// An extension represents an extension in the
+// Sec-WebSocket-Extensions header field.
+type extension struct {
+	name   string
+	params []extensionParam
+}
+
+// parseExtensions parses the Sec-WebSocket-Extensions header fields
+// hs. Quoted parameter values are unquoted.
+func parseExtensions(hs []string) []extension {
+	var exts []extension
+	for _, h := range hs {
+		for _, s := range strings.Split(h, ",") {
+			fields := strings.Split(s, ";")
+			ext := extension{name: strings.TrimSpace(fields[0])}
+			if ext.name == "" {
+				continue
+			}
+			for _, f := range fields[1:] {
+				var p extensionParam
+				p.name = strings.TrimSpace(f)
+				if i := strings.IndexByte(p.name, '='); i >= 0 {
+					p.value = strings.Trim(strings.TrimSpace(p.name[i+1:]), `"`)
+					p.name, p.hasValue = strings.TrimSpace(p.name[:i]), true
+				}
+				ext.params = append(ext.params, p)
+			}
+			exts = append(exts, ext)
+		}
+	}
+	return exts
+}
+
+func validWindowBits(p extensionParam) bool {
+	n, err := strconv.Atoi(p.value)
+	return err == nil && 8 <= n && n <= maxWindowBits
+}
+
+// compressionExtension returns the permessage-deflate extension,
+// either offered by a client or agreed by a server, with opts.
+func compressionExtension(opts *CompressionOptions) string {
+	s := permessageDeflate
+	if opts.ClientNoContextTakeover {
+		s += "; client_no_context_takeover"
+	}
+	if opts.ServerNoContextTakeover {
+		s += "; server_no_context_takeover"
+	}
+	if opts.serverMaxWindowBits != 0 {
+		s += "; server_max_window_bits=" + strconv.Itoa(opts.serverMaxWindowBits)
+	}
+	return s
+}
+
+// acceptCompression returns the parameters of the permessage-deflate
+// extension agreed by a client configured with opts, given the
+// Sec-WebSocket-Extensions header fields hs of the server response.
+// It returns nil if the server declined the extension.
+func acceptCompression(opts *CompressionOptions, hs []string) (*CompressionOptions, error) {
+	var agreed *CompressionOptions
+	for _, ext := range parseExtensions(hs) {
+		if ext.name != permessageDeflate || agreed != nil || opts == nil {
+			return nil, ErrUnsupportedExtensions
+		}
+		agreed = &CompressionOptions{
+			Level:                   opts.Level,
+			ClientNoContextTakeover: opts.ClientNoContextTakeover,
+			ServerNoContextTakeover: opts.ServerNoContextTakeover,
+		}
+		for _, p := range ext.params {
+			switch {
+			case p.name == "client_no_context_takeover" && !p.hasValue:
+				agreed.ClientNoContextTakeover = true
+			case p.name == "server_no_context_takeover" && !p.hasValue:
+				agreed.ServerNoContextTakeover = true
+			case p.name == "server_max_window_bits" && validWindowBits(p):
+				// Decompression always keeps a window of the
+				// maximum size.
+			default:
+				// client_max_window_bits is never offered.
+				return nil, ErrUnsupportedExtensions
+			}
+		}
+	}
+	return agreed, nil
+}
+
+// negotiateCompression returns the parameters of the
+// permessage-deflate extension agreed by a server configured with
+// opts, given the Sec-WebSocket-Extensions header fields hs of the
+// client request. It returns nil if none of the offers is acceptable.
+func negotiateCompression(opts *CompressionOptions, hs []string) *CompressionOptions {
+	if opts == nil {
+		return nil
+	}
+offers:
+	for _, ext := range parseExtensions(hs) {
+		if ext.name != permessageDeflate {
+			continue
+		}
+		agreed := &CompressionOptions{
+			Level:                   opts.Level,
+			ClientNoContextTakeover: opts.ClientNoContextTakeover,
+			ServerNoContextTakeover: opts.ServerNoContextTakeover,
+		}
+		for _, p := range ext.params {
+			switch {
+			case p.name == "client_no_context_takeover" && !p.hasValue:
+				agreed.ClientNoContextTakeover = true
+			case p.name == "server_no_context_takeover" && !p.hasValue:
+				agreed.ServerNoContextTakeover = true
+			case p.name == "server_max_window_bits" && validWindowBits(p):
+				if p.value != strconv.Itoa(maxWindowBits) {
+					continue offers
+				}
+				// RFC 7692 section 7.1.2.1 requires the
+				// response to include it.
+				agreed.serverMaxWindowBits = maxWindowBits
+			case p.name == "client_max_window_bits" && (!p.hasValue || validWindowBits(p)):
+				// The client may use any window size.
+			default:
+				continue offers
+			}
+		}
+		return agreed
+	}
+	return nil
+}
+
+// flateWriterPools holds flate writers by compression level, for
+// endpoints that don't keep a sliding window between messages.
+var flateWriterPools [flate.BestCompression - flate.HuffmanOnly + 1]sync.Pool
+
+// A deflater compresses the messages sent over a connection.
+type deflater struct {
+	level             int
+	noContextTakeover bool
+
+	buf bytes.Buffer
+	fw  *flate.Writer // kept between messages with context takeover
+}
+
+// compress returns the compressed payload of msg. It is only valid
+// until the next call.
+func (d *deflater) compress(msg []byte) ([]byte, error) {
+	d.buf.Reset()
+	fw := d.fw
+	if fw == nil {
+		var pool *sync.Pool
+		if flate.HuffmanOnly <= d.level && d.level <= flate.BestCompression {
+			pool = &flateWriterPools[d.level-flate.HuffmanOnly]
+		}
+		if pool != nil {
+			fw, _ = pool.Get().(*flate.Writer)
+		}
+		if fw != nil {
+			fw.Reset(&d.buf)
+		} else {
+			var err error
+			if fw, err = flate.NewWriter(&d.buf, d.level); err != nil {
+				return nil, err
+			}
+		}
+		if d.noContextTakeover {
+			defer pool.Put(fw)
+		} else {
+			d.fw = fw
+		}
+	}
+	if _, err := fw.Write(msg); err != nil {
+		return nil, err
+	}
+	if err := fw.Flush(); err != nil {
+		return nil, err
+	}
+	// Remove the empty stored block that ends the flush, as
+	// required by RFC 7692 section 7.2.1.
+	return bytes.TrimSuffix(d.buf.Bytes(), deflateTail[:4]), nil
+}
+
+// A deflateFrameWriter writes a message as a single compressed frame.
+type deflateFrameWriter struct {
+	frame    *hybiFrameWriter
+	deflater *deflater
+}
+
+func (w *deflateFrameWriter) Write(msg []byte) (int, error) {
+	b, err := w.deflater.compress(msg)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := w.frame.Write(b); err != nil {
+		return 0, err
+	}
+	return len(msg), nil
+}
+
+func (w *deflateFrameWriter) Close() error { return nil }
+
+// An inflater decompresses the messages received over a connection.
+type inflater struct {
+	noContextTakeover bool
+
+	fr   io.ReadCloser
+	dict []byte // last output, up to the window size, with context takeover
+}
+
+// An inflateFrameReader reads the decompressed payload of a message,
+// which starts with frame and may continue in later frames.
+type inflateFrameReader struct {
+	handler *hybiFrameHandler
+	frame   *hybiFrameReader
+	tail    []byte
+	eof     bool
+}
+
+func (handler *hybiFrameHandler) newInflateFrameReader(frame *hybiFrameReader) *inflateFrameReader {
+	r := &inflateFrameReader{handler: handler, frame: frame, tail: deflateTail}
+	in := handler.inflater
+	if in.fr == nil {
+		in.fr = flate.NewReaderDict(inflateSource{r}, in.dict)
+	} else {
+		in.fr.(flate.Resetter).Reset(inflateSource{r}, in.dict)
+	}
+	return r
+}
+
+func (r *inflateFrameReader) Read(msg []byte) (int, error) {
+	if r.eof {
+		return 0, io.EOF
+	}
+	in := r.handler.inflater
+	n, err := in.fr.Read(msg)
+	if !in.noContextTakeover && n > 0 {
+		b := msg[:n]
+		if len(b) > maxWindowSize {
+			b = b[len(b)-maxWindowSize:]
+		}
+		in.dict = append(in.dict, b...)
+		if l := len(in.dict); l > maxWindowSize {
+			copy(in.dict, in.dict[l-maxWindowSize:])
+			in.dict = in.dict[:maxWindowSize]
+		}
+	}
+	if err == io.EOF {
+		// Conn.Read drops the data read along with io.EOF.
+		r.eof = true
+		if n > 0 {
+			err = nil
+		}
+	}
+	return n, err
+}
+
+func (r *inflateFrameReader) PayloadType() byte { return r.frame.header.OpCode }
+
+func (r *inflateFrameReader) HeaderReader() io.Reader { return nil }
+
+func (r *inflateFrameReader) TrailerReader() io.Reader { return nil }
+
+func (r *inflateFrameReader) Len() int { return 0 }
+
+// An inflateSource reads the compressed payload of a message across
+// its frames, followed by deflateTail.
+type inflateSource struct {
+	r *inflateFrameReader
+}
+
+func (s inflateSource) Read(b []byte) (int, error) {
+	r := s.r
+	for r.frame != nil {
+		n, err := r.frame.Read(b)
+		if err != io.EOF {
+			return n, err
+		}
+		if r.frame.header.Fin {
+			r.frame = nil
+		} else if err := r.next(); err != nil {
+			return n, err
+		}
+		if n > 0 {
+			return n, nil
+		}
+	}
+	if len(r.tail) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(b, r.tail)
+	r.tail = r.tail[n:]
+	return n, nil
+}
+
+// next reads the next continuation frame of the message, handling
+// control frames on the way.
+func (r *inflateFrameReader) next() error {
+	for {
+		frame, err := r.handler.conn.frameReaderFactory.NewFrameReader()
+		if err != nil {
+			return err
+		}
+		frame, err = r.handler.HandleFrame(frame)
+		if err != nil {
+			return err
+		}
+		if frame != nil {
+			r.frame = frame.(*hybiFrameReader)
+			return nil
+		}
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package websocket
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNegotiateCompression(t *testing.T) {
+	server := &CompressionOptions{Level: flate.BestSpeed}
+	for i, tt := range []struct {
+		hs   []string
+		want *CompressionOptions
+	}{
+		{nil, nil},
+		{[]string{"x-webkit-deflate-frame"}, nil},
+		{[]string{"permessage-deflate"}, &CompressionOptions{Level: flate.BestSpeed}},
+		{[]string{"permessage-deflate; client_max_window_bits"}, &CompressionOptions{Level: flate.BestSpeed}},
+		{[]string{"permessage-deflate; client_no_context_takeover; server_no_context_takeover"}, &CompressionOptions{Level: flate.BestSpeed, ClientNoContextTakeover: true, ServerNoContextTakeover: true}},
+		{[]string{`permessage-deflate; server_max_window_bits="15"`}, &CompressionOptions{Level: flate.BestSpeed, serverMaxWindowBits: 15}},
+		{[]string{"permessage-deflate; server_max_window_bits=10"}, nil},
+		{[]string{"permessage-deflate; server_max_window_bits=10, permessage-deflate; client_no_context_takeover"}, &CompressionOptions{Level: flate.BestSpeed, ClientNoContextTakeover: true}},
+		{[]string{"permessage-deflate; unknown", "permessage-deflate"}, &CompressionOptions{Level: flate.BestSpeed}},
+	} {
+		got := negotiateCompression(server, tt.hs)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("#%d: got %+v; want %+v", i, got, tt.want)
+		}
+	}
+	if got := negotiateCompression(nil, []string{"permessage-deflate"}); got != nil {
+		t.Errorf("got %+v for a server without compression; want nil", got)
+	}
+}
+
+func TestAcceptCompression(t *testing.T) {
+	client := &CompressionOptions{ClientNoContextTakeover: true}
+	for i, tt := range []struct {
+		hs   []string
+		want *CompressionOptions
+		err  error
+	}{
+		{nil, nil, nil},
+		{[]string{"permessage-deflate"}, &CompressionOptions{ClientNoContextTakeover: true}, nil},
+		{[]string{"permessage-deflate; server_no_context_takeover; server_max_window_bits=12"}, &CompressionOptions{ClientNoContextTakeover: true, ServerNoContextTakeover: true}, nil},
+		{[]string{"permessage-deflate; client_max_window_bits=12"}, nil, ErrUnsupportedExtensions},
+		{[]string{"permessage-deflate, permessage-deflate"}, nil, ErrUnsupportedExtensions},
+		{[]string{"x-webkit-deflate-frame"}, nil, ErrUnsupportedExtensions},
+	} {
+		got, err := acceptCompression(client, tt.hs)
+		if err != tt.err || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("#%d: got %+v, %v; want %+v, %v", i, got, err, tt.want, tt.err)
+		}
+	}
+	if _, err := acceptCompression(nil, []string{"permessage-deflate"}); err != ErrUnsupportedExtensions {
+		t.Errorf("got %v for a client without compression; want %v", err, ErrUnsupportedExtensions)
+	}
+}
+
+func TestServerHandshakeCompression(t *testing.T) {
+	for i, tt := range []struct {
+		offer, response string
+	}{
+		{"permessage-deflate", "permessage-deflate"},
+		{"permessage-deflate; client_max_window_bits", "permessage-deflate"},
+		{"permessage-deflate; server_no_context_takeover", "permessage-deflate; server_no_context_takeover"},
+		{"permessage-deflate; server_max_window_bits=15", "permessage-deflate; server_max_window_bits=15"},
+		{
+			"permessage-deflate; client_no_context_takeover; server_max_window_bits=15; client_max_window_bits",
+			"permessage-deflate; client_no_context_takeover; server_max_window_bits=15",
+		},
+		{"permessage-deflate; server_max_window_bits=10, permessage-deflate", "permessage-deflate"},
+		{"permessage-deflate; server_max_window_bits=10", ""},
+	} {
+		config := &Config{Compression: &CompressionOptions{}}
+		handshaker := &hybiServerHandshaker{Config: config}
+		br := bufio.NewReader(strings.NewReader("GET /chat HTTP/1.1\r\n" +
+			"Host: server.example.com\r\n" +
+			"Upgrade: websocket\r\n" +
+			"Connection: Upgrade\r\n" +
+			"Sec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==\r\n" +
+			"Sec-WebSocket-Version: 13\r\n" +
+			"Sec-WebSocket-Extensions: " + tt.offer + "\r\n\r\n"))
+		req, err := http.ReadRequest(br)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := handshaker.ReadHandshake(br, req); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		var b bytes.Buffer
+		bw := bufio.NewWriter(&b)
+		if err := handshaker.AcceptHandshake(bw); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		lines := []string{
+			"HTTP/1.1 101 Switching Protocols",
+			"Upgrade: websocket",
+			"Connection: Upgrade",
+			"Sec-WebSocket-Accept: s3pPLMBiTxaQ9kYGzzhZRbK+xOo=",
+		}
+		if tt.response != "" {
+			lines = append(lines, "Sec-WebSocket-Extensions: "+tt.response)
+		}
+		if want := strings.Join(append(lines, "", ""), "\r\n"); b.String() != want {
+			t.Errorf("#%d: got %q; want %q", i, b.String(), want)
+		}
+	}
+}
+
+func newDeflateTestConn(wireData []byte, config *Config, request *http.Request) (*Conn, *bytes.Buffer) {
+	br := bufio.NewReader(bytes.NewBuffer(wireData))
+	out := new(bytes.Buffer)
+	bw := bufio.NewWriter(out)
+	return newHybiConn(config, bufio.NewReadWriter(br, bw), nil, request), out
+}
+
+// Examples from RFC 7692 section 7.2.3.
+func TestDeflateClientRead(t *testing.T) {
+	for i, tt := range []struct {
+		opts     *CompressionOptions
+		wireData []byte
+		want     []string
+	}{
+		{
+			&CompressionOptions{},
+			[]byte{0xc1, 0x07, 0xf2, 0x48, 0xcd, 0xc9, 0xc9, 0x07, 0x00},
+			[]string{"Hello"},
+		},
+		{
+			&CompressionOptions{},
+			[]byte{0x41, 0x03, 0xf2, 0x48, 0xcd,
+				0x89, 0x00, // ping
+				0x80, 0x04, 0xc9, 0xc9, 0x07, 0x00},
+			[]string{"Hello"},
+		},
+		{
+			&CompressionOptions{},
+			[]byte{0xc1, 0x07, 0xf2, 0x48, 0xcd, 0xc9, 0xc9, 0x07, 0x00,
+				0xc1, 0x05, 0xf2, 0x00, 0x11, 0x00, 0x00},
+			[]string{"Hello", "Hello"},
+		},
+		{
+			&CompressionOptions{ServerNoContextTakeover: true},
+			[]byte{0xc1, 0x07, 0xf2, 0x48, 0xcd, 0xc9, 0xc9, 0x07, 0x00,
+				0xc1, 0x07, 0xf2, 0x48, 0xcd, 0xc9, 0xc9, 0x07, 0x00,
+				0x81, 0x05, 'H', 'e', 'l', 'l', 'o'},
+			[]string{"Hello", "Hello", "Hello"},
+		},
+	} {
+		config := newConfig(t, "/")
+		config.Compression = tt.opts
+		conn, _ := newDeflateTestConn(tt.wireData, config, nil)
+		for _, want := range tt.want {
+			var msg string
+			if err := Message.Receive(conn, &msg); err != nil {
+				t.Fatalf("#%d: %v", i, err)
+			}
+			if msg != want {
+				t.Errorf("#%d: got %q; want %q", i, msg, want)
+			}
+		}
+		conn, _ = newDeflateTestConn(tt.wireData, config, nil)
+		b, err := io.ReadAll(conn)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if want := strings.Join(tt.want, ""); string(b) != want {
+			t.Errorf("#%d: got %q; want %q", i, b, want)
+		}
+	}
+}
+
+func TestDeflateClientReadNotNegotiated(t *testing.T) {
+	for i, tt := range []struct {
+		opts     *CompressionOptions
+		wireData []byte
+	}{
+		{nil, []byte{0xc1, 0x07, 0xf2, 0x48, 0xcd, 0xc9, 0xc9, 0x07, 0x00}},
+		{&CompressionOptions{}, []byte{0x41, 0x03, 0xf2, 0x48, 0xcd, 0xc0, 0x04, 0xc9, 0xc9, 0x07, 0x00}},
+		{&CompressionOptions{}, []byte{0xc9, 0x00}},
+		{&CompressionOptions{}, []byte{0xa1, 0x05, 'H', 'e', 'l', 'l', 'o'}},
+	} {
+		config := newConfig(t, "/")
+		config.Compression = tt.opts
+		conn, _ := newDeflateTestConn(tt.wireData, config, nil)
+		var msg string
+		if err := Message.Receive(conn, &msg); err == nil {
+			t.Errorf("#%d: got %q; want an error", i, msg)
+		}
+	}
+}
+
+func TestDeflateWrite(t *testing.T) {
+	text := strings.Repeat("The quick brown fox jumps over the lazy dog. ", 20)
+	config := newConfig(t, "/")
+	config.Compression = &CompressionOptions{}
+	conn, out := newDeflateTestConn(nil, config, new(http.Request))
+	for i := 0; i < 2; i++ {
+		if _, err := conn.Write([]byte(text)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wire := out.Bytes()
+	if len(wire) < 2 || wire[0] != 0xc1 || int(wire[1]) >= len(text) {
+		t.Fatalf("got %x; want compressed text frame", wire)
+	}
+	second := wire[2+int(wire[1]):]
+	if len(second) < 2 || second[0] != 0xc1 || second[1] >= wire[1] {
+		t.Fatalf("got second frame %x; want smaller compressed frame with context takeover", second)
+	}
+
+	// Read what was written, as a client.
+	rconfig := newConfig(t, "/")
+	rconfig.Compression = &CompressionOptions{}
+	rconn, _ := newDeflateTestConn(wire, rconfig, nil)
+	for i := 0; i < 2; i++ {
+		var msg string
+		if err := Message.Receive(rconn, &msg); err != nil {
+			t.Fatal(err)
+		}
+		if msg != text {
+			t.Errorf("#%d: got %q; want %q", i, msg, text)
+		}
+	}
+}
+
+func TestDeflateReceiveTooLarge(t *testing.T) {
+	var wire bytes.Buffer
+	config := newConfig(t, "/")
+	config.Compression = &CompressionOptions{ServerNoContextTakeover: true}
+	wconn, out := newDeflateTestConn(nil, config, new(http.Request))
+	if err := Message.Send(wconn, strings.Repeat("a", 1<<20)); err != nil {
+		t.Fatal(err)
+	}
+	if err := Message.Send(wconn, "small"); err != nil {
+		t.Fatal(err)
+	}
+	wire.Write(out.Bytes())
+
+	conn, _ := newDeflateTestConn(wire.Bytes(), config, nil)
+	conn.MaxPayloadBytes = 1024
+	var msg string
+	if err := Message.Receive(conn, &msg); err != ErrFrameTooLarge {
+		t.Fatalf("got %v; want %v", err, ErrFrameTooLarge)
+	}
+	if err := Message.Receive(conn, &msg); err != nil {
+		t.Fatal(err)
+	}
+	if msg != "small" {
+		t.Errorf("got %q; want %q", msg, "small")
+	}
+}
+
+func TestDeflateEcho(t *testing.T) {
+	for _, opts := range []CompressionOptions{
+		{},
+		{ClientNoContextTakeover: true, ServerNoContextTakeover: true},
+		{Level: flate.BestSpeed},
+	} {
+		t.Run(fmt.Sprintf("%+v", opts), func(t *testing.T) {
+			var serverCompression *CompressionOptions
+			s := Server{
+				Config: Config{Compression: &CompressionOptions{}},
+				Handler: func(ws *Conn) {
+					serverCompression = ws.Config().Compression
+					io.Copy(ws, ws)
+				},
+			}
+			ts := httptest.NewServer(s)
+			defer ts.Close()
+
+			config, err := NewConfig("ws"+strings.TrimPrefix(ts.URL, "http"), "http://localhost")
+			if err != nil {
+				t.Fatal(err)
+			}
+			o := opts
+			config.Compression = &o
+			ws, err := DialConfig(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ws.Close()
+			if config.Compression == nil {
+				t.Fatal("compression not negotiated")
+			}
+
+			for _, msg := range []string{"hello", strings.Repeat("hello, world ", 10000), "", "hello"} {
+				if err := Message.Send(ws, msg); err != nil {
+					t.Fatal(err)
+				}
+				var got string
+				for len(got) < len(msg) {
+					b := make([]byte, len(msg)-len(got))
+					n, err := ws.Read(b)
+					if err != nil {
+						t.Fatal(err)
+					}
+					got += string(b[:n])
+				}
+				if got != msg {
+					t.Fatalf("got %d bytes; want %d bytes", len(got), len(msg))
+				}
+			}
+			if serverCompression == nil {
+				t.Fatal("compression not in use by server")
+			}
+			if serverCompression.ClientNoContextTakeover != config.Compression.ClientNoContextTakeover ||
+				serverCompression.ServerNoContextTakeover != config.Compression.ServerNoContextTakeover {
+				t.Errorf("server agreed %+v; client agreed %+v", *serverCompression, *config.Compression)
+			}
+		})
+	}
+}
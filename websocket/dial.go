@@ -5,20 +5,189 @@
 package websocket
 
 import (
+	"bufio"
+	"context"
 	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"io"
 	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/proxy"
 )
 
-func dialWithDialer(dialer *net.Dialer, config *Config) (conn net.Conn, err error) {
+var aLongTimeAgo = time.Unix(1, 0)
+
+func dialWithDialer(ctx context.Context, dialer *net.Dialer, config *Config) (conn net.Conn, err error) {
 	switch config.Location.Scheme {
-	case "ws":
-		conn, err = dialer.Dial("tcp", parseAuthority(config.Location))
+	case "ws", "wss":
+	default:
+		return nil, ErrBadScheme
+	}
+	addr := parseAuthority(config.Location)
+	var proxyURL *url.URL
+	if config.Proxy != nil {
+		proxyURL, err = config.Proxy(proxyRequest(config.Location))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if proxyURL == nil {
+		if config.Location.Scheme == "wss" {
+			d := &tls.Dialer{NetDialer: dialer, Config: config.TlsConfig}
+			return d.DialContext(ctx, "tcp", addr)
+		}
+		return dialer.DialContext(ctx, "tcp", addr)
+	}
 
+	conn, err = dialProxy(ctx, dialer, proxyURL, addr)
+	if err != nil {
+		return nil, err
+	}
+	if config.Location.Scheme == "wss" {
+		tlsConn := tls.Client(conn, tlsClientConfig(config.TlsConfig, addr))
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tlsConn
+	}
+	return conn, nil
+}
+
+// proxyRequest returns the request passed to Config.Proxy for the
+// WebSocket server location, with the scheme mapped to the matching
+// HTTP one, so that http.ProxyFromEnvironment can be used.
+func proxyRequest(location *url.URL) *http.Request {
+	u := *location
+	switch u.Scheme {
+	case "ws":
+		u.Scheme = "http"
 	case "wss":
-		conn, err = tls.DialWithDialer(dialer, "tcp", parseAuthority(config.Location), config.TlsConfig)
+		u.Scheme = "https"
+	}
+	return &http.Request{Method: "GET", URL: &u, Host: u.Host, Header: make(http.Header)}
+}
 
-	default:
-		err = ErrBadScheme
+// tlsClientConfig returns a copy of config, with ServerName set to
+// the host of addr when empty.
+func tlsClientConfig(config *tls.Config, addr string) *tls.Config {
+	if config == nil {
+		config = &tls.Config{}
+	} else {
+		config = config.Clone()
+	}
+	if config.ServerName == "" {
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			host = addr
+		}
+		config.ServerName = host
+	}
+	return config
+}
+
+// dialProxy connects to addr through the proxy at u. HTTP and HTTPS
+// proxies are asked to open a tunnel with the CONNECT method; other
+// schemes, such as SOCKS5, are handled by the proxy package.
+func dialProxy(ctx context.Context, dialer *net.Dialer, u *url.URL, addr string) (net.Conn, error) {
+	switch u.Scheme {
+	case "http", "https":
+		return dialHTTPProxy(ctx, dialer, u, addr)
+	}
+	d, err := proxy.FromURL(u, dialer)
+	if err != nil {
+		return nil, err
+	}
+	if cd, ok := d.(proxy.ContextDialer); ok {
+		return cd.DialContext(ctx, "tcp", addr)
+	}
+	return d.Dial("tcp", addr)
+}
+
+func dialHTTPProxy(ctx context.Context, dialer *net.Dialer, u *url.URL, addr string) (net.Conn, error) {
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+	paddr := net.JoinHostPort(u.Hostname(), port)
+	conn, err := dialer.DialContext(ctx, "tcp", paddr)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "https" {
+		tlsConn := tls.Client(conn, tlsClientConfig(nil, paddr))
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tlsConn
+	}
+
+	req := &http.Request{
+		Method: "CONNECT",
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: make(http.Header),
+	}
+	if u.User != nil {
+		password, _ := u.User.Password()
+		auth := u.User.Username() + ":" + password
+		req.Header.Set("Proxy-Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(auth)))
+	}
+	var br *bufio.Reader
+	err = runWithContext(ctx, conn, func() error {
+		if err := req.Write(conn); err != nil {
+			return err
+		}
+		br = bufio.NewReader(conn)
+		resp, err := http.ReadResponse(br, req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return errors.New("websocket: proxy refused connection: " + resp.Status)
+		}
+		return nil
+	})
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if br.Buffered() > 0 {
+		return &bufferedConn{Conn: conn, r: br}, nil
+	}
+	return conn, nil
+}
+
+// A bufferedConn is a net.Conn whose first bytes were read ahead into
+// a buffer.
+type bufferedConn struct {
+	net.Conn
+	r io.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) { return c.r.Read(b) }
+
+// runWithContext runs f, which does I/O on conn, and interrupts it by
+// expiring the deadline of conn when ctx is done first.
+func runWithContext(ctx context.Context, conn net.Conn, f func() error) error {
+	done := make(chan error, 1)
+	go func() { done <- f() }()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		conn.SetDeadline(aLongTimeAgo)
+		<-done
+		conn.SetDeadline(time.Time{})
+		return ctx.Err()
 	}
-	return
 }
@@ -5,13 +5,21 @@
 package websocket
 
 import (
+	"bufio"
+	"context"
 	"crypto/tls"
 	"fmt"
+	"io"
 	"log"
 	"net"
+	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
+
+	"golang.org/x/net/internal/socks"
+	"golang.org/x/net/internal/sockstest"
 )
 
 // This test depend on Go 1.3+ because in earlier versions the Dialer won't be
@@ -41,3 +49,154 @@ func TestDialConfigTLSWithDialer(t *testing.T) {
 		t.Fatalf("expected timeout error, got %#v", neterr)
 	}
 }
+
+func TestDialContextTimeout(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		// Accept the connection but never answer the handshake.
+		c, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		io.Copy(io.Discard, c)
+	}()
+
+	config, _ := NewConfig(fmt.Sprintf("ws://%s/echo", ln.Addr()), "http://localhost")
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = config.DialContext(ctx)
+	dialerr, ok := err.(*DialError)
+	if !ok {
+		t.Fatalf("DialError expected, got %#v", err)
+	}
+	if dialerr.Err != context.DeadlineExceeded {
+		t.Fatalf("got %v; want %v", dialerr.Err, context.DeadlineExceeded)
+	}
+}
+
+// relay copies data between c and a new connection to addr, until
+// either side closes.
+func relay(c io.ReadWriter, addr string) error {
+	target, err := net.Dial("tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer target.Close()
+	go func() {
+		io.Copy(target, c)
+		target.Close()
+	}()
+	_, err = io.Copy(c, target)
+	return err
+}
+
+func startHTTPProxy(t *testing.T, auth string) net.Listener {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		c, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		br := bufio.NewReader(c)
+		req, err := http.ReadRequest(br)
+		if err != nil {
+			return
+		}
+		if req.Method != "CONNECT" || req.Header.Get("Proxy-Authorization") != auth {
+			io.WriteString(c, "HTTP/1.1 407 Proxy Authentication Required\r\n\r\n")
+			return
+		}
+		io.WriteString(c, "HTTP/1.1 200 Connection established\r\n\r\n")
+		relay(struct {
+			io.Reader
+			io.Writer
+		}{br, c}, req.Host)
+	}()
+	return ln
+}
+
+func testDialProxy(t *testing.T, proxyURL *url.URL) error {
+	once.Do(startServer)
+	config, _ := NewConfig(fmt.Sprintf("ws://%s/echo", serverAddr), "http://localhost")
+	return testDialProxyConfig(config, proxyURL)
+}
+
+func testDialProxyConfig(config *Config, proxyURL *url.URL) error {
+	config.Proxy = http.ProxyURL(proxyURL)
+	ws, err := config.DialContext(context.Background())
+	if err != nil {
+		return err
+	}
+	defer ws.Close()
+	if err := Message.Send(ws, "hello"); err != nil {
+		return err
+	}
+	var msg string
+	if err := Message.Receive(ws, &msg); err != nil {
+		return err
+	}
+	if msg != "hello" {
+		return fmt.Errorf("got %q; want %q", msg, "hello")
+	}
+	return nil
+}
+
+func TestDialHTTPProxy(t *testing.T) {
+	ln := startHTTPProxy(t, "Basic dXNlcjpwYXNzd29yZA==") // user:password
+	defer ln.Close()
+	if err := testDialProxy(t, &url.URL{Scheme: "http", User: url.UserPassword("user", "password"), Host: ln.Addr().String()}); err != nil {
+		t.Fatal(err)
+	}
+
+	ln = startHTTPProxy(t, "Basic dXNlcjpwYXNzd29yZA==")
+	defer ln.Close()
+	if err := testDialProxy(t, &url.URL{Scheme: "http", Host: ln.Addr().String()}); err == nil {
+		t.Fatal("dial through proxy without credentials succeeded")
+	}
+}
+
+func TestDialHTTPProxyTLS(t *testing.T) {
+	tlsServer := httptest.NewTLSServer(Handler(echoServer))
+	defer tlsServer.Close()
+	ln := startHTTPProxy(t, "")
+	defer ln.Close()
+
+	config, _ := NewConfig(fmt.Sprintf("wss://%s/echo", tlsServer.Listener.Addr()), "http://localhost")
+	config.TlsConfig = &tls.Config{InsecureSkipVerify: true}
+	if err := testDialProxyConfig(config, &url.URL{Scheme: "http", Host: ln.Addr().String()}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDialSOCKS5Proxy(t *testing.T) {
+	s, err := sockstest.NewServer(sockstest.NoAuthRequired, func(rw io.ReadWriter, b []byte) error {
+		req, err := sockstest.ParseCmdRequest(b)
+		if err != nil {
+			return err
+		}
+		b, err = sockstest.MarshalCmdReply(socks.Version5, socks.StatusSucceeded, &req.Addr)
+		if err != nil {
+			return err
+		}
+		if _, err := rw.Write(b); err != nil {
+			return err
+		}
+		return relay(rw, req.Addr.String())
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	if err := testDialProxy(t, &url.URL{Scheme: "socks5", Host: s.Addr().String()}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -48,14 +48,15 @@ var (
 	ErrNotImplemented        = &ProtocolError{"not implemented"}
 
 	handshakeHeader = map[string]bool{
-		"Host":                   true,
-		"Upgrade":                true,
-		"Connection":             true,
-		"Sec-Websocket-Key":      true,
-		"Sec-Websocket-Origin":   true,
-		"Sec-Websocket-Version":  true,
-		"Sec-Websocket-Protocol": true,
-		"Sec-Websocket-Accept":   true,
+		"Host":                     true,
+		"Upgrade":                  true,
+		"Connection":               true,
+		"Sec-Websocket-Key":        true,
+		"Sec-Websocket-Origin":     true,
+		"Sec-Websocket-Version":    true,
+		"Sec-Websocket-Protocol":   true,
+		"Sec-Websocket-Accept":     true,
+		"Sec-Websocket-Extensions": true,
 	}
 )
 
@@ -246,6 +247,7 @@ func (frame *hybiFrameWriter) Close() error { return nil }
 type hybiFrameWriterFactory struct {
 	*bufio.Writer
 	needMaskingKey bool
+	deflater       *deflater // nil unless permessage-deflate is in use
 }
 
 func (buf hybiFrameWriterFactory) NewFrameWriter(payloadType byte) (frame frameWriter, err error) {
@@ -256,12 +258,19 @@ func (buf hybiFrameWriterFactory) NewFrameWriter(payloadType byte) (frame frameW
 			return nil, err
 		}
 	}
-	return &hybiFrameWriter{writer: buf.Writer, header: frameHeader}, nil
+	w := &hybiFrameWriter{writer: buf.Writer, header: frameHeader}
+	if buf.deflater != nil && (payloadType == TextFrame || payloadType == BinaryFrame) {
+		frameHeader.Rsv[0] = true
+		return &deflateFrameWriter{frame: w, deflater: buf.deflater}, nil
+	}
+	return w, nil
 }
 
 type hybiFrameHandler struct {
 	conn        *Conn
 	payloadType byte
+	fragmented  bool      // in the middle of a fragmented message
+	inflater    *inflater // nil unless permessage-deflate is in use
 }
 
 func (handler *hybiFrameHandler) HandleFrame(frame frameReader) (frameReader, error) {
@@ -281,11 +290,35 @@ func (handler *hybiFrameHandler) HandleFrame(frame frameReader) (frameReader, er
 	if header := frame.HeaderReader(); header != nil {
 		io.Copy(ioutil.Discard, header)
 	}
+	hdr := &frame.(*hybiFrameReader).header
+	fin := hdr.Fin
+	// Only the first frame of a data message may have the RSV1 bit
+	// set, to mark it compressed with permessage-deflate.
+	if hdr.Rsv[1] || hdr.Rsv[2] || hdr.Rsv[0] && (handler.inflater == nil || hdr.OpCode != TextFrame && hdr.OpCode != BinaryFrame) {
+		handler.WriteClose(closeStatusProtocolError)
+		return nil, io.EOF
+	}
 	switch frame.PayloadType() {
 	case ContinuationFrame:
+		// A continuation frame must follow a non-final data frame,
+		// and a new data frame must not interleave with the
+		// fragments of a message. See RFC 6455 section 5.4.
+		if !handler.fragmented {
+			handler.WriteClose(closeStatusProtocolError)
+			return nil, io.EOF
+		}
 		frame.(*hybiFrameReader).header.OpCode = handler.payloadType
+		handler.fragmented = !fin
 	case TextFrame, BinaryFrame:
+		if handler.fragmented {
+			handler.WriteClose(closeStatusProtocolError)
+			return nil, io.EOF
+		}
 		handler.payloadType = frame.PayloadType()
+		handler.fragmented = !fin
+		if hdr.Rsv[0] {
+			return handler.newInflateFrameReader(frame.(*hybiFrameReader)), nil
+		}
 	case CloseFrame:
 		return nil, io.EOF
 	case PingFrame, PongFrame:
@@ -338,13 +371,20 @@ func newHybiConn(config *Config, buf *bufio.ReadWriter, rwc io.ReadWriteCloser,
 		bw := bufio.NewWriter(rwc)
 		buf = bufio.NewReadWriter(br, bw)
 	}
+	var d *deflater
+	var in *inflater
+	if opts := config.Compression; opts != nil {
+		isClient := request == nil
+		d = &deflater{level: opts.level(), noContextTakeover: isClient && opts.ClientNoContextTakeover || !isClient && opts.ServerNoContextTakeover}
+		in = &inflater{noContextTakeover: isClient && opts.ServerNoContextTakeover || !isClient && opts.ClientNoContextTakeover}
+	}
 	ws := &Conn{config: config, request: request, buf: buf, rwc: rwc,
 		frameReaderFactory: hybiFrameReaderFactory{buf.Reader},
 		frameWriterFactory: hybiFrameWriterFactory{
-			buf.Writer, request == nil},
+			Writer: buf.Writer, needMaskingKey: request == nil, deflater: d},
 		PayloadType:        TextFrame,
 		defaultCloseStatus: closeStatusNormal}
-	ws.frameHandler = &hybiFrameHandler{conn: ws}
+	ws.frameHandler = &hybiFrameHandler{conn: ws, inflater: in}
 	return ws
 }
 
@@ -426,7 +466,9 @@ func hybiClientHandshake(config *Config, br *bufio.Reader, bw *bufio.Writer) (er
 	if len(config.Protocol) > 0 {
 		bw.WriteString("Sec-WebSocket-Protocol: " + strings.Join(config.Protocol, ", ") + "\r\n")
 	}
-	// TODO(ukai): send Sec-WebSocket-Extensions.
+	if config.Compression != nil {
+		bw.WriteString("Sec-WebSocket-Extensions: " + compressionExtension(config.Compression) + "\r\n")
+	}
 	err = config.Header.WriteSubset(bw, handshakeHeader)
 	if err != nil {
 		return err
@@ -455,8 +497,9 @@ func hybiClientHandshake(config *Config, br *bufio.Reader, bw *bufio.Writer) (er
 	if resp.Header.Get("Sec-WebSocket-Accept") != string(expectedAccept) {
 		return ErrChallengeResponse
 	}
-	if resp.Header.Get("Sec-WebSocket-Extensions") != "" {
-		return ErrUnsupportedExtensions
+	config.Compression, err = acceptCompression(config.Compression, resp.Header.Values("Sec-WebSocket-Extensions"))
+	if err != nil {
+		return err
 	}
 	offeredProtocol := resp.Header.Get("Sec-WebSocket-Protocol")
 	if offeredProtocol != "" {
@@ -527,6 +570,7 @@ func (c *hybiServerHandshaker) ReadHandshake(buf *bufio.Reader, req *http.Reques
 			c.Protocol = append(c.Protocol, strings.TrimSpace(protocols[i]))
 		}
 	}
+	c.Compression = negotiateCompression(c.Compression, req.Header.Values("Sec-Websocket-Extensions"))
 	c.accept, err = getNonceAccept([]byte(key))
 	if err != nil {
 		return http.StatusInternalServerError, err
@@ -562,7 +606,9 @@ func (c *hybiServerHandshaker) AcceptHandshake(buf *bufio.Writer) (err error) {
 	if len(c.Protocol) > 0 {
 		buf.WriteString("Sec-WebSocket-Protocol: " + c.Protocol[0] + "\r\n")
 	}
-	// TODO(ukai): send Sec-WebSocket-Extensions.
+	if c.Compression != nil {
+		buf.WriteString("Sec-WebSocket-Extensions: " + compressionExtension(c.Compression) + "\r\n")
+	}
 	if c.Header != nil {
 		err := c.Header.WriteSubset(buf, handshakeHeader)
 		if err != nil {
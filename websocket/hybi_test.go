@@ -291,7 +291,7 @@ Sec-WebSocket-Version: 9
 
 func testHybiFrame(t *testing.T, testHeader, testPayload, testMaskedPayload []byte, frameHeader *hybiFrameHeader) {
 	b := bytes.NewBuffer([]byte{})
-	frameWriterFactory := &hybiFrameWriterFactory{bufio.NewWriter(b), false}
+	frameWriterFactory := &hybiFrameWriterFactory{Writer: bufio.NewWriter(b)}
 	w, _ := frameWriterFactory.NewFrameWriter(TextFrame)
 	w.(*hybiFrameWriter).header = frameHeader
 	_, err := w.Write(testPayload)
@@ -606,3 +606,63 @@ Sec-WebSocket-Version: 13
 		t.Errorf("handshake expected %q but got %q", expectedResponse, b.String())
 	}
 }
+
+func TestHybiClientReceiveFragmented(t *testing.T) {
+	wireData := []byte{0x01, 0x03, 'h', 'e', 'l', // text, not final
+		0x89, 0x05, 'h', 'e', 'l', 'l', 'o', // ping
+		0x00, 0x02, 'l', 'o', // continuation, not final
+		0x80, 0x00, // continuation, final
+		0x81, 0x05, 'w', 'o', 'r', 'l', 'd'}
+	br := bufio.NewReader(bytes.NewBuffer(wireData))
+	bw := bufio.NewWriter(bytes.NewBuffer([]byte{}))
+	conn := newHybiConn(newConfig(t, "/"), bufio.NewReadWriter(br, bw), nil, nil)
+
+	for _, want := range []string{"hello", "world"} {
+		var msg string
+		if err := Message.Receive(conn, &msg); err != nil {
+			t.Fatal(err)
+		}
+		if msg != want {
+			t.Errorf("got %q; want %q", msg, want)
+		}
+	}
+}
+
+func TestHybiClientReceiveFragmentedTooLarge(t *testing.T) {
+	wireData := []byte{0x01, 0x03, 'h', 'e', 'l', // text, not final
+		0x00, 0x02, 'l', 'o', // continuation, not final
+		0x80, 0x01, '!', // continuation, final
+		0x81, 0x05, 'w', 'o', 'r', 'l', 'd'}
+	br := bufio.NewReader(bytes.NewBuffer(wireData))
+	bw := bufio.NewWriter(bytes.NewBuffer([]byte{}))
+	conn := newHybiConn(newConfig(t, "/"), bufio.NewReadWriter(br, bw), nil, nil)
+	conn.MaxPayloadBytes = 5
+
+	var msg string
+	if err := Message.Receive(conn, &msg); err != ErrFrameTooLarge {
+		t.Fatalf("got %v; want %v", err, ErrFrameTooLarge)
+	}
+	if err := Message.Receive(conn, &msg); err != nil {
+		t.Fatal(err)
+	}
+	if msg != "world" {
+		t.Errorf("got %q; want %q", msg, "world")
+	}
+}
+
+func TestHybiClientReadBadContinuation(t *testing.T) {
+	for i, wireData := range [][]byte{
+		{0x80, 0x05, 'h', 'e', 'l', 'l', 'o'}, // continuation without a message
+		{0x01, 0x03, 'h', 'e', 'l', // text, not final
+			0x81, 0x02, 'l', 'o'}, // text interleaved with fragments
+	} {
+		br := bufio.NewReader(bytes.NewBuffer(wireData))
+		bw := bufio.NewWriter(bytes.NewBuffer([]byte{}))
+		conn := newHybiConn(newConfig(t, "/"), bufio.NewReadWriter(br, bw), nil, nil)
+
+		var msg string
+		if err := Message.Receive(conn, &msg); err != io.EOF {
+			t.Errorf("#%d: got %v; want %v", i, err, io.EOF)
+		}
+	}
+}
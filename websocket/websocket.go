@@ -101,6 +101,24 @@ type Config struct {
 	// Dialer used when opening websocket connections.
 	Dialer *net.Dialer
 
+	// Proxy returns the proxy to use for a client connection,
+	// given a request whose URL is the server location with the
+	// "ws" and "wss" schemes mapped to "http" and "https", so that
+	// http.ProxyFromEnvironment can be used. If Proxy is nil or
+	// returns a nil URL, no proxy is used.
+	//
+	// HTTP and HTTPS proxies are asked to open a tunnel with the
+	// CONNECT method, with basic authentication if the URL has user
+	// information. Other schemes, such as "socks5", are handled by
+	// the golang.org/x/net/proxy package.
+	Proxy func(*http.Request) (*url.URL, error)
+
+	// Compression enables the permessage-deflate extension with
+	// the given parameters when the peer supports it. After the
+	// handshake, it holds the agreed parameters, or nil if the
+	// extension is not in use.
+	Compression *CompressionOptions
+
 	handshakeData map[string]string
 }
 
@@ -321,12 +339,13 @@ func (cd Codec) Send(ws *Conn, v interface{}) (err error) {
 	return err
 }
 
-// Receive receives single frame from ws, unmarshaled by cd.Unmarshal and stores
-// in v. The whole frame payload is read to an in-memory buffer; max size of
-// payload is defined by ws.MaxPayloadBytes. If frame payload size exceeds
-// limit, ErrFrameTooLarge is returned; in this case frame is not read off wire
+// Receive receives single message from ws, unmarshaled by cd.Unmarshal and
+// stores in v. A message fragmented into several frames is reassembled. The
+// whole message payload is read to an in-memory buffer; max size of payload is
+// defined by ws.MaxPayloadBytes. If message payload size exceeds limit,
+// ErrFrameTooLarge is returned; in this case message is not read off wire
 // completely. The next call to Receive would read and discard leftover data of
-// previous oversized frame before processing next frame.
+// previous oversized message before processing next message.
 func (cd Codec) Receive(ws *Conn, v interface{}) (err error) {
 	ws.rio.Lock()
 	defer ws.rio.Unlock()
@@ -335,41 +354,84 @@ func (cd Codec) Receive(ws *Conn, v interface{}) (err error) {
 		if err != nil {
 			return err
 		}
+		fin := isFinalFrame(ws.frameReader)
 		ws.frameReader = nil
+		for !fin {
+			frame, err := ws.nextFrame()
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(ioutil.Discard, frame); err != nil {
+				return err
+			}
+			fin = isFinalFrame(frame)
+		}
 	}
-again:
-	frame, err := ws.frameReaderFactory.NewFrameReader()
-	if err != nil {
-		return err
-	}
-	frame, err = ws.frameHandler.HandleFrame(frame)
+	frame, err := ws.nextFrame()
 	if err != nil {
 		return err
 	}
-	if frame == nil {
-		goto again
-	}
 	maxPayloadBytes := ws.MaxPayloadBytes
 	if maxPayloadBytes == 0 {
 		maxPayloadBytes = DefaultMaxPayloadBytes
 	}
-	if hf, ok := frame.(*hybiFrameReader); ok && hf.header.Length > int64(maxPayloadBytes) {
-		// payload size exceeds limit, no need to call Unmarshal
-		//
-		// set frameReader to current oversized frame so that
-		// the next call to this function can drain leftover
-		// data before processing the next frame
-		ws.frameReader = frame
-		return ErrFrameTooLarge
-	}
 	payloadType := frame.PayloadType()
-	data, err := ioutil.ReadAll(frame)
-	if err != nil {
-		return err
+	var data []byte
+	for {
+		if hf, ok := frame.(*hybiFrameReader); ok && int64(len(data))+hf.header.Length > int64(maxPayloadBytes) {
+			// payload size exceeds limit, no need to call Unmarshal
+			//
+			// set frameReader to current oversized frame so that
+			// the next call to this function can drain leftover
+			// data before processing the next message
+			ws.frameReader = frame
+			return ErrFrameTooLarge
+		}
+		// The payload of a compressed message is only known
+		// after decompressing it.
+		b, err := ioutil.ReadAll(io.LimitReader(frame, int64(maxPayloadBytes-len(data))+1))
+		if err != nil {
+			return err
+		}
+		data = append(data, b...)
+		if len(data) > maxPayloadBytes {
+			ws.frameReader = frame
+			return ErrFrameTooLarge
+		}
+		if isFinalFrame(frame) {
+			break
+		}
+		if frame, err = ws.nextFrame(); err != nil {
+			return err
+		}
 	}
 	return cd.Unmarshal(data, payloadType, v)
 }
 
+// nextFrame reads frames from ws until one carrying data arrives,
+// handling control frames on the way.
+func (ws *Conn) nextFrame() (frameReader, error) {
+	for {
+		frame, err := ws.frameReaderFactory.NewFrameReader()
+		if err != nil {
+			return nil, err
+		}
+		frame, err = ws.frameHandler.HandleFrame(frame)
+		if err != nil {
+			return nil, err
+		}
+		if frame != nil {
+			return frame, nil
+		}
+	}
+}
+
+// isFinalFrame reports whether frame is the last frame of a message.
+func isFinalFrame(frame frameReader) bool {
+	hf, ok := frame.(*hybiFrameReader)
+	return !ok || hf.header.Fin
+}
+
 func marshal(v interface{}) (msg []byte, payloadType byte, err error) {
 	switch data := v.(type) {
 	case string: